@@ -3,6 +3,7 @@ package main
 import (
 	"flag"
 	"fmt"
+	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/types/pluginpb"
@@ -23,35 +24,129 @@ func main() {
 	var flags flag.FlagSet
 	languageFlag := flags.String("language", "all", "target language: go, java, python, or all")
 	commonNamespaceFlag := flags.String("common_namespace", "", "namespace for common classes/interfaces (e.g., 'common' or 'shared.transport')")
+	commonGoImportPathFlag := flags.String("common_go_import_path", "", "Go import path of the common_namespace package (e.g., 'github.com/acme/api/shared/transport')")
+	goModuleFlag := flags.String("go_module", "", "Go module path of the generated code; defaults to the module option")
+	pythonStubsFlag := flags.Bool("python_stubs", false, "also write .pyi stubs for generated Python modules")
+	pythonStyleFlag := flags.String("python_style", "dataclass", "Python message classes: dataclass or pydantic")
+	pythonAsyncServicesFlag := flags.Bool("python_async_services", false, "generate Python service interfaces with async methods")
+	goIntEnumsFlag := flags.String("go_int_enums", "number", "JSON form of Go integer enums: number or name")
+	javaStyleFlag := flags.String("java_style", "class", "Java message classes: class, records or immutable")
+	javaIntEnumsFlag := flags.String("java_int_enums", "number", "JSON form of Java integer enums: number or name")
+	sqlDialectFlag := flags.String("sql_dialect", "", "also write CREATE TABLE scripts and Go database/sql helpers: postgres or sqlite")
+	jsonNamingFlag := flags.String("json_naming", "camel", "JSON field names: camel, proto or custom (explicit json_name, else the proto name)")
+	configFlag := flags.String("config", "", "path to a YAML or JSON config file (e.g., 'puregen.yaml')")
+	goOutPrefixFlag := flags.String("go_out_prefix", "", "output root for Go files, relative to --puregen_out")
+	javaOutPrefixFlag := flags.String("java_out_prefix", "", "output root for Java files, relative to --puregen_out")
+	pythonOutPrefixFlag := flags.String("python_out_prefix", "", "output root for Python files, relative to --puregen_out")
+	sqlOutPrefixFlag := flags.String("sql_out_prefix", "", "output root for SQL files, relative to --puregen_out")
 
 	protogen.Options{
 		ParamFunc: flags.Set,
 	}.Run(func(gen *protogen.Plugin) error {
 		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 
-		language := *languageFlag
-		commonNamespace := *commonNamespaceFlag
+		config := generator.DefaultConfig()
+		if *configFlag != "" {
+			if err := config.LoadConfigFile(*configFlag); err != nil {
+				return err
+			}
+		}
+
+		// Explicit flags take precedence over the config file, including its
+		// per-package overrides
+		applyFlags := func(config *generator.Config) {
+			flags.Visit(func(f *flag.Flag) {
+				switch f.Name {
+				case "language":
+					config.Language = *languageFlag
+				case "common_namespace":
+					config.CommonNamespace = *commonNamespaceFlag
+				case "go_out_prefix":
+					config.Output.Go = *goOutPrefixFlag
+				case "java_out_prefix":
+					config.Output.Java = *javaOutPrefixFlag
+				case "python_out_prefix":
+					config.Output.Python = *pythonOutPrefixFlag
+				case "sql_out_prefix":
+					config.Output.SQL = *sqlOutPrefixFlag
+				case "common_go_import_path":
+					config.CommonGoImportPath = *commonGoImportPathFlag
+				case "go_module":
+					config.GoModule = *goModuleFlag
+				case "python_stubs":
+					config.Python.Stubs = *pythonStubsFlag
+				case "python_style":
+					config.Python.Style = *pythonStyleFlag
+				case "python_async_services":
+					config.Python.AsyncServices = *pythonAsyncServicesFlag
+				case "go_int_enums":
+					config.Go.IntEnums = *goIntEnumsFlag
+				case "java_style":
+					config.Java.Style = *javaStyleFlag
+				case "java_int_enums":
+					config.Java.IntEnums = *javaIntEnumsFlag
+				case "json_naming":
+					config.JSON.Naming = *jsonNamingFlag
+				case "sql_dialect":
+					config.SQL.Dialect = *sqlDialectFlag
+				}
+			})
+		}
+		applyFlags(config)
+		// protogen consumes the paths option itself, so read it from the raw parameter
+		if paths, ok := requestParameter(gen, "paths"); ok {
+			config.Paths = paths
+		}
+		// Likewise module, which also locates the common_namespace Go package
+		if module, ok := requestParameter(gen, "module"); ok && config.GoModule == "" {
+			config.GoModule = module
+		}
+		if err := config.Validate(); err != nil {
+			return err
+		}
+
+		// The generator state of the request, shared by the package configs.
+		// Package-level directives apply across all files of a package.
+		config.StartRequest(gen)
 
 		for _, f := range gen.Files {
 			if !f.Generate {
 				continue
 			}
 
-			switch language {
+			fileConfig, err := config.ForPackage(string(f.Desc.Package()))
+			if err != nil {
+				return err
+			}
+			if fileConfig != config {
+				applyFlags(fileConfig)
+			}
+
+			switch fileConfig.Language {
 			case "go":
-				generator.GenerateGoFile(gen, f, commonNamespace)
+				generator.GenerateGoFile(gen, f, fileConfig)
 			case "java":
-				generator.GenerateJavaFile(gen, f, commonNamespace)
+				generator.GenerateJavaFile(gen, f, fileConfig)
 			case "python":
-				generator.GeneratePythonFile(gen, f, commonNamespace)
+				generator.GeneratePythonFile(gen, f, fileConfig)
 			case "all":
-				generator.GenerateGoFile(gen, f, commonNamespace)
-				generator.GenerateJavaFile(gen, f, commonNamespace)
-				generator.GeneratePythonFile(gen, f, commonNamespace)
-			default:
-				return fmt.Errorf("unsupported language: %s", language)
+				generator.GenerateGoFile(gen, f, fileConfig)
+				generator.GenerateJavaFile(gen, f, fileConfig)
+				generator.GeneratePythonFile(gen, f, fileConfig)
 			}
+			generator.GenerateSQLFile(gen, f, fileConfig)
 		}
 		return nil
 	})
 }
+
+// requestParameter returns the value of a name=value plugin parameter
+func requestParameter(gen *protogen.Plugin, name string) (string, bool) {
+	for _, param := range strings.Split(gen.Request.GetParameter(), ",") {
+		key, value, _ := strings.Cut(param, "=")
+		if key == name {
+			return value, true
+		}
+	}
+	return "", false
+}
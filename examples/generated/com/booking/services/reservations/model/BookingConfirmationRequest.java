@@ -8,6 +8,17 @@ import com.fasterxml.jackson.annotation.*;
 import com.fasterxml.jackson.databind.*;
 
 public class BookingConfirmationRequest {
+    // Field paths, for applyMask and field masks
+    public static final String BookingConfirmationRequest_BookingIds_PATH = "bookingIds";
+    public static final String BookingConfirmationRequest_PaymentInfo_PATH = "paymentInfo";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.BookingConfirmationRequest", "BookingConfirmationRequest", "BookingConfirmationRequest",
+        Arrays.asList(
+            new PuregenFieldDescriptor("bookingIds", "bookingIds", "bookingIds", 1, "string", null, null, true, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("paymentInfo", "paymentInfo", "paymentInfo", 2, "message", "puregen.booking.reservations.PaymentInfo", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // Booking ID
     @JsonProperty("bookingIds")
     private List<String> bookingIds = new ArrayList<>();
@@ -60,19 +71,133 @@ public class BookingConfirmationRequest {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        BookingConfirmationRequest other = (BookingConfirmationRequest) o;
+        return Objects.equals(bookingIds, other.bookingIds)
+            && Objects.equals(paymentInfo, other.paymentInfo);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(bookingIds, paymentInfo);
+    }
+
+    @Override
+    public String toString() {
+        return "BookingConfirmationRequest{"
+            + "bookingIds=" + bookingIds
+            + ", paymentInfo=" + paymentInfo
+            + "}";
+    }
+
+    /** Returns a BookingConfirmationRequest with every field at its zero value, unlike new BookingConfirmationRequest(), which applies the field defaults. */
+    public static BookingConfirmationRequest zeroValue() {
+        BookingConfirmationRequest zero = new BookingConfirmationRequest();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new BookingConfirmationRequest.
+     */
+    public void applyMask(BookingConfirmationRequest src, Collection<String> paths) {
+        BookingConfirmationRequest source = src != null ? src : new BookingConfirmationRequest();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case BookingConfirmationRequest_BookingIds_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("BookingConfirmationRequest: field " + name + " has no subfields");
+                    }
+                    this.bookingIds = source.bookingIds != null ? new ArrayList<>(source.bookingIds) : new ArrayList<>();
+                    break;
+                case BookingConfirmationRequest_PaymentInfo_PATH:
+                    if (rest == null) {
+                        this.paymentInfo = source.paymentInfo;
+                    } else {
+                        if (this.paymentInfo == null) {
+                            this.paymentInfo = PaymentInfo.zeroValue();
+                        }
+                        this.paymentInfo.applyMask(source.paymentInfo, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("BookingConfirmationRequest: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(BookingConfirmationRequest src) {
+        if (src == null) {
+            return;
+        }
+        if (src.bookingIds != null) {
+            if (this.bookingIds == null) {
+                this.bookingIds = new ArrayList<>();
+            }
+            this.bookingIds.addAll(src.bookingIds);
+        }
+        if (src.paymentInfo != null) {
+            if (this.paymentInfo == null) {
+                this.paymentInfo = PaymentInfo.zeroValue();
+            }
+            this.paymentInfo.merge(src.paymentInfo);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(BookingConfirmationRequest src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(BookingConfirmationRequest src, PuregenFieldMask mask) {
+        BookingConfirmationRequest masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public BookingConfirmationRequest redacted() {
+        BookingConfirmationRequest copy = new BookingConfirmationRequest();
+        copy.bookingIds = bookingIds != null ? new ArrayList<>(bookingIds) : null;
+        copy.paymentInfo = paymentInfo != null ? paymentInfo.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static BookingConfirmationRequest fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, BookingConfirmationRequest.class);
+        return PuregenJson.fromJson(json, BookingConfirmationRequest.class);
     }
 
 }
@@ -9,6 +9,21 @@ import com.fasterxml.jackson.databind.*;
 
     // Information about the user making the booking request
 public class BookingHeader {
+    // Field paths, for applyMask and field masks
+    public static final String BookingHeader_UserId_PATH = "userId";
+    public static final String BookingHeader_ApplicationName_PATH = "applicationName";
+    public static final String BookingHeader_RequestId_PATH = "requestId";
+    public static final String BookingHeader_RequestTimestamp_PATH = "requestTimestamp";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.BookingHeader", "BookingHeader", "BookingHeader",
+        Arrays.asList(
+            new PuregenFieldDescriptor("userId", "userId", "userId", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("applicationName", "applicationName", "applicationName", 2, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("requestId", "requestId", "requestId", 3, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("requestTimestamp", "requestTimestamp", "requestTimestamp", 4, "int64", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // User who initiated the booking request
     @JsonProperty("userId")
     private String userId;
@@ -88,19 +103,147 @@ public class BookingHeader {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        BookingHeader other = (BookingHeader) o;
+        return Objects.equals(userId, other.userId)
+            && Objects.equals(applicationName, other.applicationName)
+            && Objects.equals(requestId, other.requestId)
+            && requestTimestamp == other.requestTimestamp;
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(userId, applicationName, requestId, requestTimestamp);
+    }
+
+    @Override
+    public String toString() {
+        return "BookingHeader{"
+            + "userId=" + (userId != null ? "'" + userId + "'" : null)
+            + ", applicationName=" + (applicationName != null ? "'" + applicationName + "'" : null)
+            + ", requestId=" + (requestId != null ? "'" + requestId + "'" : null)
+            + ", requestTimestamp=" + requestTimestamp
+            + "}";
+    }
+
+    /** Returns a BookingHeader with every field at its zero value, unlike new BookingHeader(), which applies the field defaults. */
+    public static BookingHeader zeroValue() {
+        BookingHeader zero = new BookingHeader();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new BookingHeader.
+     */
+    public void applyMask(BookingHeader src, Collection<String> paths) {
+        BookingHeader source = src != null ? src : new BookingHeader();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case BookingHeader_UserId_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("BookingHeader: field " + name + " has no subfields");
+                    }
+                    this.userId = source.userId;
+                    break;
+                case BookingHeader_ApplicationName_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("BookingHeader: field " + name + " has no subfields");
+                    }
+                    this.applicationName = source.applicationName;
+                    break;
+                case BookingHeader_RequestId_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("BookingHeader: field " + name + " has no subfields");
+                    }
+                    this.requestId = source.requestId;
+                    break;
+                case BookingHeader_RequestTimestamp_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("BookingHeader: field " + name + " has no subfields");
+                    }
+                    this.requestTimestamp = source.requestTimestamp;
+                    break;
+                default:
+                    throw new IllegalArgumentException("BookingHeader: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(BookingHeader src) {
+        if (src == null) {
+            return;
+        }
+        if (src.userId != null && !src.userId.isEmpty()) {
+            this.userId = src.userId;
+        }
+        if (src.applicationName != null && !src.applicationName.isEmpty()) {
+            this.applicationName = src.applicationName;
+        }
+        if (src.requestId != null && !src.requestId.isEmpty()) {
+            this.requestId = src.requestId;
+        }
+        if (src.requestTimestamp != 0) {
+            this.requestTimestamp = src.requestTimestamp;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(BookingHeader src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(BookingHeader src, PuregenFieldMask mask) {
+        BookingHeader masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public BookingHeader redacted() {
+        BookingHeader copy = new BookingHeader();
+        copy.userId = userId;
+        copy.applicationName = applicationName;
+        copy.requestId = requestId;
+        copy.requestTimestamp = requestTimestamp;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static BookingHeader fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, BookingHeader.class);
+        return PuregenJson.fromJson(json, BookingHeader.class);
     }
 
 }
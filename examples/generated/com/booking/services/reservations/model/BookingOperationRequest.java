@@ -8,6 +8,19 @@ import com.fasterxml.jackson.annotation.*;
 import com.fasterxml.jackson.databind.*;
 
 public class BookingOperationRequest {
+    // Field paths, for applyMask and field masks
+    public static final String BookingOperationRequest_OperationId_PATH = "operationId";
+    public static final String BookingOperationRequest_PaymentInfo_PATH = "paymentInfo";
+    public static final String BookingOperationRequest_Confirm_PATH = "confirm";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.BookingOperationRequest", "BookingOperationRequest", "BookingOperationRequest",
+        Arrays.asList(
+            new PuregenFieldDescriptor("operationId", "operationId", "operationId", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("paymentInfo", "paymentInfo", "paymentInfo", 2, "message", "puregen.booking.reservations.PaymentInfo", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("confirm", "confirm", "confirm", 3, "bool", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // Operation ID
     @JsonProperty("operationId")
     private String operationId;
@@ -70,19 +83,142 @@ public class BookingOperationRequest {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        BookingOperationRequest other = (BookingOperationRequest) o;
+        return Objects.equals(operationId, other.operationId)
+            && Objects.equals(paymentInfo, other.paymentInfo)
+            && confirm == other.confirm;
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(operationId, paymentInfo, confirm);
+    }
+
+    @Override
+    public String toString() {
+        return "BookingOperationRequest{"
+            + "operationId=" + (operationId != null ? "'" + operationId + "'" : null)
+            + ", paymentInfo=" + paymentInfo
+            + ", confirm=" + confirm
+            + "}";
+    }
+
+    /** Returns a BookingOperationRequest with every field at its zero value, unlike new BookingOperationRequest(), which applies the field defaults. */
+    public static BookingOperationRequest zeroValue() {
+        BookingOperationRequest zero = new BookingOperationRequest();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new BookingOperationRequest.
+     */
+    public void applyMask(BookingOperationRequest src, Collection<String> paths) {
+        BookingOperationRequest source = src != null ? src : new BookingOperationRequest();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case BookingOperationRequest_OperationId_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("BookingOperationRequest: field " + name + " has no subfields");
+                    }
+                    this.operationId = source.operationId;
+                    break;
+                case BookingOperationRequest_PaymentInfo_PATH:
+                    if (rest == null) {
+                        this.paymentInfo = source.paymentInfo;
+                    } else {
+                        if (this.paymentInfo == null) {
+                            this.paymentInfo = PaymentInfo.zeroValue();
+                        }
+                        this.paymentInfo.applyMask(source.paymentInfo, List.of(rest));
+                    }
+                    break;
+                case BookingOperationRequest_Confirm_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("BookingOperationRequest: field " + name + " has no subfields");
+                    }
+                    this.confirm = source.confirm;
+                    break;
+                default:
+                    throw new IllegalArgumentException("BookingOperationRequest: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(BookingOperationRequest src) {
+        if (src == null) {
+            return;
+        }
+        if (src.operationId != null && !src.operationId.isEmpty()) {
+            this.operationId = src.operationId;
+        }
+        if (src.paymentInfo != null) {
+            if (this.paymentInfo == null) {
+                this.paymentInfo = PaymentInfo.zeroValue();
+            }
+            this.paymentInfo.merge(src.paymentInfo);
+        }
+        if (src.confirm) {
+            this.confirm = src.confirm;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(BookingOperationRequest src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(BookingOperationRequest src, PuregenFieldMask mask) {
+        BookingOperationRequest masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public BookingOperationRequest redacted() {
+        BookingOperationRequest copy = new BookingOperationRequest();
+        copy.operationId = operationId;
+        copy.paymentInfo = paymentInfo != null ? paymentInfo.redacted() : null;
+        copy.confirm = confirm;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static BookingOperationRequest fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, BookingOperationRequest.class);
+        return PuregenJson.fromJson(json, BookingOperationRequest.class);
     }
 
 }
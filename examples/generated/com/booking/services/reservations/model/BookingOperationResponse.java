@@ -9,6 +9,19 @@ import com.fasterxml.jackson.databind.*;
 
     // Response for booking operations
 public class BookingOperationResponse {
+    // Field paths, for applyMask and field masks
+    public static final String BookingOperationResponse_OperationId_PATH = "operationId";
+    public static final String BookingOperationResponse_Status_PATH = "status";
+    public static final String BookingOperationResponse_Error_PATH = "error";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.BookingOperationResponse", "BookingOperationResponse", "BookingOperationResponse",
+        Arrays.asList(
+            new PuregenFieldDescriptor("operationId", "operationId", "operationId", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("status", "status", "status", 2, "enum", "puregen.booking.reservations.BookingStatus", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("error", "error", "error", 3, "message", "puregen.booking.reservations.Error", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // Operation ID
     @JsonProperty("operationId")
     private String operationId;
@@ -71,19 +84,142 @@ public class BookingOperationResponse {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        BookingOperationResponse other = (BookingOperationResponse) o;
+        return Objects.equals(operationId, other.operationId)
+            && Objects.equals(status, other.status)
+            && Objects.equals(error, other.error);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(operationId, status, error);
+    }
+
+    @Override
+    public String toString() {
+        return "BookingOperationResponse{"
+            + "operationId=" + (operationId != null ? "'" + operationId + "'" : null)
+            + ", status=" + (status != null ? "'" + status + "'" : null)
+            + ", error=" + error
+            + "}";
+    }
+
+    /** Returns a BookingOperationResponse with every field at its zero value, unlike new BookingOperationResponse(), which applies the field defaults. */
+    public static BookingOperationResponse zeroValue() {
+        BookingOperationResponse zero = new BookingOperationResponse();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new BookingOperationResponse.
+     */
+    public void applyMask(BookingOperationResponse src, Collection<String> paths) {
+        BookingOperationResponse source = src != null ? src : new BookingOperationResponse();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case BookingOperationResponse_OperationId_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("BookingOperationResponse: field " + name + " has no subfields");
+                    }
+                    this.operationId = source.operationId;
+                    break;
+                case BookingOperationResponse_Status_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("BookingOperationResponse: field " + name + " has no subfields");
+                    }
+                    this.status = source.status;
+                    break;
+                case BookingOperationResponse_Error_PATH:
+                    if (rest == null) {
+                        this.error = source.error;
+                    } else {
+                        if (this.error == null) {
+                            this.error = Error.zeroValue();
+                        }
+                        this.error.applyMask(source.error, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("BookingOperationResponse: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(BookingOperationResponse src) {
+        if (src == null) {
+            return;
+        }
+        if (src.operationId != null && !src.operationId.isEmpty()) {
+            this.operationId = src.operationId;
+        }
+        if (src.status != null && !src.status.isEmpty()) {
+            this.status = src.status;
+        }
+        if (src.error != null) {
+            if (this.error == null) {
+                this.error = Error.zeroValue();
+            }
+            this.error.merge(src.error);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(BookingOperationResponse src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(BookingOperationResponse src, PuregenFieldMask mask) {
+        BookingOperationResponse masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public BookingOperationResponse redacted() {
+        BookingOperationResponse copy = new BookingOperationResponse();
+        copy.operationId = operationId;
+        copy.status = status;
+        copy.error = error != null ? error.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static BookingOperationResponse fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, BookingOperationResponse.class);
+        return PuregenJson.fromJson(json, BookingOperationResponse.class);
     }
 
 }
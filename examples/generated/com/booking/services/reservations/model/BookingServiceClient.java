@@ -14,7 +14,7 @@ public class BookingServiceClient {
     // Starts hotel reservation process for given search criteria and returns operation ID
     public HotelReservationResponse startHotelReservation(Map<String, Object> ctx, HotelReservationRequest request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_StartHotelReservation);
+        Map<String, Object> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_StartHotelReservation);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -24,7 +24,7 @@ public class BookingServiceClient {
     // Describes hotel reservation operations
     public HotelReservationResponse describeHotelReservation(Map<String, Object> ctx, HotelReservationRequest request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_DescribeHotelReservation);
+        Map<String, Object> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_DescribeHotelReservation);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -34,7 +34,7 @@ public class BookingServiceClient {
     // Gets hotel reservation details for given operation ID
     public HotelReservationResponse getHotelReservationResult(Map<String, Object> ctx, HotelReservationRequest request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_GetHotelReservationResult);
+        Map<String, Object> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_GetHotelReservationResult);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -44,7 +44,7 @@ public class BookingServiceClient {
     // Starts flight booking operation and returns operation ID
     public FlightBookingResponse startFlightBooking(Map<String, Object> ctx, FlightBookingRequest request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_StartFlightBooking);
+        Map<String, Object> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_StartFlightBooking);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -54,7 +54,7 @@ public class BookingServiceClient {
     // Describes flight booking operations
     public FlightBookingResponse describeFlightBooking(Map<String, Object> ctx, FlightBookingRequest request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_DescribeFlightBooking);
+        Map<String, Object> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_DescribeFlightBooking);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -64,7 +64,7 @@ public class BookingServiceClient {
     // Gets flight booking results for given operation ID
     public FlightBookingResponse getFlightBookingResult(Map<String, Object> ctx, FlightBookingRequest request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_GetFlightBookingResult);
+        Map<String, Object> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_GetFlightBookingResult);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -74,7 +74,7 @@ public class BookingServiceClient {
     // Starts travel package booking operation and returns operation ID
     public TravelPackageBookingResponse startTravelPackageBooking(Map<String, Object> ctx, TravelPackageBookingRequest request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_StartTravelPackageBooking);
+        Map<String, Object> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_StartTravelPackageBooking);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -84,7 +84,7 @@ public class BookingServiceClient {
     // Describes travel package booking operations
     public TravelPackageBookingResponse describeTravelPackageBooking(Map<String, Object> ctx, TravelPackageBookingRequest request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_DescribeTravelPackageBooking);
+        Map<String, Object> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_DescribeTravelPackageBooking);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -94,7 +94,7 @@ public class BookingServiceClient {
     // Gets travel package booking results for given operation ID
     public TravelPackageBookingResponse getTravelPackageBookingResult(Map<String, Object> ctx, TravelPackageBookingRequest request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_GetTravelPackageBookingResult);
+        Map<String, Object> methodMetadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_GetTravelPackageBookingResult);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -17,7 +17,7 @@ public final class BookingServiceMethods {
     public static final String BookingService_DescribeTravelPackageBooking = "BookingService_DescribeTravelPackageBooking";
     public static final String BookingService_GetTravelPackageBookingResult = "BookingService_GetTravelPackageBookingResult";
 
-    public static final Map<String, Map<String, String>> METHOD_METADATA = new HashMap<>();
+    public static final Map<String, Map<String, Object>> METHOD_METADATA = new HashMap<>();
     static {
     }
 }
@@ -2,6 +2,8 @@
 
 package com.booking.services.reservations.model;
 
+import java.util.*;
+
     /**
      * Booking Service provides comprehensive reservation management capabilities including
      * hotel bookings, flight reservations, and travel package management.
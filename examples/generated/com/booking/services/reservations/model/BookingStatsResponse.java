@@ -8,6 +8,19 @@ import com.fasterxml.jackson.annotation.*;
 import com.fasterxml.jackson.databind.*;
 
 public class BookingStatsResponse {
+    // Field paths, for applyMask and field masks
+    public static final String BookingStatsResponse_TotalAmountCharged_PATH = "totalAmountCharged";
+    public static final String BookingStatsResponse_TotalGuests_PATH = "totalGuests";
+    public static final String BookingStatsResponse_TotalBookings_PATH = "totalBookings";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.BookingStatsResponse", "BookingStatsResponse", "BookingStatsResponse",
+        Arrays.asList(
+            new PuregenFieldDescriptor("totalAmountCharged", "totalAmountCharged", "totalAmountCharged", 1, "double", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("totalGuests", "totalGuests", "totalGuests", 2, "int32", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("totalBookings", "totalBookings", "totalBookings", 3, "int32", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // Total amount charged
     @JsonProperty("totalAmountCharged")
     private double totalAmountCharged;
@@ -70,19 +83,135 @@ public class BookingStatsResponse {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        BookingStatsResponse other = (BookingStatsResponse) o;
+        return Double.compare(totalAmountCharged, other.totalAmountCharged) == 0
+            && totalGuests == other.totalGuests
+            && totalBookings == other.totalBookings;
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(totalAmountCharged, totalGuests, totalBookings);
+    }
+
+    @Override
+    public String toString() {
+        return "BookingStatsResponse{"
+            + "totalAmountCharged=" + totalAmountCharged
+            + ", totalGuests=" + totalGuests
+            + ", totalBookings=" + totalBookings
+            + "}";
+    }
+
+    /** Returns a BookingStatsResponse with every field at its zero value, unlike new BookingStatsResponse(), which applies the field defaults. */
+    public static BookingStatsResponse zeroValue() {
+        BookingStatsResponse zero = new BookingStatsResponse();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new BookingStatsResponse.
+     */
+    public void applyMask(BookingStatsResponse src, Collection<String> paths) {
+        BookingStatsResponse source = src != null ? src : new BookingStatsResponse();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case BookingStatsResponse_TotalAmountCharged_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("BookingStatsResponse: field " + name + " has no subfields");
+                    }
+                    this.totalAmountCharged = source.totalAmountCharged;
+                    break;
+                case BookingStatsResponse_TotalGuests_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("BookingStatsResponse: field " + name + " has no subfields");
+                    }
+                    this.totalGuests = source.totalGuests;
+                    break;
+                case BookingStatsResponse_TotalBookings_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("BookingStatsResponse: field " + name + " has no subfields");
+                    }
+                    this.totalBookings = source.totalBookings;
+                    break;
+                default:
+                    throw new IllegalArgumentException("BookingStatsResponse: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(BookingStatsResponse src) {
+        if (src == null) {
+            return;
+        }
+        if (src.totalAmountCharged != 0) {
+            this.totalAmountCharged = src.totalAmountCharged;
+        }
+        if (src.totalGuests != 0) {
+            this.totalGuests = src.totalGuests;
+        }
+        if (src.totalBookings != 0) {
+            this.totalBookings = src.totalBookings;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(BookingStatsResponse src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(BookingStatsResponse src, PuregenFieldMask mask) {
+        BookingStatsResponse masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public BookingStatsResponse redacted() {
+        BookingStatsResponse copy = new BookingStatsResponse();
+        copy.totalAmountCharged = totalAmountCharged;
+        copy.totalGuests = totalGuests;
+        copy.totalBookings = totalBookings;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static BookingStatsResponse fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, BookingStatsResponse.class);
+        return PuregenJson.fromJson(json, BookingStatsResponse.class);
     }
 
 }
@@ -2,6 +2,8 @@
 
 package com.booking.services.reservations.model;
 
+import java.util.*;
+
 public class DefaultBookingServiceService implements BookingServiceService {
     // Starts hotel reservation process for given search criteria and returns operation ID
     @Override
@@ -9,6 +9,17 @@ import com.fasterxml.jackson.databind.*;
 
     // Error Response
 public class Error {
+    // Field paths, for applyMask and field masks
+    public static final String Error_Message_PATH = "message";
+    public static final String Error_Code_PATH = "code";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.Error", "Error", "Error",
+        Arrays.asList(
+            new PuregenFieldDescriptor("message", "message", "message", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("code", "code", "code", 2, "string", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // Error message
     @JsonProperty("message")
     private String message;
@@ -54,19 +65,123 @@ public class Error {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        Error other = (Error) o;
+        return Objects.equals(message, other.message)
+            && Objects.equals(code, other.code);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(message, code);
+    }
+
+    @Override
+    public String toString() {
+        return "Error{"
+            + "message=" + (message != null ? "'" + message + "'" : null)
+            + ", code=" + (code != null ? "'" + code + "'" : null)
+            + "}";
+    }
+
+    /** Returns a Error with every field at its zero value, unlike new Error(), which applies the field defaults. */
+    public static Error zeroValue() {
+        Error zero = new Error();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new Error.
+     */
+    public void applyMask(Error src, Collection<String> paths) {
+        Error source = src != null ? src : new Error();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case Error_Message_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Error: field " + name + " has no subfields");
+                    }
+                    this.message = source.message;
+                    break;
+                case Error_Code_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Error: field " + name + " has no subfields");
+                    }
+                    this.code = source.code;
+                    break;
+                default:
+                    throw new IllegalArgumentException("Error: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(Error src) {
+        if (src == null) {
+            return;
+        }
+        if (src.message != null && !src.message.isEmpty()) {
+            this.message = src.message;
+        }
+        if (src.code != null && !src.code.isEmpty()) {
+            this.code = src.code;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(Error src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(Error src, PuregenFieldMask mask) {
+        Error masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public Error redacted() {
+        Error copy = new Error();
+        copy.message = message;
+        copy.code = code;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static Error fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, Error.class);
+        return PuregenJson.fromJson(json, Error.class);
     }
 
 }
@@ -9,6 +9,25 @@ import com.fasterxml.jackson.databind.*;
 
     // Request for flight booking
 public class FlightBookingRequest {
+    // Field paths, for applyMask and field masks
+    public static final String FlightBookingRequest_FlightRoutes_PATH = "flightRoutes";
+    public static final String FlightBookingRequest_PaymentInfo_PATH = "paymentInfo";
+    public static final String FlightBookingRequest_IncludeHotelRecommendations_PATH = "includeHotelRecommendations";
+    public static final String FlightBookingRequest_DepartureDate_PATH = "departureDate";
+    public static final String FlightBookingRequest_ReturnDate_PATH = "returnDate";
+    public static final String FlightBookingRequest_NumberOfPassengers_PATH = "numberOfPassengers";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.FlightBookingRequest", "FlightBookingRequest", "FlightBookingRequest",
+        Arrays.asList(
+            new PuregenFieldDescriptor("flightRoutes", "flightRoutes", "flightRoutes", 1, "string", null, null, true, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("paymentInfo", "paymentInfo", "paymentInfo", 2, "message", "puregen.booking.reservations.PaymentInfo", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("includeHotelRecommendations", "includeHotelRecommendations", "includeHotelRecommendations", 3, "bool", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("departureDate", "departureDate", "departureDate", 4, "int64", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("returnDate", "returnDate", "returnDate", 5, "int64", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("numberOfPassengers", "numberOfPassengers", "numberOfPassengers", 6, "int32", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // Flight search criteria
     @JsonProperty("flightRoutes")
     private List<String> flightRoutes = new ArrayList<>();
@@ -128,19 +147,181 @@ public class FlightBookingRequest {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        FlightBookingRequest other = (FlightBookingRequest) o;
+        return Objects.equals(flightRoutes, other.flightRoutes)
+            && Objects.equals(paymentInfo, other.paymentInfo)
+            && includeHotelRecommendations == other.includeHotelRecommendations
+            && departureDate == other.departureDate
+            && returnDate == other.returnDate
+            && numberOfPassengers == other.numberOfPassengers;
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(flightRoutes, paymentInfo, includeHotelRecommendations, departureDate, returnDate, numberOfPassengers);
+    }
+
+    @Override
+    public String toString() {
+        return "FlightBookingRequest{"
+            + "flightRoutes=" + flightRoutes
+            + ", paymentInfo=" + paymentInfo
+            + ", includeHotelRecommendations=" + includeHotelRecommendations
+            + ", departureDate=" + departureDate
+            + ", returnDate=" + returnDate
+            + ", numberOfPassengers=" + numberOfPassengers
+            + "}";
+    }
+
+    /** Returns a FlightBookingRequest with every field at its zero value, unlike new FlightBookingRequest(), which applies the field defaults. */
+    public static FlightBookingRequest zeroValue() {
+        FlightBookingRequest zero = new FlightBookingRequest();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new FlightBookingRequest.
+     */
+    public void applyMask(FlightBookingRequest src, Collection<String> paths) {
+        FlightBookingRequest source = src != null ? src : new FlightBookingRequest();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case FlightBookingRequest_FlightRoutes_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("FlightBookingRequest: field " + name + " has no subfields");
+                    }
+                    this.flightRoutes = source.flightRoutes != null ? new ArrayList<>(source.flightRoutes) : new ArrayList<>();
+                    break;
+                case FlightBookingRequest_PaymentInfo_PATH:
+                    if (rest == null) {
+                        this.paymentInfo = source.paymentInfo;
+                    } else {
+                        if (this.paymentInfo == null) {
+                            this.paymentInfo = PaymentInfo.zeroValue();
+                        }
+                        this.paymentInfo.applyMask(source.paymentInfo, List.of(rest));
+                    }
+                    break;
+                case FlightBookingRequest_IncludeHotelRecommendations_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("FlightBookingRequest: field " + name + " has no subfields");
+                    }
+                    this.includeHotelRecommendations = source.includeHotelRecommendations;
+                    break;
+                case FlightBookingRequest_DepartureDate_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("FlightBookingRequest: field " + name + " has no subfields");
+                    }
+                    this.departureDate = source.departureDate;
+                    break;
+                case FlightBookingRequest_ReturnDate_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("FlightBookingRequest: field " + name + " has no subfields");
+                    }
+                    this.returnDate = source.returnDate;
+                    break;
+                case FlightBookingRequest_NumberOfPassengers_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("FlightBookingRequest: field " + name + " has no subfields");
+                    }
+                    this.numberOfPassengers = source.numberOfPassengers;
+                    break;
+                default:
+                    throw new IllegalArgumentException("FlightBookingRequest: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(FlightBookingRequest src) {
+        if (src == null) {
+            return;
+        }
+        if (src.flightRoutes != null) {
+            if (this.flightRoutes == null) {
+                this.flightRoutes = new ArrayList<>();
+            }
+            this.flightRoutes.addAll(src.flightRoutes);
+        }
+        if (src.paymentInfo != null) {
+            if (this.paymentInfo == null) {
+                this.paymentInfo = PaymentInfo.zeroValue();
+            }
+            this.paymentInfo.merge(src.paymentInfo);
+        }
+        if (src.includeHotelRecommendations) {
+            this.includeHotelRecommendations = src.includeHotelRecommendations;
+        }
+        if (src.departureDate != 0) {
+            this.departureDate = src.departureDate;
+        }
+        if (src.returnDate != 0) {
+            this.returnDate = src.returnDate;
+        }
+        if (src.numberOfPassengers != 0) {
+            this.numberOfPassengers = src.numberOfPassengers;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(FlightBookingRequest src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(FlightBookingRequest src, PuregenFieldMask mask) {
+        FlightBookingRequest masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public FlightBookingRequest redacted() {
+        FlightBookingRequest copy = new FlightBookingRequest();
+        copy.flightRoutes = flightRoutes != null ? new ArrayList<>(flightRoutes) : null;
+        copy.paymentInfo = paymentInfo != null ? paymentInfo.redacted() : null;
+        copy.includeHotelRecommendations = includeHotelRecommendations;
+        copy.departureDate = departureDate;
+        copy.returnDate = returnDate;
+        copy.numberOfPassengers = numberOfPassengers;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static FlightBookingRequest fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, FlightBookingRequest.class);
+        return PuregenJson.fromJson(json, FlightBookingRequest.class);
     }
 
 }
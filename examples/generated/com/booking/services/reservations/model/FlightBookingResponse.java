@@ -9,6 +9,21 @@ import com.fasterxml.jackson.databind.*;
 
     // Response for flight booking
 public class FlightBookingResponse {
+    // Field paths, for applyMask and field masks
+    public static final String FlightBookingResponse_FlightBooking_PATH = "FlightBooking";
+    public static final String FlightBookingResponse_Error_PATH = "error";
+    public static final String FlightBookingResponse_Status_PATH = "status";
+    public static final String FlightBookingResponse_BookingStats_PATH = "bookingStats";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.FlightBookingResponse", "FlightBookingResponse", "FlightBookingResponse",
+        Arrays.asList(
+            new PuregenFieldDescriptor("FlightBooking", "flightBooking", "FlightBooking", 1, "message", "puregen.booking.reservations.FlightBookingResponse.SingleFlightBooking", null, true, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("error", "error", "error", 3, "message", "puregen.booking.reservations.Error", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("status", "status", "status", 4, "enum", "puregen.booking.reservations.BookingStatus", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("bookingStats", "bookingStats", "bookingStats", 5, "message", "puregen.booking.reservations.BookingStatsResponse", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // List of flight bookings for each route
     @JsonProperty("FlightBooking")
     private List<FlightBookingResponse_SingleFlightBooking> flightBooking = new ArrayList<>();
@@ -95,19 +110,169 @@ public class FlightBookingResponse {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        FlightBookingResponse other = (FlightBookingResponse) o;
+        return Objects.equals(flightBooking, other.flightBooking)
+            && Objects.equals(error, other.error)
+            && Objects.equals(status, other.status)
+            && Objects.equals(bookingStats, other.bookingStats);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(flightBooking, error, status, bookingStats);
+    }
+
+    @Override
+    public String toString() {
+        return "FlightBookingResponse{"
+            + "flightBooking=" + flightBooking
+            + ", error=" + error
+            + ", status=" + (status != null ? "'" + status + "'" : null)
+            + ", bookingStats=" + bookingStats
+            + "}";
+    }
+
+    /** Returns a FlightBookingResponse with every field at its zero value, unlike new FlightBookingResponse(), which applies the field defaults. */
+    public static FlightBookingResponse zeroValue() {
+        FlightBookingResponse zero = new FlightBookingResponse();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new FlightBookingResponse.
+     */
+    public void applyMask(FlightBookingResponse src, Collection<String> paths) {
+        FlightBookingResponse source = src != null ? src : new FlightBookingResponse();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case FlightBookingResponse_FlightBooking_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("FlightBookingResponse: field " + name + " has no subfields");
+                    }
+                    this.flightBooking = source.flightBooking != null ? new ArrayList<>(source.flightBooking) : new ArrayList<>();
+                    break;
+                case FlightBookingResponse_Error_PATH:
+                    if (rest == null) {
+                        this.error = source.error;
+                    } else {
+                        if (this.error == null) {
+                            this.error = Error.zeroValue();
+                        }
+                        this.error.applyMask(source.error, List.of(rest));
+                    }
+                    break;
+                case FlightBookingResponse_Status_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("FlightBookingResponse: field " + name + " has no subfields");
+                    }
+                    this.status = source.status;
+                    break;
+                case FlightBookingResponse_BookingStats_PATH:
+                    if (rest == null) {
+                        this.bookingStats = source.bookingStats;
+                    } else {
+                        if (this.bookingStats == null) {
+                            this.bookingStats = BookingStatsResponse.zeroValue();
+                        }
+                        this.bookingStats.applyMask(source.bookingStats, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("FlightBookingResponse: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(FlightBookingResponse src) {
+        if (src == null) {
+            return;
+        }
+        if (src.flightBooking != null) {
+            if (this.flightBooking == null) {
+                this.flightBooking = new ArrayList<>();
+            }
+            this.flightBooking.addAll(src.flightBooking);
+        }
+        if (src.error != null) {
+            if (this.error == null) {
+                this.error = Error.zeroValue();
+            }
+            this.error.merge(src.error);
+        }
+        if (src.status != null && !src.status.isEmpty()) {
+            this.status = src.status;
+        }
+        if (src.bookingStats != null) {
+            if (this.bookingStats == null) {
+                this.bookingStats = BookingStatsResponse.zeroValue();
+            }
+            this.bookingStats.merge(src.bookingStats);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(FlightBookingResponse src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(FlightBookingResponse src, PuregenFieldMask mask) {
+        FlightBookingResponse masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public FlightBookingResponse redacted() {
+        FlightBookingResponse copy = new FlightBookingResponse();
+        if (flightBooking != null) {
+            copy.flightBooking = new ArrayList<>(flightBooking);
+            copy.flightBooking.replaceAll(item -> item != null ? item.redacted() : null);
+        } else {
+            copy.flightBooking = null;
+        }
+        copy.error = error != null ? error.redacted() : null;
+        copy.status = status;
+        copy.bookingStats = bookingStats != null ? bookingStats.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static FlightBookingResponse fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, FlightBookingResponse.class);
+        return PuregenJson.fromJson(json, FlightBookingResponse.class);
     }
 
 }
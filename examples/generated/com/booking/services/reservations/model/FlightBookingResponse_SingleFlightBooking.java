@@ -9,6 +9,27 @@ import com.fasterxml.jackson.databind.*;
 
     // Response for single flight booking
 public class FlightBookingResponse_SingleFlightBooking {
+    // Field paths, for applyMask and field masks
+    public static final String FlightBookingResponse_SingleFlightBooking_FlightNumber_PATH = "flightNumber";
+    public static final String FlightBookingResponse_SingleFlightBooking_Airline_PATH = "airline";
+    public static final String FlightBookingResponse_SingleFlightBooking_Price_PATH = "price";
+    public static final String FlightBookingResponse_SingleFlightBooking_DepartureTime_PATH = "departureTime";
+    public static final String FlightBookingResponse_SingleFlightBooking_ArrivalTime_PATH = "arrivalTime";
+    public static final String FlightBookingResponse_SingleFlightBooking_Error_PATH = "error";
+    public static final String FlightBookingResponse_SingleFlightBooking_HotelRecommendations_PATH = "hotelRecommendations";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.FlightBookingResponse.SingleFlightBooking", "SingleFlightBooking", "FlightBookingResponse_SingleFlightBooking",
+        Arrays.asList(
+            new PuregenFieldDescriptor("flightNumber", "flightNumber", "flightNumber", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("airline", "airline", "airline", 2, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("price", "price", "price", 3, "double", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("departureTime", "departureTime", "departureTime", 4, "int64", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("arrivalTime", "arrivalTime", "arrivalTime", 5, "int64", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("error", "error", "error", 6, "message", "puregen.booking.reservations.Error", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("hotelRecommendations", "hotelRecommendations", "hotelRecommendations", 7, "message", "puregen.booking.reservations.HotelReservationResponse.SingleHotelReservationResponse", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // Flight details
     @JsonProperty("flightNumber")
     private String flightNumber;
@@ -139,19 +160,197 @@ public class FlightBookingResponse_SingleFlightBooking {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        FlightBookingResponse_SingleFlightBooking other = (FlightBookingResponse_SingleFlightBooking) o;
+        return Objects.equals(flightNumber, other.flightNumber)
+            && Objects.equals(airline, other.airline)
+            && Double.compare(price, other.price) == 0
+            && departureTime == other.departureTime
+            && arrivalTime == other.arrivalTime
+            && Objects.equals(error, other.error)
+            && Objects.equals(hotelRecommendations, other.hotelRecommendations);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(flightNumber, airline, price, departureTime, arrivalTime, error, hotelRecommendations);
+    }
+
+    @Override
+    public String toString() {
+        return "FlightBookingResponse_SingleFlightBooking{"
+            + "flightNumber=" + (flightNumber != null ? "'" + flightNumber + "'" : null)
+            + ", airline=" + (airline != null ? "'" + airline + "'" : null)
+            + ", price=" + price
+            + ", departureTime=" + departureTime
+            + ", arrivalTime=" + arrivalTime
+            + ", error=" + error
+            + ", hotelRecommendations=" + hotelRecommendations
+            + "}";
+    }
+
+    /** Returns a FlightBookingResponse_SingleFlightBooking with every field at its zero value, unlike new FlightBookingResponse_SingleFlightBooking(), which applies the field defaults. */
+    public static FlightBookingResponse_SingleFlightBooking zeroValue() {
+        FlightBookingResponse_SingleFlightBooking zero = new FlightBookingResponse_SingleFlightBooking();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new FlightBookingResponse_SingleFlightBooking.
+     */
+    public void applyMask(FlightBookingResponse_SingleFlightBooking src, Collection<String> paths) {
+        FlightBookingResponse_SingleFlightBooking source = src != null ? src : new FlightBookingResponse_SingleFlightBooking();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case FlightBookingResponse_SingleFlightBooking_FlightNumber_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("FlightBookingResponse_SingleFlightBooking: field " + name + " has no subfields");
+                    }
+                    this.flightNumber = source.flightNumber;
+                    break;
+                case FlightBookingResponse_SingleFlightBooking_Airline_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("FlightBookingResponse_SingleFlightBooking: field " + name + " has no subfields");
+                    }
+                    this.airline = source.airline;
+                    break;
+                case FlightBookingResponse_SingleFlightBooking_Price_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("FlightBookingResponse_SingleFlightBooking: field " + name + " has no subfields");
+                    }
+                    this.price = source.price;
+                    break;
+                case FlightBookingResponse_SingleFlightBooking_DepartureTime_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("FlightBookingResponse_SingleFlightBooking: field " + name + " has no subfields");
+                    }
+                    this.departureTime = source.departureTime;
+                    break;
+                case FlightBookingResponse_SingleFlightBooking_ArrivalTime_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("FlightBookingResponse_SingleFlightBooking: field " + name + " has no subfields");
+                    }
+                    this.arrivalTime = source.arrivalTime;
+                    break;
+                case FlightBookingResponse_SingleFlightBooking_Error_PATH:
+                    if (rest == null) {
+                        this.error = source.error;
+                    } else {
+                        if (this.error == null) {
+                            this.error = Error.zeroValue();
+                        }
+                        this.error.applyMask(source.error, List.of(rest));
+                    }
+                    break;
+                case FlightBookingResponse_SingleFlightBooking_HotelRecommendations_PATH:
+                    if (rest == null) {
+                        this.hotelRecommendations = source.hotelRecommendations;
+                    } else {
+                        if (this.hotelRecommendations == null) {
+                            this.hotelRecommendations = HotelReservationResponse_SingleHotelReservationResponse.zeroValue();
+                        }
+                        this.hotelRecommendations.applyMask(source.hotelRecommendations, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("FlightBookingResponse_SingleFlightBooking: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(FlightBookingResponse_SingleFlightBooking src) {
+        if (src == null) {
+            return;
+        }
+        if (src.flightNumber != null && !src.flightNumber.isEmpty()) {
+            this.flightNumber = src.flightNumber;
+        }
+        if (src.airline != null && !src.airline.isEmpty()) {
+            this.airline = src.airline;
+        }
+        if (src.price != 0) {
+            this.price = src.price;
+        }
+        if (src.departureTime != 0) {
+            this.departureTime = src.departureTime;
+        }
+        if (src.arrivalTime != 0) {
+            this.arrivalTime = src.arrivalTime;
+        }
+        if (src.error != null) {
+            if (this.error == null) {
+                this.error = Error.zeroValue();
+            }
+            this.error.merge(src.error);
+        }
+        if (src.hotelRecommendations != null) {
+            if (this.hotelRecommendations == null) {
+                this.hotelRecommendations = HotelReservationResponse_SingleHotelReservationResponse.zeroValue();
+            }
+            this.hotelRecommendations.merge(src.hotelRecommendations);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(FlightBookingResponse_SingleFlightBooking src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(FlightBookingResponse_SingleFlightBooking src, PuregenFieldMask mask) {
+        FlightBookingResponse_SingleFlightBooking masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public FlightBookingResponse_SingleFlightBooking redacted() {
+        FlightBookingResponse_SingleFlightBooking copy = new FlightBookingResponse_SingleFlightBooking();
+        copy.flightNumber = flightNumber;
+        copy.airline = airline;
+        copy.price = price;
+        copy.departureTime = departureTime;
+        copy.arrivalTime = arrivalTime;
+        copy.error = error != null ? error.redacted() : null;
+        copy.hotelRecommendations = hotelRecommendations != null ? hotelRecommendations.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static FlightBookingResponse_SingleFlightBooking fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, FlightBookingResponse_SingleFlightBooking.class);
+        return PuregenJson.fromJson(json, FlightBookingResponse_SingleFlightBooking.class);
     }
 
 }
@@ -9,6 +9,27 @@ import com.fasterxml.jackson.databind.*;
 
     // Request for hotel reservation
 public class HotelReservationRequest {
+    // Field paths, for applyMask and field masks
+    public static final String HotelReservationRequest_HotelLocations_PATH = "hotelLocations";
+    public static final String HotelReservationRequest_RoomTypes_PATH = "roomTypes";
+    public static final String HotelReservationRequest_MaxPricePerNight_PATH = "maxPricePerNight";
+    public static final String HotelReservationRequest_PaymentInfo_PATH = "paymentInfo";
+    public static final String HotelReservationRequest_CheckInDate_PATH = "checkInDate";
+    public static final String HotelReservationRequest_CheckOutDate_PATH = "checkOutDate";
+    public static final String HotelReservationRequest_NumberOfGuests_PATH = "numberOfGuests";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.HotelReservationRequest", "HotelReservationRequest", "HotelReservationRequest",
+        Arrays.asList(
+            new PuregenFieldDescriptor("hotelLocations", "hotelLocations", "hotelLocations", 1, "string", null, null, true, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("roomTypes", "roomTypes", "roomTypes", 2, "enum", "puregen.booking.reservations.HotelReservationRequest.RoomType", null, true, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("maxPricePerNight", "maxPricePerNight", "maxPricePerNight", 3, "double", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("paymentInfo", "paymentInfo", "paymentInfo", 4, "message", "puregen.booking.reservations.PaymentInfo", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("checkInDate", "checkInDate", "checkInDate", 5, "int64", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("checkOutDate", "checkOutDate", "checkOutDate", 6, "int64", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("numberOfGuests", "numberOfGuests", "numberOfGuests", 7, "int32", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // Hotel search criteria
     @JsonProperty("hotelLocations")
     private List<String> hotelLocations = new ArrayList<>();
@@ -152,19 +173,196 @@ public class HotelReservationRequest {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        HotelReservationRequest other = (HotelReservationRequest) o;
+        return Objects.equals(hotelLocations, other.hotelLocations)
+            && Objects.equals(roomTypes, other.roomTypes)
+            && Double.compare(maxPricePerNight, other.maxPricePerNight) == 0
+            && Objects.equals(paymentInfo, other.paymentInfo)
+            && checkInDate == other.checkInDate
+            && checkOutDate == other.checkOutDate
+            && numberOfGuests == other.numberOfGuests;
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(hotelLocations, roomTypes, maxPricePerNight, paymentInfo, checkInDate, checkOutDate, numberOfGuests);
+    }
+
+    @Override
+    public String toString() {
+        return "HotelReservationRequest{"
+            + "hotelLocations=" + hotelLocations
+            + ", roomTypes=" + roomTypes
+            + ", maxPricePerNight=" + maxPricePerNight
+            + ", paymentInfo=" + paymentInfo
+            + ", checkInDate=" + checkInDate
+            + ", checkOutDate=" + checkOutDate
+            + ", numberOfGuests=" + numberOfGuests
+            + "}";
+    }
+
+    /** Returns a HotelReservationRequest with every field at its zero value, unlike new HotelReservationRequest(), which applies the field defaults. */
+    public static HotelReservationRequest zeroValue() {
+        HotelReservationRequest zero = new HotelReservationRequest();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new HotelReservationRequest.
+     */
+    public void applyMask(HotelReservationRequest src, Collection<String> paths) {
+        HotelReservationRequest source = src != null ? src : new HotelReservationRequest();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case HotelReservationRequest_HotelLocations_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("HotelReservationRequest: field " + name + " has no subfields");
+                    }
+                    this.hotelLocations = source.hotelLocations != null ? new ArrayList<>(source.hotelLocations) : new ArrayList<>();
+                    break;
+                case HotelReservationRequest_RoomTypes_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("HotelReservationRequest: field " + name + " has no subfields");
+                    }
+                    this.roomTypes = source.roomTypes != null ? new ArrayList<>(source.roomTypes) : new ArrayList<>();
+                    break;
+                case HotelReservationRequest_MaxPricePerNight_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("HotelReservationRequest: field " + name + " has no subfields");
+                    }
+                    this.maxPricePerNight = source.maxPricePerNight;
+                    break;
+                case HotelReservationRequest_PaymentInfo_PATH:
+                    if (rest == null) {
+                        this.paymentInfo = source.paymentInfo;
+                    } else {
+                        if (this.paymentInfo == null) {
+                            this.paymentInfo = PaymentInfo.zeroValue();
+                        }
+                        this.paymentInfo.applyMask(source.paymentInfo, List.of(rest));
+                    }
+                    break;
+                case HotelReservationRequest_CheckInDate_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("HotelReservationRequest: field " + name + " has no subfields");
+                    }
+                    this.checkInDate = source.checkInDate;
+                    break;
+                case HotelReservationRequest_CheckOutDate_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("HotelReservationRequest: field " + name + " has no subfields");
+                    }
+                    this.checkOutDate = source.checkOutDate;
+                    break;
+                case HotelReservationRequest_NumberOfGuests_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("HotelReservationRequest: field " + name + " has no subfields");
+                    }
+                    this.numberOfGuests = source.numberOfGuests;
+                    break;
+                default:
+                    throw new IllegalArgumentException("HotelReservationRequest: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(HotelReservationRequest src) {
+        if (src == null) {
+            return;
+        }
+        if (src.hotelLocations != null) {
+            if (this.hotelLocations == null) {
+                this.hotelLocations = new ArrayList<>();
+            }
+            this.hotelLocations.addAll(src.hotelLocations);
+        }
+        if (src.roomTypes != null) {
+            if (this.roomTypes == null) {
+                this.roomTypes = new ArrayList<>();
+            }
+            this.roomTypes.addAll(src.roomTypes);
+        }
+        if (src.maxPricePerNight != 0) {
+            this.maxPricePerNight = src.maxPricePerNight;
+        }
+        if (src.paymentInfo != null) {
+            if (this.paymentInfo == null) {
+                this.paymentInfo = PaymentInfo.zeroValue();
+            }
+            this.paymentInfo.merge(src.paymentInfo);
+        }
+        if (src.checkInDate != 0) {
+            this.checkInDate = src.checkInDate;
+        }
+        if (src.checkOutDate != 0) {
+            this.checkOutDate = src.checkOutDate;
+        }
+        if (src.numberOfGuests != 0) {
+            this.numberOfGuests = src.numberOfGuests;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(HotelReservationRequest src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(HotelReservationRequest src, PuregenFieldMask mask) {
+        HotelReservationRequest masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public HotelReservationRequest redacted() {
+        HotelReservationRequest copy = new HotelReservationRequest();
+        copy.hotelLocations = hotelLocations != null ? new ArrayList<>(hotelLocations) : null;
+        copy.roomTypes = roomTypes != null ? new ArrayList<>(roomTypes) : null;
+        copy.maxPricePerNight = maxPricePerNight;
+        copy.paymentInfo = paymentInfo != null ? paymentInfo.redacted() : null;
+        copy.checkInDate = checkInDate;
+        copy.checkOutDate = checkOutDate;
+        copy.numberOfGuests = numberOfGuests;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static HotelReservationRequest fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, HotelReservationRequest.class);
+        return PuregenJson.fromJson(json, HotelReservationRequest.class);
     }
 
 }
@@ -9,6 +9,21 @@ import com.fasterxml.jackson.databind.*;
 
     // Response for hotel reservation
 public class HotelReservationResponse {
+    // Field paths, for applyMask and field masks
+    public static final String HotelReservationResponse_Result_PATH = "result";
+    public static final String HotelReservationResponse_Status_PATH = "status";
+    public static final String HotelReservationResponse_Error_PATH = "error";
+    public static final String HotelReservationResponse_BookingStats_PATH = "bookingStats";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.HotelReservationResponse", "HotelReservationResponse", "HotelReservationResponse",
+        Arrays.asList(
+            new PuregenFieldDescriptor("result", "result", "result", 2, "message", "puregen.booking.reservations.HotelReservationResponse.SingleHotelReservationResponse", null, true, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("status", "status", "status", 3, "enum", "puregen.booking.reservations.BookingStatus", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("error", "error", "error", 4, "message", "puregen.booking.reservations.Error", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("bookingStats", "bookingStats", "bookingStats", 5, "message", "puregen.booking.reservations.BookingStatsResponse", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // List of results for each search location
     @JsonProperty("result")
     private List<HotelReservationResponse_SingleHotelReservationResponse> result = new ArrayList<>();
@@ -95,19 +110,169 @@ public class HotelReservationResponse {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        HotelReservationResponse other = (HotelReservationResponse) o;
+        return Objects.equals(result, other.result)
+            && Objects.equals(status, other.status)
+            && Objects.equals(error, other.error)
+            && Objects.equals(bookingStats, other.bookingStats);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(result, status, error, bookingStats);
+    }
+
+    @Override
+    public String toString() {
+        return "HotelReservationResponse{"
+            + "result=" + result
+            + ", status=" + (status != null ? "'" + status + "'" : null)
+            + ", error=" + error
+            + ", bookingStats=" + bookingStats
+            + "}";
+    }
+
+    /** Returns a HotelReservationResponse with every field at its zero value, unlike new HotelReservationResponse(), which applies the field defaults. */
+    public static HotelReservationResponse zeroValue() {
+        HotelReservationResponse zero = new HotelReservationResponse();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new HotelReservationResponse.
+     */
+    public void applyMask(HotelReservationResponse src, Collection<String> paths) {
+        HotelReservationResponse source = src != null ? src : new HotelReservationResponse();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case HotelReservationResponse_Result_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("HotelReservationResponse: field " + name + " has no subfields");
+                    }
+                    this.result = source.result != null ? new ArrayList<>(source.result) : new ArrayList<>();
+                    break;
+                case HotelReservationResponse_Status_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("HotelReservationResponse: field " + name + " has no subfields");
+                    }
+                    this.status = source.status;
+                    break;
+                case HotelReservationResponse_Error_PATH:
+                    if (rest == null) {
+                        this.error = source.error;
+                    } else {
+                        if (this.error == null) {
+                            this.error = Error.zeroValue();
+                        }
+                        this.error.applyMask(source.error, List.of(rest));
+                    }
+                    break;
+                case HotelReservationResponse_BookingStats_PATH:
+                    if (rest == null) {
+                        this.bookingStats = source.bookingStats;
+                    } else {
+                        if (this.bookingStats == null) {
+                            this.bookingStats = BookingStatsResponse.zeroValue();
+                        }
+                        this.bookingStats.applyMask(source.bookingStats, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("HotelReservationResponse: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(HotelReservationResponse src) {
+        if (src == null) {
+            return;
+        }
+        if (src.result != null) {
+            if (this.result == null) {
+                this.result = new ArrayList<>();
+            }
+            this.result.addAll(src.result);
+        }
+        if (src.status != null && !src.status.isEmpty()) {
+            this.status = src.status;
+        }
+        if (src.error != null) {
+            if (this.error == null) {
+                this.error = Error.zeroValue();
+            }
+            this.error.merge(src.error);
+        }
+        if (src.bookingStats != null) {
+            if (this.bookingStats == null) {
+                this.bookingStats = BookingStatsResponse.zeroValue();
+            }
+            this.bookingStats.merge(src.bookingStats);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(HotelReservationResponse src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(HotelReservationResponse src, PuregenFieldMask mask) {
+        HotelReservationResponse masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public HotelReservationResponse redacted() {
+        HotelReservationResponse copy = new HotelReservationResponse();
+        if (result != null) {
+            copy.result = new ArrayList<>(result);
+            copy.result.replaceAll(item -> item != null ? item.redacted() : null);
+        } else {
+            copy.result = null;
+        }
+        copy.status = status;
+        copy.error = error != null ? error.redacted() : null;
+        copy.bookingStats = bookingStats != null ? bookingStats.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static HotelReservationResponse fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, HotelReservationResponse.class);
+        return PuregenJson.fromJson(json, HotelReservationResponse.class);
     }
 
 }
@@ -9,6 +9,19 @@ import com.fasterxml.jackson.databind.*;
 
     // Room availability with hotel details
 public class HotelReservationResponse_AvailableRoom {
+    // Field paths, for applyMask and field masks
+    public static final String HotelReservationResponse_AvailableRoom_Hotel_PATH = "hotel";
+    public static final String HotelReservationResponse_AvailableRoom_RoomType_PATH = "roomType";
+    public static final String HotelReservationResponse_AvailableRoom_AvailableRooms_PATH = "availableRooms";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.HotelReservationResponse.AvailableRoom", "AvailableRoom", "HotelReservationResponse_AvailableRoom",
+        Arrays.asList(
+            new PuregenFieldDescriptor("hotel", "hotel", "hotel", 1, "message", "puregen.booking.reservations.HotelReservationResponse.Hotel", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("roomType", "roomType", "roomType", 2, "enum", "puregen.booking.reservations.HotelReservationRequest.RoomType", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("availableRooms", "availableRooms", "availableRooms", 3, "int32", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // Hotel information
     @JsonProperty("hotel")
     private HotelReservationResponse_Hotel hotel;
@@ -71,19 +84,142 @@ public class HotelReservationResponse_AvailableRoom {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        HotelReservationResponse_AvailableRoom other = (HotelReservationResponse_AvailableRoom) o;
+        return Objects.equals(hotel, other.hotel)
+            && Objects.equals(roomType, other.roomType)
+            && availableRooms == other.availableRooms;
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(hotel, roomType, availableRooms);
+    }
+
+    @Override
+    public String toString() {
+        return "HotelReservationResponse_AvailableRoom{"
+            + "hotel=" + hotel
+            + ", roomType=" + (roomType != null ? "'" + roomType + "'" : null)
+            + ", availableRooms=" + availableRooms
+            + "}";
+    }
+
+    /** Returns a HotelReservationResponse_AvailableRoom with every field at its zero value, unlike new HotelReservationResponse_AvailableRoom(), which applies the field defaults. */
+    public static HotelReservationResponse_AvailableRoom zeroValue() {
+        HotelReservationResponse_AvailableRoom zero = new HotelReservationResponse_AvailableRoom();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new HotelReservationResponse_AvailableRoom.
+     */
+    public void applyMask(HotelReservationResponse_AvailableRoom src, Collection<String> paths) {
+        HotelReservationResponse_AvailableRoom source = src != null ? src : new HotelReservationResponse_AvailableRoom();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case HotelReservationResponse_AvailableRoom_Hotel_PATH:
+                    if (rest == null) {
+                        this.hotel = source.hotel;
+                    } else {
+                        if (this.hotel == null) {
+                            this.hotel = HotelReservationResponse_Hotel.zeroValue();
+                        }
+                        this.hotel.applyMask(source.hotel, List.of(rest));
+                    }
+                    break;
+                case HotelReservationResponse_AvailableRoom_RoomType_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("HotelReservationResponse_AvailableRoom: field " + name + " has no subfields");
+                    }
+                    this.roomType = source.roomType;
+                    break;
+                case HotelReservationResponse_AvailableRoom_AvailableRooms_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("HotelReservationResponse_AvailableRoom: field " + name + " has no subfields");
+                    }
+                    this.availableRooms = source.availableRooms;
+                    break;
+                default:
+                    throw new IllegalArgumentException("HotelReservationResponse_AvailableRoom: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(HotelReservationResponse_AvailableRoom src) {
+        if (src == null) {
+            return;
+        }
+        if (src.hotel != null) {
+            if (this.hotel == null) {
+                this.hotel = HotelReservationResponse_Hotel.zeroValue();
+            }
+            this.hotel.merge(src.hotel);
+        }
+        if (src.roomType != null && !src.roomType.isEmpty()) {
+            this.roomType = src.roomType;
+        }
+        if (src.availableRooms != 0) {
+            this.availableRooms = src.availableRooms;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(HotelReservationResponse_AvailableRoom src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(HotelReservationResponse_AvailableRoom src, PuregenFieldMask mask) {
+        HotelReservationResponse_AvailableRoom masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public HotelReservationResponse_AvailableRoom redacted() {
+        HotelReservationResponse_AvailableRoom copy = new HotelReservationResponse_AvailableRoom();
+        copy.hotel = hotel != null ? hotel.redacted() : null;
+        copy.roomType = roomType;
+        copy.availableRooms = availableRooms;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static HotelReservationResponse_AvailableRoom fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, HotelReservationResponse_AvailableRoom.class);
+        return PuregenJson.fromJson(json, HotelReservationResponse_AvailableRoom.class);
     }
 
 }
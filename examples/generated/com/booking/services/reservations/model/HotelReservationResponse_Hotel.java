@@ -9,6 +9,21 @@ import com.fasterxml.jackson.databind.*;
 
     // Hotel information
 public class HotelReservationResponse_Hotel {
+    // Field paths, for applyMask and field masks
+    public static final String HotelReservationResponse_Hotel_Name_PATH = "name";
+    public static final String HotelReservationResponse_Hotel_Rating_PATH = "rating";
+    public static final String HotelReservationResponse_Hotel_PricePerNight_PATH = "pricePerNight";
+    public static final String HotelReservationResponse_Hotel_Address_PATH = "address";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.HotelReservationResponse.Hotel", "Hotel", "HotelReservationResponse_Hotel",
+        Arrays.asList(
+            new PuregenFieldDescriptor("name", "name", "name", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("rating", "rating", "rating", 2, "double", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("pricePerNight", "pricePerNight", "pricePerNight", 3, "double", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("address", "address", "address", 4, "string", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // Name of the hotel
     @JsonProperty("name")
     private String name;
@@ -88,19 +103,147 @@ public class HotelReservationResponse_Hotel {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        HotelReservationResponse_Hotel other = (HotelReservationResponse_Hotel) o;
+        return Objects.equals(name, other.name)
+            && Double.compare(rating, other.rating) == 0
+            && Double.compare(pricePerNight, other.pricePerNight) == 0
+            && Objects.equals(address, other.address);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(name, rating, pricePerNight, address);
+    }
+
+    @Override
+    public String toString() {
+        return "HotelReservationResponse_Hotel{"
+            + "name=" + (name != null ? "'" + name + "'" : null)
+            + ", rating=" + rating
+            + ", pricePerNight=" + pricePerNight
+            + ", address=" + (address != null ? "'" + address + "'" : null)
+            + "}";
+    }
+
+    /** Returns a HotelReservationResponse_Hotel with every field at its zero value, unlike new HotelReservationResponse_Hotel(), which applies the field defaults. */
+    public static HotelReservationResponse_Hotel zeroValue() {
+        HotelReservationResponse_Hotel zero = new HotelReservationResponse_Hotel();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new HotelReservationResponse_Hotel.
+     */
+    public void applyMask(HotelReservationResponse_Hotel src, Collection<String> paths) {
+        HotelReservationResponse_Hotel source = src != null ? src : new HotelReservationResponse_Hotel();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case HotelReservationResponse_Hotel_Name_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("HotelReservationResponse_Hotel: field " + name + " has no subfields");
+                    }
+                    this.name = source.name;
+                    break;
+                case HotelReservationResponse_Hotel_Rating_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("HotelReservationResponse_Hotel: field " + name + " has no subfields");
+                    }
+                    this.rating = source.rating;
+                    break;
+                case HotelReservationResponse_Hotel_PricePerNight_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("HotelReservationResponse_Hotel: field " + name + " has no subfields");
+                    }
+                    this.pricePerNight = source.pricePerNight;
+                    break;
+                case HotelReservationResponse_Hotel_Address_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("HotelReservationResponse_Hotel: field " + name + " has no subfields");
+                    }
+                    this.address = source.address;
+                    break;
+                default:
+                    throw new IllegalArgumentException("HotelReservationResponse_Hotel: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(HotelReservationResponse_Hotel src) {
+        if (src == null) {
+            return;
+        }
+        if (src.name != null && !src.name.isEmpty()) {
+            this.name = src.name;
+        }
+        if (src.rating != 0) {
+            this.rating = src.rating;
+        }
+        if (src.pricePerNight != 0) {
+            this.pricePerNight = src.pricePerNight;
+        }
+        if (src.address != null && !src.address.isEmpty()) {
+            this.address = src.address;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(HotelReservationResponse_Hotel src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(HotelReservationResponse_Hotel src, PuregenFieldMask mask) {
+        HotelReservationResponse_Hotel masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public HotelReservationResponse_Hotel redacted() {
+        HotelReservationResponse_Hotel copy = new HotelReservationResponse_Hotel();
+        copy.name = name;
+        copy.rating = rating;
+        copy.pricePerNight = pricePerNight;
+        copy.address = address;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static HotelReservationResponse_Hotel fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, HotelReservationResponse_Hotel.class);
+        return PuregenJson.fromJson(json, HotelReservationResponse_Hotel.class);
     }
 
 }
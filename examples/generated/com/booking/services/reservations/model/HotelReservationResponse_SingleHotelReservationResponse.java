@@ -9,6 +9,17 @@ import com.fasterxml.jackson.databind.*;
 
     // Hotel reservation result for single location
 public class HotelReservationResponse_SingleHotelReservationResponse {
+    // Field paths, for applyMask and field masks
+    public static final String HotelReservationResponse_SingleHotelReservationResponse_AvailableRooms_PATH = "availableRooms";
+    public static final String HotelReservationResponse_SingleHotelReservationResponse_Error_PATH = "error";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.HotelReservationResponse.SingleHotelReservationResponse", "SingleHotelReservationResponse", "HotelReservationResponse_SingleHotelReservationResponse",
+        Arrays.asList(
+            new PuregenFieldDescriptor("availableRooms", "availableRooms", "availableRooms", 1, "message", "puregen.booking.reservations.HotelReservationResponse.AvailableRoom", null, true, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("error", "error", "error", 2, "message", "puregen.booking.reservations.Error", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // List of available rooms
     @JsonProperty("availableRooms")
     private List<HotelReservationResponse_AvailableRoom> availableRooms = new ArrayList<>();
@@ -61,19 +72,138 @@ public class HotelReservationResponse_SingleHotelReservationResponse {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        HotelReservationResponse_SingleHotelReservationResponse other = (HotelReservationResponse_SingleHotelReservationResponse) o;
+        return Objects.equals(availableRooms, other.availableRooms)
+            && Objects.equals(error, other.error);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(availableRooms, error);
+    }
+
+    @Override
+    public String toString() {
+        return "HotelReservationResponse_SingleHotelReservationResponse{"
+            + "availableRooms=" + availableRooms
+            + ", error=" + error
+            + "}";
+    }
+
+    /** Returns a HotelReservationResponse_SingleHotelReservationResponse with every field at its zero value, unlike new HotelReservationResponse_SingleHotelReservationResponse(), which applies the field defaults. */
+    public static HotelReservationResponse_SingleHotelReservationResponse zeroValue() {
+        HotelReservationResponse_SingleHotelReservationResponse zero = new HotelReservationResponse_SingleHotelReservationResponse();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new HotelReservationResponse_SingleHotelReservationResponse.
+     */
+    public void applyMask(HotelReservationResponse_SingleHotelReservationResponse src, Collection<String> paths) {
+        HotelReservationResponse_SingleHotelReservationResponse source = src != null ? src : new HotelReservationResponse_SingleHotelReservationResponse();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case HotelReservationResponse_SingleHotelReservationResponse_AvailableRooms_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("HotelReservationResponse_SingleHotelReservationResponse: field " + name + " has no subfields");
+                    }
+                    this.availableRooms = source.availableRooms != null ? new ArrayList<>(source.availableRooms) : new ArrayList<>();
+                    break;
+                case HotelReservationResponse_SingleHotelReservationResponse_Error_PATH:
+                    if (rest == null) {
+                        this.error = source.error;
+                    } else {
+                        if (this.error == null) {
+                            this.error = Error.zeroValue();
+                        }
+                        this.error.applyMask(source.error, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("HotelReservationResponse_SingleHotelReservationResponse: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(HotelReservationResponse_SingleHotelReservationResponse src) {
+        if (src == null) {
+            return;
+        }
+        if (src.availableRooms != null) {
+            if (this.availableRooms == null) {
+                this.availableRooms = new ArrayList<>();
+            }
+            this.availableRooms.addAll(src.availableRooms);
+        }
+        if (src.error != null) {
+            if (this.error == null) {
+                this.error = Error.zeroValue();
+            }
+            this.error.merge(src.error);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(HotelReservationResponse_SingleHotelReservationResponse src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(HotelReservationResponse_SingleHotelReservationResponse src, PuregenFieldMask mask) {
+        HotelReservationResponse_SingleHotelReservationResponse masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public HotelReservationResponse_SingleHotelReservationResponse redacted() {
+        HotelReservationResponse_SingleHotelReservationResponse copy = new HotelReservationResponse_SingleHotelReservationResponse();
+        if (availableRooms != null) {
+            copy.availableRooms = new ArrayList<>(availableRooms);
+            copy.availableRooms.replaceAll(item -> item != null ? item.redacted() : null);
+        } else {
+            copy.availableRooms = null;
+        }
+        copy.error = error != null ? error.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static HotelReservationResponse_SingleHotelReservationResponse fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, HotelReservationResponse_SingleHotelReservationResponse.class);
+        return PuregenJson.fromJson(json, HotelReservationResponse_SingleHotelReservationResponse.class);
     }
 
 }
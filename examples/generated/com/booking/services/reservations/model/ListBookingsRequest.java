@@ -8,6 +8,15 @@ import com.fasterxml.jackson.annotation.*;
 import com.fasterxml.jackson.databind.*;
 
 public class ListBookingsRequest {
+    // Field paths, for applyMask and field masks
+    public static final String ListBookingsRequest_PaymentInfo_PATH = "paymentInfo";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.ListBookingsRequest", "ListBookingsRequest", "ListBookingsRequest",
+        Arrays.asList(
+            new PuregenFieldDescriptor("paymentInfo", "paymentInfo", "paymentInfo", 1, "message", "puregen.booking.reservations.PaymentInfo", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // Payment info used during original request
     @JsonProperty("paymentInfo")
     private PaymentInfo paymentInfo;
@@ -36,19 +45,118 @@ public class ListBookingsRequest {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        ListBookingsRequest other = (ListBookingsRequest) o;
+        return Objects.equals(paymentInfo, other.paymentInfo);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(paymentInfo);
+    }
+
+    @Override
+    public String toString() {
+        return "ListBookingsRequest{"
+            + "paymentInfo=" + paymentInfo
+            + "}";
+    }
+
+    /** Returns a ListBookingsRequest with every field at its zero value, unlike new ListBookingsRequest(), which applies the field defaults. */
+    public static ListBookingsRequest zeroValue() {
+        ListBookingsRequest zero = new ListBookingsRequest();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new ListBookingsRequest.
+     */
+    public void applyMask(ListBookingsRequest src, Collection<String> paths) {
+        ListBookingsRequest source = src != null ? src : new ListBookingsRequest();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case ListBookingsRequest_PaymentInfo_PATH:
+                    if (rest == null) {
+                        this.paymentInfo = source.paymentInfo;
+                    } else {
+                        if (this.paymentInfo == null) {
+                            this.paymentInfo = PaymentInfo.zeroValue();
+                        }
+                        this.paymentInfo.applyMask(source.paymentInfo, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("ListBookingsRequest: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(ListBookingsRequest src) {
+        if (src == null) {
+            return;
+        }
+        if (src.paymentInfo != null) {
+            if (this.paymentInfo == null) {
+                this.paymentInfo = PaymentInfo.zeroValue();
+            }
+            this.paymentInfo.merge(src.paymentInfo);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(ListBookingsRequest src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(ListBookingsRequest src, PuregenFieldMask mask) {
+        ListBookingsRequest masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public ListBookingsRequest redacted() {
+        ListBookingsRequest copy = new ListBookingsRequest();
+        copy.paymentInfo = paymentInfo != null ? paymentInfo.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static ListBookingsRequest fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, ListBookingsRequest.class);
+        return PuregenJson.fromJson(json, ListBookingsRequest.class);
     }
 
 }
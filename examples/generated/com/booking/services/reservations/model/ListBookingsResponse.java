@@ -9,6 +9,19 @@ import com.fasterxml.jackson.databind.*;
 
     // Response for list bookings
 public class ListBookingsResponse {
+    // Field paths, for applyMask and field masks
+    public static final String ListBookingsResponse_ConfirmedBookingIds_PATH = "confirmedBookingIds";
+    public static final String ListBookingsResponse_PendingBookingIds_PATH = "pendingBookingIds";
+    public static final String ListBookingsResponse_Error_PATH = "error";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.ListBookingsResponse", "ListBookingsResponse", "ListBookingsResponse",
+        Arrays.asList(
+            new PuregenFieldDescriptor("confirmedBookingIds", "confirmedBookingIds", "confirmedBookingIds", 1, "string", null, null, true, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("pendingBookingIds", "pendingBookingIds", "pendingBookingIds", 2, "string", null, null, true, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("error", "error", "error", 3, "message", "puregen.booking.reservations.Error", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // List of confirmed booking IDs
     @JsonProperty("confirmedBookingIds")
     private List<String> confirmedBookingIds = new ArrayList<>();
@@ -85,19 +98,148 @@ public class ListBookingsResponse {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        ListBookingsResponse other = (ListBookingsResponse) o;
+        return Objects.equals(confirmedBookingIds, other.confirmedBookingIds)
+            && Objects.equals(pendingBookingIds, other.pendingBookingIds)
+            && Objects.equals(error, other.error);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(confirmedBookingIds, pendingBookingIds, error);
+    }
+
+    @Override
+    public String toString() {
+        return "ListBookingsResponse{"
+            + "confirmedBookingIds=" + confirmedBookingIds
+            + ", pendingBookingIds=" + pendingBookingIds
+            + ", error=" + error
+            + "}";
+    }
+
+    /** Returns a ListBookingsResponse with every field at its zero value, unlike new ListBookingsResponse(), which applies the field defaults. */
+    public static ListBookingsResponse zeroValue() {
+        ListBookingsResponse zero = new ListBookingsResponse();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new ListBookingsResponse.
+     */
+    public void applyMask(ListBookingsResponse src, Collection<String> paths) {
+        ListBookingsResponse source = src != null ? src : new ListBookingsResponse();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case ListBookingsResponse_ConfirmedBookingIds_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("ListBookingsResponse: field " + name + " has no subfields");
+                    }
+                    this.confirmedBookingIds = source.confirmedBookingIds != null ? new ArrayList<>(source.confirmedBookingIds) : new ArrayList<>();
+                    break;
+                case ListBookingsResponse_PendingBookingIds_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("ListBookingsResponse: field " + name + " has no subfields");
+                    }
+                    this.pendingBookingIds = source.pendingBookingIds != null ? new ArrayList<>(source.pendingBookingIds) : new ArrayList<>();
+                    break;
+                case ListBookingsResponse_Error_PATH:
+                    if (rest == null) {
+                        this.error = source.error;
+                    } else {
+                        if (this.error == null) {
+                            this.error = Error.zeroValue();
+                        }
+                        this.error.applyMask(source.error, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("ListBookingsResponse: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(ListBookingsResponse src) {
+        if (src == null) {
+            return;
+        }
+        if (src.confirmedBookingIds != null) {
+            if (this.confirmedBookingIds == null) {
+                this.confirmedBookingIds = new ArrayList<>();
+            }
+            this.confirmedBookingIds.addAll(src.confirmedBookingIds);
+        }
+        if (src.pendingBookingIds != null) {
+            if (this.pendingBookingIds == null) {
+                this.pendingBookingIds = new ArrayList<>();
+            }
+            this.pendingBookingIds.addAll(src.pendingBookingIds);
+        }
+        if (src.error != null) {
+            if (this.error == null) {
+                this.error = Error.zeroValue();
+            }
+            this.error.merge(src.error);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(ListBookingsResponse src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(ListBookingsResponse src, PuregenFieldMask mask) {
+        ListBookingsResponse masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public ListBookingsResponse redacted() {
+        ListBookingsResponse copy = new ListBookingsResponse();
+        copy.confirmedBookingIds = confirmedBookingIds != null ? new ArrayList<>(confirmedBookingIds) : null;
+        copy.pendingBookingIds = pendingBookingIds != null ? new ArrayList<>(pendingBookingIds) : null;
+        copy.error = error != null ? error.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static ListBookingsResponse fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, ListBookingsResponse.class);
+        return PuregenJson.fromJson(json, ListBookingsResponse.class);
     }
 
 }
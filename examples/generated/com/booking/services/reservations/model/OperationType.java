@@ -2,12 +2,15 @@
 
 package com.booking.services.reservations.model;
 
+import com.fasterxml.jackson.annotation.*;
+
     // Operation types for booking system
 public enum OperationType {
     OPERATIONTYPE_UNKNOWN(0),
     OPERATIONTYPE_HOTEL_RESERVATION(1),
     OPERATIONTYPE_FLIGHT_BOOKING(2),
-    OPERATIONTYPE_TRAVEL_PACKAGE(3);
+    OPERATIONTYPE_TRAVEL_PACKAGE(3),
+    UNRECOGNIZED(-1);
 
     private final int value;
 
@@ -15,22 +18,46 @@ public enum OperationType {
         this.value = value;
     }
 
+    @JsonValue
     public int getValue() {
         return value;
     }
 
     public static OperationType fromValue(int value) {
         for (OperationType e : values()) {
-            if (e.value == value) {
+            if (e != UNRECOGNIZED && e.value == value) {
                 return e;
             }
         }
-        throw new IllegalArgumentException("Invalid OperationType value: " + value);
+        return UNRECOGNIZED;
+    }
+
+    public static OperationType fromName(String name) {
+        switch (name) {
+            case "OperationType_UNKNOWN":
+                return OPERATIONTYPE_UNKNOWN;
+            case "OperationType_HOTEL_RESERVATION":
+                return OPERATIONTYPE_HOTEL_RESERVATION;
+            case "OperationType_FLIGHT_BOOKING":
+                return OPERATIONTYPE_FLIGHT_BOOKING;
+            case "OperationType_TRAVEL_PACKAGE":
+                return OPERATIONTYPE_TRAVEL_PACKAGE;
+            default:
+                return UNRECOGNIZED;
+        }
+    }
+
+    @JsonCreator(mode = JsonCreator.Mode.DELEGATING)
+    public static OperationType fromJsonValue(Object value) {
+        if (value instanceof Number) {
+            return fromValue(((Number) value).intValue());
+        }
+        return fromName(String.valueOf(value));
     }
 
     public static boolean isValid(int value) {
         for (OperationType e : values()) {
-            if (e.value == value) {
+            if (e != UNRECOGNIZED && e.value == value) {
                 return true;
             }
         }
@@ -9,6 +9,19 @@ import com.fasterxml.jackson.databind.*;
 
     // Payment information
 public class PaymentInfo {
+    // Field paths, for applyMask and field masks
+    public static final String PaymentInfo_PaymentMethod_PATH = "paymentMethod";
+    public static final String PaymentInfo_PaymentToken_PATH = "paymentToken";
+    public static final String PaymentInfo_OperationType_PATH = "operationType";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.PaymentInfo", "PaymentInfo", "PaymentInfo",
+        Arrays.asList(
+            new PuregenFieldDescriptor("paymentMethod", "paymentMethod", "paymentMethod", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("paymentToken", "paymentToken", "paymentToken", 2, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("operationType", "operationType", "operationType", 3, "enum", "puregen.booking.reservations.OperationType", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // Payment method (e.g., credit card, PayPal)
     @JsonProperty("paymentMethod")
     private String paymentMethod;
@@ -70,19 +83,135 @@ public class PaymentInfo {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        PaymentInfo other = (PaymentInfo) o;
+        return Objects.equals(paymentMethod, other.paymentMethod)
+            && Objects.equals(paymentToken, other.paymentToken)
+            && Objects.equals(operationType, other.operationType);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(paymentMethod, paymentToken, operationType);
+    }
+
+    @Override
+    public String toString() {
+        return "PaymentInfo{"
+            + "paymentMethod=" + (paymentMethod != null ? "'" + paymentMethod + "'" : null)
+            + ", paymentToken=" + (paymentToken != null ? "'" + paymentToken + "'" : null)
+            + ", operationType=" + operationType
+            + "}";
+    }
+
+    /** Returns a PaymentInfo with every field at its zero value, unlike new PaymentInfo(), which applies the field defaults. */
+    public static PaymentInfo zeroValue() {
+        PaymentInfo zero = new PaymentInfo();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new PaymentInfo.
+     */
+    public void applyMask(PaymentInfo src, Collection<String> paths) {
+        PaymentInfo source = src != null ? src : new PaymentInfo();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case PaymentInfo_PaymentMethod_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("PaymentInfo: field " + name + " has no subfields");
+                    }
+                    this.paymentMethod = source.paymentMethod;
+                    break;
+                case PaymentInfo_PaymentToken_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("PaymentInfo: field " + name + " has no subfields");
+                    }
+                    this.paymentToken = source.paymentToken;
+                    break;
+                case PaymentInfo_OperationType_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("PaymentInfo: field " + name + " has no subfields");
+                    }
+                    this.operationType = source.operationType;
+                    break;
+                default:
+                    throw new IllegalArgumentException("PaymentInfo: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(PaymentInfo src) {
+        if (src == null) {
+            return;
+        }
+        if (src.paymentMethod != null && !src.paymentMethod.isEmpty()) {
+            this.paymentMethod = src.paymentMethod;
+        }
+        if (src.paymentToken != null && !src.paymentToken.isEmpty()) {
+            this.paymentToken = src.paymentToken;
+        }
+        if (src.operationType != null) {
+            this.operationType = src.operationType;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(PaymentInfo src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(PaymentInfo src, PuregenFieldMask mask) {
+        PaymentInfo masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public PaymentInfo redacted() {
+        PaymentInfo copy = new PaymentInfo();
+        copy.paymentMethod = paymentMethod;
+        copy.paymentToken = paymentToken;
+        copy.operationType = operationType;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static PaymentInfo fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, PaymentInfo.class);
+        return PuregenJson.fromJson(json, PaymentInfo.class);
     }
 
 }
@@ -9,6 +9,17 @@ import com.fasterxml.jackson.databind.*;
 
     // Request for travel package booking
 public class TravelPackageBookingRequest {
+    // Field paths, for applyMask and field masks
+    public static final String TravelPackageBookingRequest_Destinations_PATH = "destinations";
+    public static final String TravelPackageBookingRequest_PaymentInfo_PATH = "paymentInfo";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.TravelPackageBookingRequest", "TravelPackageBookingRequest", "TravelPackageBookingRequest",
+        Arrays.asList(
+            new PuregenFieldDescriptor("destinations", "destinations", "destinations", 1, "string", null, null, true, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("paymentInfo", "paymentInfo", "paymentInfo", 2, "message", "puregen.booking.reservations.PaymentInfo", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // Travel destinations
     @JsonProperty("destinations")
     private List<String> destinations = new ArrayList<>();
@@ -61,19 +72,133 @@ public class TravelPackageBookingRequest {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        TravelPackageBookingRequest other = (TravelPackageBookingRequest) o;
+        return Objects.equals(destinations, other.destinations)
+            && Objects.equals(paymentInfo, other.paymentInfo);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(destinations, paymentInfo);
+    }
+
+    @Override
+    public String toString() {
+        return "TravelPackageBookingRequest{"
+            + "destinations=" + destinations
+            + ", paymentInfo=" + paymentInfo
+            + "}";
+    }
+
+    /** Returns a TravelPackageBookingRequest with every field at its zero value, unlike new TravelPackageBookingRequest(), which applies the field defaults. */
+    public static TravelPackageBookingRequest zeroValue() {
+        TravelPackageBookingRequest zero = new TravelPackageBookingRequest();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new TravelPackageBookingRequest.
+     */
+    public void applyMask(TravelPackageBookingRequest src, Collection<String> paths) {
+        TravelPackageBookingRequest source = src != null ? src : new TravelPackageBookingRequest();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case TravelPackageBookingRequest_Destinations_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TravelPackageBookingRequest: field " + name + " has no subfields");
+                    }
+                    this.destinations = source.destinations != null ? new ArrayList<>(source.destinations) : new ArrayList<>();
+                    break;
+                case TravelPackageBookingRequest_PaymentInfo_PATH:
+                    if (rest == null) {
+                        this.paymentInfo = source.paymentInfo;
+                    } else {
+                        if (this.paymentInfo == null) {
+                            this.paymentInfo = PaymentInfo.zeroValue();
+                        }
+                        this.paymentInfo.applyMask(source.paymentInfo, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("TravelPackageBookingRequest: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(TravelPackageBookingRequest src) {
+        if (src == null) {
+            return;
+        }
+        if (src.destinations != null) {
+            if (this.destinations == null) {
+                this.destinations = new ArrayList<>();
+            }
+            this.destinations.addAll(src.destinations);
+        }
+        if (src.paymentInfo != null) {
+            if (this.paymentInfo == null) {
+                this.paymentInfo = PaymentInfo.zeroValue();
+            }
+            this.paymentInfo.merge(src.paymentInfo);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(TravelPackageBookingRequest src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(TravelPackageBookingRequest src, PuregenFieldMask mask) {
+        TravelPackageBookingRequest masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public TravelPackageBookingRequest redacted() {
+        TravelPackageBookingRequest copy = new TravelPackageBookingRequest();
+        copy.destinations = destinations != null ? new ArrayList<>(destinations) : null;
+        copy.paymentInfo = paymentInfo != null ? paymentInfo.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static TravelPackageBookingRequest fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, TravelPackageBookingRequest.class);
+        return PuregenJson.fromJson(json, TravelPackageBookingRequest.class);
     }
 
 }
@@ -9,6 +9,21 @@ import com.fasterxml.jackson.databind.*;
 
     // Response for travel package booking
 public class TravelPackageBookingResponse {
+    // Field paths, for applyMask and field masks
+    public static final String TravelPackageBookingResponse_TravelPackages_PATH = "travelPackages";
+    public static final String TravelPackageBookingResponse_Error_PATH = "error";
+    public static final String TravelPackageBookingResponse_Status_PATH = "status";
+    public static final String TravelPackageBookingResponse_BookingStats_PATH = "bookingStats";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.TravelPackageBookingResponse", "TravelPackageBookingResponse", "TravelPackageBookingResponse",
+        Arrays.asList(
+            new PuregenFieldDescriptor("travelPackages", "travelPackages", "travelPackages", 1, "message", "puregen.booking.reservations.TravelPackageBookingResponse.SingleTravelPackageResponse", null, true, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("error", "error", "error", 3, "message", "puregen.booking.reservations.Error", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("status", "status", "status", 4, "enum", "puregen.booking.reservations.BookingStatus", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("bookingStats", "bookingStats", "bookingStats", 5, "message", "puregen.booking.reservations.BookingStatsResponse", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // List of travel packages for each destination
     @JsonProperty("travelPackages")
     private List<TravelPackageBookingResponse_SingleTravelPackageResponse> travelPackages = new ArrayList<>();
@@ -95,19 +110,169 @@ public class TravelPackageBookingResponse {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        TravelPackageBookingResponse other = (TravelPackageBookingResponse) o;
+        return Objects.equals(travelPackages, other.travelPackages)
+            && Objects.equals(error, other.error)
+            && Objects.equals(status, other.status)
+            && Objects.equals(bookingStats, other.bookingStats);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(travelPackages, error, status, bookingStats);
+    }
+
+    @Override
+    public String toString() {
+        return "TravelPackageBookingResponse{"
+            + "travelPackages=" + travelPackages
+            + ", error=" + error
+            + ", status=" + (status != null ? "'" + status + "'" : null)
+            + ", bookingStats=" + bookingStats
+            + "}";
+    }
+
+    /** Returns a TravelPackageBookingResponse with every field at its zero value, unlike new TravelPackageBookingResponse(), which applies the field defaults. */
+    public static TravelPackageBookingResponse zeroValue() {
+        TravelPackageBookingResponse zero = new TravelPackageBookingResponse();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new TravelPackageBookingResponse.
+     */
+    public void applyMask(TravelPackageBookingResponse src, Collection<String> paths) {
+        TravelPackageBookingResponse source = src != null ? src : new TravelPackageBookingResponse();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case TravelPackageBookingResponse_TravelPackages_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TravelPackageBookingResponse: field " + name + " has no subfields");
+                    }
+                    this.travelPackages = source.travelPackages != null ? new ArrayList<>(source.travelPackages) : new ArrayList<>();
+                    break;
+                case TravelPackageBookingResponse_Error_PATH:
+                    if (rest == null) {
+                        this.error = source.error;
+                    } else {
+                        if (this.error == null) {
+                            this.error = Error.zeroValue();
+                        }
+                        this.error.applyMask(source.error, List.of(rest));
+                    }
+                    break;
+                case TravelPackageBookingResponse_Status_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TravelPackageBookingResponse: field " + name + " has no subfields");
+                    }
+                    this.status = source.status;
+                    break;
+                case TravelPackageBookingResponse_BookingStats_PATH:
+                    if (rest == null) {
+                        this.bookingStats = source.bookingStats;
+                    } else {
+                        if (this.bookingStats == null) {
+                            this.bookingStats = BookingStatsResponse.zeroValue();
+                        }
+                        this.bookingStats.applyMask(source.bookingStats, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("TravelPackageBookingResponse: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(TravelPackageBookingResponse src) {
+        if (src == null) {
+            return;
+        }
+        if (src.travelPackages != null) {
+            if (this.travelPackages == null) {
+                this.travelPackages = new ArrayList<>();
+            }
+            this.travelPackages.addAll(src.travelPackages);
+        }
+        if (src.error != null) {
+            if (this.error == null) {
+                this.error = Error.zeroValue();
+            }
+            this.error.merge(src.error);
+        }
+        if (src.status != null && !src.status.isEmpty()) {
+            this.status = src.status;
+        }
+        if (src.bookingStats != null) {
+            if (this.bookingStats == null) {
+                this.bookingStats = BookingStatsResponse.zeroValue();
+            }
+            this.bookingStats.merge(src.bookingStats);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(TravelPackageBookingResponse src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(TravelPackageBookingResponse src, PuregenFieldMask mask) {
+        TravelPackageBookingResponse masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public TravelPackageBookingResponse redacted() {
+        TravelPackageBookingResponse copy = new TravelPackageBookingResponse();
+        if (travelPackages != null) {
+            copy.travelPackages = new ArrayList<>(travelPackages);
+            copy.travelPackages.replaceAll(item -> item != null ? item.redacted() : null);
+        } else {
+            copy.travelPackages = null;
+        }
+        copy.error = error != null ? error.redacted() : null;
+        copy.status = status;
+        copy.bookingStats = bookingStats != null ? bookingStats.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static TravelPackageBookingResponse fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, TravelPackageBookingResponse.class);
+        return PuregenJson.fromJson(json, TravelPackageBookingResponse.class);
     }
 
 }
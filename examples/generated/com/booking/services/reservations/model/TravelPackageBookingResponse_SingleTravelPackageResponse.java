@@ -9,6 +9,23 @@ import com.fasterxml.jackson.databind.*;
 
     // Response for single travel package
 public class TravelPackageBookingResponse_SingleTravelPackageResponse {
+    // Field paths, for applyMask and field masks
+    public static final String TravelPackageBookingResponse_SingleTravelPackageResponse_PackageName_PATH = "packageName";
+    public static final String TravelPackageBookingResponse_SingleTravelPackageResponse_Description_PATH = "description";
+    public static final String TravelPackageBookingResponse_SingleTravelPackageResponse_TotalPrice_PATH = "totalPrice";
+    public static final String TravelPackageBookingResponse_SingleTravelPackageResponse_DurationDays_PATH = "durationDays";
+    public static final String TravelPackageBookingResponse_SingleTravelPackageResponse_Error_PATH = "error";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.booking.reservations.TravelPackageBookingResponse.SingleTravelPackageResponse", "SingleTravelPackageResponse", "TravelPackageBookingResponse_SingleTravelPackageResponse",
+        Arrays.asList(
+            new PuregenFieldDescriptor("packageName", "packageName", "packageName", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("description", "description", "description", 2, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("totalPrice", "totalPrice", "totalPrice", 3, "double", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("durationDays", "durationDays", "durationDays", 4, "int32", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("error", "error", "error", 5, "message", "puregen.booking.reservations.Error", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // Package name
     @JsonProperty("packageName")
     private String packageName;
@@ -105,19 +122,166 @@ public class TravelPackageBookingResponse_SingleTravelPackageResponse {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        TravelPackageBookingResponse_SingleTravelPackageResponse other = (TravelPackageBookingResponse_SingleTravelPackageResponse) o;
+        return Objects.equals(packageName, other.packageName)
+            && Objects.equals(description, other.description)
+            && Double.compare(totalPrice, other.totalPrice) == 0
+            && durationDays == other.durationDays
+            && Objects.equals(error, other.error);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(packageName, description, totalPrice, durationDays, error);
+    }
+
+    @Override
+    public String toString() {
+        return "TravelPackageBookingResponse_SingleTravelPackageResponse{"
+            + "packageName=" + (packageName != null ? "'" + packageName + "'" : null)
+            + ", description=" + (description != null ? "'" + description + "'" : null)
+            + ", totalPrice=" + totalPrice
+            + ", durationDays=" + durationDays
+            + ", error=" + error
+            + "}";
+    }
+
+    /** Returns a TravelPackageBookingResponse_SingleTravelPackageResponse with every field at its zero value, unlike new TravelPackageBookingResponse_SingleTravelPackageResponse(), which applies the field defaults. */
+    public static TravelPackageBookingResponse_SingleTravelPackageResponse zeroValue() {
+        TravelPackageBookingResponse_SingleTravelPackageResponse zero = new TravelPackageBookingResponse_SingleTravelPackageResponse();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new TravelPackageBookingResponse_SingleTravelPackageResponse.
+     */
+    public void applyMask(TravelPackageBookingResponse_SingleTravelPackageResponse src, Collection<String> paths) {
+        TravelPackageBookingResponse_SingleTravelPackageResponse source = src != null ? src : new TravelPackageBookingResponse_SingleTravelPackageResponse();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case TravelPackageBookingResponse_SingleTravelPackageResponse_PackageName_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TravelPackageBookingResponse_SingleTravelPackageResponse: field " + name + " has no subfields");
+                    }
+                    this.packageName = source.packageName;
+                    break;
+                case TravelPackageBookingResponse_SingleTravelPackageResponse_Description_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TravelPackageBookingResponse_SingleTravelPackageResponse: field " + name + " has no subfields");
+                    }
+                    this.description = source.description;
+                    break;
+                case TravelPackageBookingResponse_SingleTravelPackageResponse_TotalPrice_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TravelPackageBookingResponse_SingleTravelPackageResponse: field " + name + " has no subfields");
+                    }
+                    this.totalPrice = source.totalPrice;
+                    break;
+                case TravelPackageBookingResponse_SingleTravelPackageResponse_DurationDays_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TravelPackageBookingResponse_SingleTravelPackageResponse: field " + name + " has no subfields");
+                    }
+                    this.durationDays = source.durationDays;
+                    break;
+                case TravelPackageBookingResponse_SingleTravelPackageResponse_Error_PATH:
+                    if (rest == null) {
+                        this.error = source.error;
+                    } else {
+                        if (this.error == null) {
+                            this.error = Error.zeroValue();
+                        }
+                        this.error.applyMask(source.error, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("TravelPackageBookingResponse_SingleTravelPackageResponse: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(TravelPackageBookingResponse_SingleTravelPackageResponse src) {
+        if (src == null) {
+            return;
+        }
+        if (src.packageName != null && !src.packageName.isEmpty()) {
+            this.packageName = src.packageName;
+        }
+        if (src.description != null && !src.description.isEmpty()) {
+            this.description = src.description;
+        }
+        if (src.totalPrice != 0) {
+            this.totalPrice = src.totalPrice;
+        }
+        if (src.durationDays != 0) {
+            this.durationDays = src.durationDays;
+        }
+        if (src.error != null) {
+            if (this.error == null) {
+                this.error = Error.zeroValue();
+            }
+            this.error.merge(src.error);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(TravelPackageBookingResponse_SingleTravelPackageResponse src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(TravelPackageBookingResponse_SingleTravelPackageResponse src, PuregenFieldMask mask) {
+        TravelPackageBookingResponse_SingleTravelPackageResponse masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public TravelPackageBookingResponse_SingleTravelPackageResponse redacted() {
+        TravelPackageBookingResponse_SingleTravelPackageResponse copy = new TravelPackageBookingResponse_SingleTravelPackageResponse();
+        copy.packageName = packageName;
+        copy.description = description;
+        copy.totalPrice = totalPrice;
+        copy.durationDays = durationDays;
+        copy.error = error != null ? error.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static TravelPackageBookingResponse_SingleTravelPackageResponse fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, TravelPackageBookingResponse_SingleTravelPackageResponse.class);
+        return PuregenJson.fromJson(json, TravelPackageBookingResponse_SingleTravelPackageResponse.class);
     }
 
 }
@@ -0,0 +1,42 @@
+// Code generated by protoc-gen-puregen. DO NOT EDIT.
+
+package com.company.examples.error.v1;
+
+import java.util.*;
+import com.fasterxml.jackson.databind.*;
+
+/**
+ * Shared Jackson configuration of the generated messages. Unknown fields
+ * are ignored so older clients can read messages from newer servers.
+ */
+public final class PuregenJson {
+    private PuregenJson() {} // Prevent instantiation
+
+    private static volatile ObjectMapper mapper = newMapper();
+
+    /** Returns a new ObjectMapper with the puregen settings. */
+    public static ObjectMapper newMapper() {
+        ObjectMapper mapper = new ObjectMapper();
+        mapper.configure(DeserializationFeature.FAIL_ON_UNKNOWN_PROPERTIES, false);
+        mapper.configure(SerializationFeature.FAIL_ON_EMPTY_BEANS, false);
+        return mapper;
+    }
+
+    /** Returns the mapper used by toJson and fromJson. */
+    public static ObjectMapper mapper() {
+        return mapper;
+    }
+
+    /** Replaces the mapper used by toJson and fromJson, e.g. one built from newMapper(). */
+    public static void setMapper(ObjectMapper mapper) {
+        PuregenJson.mapper = Objects.requireNonNull(mapper);
+    }
+
+    public static String toJson(Object value) throws Exception {
+        return mapper.writeValueAsString(value);
+    }
+
+    public static <T> T fromJson(String json, Class<T> type) throws Exception {
+        return mapper.readValue(json, type);
+    }
+}
@@ -2,6 +2,8 @@
 
 package com.demo.enums;
 
+import java.util.*;
+
 public class DefaultTaskServiceService implements TaskServiceService {
     @Override
     public Task createTask(Map<String, Object> ctx, Task request) throws Exception {
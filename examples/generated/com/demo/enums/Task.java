@@ -9,6 +9,23 @@ import com.fasterxml.jackson.databind.*;
 
     // Type enum nested in message should also be integers
 public class Task {
+    // Field paths, for applyMask and field masks
+    public static final String Task_Id_PATH = "id";
+    public static final String Task_Title_PATH = "title";
+    public static final String Task_Status_PATH = "status";
+    public static final String Task_Priority_PATH = "priority";
+    public static final String Task_Type_PATH = "type";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "demo.enums.Task", "Task", "Task",
+        Arrays.asList(
+            new PuregenFieldDescriptor("id", "id", "id", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("title", "title", "title", 2, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("status", "status", "status", 3, "enum", "demo.enums.Status", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("priority", "priority", "priority", 4, "enum", "demo.enums.Priority", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("type", "type", "type", 5, "enum", "demo.enums.Task.Type", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     @JsonProperty("id")
     private String id;
 
@@ -100,19 +117,159 @@ public class Task {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        Task other = (Task) o;
+        return Objects.equals(id, other.id)
+            && Objects.equals(title, other.title)
+            && Objects.equals(status, other.status)
+            && Objects.equals(priority, other.priority)
+            && Objects.equals(type, other.type);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(id, title, status, priority, type);
+    }
+
+    @Override
+    public String toString() {
+        return "Task{"
+            + "id=" + (id != null ? "'" + id + "'" : null)
+            + ", title=" + (title != null ? "'" + title + "'" : null)
+            + ", status=" + status
+            + ", priority=" + (priority != null ? "'" + priority + "'" : null)
+            + ", type=" + type
+            + "}";
+    }
+
+    /** Returns a Task with every field at its zero value, unlike new Task(), which applies the field defaults. */
+    public static Task zeroValue() {
+        Task zero = new Task();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new Task.
+     */
+    public void applyMask(Task src, Collection<String> paths) {
+        Task source = src != null ? src : new Task();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case Task_Id_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Task: field " + name + " has no subfields");
+                    }
+                    this.id = source.id;
+                    break;
+                case Task_Title_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Task: field " + name + " has no subfields");
+                    }
+                    this.title = source.title;
+                    break;
+                case Task_Status_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Task: field " + name + " has no subfields");
+                    }
+                    this.status = source.status;
+                    break;
+                case Task_Priority_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Task: field " + name + " has no subfields");
+                    }
+                    this.priority = source.priority;
+                    break;
+                case Task_Type_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Task: field " + name + " has no subfields");
+                    }
+                    this.type = source.type;
+                    break;
+                default:
+                    throw new IllegalArgumentException("Task: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(Task src) {
+        if (src == null) {
+            return;
+        }
+        if (src.id != null && !src.id.isEmpty()) {
+            this.id = src.id;
+        }
+        if (src.title != null && !src.title.isEmpty()) {
+            this.title = src.title;
+        }
+        if (src.status != null) {
+            this.status = src.status;
+        }
+        if (src.priority != null && !src.priority.isEmpty()) {
+            this.priority = src.priority;
+        }
+        if (src.type != null) {
+            this.type = src.type;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(Task src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(Task src, PuregenFieldMask mask) {
+        Task masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public Task redacted() {
+        Task copy = new Task();
+        copy.id = id;
+        copy.title = title;
+        copy.status = status;
+        copy.priority = priority;
+        copy.type = type;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static Task fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, Task.class);
+        return PuregenJson.fromJson(json, Task.class);
     }
 
 }
@@ -8,6 +8,15 @@ import com.fasterxml.jackson.annotation.*;
 import com.fasterxml.jackson.databind.*;
 
 public class TaskList {
+    // Field paths, for applyMask and field masks
+    public static final String TaskList_Tasks_PATH = "tasks";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "demo.enums.TaskList", "TaskList", "TaskList",
+        Arrays.asList(
+            new PuregenFieldDescriptor("tasks", "tasks", "tasks", 1, "message", "demo.enums.Task", null, true, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     @JsonProperty("tasks")
     private List<Task> tasks = new ArrayList<>();
 
@@ -42,19 +51,119 @@ public class TaskList {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        TaskList other = (TaskList) o;
+        return Objects.equals(tasks, other.tasks);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(tasks);
+    }
+
+    @Override
+    public String toString() {
+        return "TaskList{"
+            + "tasks=" + tasks
+            + "}";
+    }
+
+    /** Returns a TaskList with every field at its zero value, unlike new TaskList(), which applies the field defaults. */
+    public static TaskList zeroValue() {
+        TaskList zero = new TaskList();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new TaskList.
+     */
+    public void applyMask(TaskList src, Collection<String> paths) {
+        TaskList source = src != null ? src : new TaskList();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case TaskList_Tasks_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TaskList: field " + name + " has no subfields");
+                    }
+                    this.tasks = source.tasks != null ? new ArrayList<>(source.tasks) : new ArrayList<>();
+                    break;
+                default:
+                    throw new IllegalArgumentException("TaskList: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(TaskList src) {
+        if (src == null) {
+            return;
+        }
+        if (src.tasks != null) {
+            if (this.tasks == null) {
+                this.tasks = new ArrayList<>();
+            }
+            this.tasks.addAll(src.tasks);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(TaskList src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(TaskList src, PuregenFieldMask mask) {
+        TaskList masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public TaskList redacted() {
+        TaskList copy = new TaskList();
+        if (tasks != null) {
+            copy.tasks = new ArrayList<>(tasks);
+            copy.tasks.replaceAll(item -> item != null ? item.redacted() : null);
+        } else {
+            copy.tasks = null;
+        }
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static TaskList fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, TaskList.class);
+        return PuregenJson.fromJson(json, TaskList.class);
     }
 
 }
@@ -13,7 +13,7 @@ public class TaskServiceClient {
 
     public Task createTask(Map<String, Object> ctx, Task request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = TaskServiceMethods.METHOD_METADATA.get(TaskServiceMethods.TaskService_CreateTask);
+        Map<String, Object> methodMetadata = TaskServiceMethods.METHOD_METADATA.get(TaskServiceMethods.TaskService_CreateTask);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -22,7 +22,7 @@ public class TaskServiceClient {
 
     public TaskList listTasks(Map<String, Object> ctx, TaskList request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = TaskServiceMethods.METHOD_METADATA.get(TaskServiceMethods.TaskService_ListTasks);
+        Map<String, Object> methodMetadata = TaskServiceMethods.METHOD_METADATA.get(TaskServiceMethods.TaskService_ListTasks);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -10,7 +10,7 @@ public final class TaskServiceMethods {
     public static final String TaskService_CreateTask = "TaskService_CreateTask";
     public static final String TaskService_ListTasks = "TaskService_ListTasks";
 
-    public static final Map<String, Map<String, String>> METHOD_METADATA = new HashMap<>();
+    public static final Map<String, Map<String, Object>> METHOD_METADATA = new HashMap<>();
     static {
     }
 }
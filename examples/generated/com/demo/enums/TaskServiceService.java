@@ -2,6 +2,8 @@
 
 package com.demo.enums;
 
+import java.util.*;
+
 public interface TaskServiceService {
     Task createTask(Map<String, Object> ctx, Task request) throws Exception;
     TaskList listTasks(Map<String, Object> ctx, TaskList request) throws Exception;
@@ -2,12 +2,15 @@
 
 package com.demo.enums;
 
+import com.fasterxml.jackson.annotation.*;
+
     // 
 public enum Task_Type {
     TYPE_UNKNOWN(0),
     TYPE_BUG(1),
     TYPE_FEATURE(2),
-    TYPE_ENHANCEMENT(3);
+    TYPE_ENHANCEMENT(3),
+    UNRECOGNIZED(-1);
 
     private final int value;
 
@@ -15,22 +18,46 @@ public enum Task_Type {
         this.value = value;
     }
 
+    @JsonValue
     public int getValue() {
         return value;
     }
 
     public static Task_Type fromValue(int value) {
         for (Task_Type e : values()) {
-            if (e.value == value) {
+            if (e != UNRECOGNIZED && e.value == value) {
                 return e;
             }
         }
-        throw new IllegalArgumentException("Invalid Task_Type value: " + value);
+        return UNRECOGNIZED;
+    }
+
+    public static Task_Type fromName(String name) {
+        switch (name) {
+            case "TYPE_UNKNOWN":
+                return TYPE_UNKNOWN;
+            case "TYPE_BUG":
+                return TYPE_BUG;
+            case "TYPE_FEATURE":
+                return TYPE_FEATURE;
+            case "TYPE_ENHANCEMENT":
+                return TYPE_ENHANCEMENT;
+            default:
+                return UNRECOGNIZED;
+        }
+    }
+
+    @JsonCreator(mode = JsonCreator.Mode.DELEGATING)
+    public static Task_Type fromJsonValue(Object value) {
+        if (value instanceof Number) {
+            return fromValue(((Number) value).intValue());
+        }
+        return fromName(String.valueOf(value));
     }
 
     public static boolean isValid(int value) {
         for (Task_Type e : values()) {
-            if (e.value == value) {
+            if (e != UNRECOGNIZED && e.value == value) {
                 return true;
             }
         }
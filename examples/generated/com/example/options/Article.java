@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-puregen. DO NOT EDIT.
+
+package com.example.options;
+
+import java.util.*;
+import java.io.*;
+import com.fasterxml.jackson.annotation.*;
+import com.fasterxml.jackson.databind.*;
+
+    // Article stored in the content database
+public class Article {
+    // Field paths, for applyMask and field masks
+    public static final String Article_Id_PATH = "id";
+    public static final String Article_Title_PATH = "title";
+    public static final String Article_State_PATH = "state";
+    public static final String Article_Visibility_PATH = "visibility";
+    public static final String Article_Color_PATH = "color";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "example.options.Article", "Article", "Article",
+        Arrays.asList(
+            new PuregenFieldDescriptor("id", "id", "id", 1, "string", null, null, false, false, false, ArticleFieldMetadata.FIELD_METADATA.get(ArticleFieldMetadata.Article_Id_FIELD)),
+            new PuregenFieldDescriptor("title", "title", "title", 2, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("state", "state", "state", 3, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("visibility", "visibility", "visibility", 4, "enum", "example.options.Visibility", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("color", "color", "color", 5, "enum", "example.options.Color", null, false, false, false, Collections.emptyMap())),
+        ArticleMetadata.METADATA);
+
+    @JsonProperty("id")
+    private String id;
+
+    @JsonProperty("title")
+    private String title;
+
+    // The option overrides the default from the comment directive
+    @JsonProperty("state")
+    private String state;
+
+    @JsonProperty("visibility")
+    private Visibility visibility;
+
+    @JsonProperty("color")
+    private String color;
+
+    public Article() {
+        this.title = "Untitled";
+        this.state = "new";
+    }
+
+    public String getId() {
+        return id;
+    }
+
+    public void setId(String id) {
+        this.id = id;
+    }
+
+    public String getTitle() {
+        return title;
+    }
+
+    public void setTitle(String title) {
+        this.title = title;
+    }
+
+    public String getState() {
+        return state;
+    }
+
+    public void setState(String state) {
+        this.state = state;
+    }
+
+    public Visibility getVisibility() {
+        return visibility;
+    }
+
+    public void setVisibility(Visibility visibility) {
+        this.visibility = visibility;
+    }
+
+    public String getColor() {
+        return color;
+    }
+
+    public void setColor(String color) {
+        this.color = color;
+    }
+
+    public static class Builder {
+        private Article instance = new Article();
+
+        public Builder setId(String id) {
+            instance.setId(id);
+            return this;
+        }
+
+        public Builder setTitle(String title) {
+            instance.setTitle(title);
+            return this;
+        }
+
+        public Builder setState(String state) {
+            instance.setState(state);
+            return this;
+        }
+
+        public Builder setVisibility(Visibility visibility) {
+            instance.setVisibility(visibility);
+            return this;
+        }
+
+        public Builder setColor(String color) {
+            instance.setColor(color);
+            return this;
+        }
+
+        public Article build() {
+            return instance;
+        }
+    }
+
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        Article other = (Article) o;
+        return Objects.equals(id, other.id)
+            && Objects.equals(title, other.title)
+            && Objects.equals(state, other.state)
+            && Objects.equals(visibility, other.visibility)
+            && Objects.equals(color, other.color);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(id, title, state, visibility, color);
+    }
+
+    @Override
+    public String toString() {
+        return "Article{"
+            + "id=" + (id != null ? "'" + id + "'" : null)
+            + ", title=" + (title != null ? "'" + title + "'" : null)
+            + ", state=" + (state != null ? "'" + state + "'" : null)
+            + ", visibility=" + visibility
+            + ", color=" + (color != null ? "'" + color + "'" : null)
+            + "}";
+    }
+
+    /** Returns a Article with every field at its zero value, unlike new Article(), which applies the field defaults. */
+    public static Article zeroValue() {
+        Article zero = new Article();
+        zero.title = null;
+        zero.state = null;
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new Article.
+     */
+    public void applyMask(Article src, Collection<String> paths) {
+        Article source = src != null ? src : new Article();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case Article_Id_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Article: field " + name + " has no subfields");
+                    }
+                    this.id = source.id;
+                    break;
+                case Article_Title_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Article: field " + name + " has no subfields");
+                    }
+                    this.title = source.title;
+                    break;
+                case Article_State_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Article: field " + name + " has no subfields");
+                    }
+                    this.state = source.state;
+                    break;
+                case Article_Visibility_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Article: field " + name + " has no subfields");
+                    }
+                    this.visibility = source.visibility;
+                    break;
+                case Article_Color_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Article: field " + name + " has no subfields");
+                    }
+                    this.color = source.color;
+                    break;
+                default:
+                    throw new IllegalArgumentException("Article: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(Article src) {
+        if (src == null) {
+            return;
+        }
+        if (src.id != null && !src.id.isEmpty()) {
+            this.id = src.id;
+        }
+        if (src.title != null && !src.title.isEmpty()) {
+            this.title = src.title;
+        }
+        if (src.state != null && !src.state.isEmpty()) {
+            this.state = src.state;
+        }
+        if (src.visibility != null) {
+            this.visibility = src.visibility;
+        }
+        if (src.color != null && !src.color.isEmpty()) {
+            this.color = src.color;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(Article src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(Article src, PuregenFieldMask mask) {
+        Article masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public Article redacted() {
+        Article copy = new Article();
+        copy.id = id;
+        copy.title = title;
+        copy.state = state;
+        copy.visibility = visibility;
+        copy.color = color;
+        return copy;
+    }
+
+    public boolean validate() {
+        // Add custom validation logic here
+        return true;
+    }
+
+    public String toJson() throws Exception {
+        return PuregenJson.toJson(this);
+    }
+
+    public static Article fromJson(String json) throws Exception {
+        return PuregenJson.fromJson(json, Article.class);
+    }
+
+}
@@ -0,0 +1,154 @@
+// Code generated by protoc-gen-puregen. DO NOT EDIT.
+
+package com.example.options;
+
+import java.util.*;
+import java.io.*;
+import com.fasterxml.jackson.annotation.*;
+import com.fasterxml.jackson.databind.*;
+
+public class GetArticleRequest {
+    // Field paths, for applyMask and field masks
+    public static final String GetArticleRequest_Id_PATH = "id";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "example.options.GetArticleRequest", "GetArticleRequest", "GetArticleRequest",
+        Arrays.asList(
+            new PuregenFieldDescriptor("id", "id", "id", 1, "string", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
+    @JsonProperty("id")
+    private String id;
+
+    public GetArticleRequest() {
+    }
+
+    public String getId() {
+        return id;
+    }
+
+    public void setId(String id) {
+        this.id = id;
+    }
+
+    public static class Builder {
+        private GetArticleRequest instance = new GetArticleRequest();
+
+        public Builder setId(String id) {
+            instance.setId(id);
+            return this;
+        }
+
+        public GetArticleRequest build() {
+            return instance;
+        }
+    }
+
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        GetArticleRequest other = (GetArticleRequest) o;
+        return Objects.equals(id, other.id);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(id);
+    }
+
+    @Override
+    public String toString() {
+        return "GetArticleRequest{"
+            + "id=" + (id != null ? "'" + id + "'" : null)
+            + "}";
+    }
+
+    /** Returns a GetArticleRequest with every field at its zero value, unlike new GetArticleRequest(), which applies the field defaults. */
+    public static GetArticleRequest zeroValue() {
+        GetArticleRequest zero = new GetArticleRequest();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new GetArticleRequest.
+     */
+    public void applyMask(GetArticleRequest src, Collection<String> paths) {
+        GetArticleRequest source = src != null ? src : new GetArticleRequest();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case GetArticleRequest_Id_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("GetArticleRequest: field " + name + " has no subfields");
+                    }
+                    this.id = source.id;
+                    break;
+                default:
+                    throw new IllegalArgumentException("GetArticleRequest: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(GetArticleRequest src) {
+        if (src == null) {
+            return;
+        }
+        if (src.id != null && !src.id.isEmpty()) {
+            this.id = src.id;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(GetArticleRequest src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(GetArticleRequest src, PuregenFieldMask mask) {
+        GetArticleRequest masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public GetArticleRequest redacted() {
+        GetArticleRequest copy = new GetArticleRequest();
+        copy.id = id;
+        return copy;
+    }
+
+    public boolean validate() {
+        // Add custom validation logic here
+        return true;
+    }
+
+    public String toJson() throws Exception {
+        return PuregenJson.toJson(this);
+    }
+
+    public static GetArticleRequest fromJson(String json) throws Exception {
+        return PuregenJson.fromJson(json, GetArticleRequest.class);
+    }
+
+}
@@ -9,6 +9,19 @@ import com.fasterxml.jackson.databind.*;
 
     // CreateUserRequest is the request for creating a user
 public class CreateUserRequest {
+    // Field paths, for applyMask and field masks
+    public static final String CreateUserRequest_Name_PATH = "name";
+    public static final String CreateUserRequest_Email_PATH = "email";
+    public static final String CreateUserRequest_Profile_PATH = "profile";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.examples.user.v1.CreateUserRequest", "CreateUserRequest", "CreateUserRequest",
+        Arrays.asList(
+            new PuregenFieldDescriptor("name", "name", "name", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("email", "email", "email", 2, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("profile", "profile", "profile", 3, "message", "puregen.examples.user.v1.UserProfile", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     @JsonProperty("name")
     private String name;
 
@@ -68,19 +81,142 @@ public class CreateUserRequest {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        CreateUserRequest other = (CreateUserRequest) o;
+        return Objects.equals(name, other.name)
+            && Objects.equals(email, other.email)
+            && Objects.equals(profile, other.profile);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(name, email, profile);
+    }
+
+    @Override
+    public String toString() {
+        return "CreateUserRequest{"
+            + "name=" + (name != null ? "'" + name + "'" : null)
+            + ", email=" + (email != null ? "'" + email + "'" : null)
+            + ", profile=" + profile
+            + "}";
+    }
+
+    /** Returns a CreateUserRequest with every field at its zero value, unlike new CreateUserRequest(), which applies the field defaults. */
+    public static CreateUserRequest zeroValue() {
+        CreateUserRequest zero = new CreateUserRequest();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new CreateUserRequest.
+     */
+    public void applyMask(CreateUserRequest src, Collection<String> paths) {
+        CreateUserRequest source = src != null ? src : new CreateUserRequest();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case CreateUserRequest_Name_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("CreateUserRequest: field " + name + " has no subfields");
+                    }
+                    this.name = source.name;
+                    break;
+                case CreateUserRequest_Email_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("CreateUserRequest: field " + name + " has no subfields");
+                    }
+                    this.email = source.email;
+                    break;
+                case CreateUserRequest_Profile_PATH:
+                    if (rest == null) {
+                        this.profile = source.profile;
+                    } else {
+                        if (this.profile == null) {
+                            this.profile = UserProfile.zeroValue();
+                        }
+                        this.profile.applyMask(source.profile, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("CreateUserRequest: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(CreateUserRequest src) {
+        if (src == null) {
+            return;
+        }
+        if (src.name != null && !src.name.isEmpty()) {
+            this.name = src.name;
+        }
+        if (src.email != null && !src.email.isEmpty()) {
+            this.email = src.email;
+        }
+        if (src.profile != null) {
+            if (this.profile == null) {
+                this.profile = UserProfile.zeroValue();
+            }
+            this.profile.merge(src.profile);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(CreateUserRequest src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(CreateUserRequest src, PuregenFieldMask mask) {
+        CreateUserRequest masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public CreateUserRequest redacted() {
+        CreateUserRequest copy = new CreateUserRequest();
+        copy.name = name;
+        copy.email = email;
+        copy.profile = profile != null ? profile.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static CreateUserRequest fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, CreateUserRequest.class);
+        return PuregenJson.fromJson(json, CreateUserRequest.class);
     }
 
 }
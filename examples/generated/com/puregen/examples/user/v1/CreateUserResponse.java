@@ -9,6 +9,19 @@ import com.fasterxml.jackson.databind.*;
 
     // CreateUserResponse is the response for creating a user
 public class CreateUserResponse {
+    // Field paths, for applyMask and field masks
+    public static final String CreateUserResponse_User_PATH = "user";
+    public static final String CreateUserResponse_Success_PATH = "success";
+    public static final String CreateUserResponse_Message_PATH = "message";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.examples.user.v1.CreateUserResponse", "CreateUserResponse", "CreateUserResponse",
+        Arrays.asList(
+            new PuregenFieldDescriptor("user", "user", "user", 1, "message", "puregen.examples.user.v1.User", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("success", "success", "success", 2, "bool", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("message", "message", "message", 3, "string", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     @JsonProperty("user")
     private User user;
 
@@ -68,19 +81,142 @@ public class CreateUserResponse {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        CreateUserResponse other = (CreateUserResponse) o;
+        return Objects.equals(user, other.user)
+            && success == other.success
+            && Objects.equals(message, other.message);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(user, success, message);
+    }
+
+    @Override
+    public String toString() {
+        return "CreateUserResponse{"
+            + "user=" + user
+            + ", success=" + success
+            + ", message=" + (message != null ? "'" + message + "'" : null)
+            + "}";
+    }
+
+    /** Returns a CreateUserResponse with every field at its zero value, unlike new CreateUserResponse(), which applies the field defaults. */
+    public static CreateUserResponse zeroValue() {
+        CreateUserResponse zero = new CreateUserResponse();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new CreateUserResponse.
+     */
+    public void applyMask(CreateUserResponse src, Collection<String> paths) {
+        CreateUserResponse source = src != null ? src : new CreateUserResponse();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case CreateUserResponse_User_PATH:
+                    if (rest == null) {
+                        this.user = source.user;
+                    } else {
+                        if (this.user == null) {
+                            this.user = User.zeroValue();
+                        }
+                        this.user.applyMask(source.user, List.of(rest));
+                    }
+                    break;
+                case CreateUserResponse_Success_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("CreateUserResponse: field " + name + " has no subfields");
+                    }
+                    this.success = source.success;
+                    break;
+                case CreateUserResponse_Message_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("CreateUserResponse: field " + name + " has no subfields");
+                    }
+                    this.message = source.message;
+                    break;
+                default:
+                    throw new IllegalArgumentException("CreateUserResponse: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(CreateUserResponse src) {
+        if (src == null) {
+            return;
+        }
+        if (src.user != null) {
+            if (this.user == null) {
+                this.user = User.zeroValue();
+            }
+            this.user.merge(src.user);
+        }
+        if (src.success) {
+            this.success = src.success;
+        }
+        if (src.message != null && !src.message.isEmpty()) {
+            this.message = src.message;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(CreateUserResponse src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(CreateUserResponse src, PuregenFieldMask mask) {
+        CreateUserResponse masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public CreateUserResponse redacted() {
+        CreateUserResponse copy = new CreateUserResponse();
+        copy.user = user != null ? user.redacted() : null;
+        copy.success = success;
+        copy.message = message;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static CreateUserResponse fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, CreateUserResponse.class);
+        return PuregenJson.fromJson(json, CreateUserResponse.class);
     }
 
 }
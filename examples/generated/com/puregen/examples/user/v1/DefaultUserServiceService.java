@@ -2,6 +2,8 @@
 
 package com.puregen.examples.user.v1;
 
+import java.util.*;
+
 public class DefaultUserServiceService implements UserServiceService {
     // CreateUser creates a new user
     @Override
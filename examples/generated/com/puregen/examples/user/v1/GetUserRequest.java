@@ -9,6 +9,15 @@ import com.fasterxml.jackson.databind.*;
 
     // GetUserRequest is the request for getting a user
 public class GetUserRequest {
+    // Field paths, for applyMask and field masks
+    public static final String GetUserRequest_Id_PATH = "id";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.examples.user.v1.GetUserRequest", "GetUserRequest", "GetUserRequest",
+        Arrays.asList(
+            new PuregenFieldDescriptor("id", "id", "id", 1, "int32", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     @JsonProperty("id")
     private int id;
 
@@ -36,19 +45,111 @@ public class GetUserRequest {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        GetUserRequest other = (GetUserRequest) o;
+        return id == other.id;
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(id);
+    }
+
+    @Override
+    public String toString() {
+        return "GetUserRequest{"
+            + "id=" + id
+            + "}";
+    }
+
+    /** Returns a GetUserRequest with every field at its zero value, unlike new GetUserRequest(), which applies the field defaults. */
+    public static GetUserRequest zeroValue() {
+        GetUserRequest zero = new GetUserRequest();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new GetUserRequest.
+     */
+    public void applyMask(GetUserRequest src, Collection<String> paths) {
+        GetUserRequest source = src != null ? src : new GetUserRequest();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case GetUserRequest_Id_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("GetUserRequest: field " + name + " has no subfields");
+                    }
+                    this.id = source.id;
+                    break;
+                default:
+                    throw new IllegalArgumentException("GetUserRequest: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(GetUserRequest src) {
+        if (src == null) {
+            return;
+        }
+        if (src.id != 0) {
+            this.id = src.id;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(GetUserRequest src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(GetUserRequest src, PuregenFieldMask mask) {
+        GetUserRequest masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public GetUserRequest redacted() {
+        GetUserRequest copy = new GetUserRequest();
+        copy.id = id;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static GetUserRequest fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, GetUserRequest.class);
+        return PuregenJson.fromJson(json, GetUserRequest.class);
     }
 
 }
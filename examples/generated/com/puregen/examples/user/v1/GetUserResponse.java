@@ -9,6 +9,17 @@ import com.fasterxml.jackson.databind.*;
 
     // GetUserResponse is the response for getting a user
 public class GetUserResponse {
+    // Field paths, for applyMask and field masks
+    public static final String GetUserResponse_User_PATH = "user";
+    public static final String GetUserResponse_Found_PATH = "found";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.examples.user.v1.GetUserResponse", "GetUserResponse", "GetUserResponse",
+        Arrays.asList(
+            new PuregenFieldDescriptor("user", "user", "user", 1, "message", "puregen.examples.user.v1.User", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("found", "found", "found", 2, "bool", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     @JsonProperty("user")
     private User user;
 
@@ -52,19 +63,130 @@ public class GetUserResponse {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        GetUserResponse other = (GetUserResponse) o;
+        return Objects.equals(user, other.user)
+            && found == other.found;
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(user, found);
+    }
+
+    @Override
+    public String toString() {
+        return "GetUserResponse{"
+            + "user=" + user
+            + ", found=" + found
+            + "}";
+    }
+
+    /** Returns a GetUserResponse with every field at its zero value, unlike new GetUserResponse(), which applies the field defaults. */
+    public static GetUserResponse zeroValue() {
+        GetUserResponse zero = new GetUserResponse();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new GetUserResponse.
+     */
+    public void applyMask(GetUserResponse src, Collection<String> paths) {
+        GetUserResponse source = src != null ? src : new GetUserResponse();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case GetUserResponse_User_PATH:
+                    if (rest == null) {
+                        this.user = source.user;
+                    } else {
+                        if (this.user == null) {
+                            this.user = User.zeroValue();
+                        }
+                        this.user.applyMask(source.user, List.of(rest));
+                    }
+                    break;
+                case GetUserResponse_Found_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("GetUserResponse: field " + name + " has no subfields");
+                    }
+                    this.found = source.found;
+                    break;
+                default:
+                    throw new IllegalArgumentException("GetUserResponse: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(GetUserResponse src) {
+        if (src == null) {
+            return;
+        }
+        if (src.user != null) {
+            if (this.user == null) {
+                this.user = User.zeroValue();
+            }
+            this.user.merge(src.user);
+        }
+        if (src.found) {
+            this.found = src.found;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(GetUserResponse src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(GetUserResponse src, PuregenFieldMask mask) {
+        GetUserResponse masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public GetUserResponse redacted() {
+        GetUserResponse copy = new GetUserResponse();
+        copy.user = user != null ? user.redacted() : null;
+        copy.found = found;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static GetUserResponse fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, GetUserResponse.class);
+        return PuregenJson.fromJson(json, GetUserResponse.class);
     }
 
 }
@@ -9,16 +9,37 @@ import com.fasterxml.jackson.databind.*;
 
     // User message represents a user in the system
 public class User {
+    // Field paths, for applyMask and field masks
+    public static final String User_Id_PATH = "id";
+    public static final String User_Name_PATH = "name";
+    public static final String User_Email_PATH = "email";
+    public static final String User_IsActive_PATH = "is_active";
+    public static final String User_Tags_PATH = "tags";
+    public static final String User_Profile_PATH = "profile";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.examples.user.v1.User", "User", "User",
+        Arrays.asList(
+            new PuregenFieldDescriptor("id", "id", "id", 1, "int32", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("name", "name", "name", 2, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("email", "email", "email", 3, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("is_active", "isActive", "isActive", 4, "bool", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("tags", "tags", "tags", 5, "string", null, null, true, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("profile", "profile", "profile", 6, "message", "puregen.examples.user.v1.UserProfile", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     @JsonProperty("id")
     private int id;
 
     @JsonProperty("name")
     private String name;
 
+    // 
     @JsonProperty("email")
     private String email;
 
     @JsonProperty("isActive")
+    @JsonAlias({"is_active"})
     private boolean isActive;
 
     @JsonProperty("tags")
@@ -28,6 +49,7 @@ public class User {
     private UserProfile profile;
 
     public User() {
+        this.email = "";
     }
 
     public int getId() {
@@ -123,19 +145,184 @@ public class User {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        User other = (User) o;
+        return id == other.id
+            && Objects.equals(name, other.name)
+            && Objects.equals(email, other.email)
+            && isActive == other.isActive
+            && Objects.equals(tags, other.tags)
+            && Objects.equals(profile, other.profile);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(id, name, email, isActive, tags, profile);
+    }
+
+    @Override
+    public String toString() {
+        User redacted = redacted();
+        return "User{"
+            + "id=" + redacted.id
+            + ", name=" + (redacted.name != null ? "'" + redacted.name + "'" : null)
+            + ", email=" + (redacted.email != null ? "'" + redacted.email + "'" : null)
+            + ", isActive=" + redacted.isActive
+            + ", tags=" + redacted.tags
+            + ", profile=" + redacted.profile
+            + "}";
+    }
+
+    /** Returns a User with every field at its zero value, unlike new User(), which applies the field defaults. */
+    public static User zeroValue() {
+        User zero = new User();
+        zero.email = null;
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new User.
+     */
+    public void applyMask(User src, Collection<String> paths) {
+        User source = src != null ? src : new User();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case User_Id_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("User: field " + name + " has no subfields");
+                    }
+                    this.id = source.id;
+                    break;
+                case User_Name_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("User: field " + name + " has no subfields");
+                    }
+                    this.name = source.name;
+                    break;
+                case User_Email_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("User: field " + name + " has no subfields");
+                    }
+                    this.email = source.email;
+                    break;
+                case User_IsActive_PATH:
+                case "isActive":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("User: field " + name + " has no subfields");
+                    }
+                    this.isActive = source.isActive;
+                    break;
+                case User_Tags_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("User: field " + name + " has no subfields");
+                    }
+                    this.tags = source.tags != null ? new ArrayList<>(source.tags) : new ArrayList<>();
+                    break;
+                case User_Profile_PATH:
+                    if (rest == null) {
+                        this.profile = source.profile;
+                    } else {
+                        if (this.profile == null) {
+                            this.profile = UserProfile.zeroValue();
+                        }
+                        this.profile.applyMask(source.profile, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("User: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(User src) {
+        if (src == null) {
+            return;
+        }
+        if (src.id != 0) {
+            this.id = src.id;
+        }
+        if (src.name != null && !src.name.isEmpty()) {
+            this.name = src.name;
+        }
+        if (src.email != null && !src.email.isEmpty()) {
+            this.email = src.email;
+        }
+        if (src.isActive) {
+            this.isActive = src.isActive;
+        }
+        if (src.tags != null) {
+            if (this.tags == null) {
+                this.tags = new ArrayList<>();
+            }
+            this.tags.addAll(src.tags);
+        }
+        if (src.profile != null) {
+            if (this.profile == null) {
+                this.profile = UserProfile.zeroValue();
+            }
+            this.profile.merge(src.profile);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(User src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(User src, PuregenFieldMask mask) {
+        User masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public User redacted() {
+        User copy = new User();
+        copy.id = id;
+        copy.name = name;
+        copy.email = email != null && !email.isEmpty() ? "[REDACTED]" : email;
+        copy.isActive = isActive;
+        copy.tags = tags != null ? new ArrayList<>(tags) : null;
+        copy.profile = profile != null ? profile.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static User fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, User.class);
+        return PuregenJson.fromJson(json, User.class);
     }
 
 }
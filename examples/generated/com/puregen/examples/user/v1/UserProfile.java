@@ -9,13 +9,28 @@ import com.fasterxml.jackson.databind.*;
 
     // UserProfile contains additional user information
 public class UserProfile {
+    // Field paths, for applyMask and field masks
+    public static final String UserProfile_Bio_PATH = "bio";
+    public static final String UserProfile_AvatarUrl_PATH = "avatar_url";
+    public static final String UserProfile_CreatedAt_PATH = "created_at";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.examples.user.v1.UserProfile", "UserProfile", "UserProfile",
+        Arrays.asList(
+            new PuregenFieldDescriptor("bio", "bio", "bio", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("avatar_url", "avatarUrl", "avatarUrl", 2, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("created_at", "createdAt", "createdAt", 3, "int64", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     @JsonProperty("bio")
     private String bio;
 
     @JsonProperty("avatarUrl")
+    @JsonAlias({"avatar_url"})
     private String avatarUrl;
 
     @JsonProperty("createdAt")
+    @JsonAlias({"created_at"})
     private long createdAt;
 
     public UserProfile() {
@@ -68,19 +83,137 @@ public class UserProfile {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        UserProfile other = (UserProfile) o;
+        return Objects.equals(bio, other.bio)
+            && Objects.equals(avatarUrl, other.avatarUrl)
+            && createdAt == other.createdAt;
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(bio, avatarUrl, createdAt);
+    }
+
+    @Override
+    public String toString() {
+        return "UserProfile{"
+            + "bio=" + (bio != null ? "'" + bio + "'" : null)
+            + ", avatarUrl=" + (avatarUrl != null ? "'" + avatarUrl + "'" : null)
+            + ", createdAt=" + createdAt
+            + "}";
+    }
+
+    /** Returns a UserProfile with every field at its zero value, unlike new UserProfile(), which applies the field defaults. */
+    public static UserProfile zeroValue() {
+        UserProfile zero = new UserProfile();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new UserProfile.
+     */
+    public void applyMask(UserProfile src, Collection<String> paths) {
+        UserProfile source = src != null ? src : new UserProfile();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case UserProfile_Bio_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("UserProfile: field " + name + " has no subfields");
+                    }
+                    this.bio = source.bio;
+                    break;
+                case UserProfile_AvatarUrl_PATH:
+                case "avatarUrl":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("UserProfile: field " + name + " has no subfields");
+                    }
+                    this.avatarUrl = source.avatarUrl;
+                    break;
+                case UserProfile_CreatedAt_PATH:
+                case "createdAt":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("UserProfile: field " + name + " has no subfields");
+                    }
+                    this.createdAt = source.createdAt;
+                    break;
+                default:
+                    throw new IllegalArgumentException("UserProfile: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(UserProfile src) {
+        if (src == null) {
+            return;
+        }
+        if (src.bio != null && !src.bio.isEmpty()) {
+            this.bio = src.bio;
+        }
+        if (src.avatarUrl != null && !src.avatarUrl.isEmpty()) {
+            this.avatarUrl = src.avatarUrl;
+        }
+        if (src.createdAt != 0) {
+            this.createdAt = src.createdAt;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(UserProfile src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(UserProfile src, PuregenFieldMask mask) {
+        UserProfile masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public UserProfile redacted() {
+        UserProfile copy = new UserProfile();
+        copy.bio = bio;
+        copy.avatarUrl = avatarUrl;
+        copy.createdAt = createdAt;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static UserProfile fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, UserProfile.class);
+        return PuregenJson.fromJson(json, UserProfile.class);
     }
 
 }
@@ -14,7 +14,7 @@ public class UserServiceClient {
     // CreateUser creates a new user
     public CreateUserResponse createUser(Map<String, Object> ctx, CreateUserRequest request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = UserServiceMethods.METHOD_METADATA.get(UserServiceMethods.UserService_CreateUser);
+        Map<String, Object> methodMetadata = UserServiceMethods.METHOD_METADATA.get(UserServiceMethods.UserService_CreateUser);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -28,7 +28,7 @@ public class UserServiceClient {
      */
     public GetUserResponse getUser(Map<String, Object> ctx, GetUserRequest request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = UserServiceMethods.METHOD_METADATA.get(UserServiceMethods.UserService_GetUser);
+        Map<String, Object> methodMetadata = UserServiceMethods.METHOD_METADATA.get(UserServiceMethods.UserService_GetUser);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -2,6 +2,8 @@
 
 package com.puregen.examples.user.v1;
 
+import java.util.*;
+
     // UserService provides operations for managing users
 public interface UserServiceService {
     // CreateUser creates a new user
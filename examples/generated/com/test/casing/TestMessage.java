@@ -8,6 +8,33 @@ import com.fasterxml.jackson.annotation.*;
 import com.fasterxml.jackson.databind.*;
 
 public class TestMessage {
+    // Field paths, for applyMask and field masks
+    public static final String TestMessage_APIHost_PATH = "APIHost";
+    public static final String TestMessage_TPMData_PATH = "TPMData";
+    public static final String TestMessage_XMLContent_PATH = "XMLContent";
+    public static final String TestMessage_URLPath_PATH = "URLPath";
+    public static final String TestMessage_HTTPSEnabled_PATH = "HTTPSEnabled";
+    public static final String TestMessage_UUIDValue_PATH = "UUIDValue";
+    public static final String TestMessage_JSONData_PATH = "JSONData";
+    public static final String TestMessage_APIKey_PATH = "APIKey";
+    public static final String TestMessage_SQLQuery_PATH = "SQLQuery";
+    public static final String TestMessage_HTMLContent_PATH = "HTMLContent";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "test.casing.TestMessage", "TestMessage", "TestMessage",
+        Arrays.asList(
+            new PuregenFieldDescriptor("APIHost", "apiHost", "APIHost", 1, "string", null, null, false, false, false, TestMessageFieldMetadata.FIELD_METADATA.get(TestMessageFieldMetadata.TestMessage_APIHost_FIELD)),
+            new PuregenFieldDescriptor("TPMData", "tpmData", "TPMData", 2, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("XMLContent", "xmlContent", "XMLContent", 3, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("URLPath", "urlPath", "URLPath", 4, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("HTTPSEnabled", "httpsEnabled", "HTTPSEnabled", 5, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("UUIDValue", "uuidValue", "UUIDValue", 6, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("JSONData", "jsonData", "JSONData", 7, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("APIKey", "apiKey", "APIKey", 8, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("SQLQuery", "sqlQuery", "SQLQuery", 9, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("HTMLContent", "htmlContent", "HTMLContent", 10, "string", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // 
     @JsonProperty("APIHost")
     private String apiHost;
@@ -180,19 +207,219 @@ public class TestMessage {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        TestMessage other = (TestMessage) o;
+        return Objects.equals(apiHost, other.apiHost)
+            && Objects.equals(tpmData, other.tpmData)
+            && Objects.equals(xmlContent, other.xmlContent)
+            && Objects.equals(urlPath, other.urlPath)
+            && Objects.equals(httpsEnabled, other.httpsEnabled)
+            && Objects.equals(uuidValue, other.uuidValue)
+            && Objects.equals(jsonData, other.jsonData)
+            && Objects.equals(apiKey, other.apiKey)
+            && Objects.equals(sqlQuery, other.sqlQuery)
+            && Objects.equals(htmlContent, other.htmlContent);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(apiHost, tpmData, xmlContent, urlPath, httpsEnabled, uuidValue, jsonData, apiKey, sqlQuery, htmlContent);
+    }
+
+    @Override
+    public String toString() {
+        return "TestMessage{"
+            + "apiHost=" + (apiHost != null ? "'" + apiHost + "'" : null)
+            + ", tpmData=" + (tpmData != null ? "'" + tpmData + "'" : null)
+            + ", xmlContent=" + (xmlContent != null ? "'" + xmlContent + "'" : null)
+            + ", urlPath=" + (urlPath != null ? "'" + urlPath + "'" : null)
+            + ", httpsEnabled=" + (httpsEnabled != null ? "'" + httpsEnabled + "'" : null)
+            + ", uuidValue=" + (uuidValue != null ? "'" + uuidValue + "'" : null)
+            + ", jsonData=" + (jsonData != null ? "'" + jsonData + "'" : null)
+            + ", apiKey=" + (apiKey != null ? "'" + apiKey + "'" : null)
+            + ", sqlQuery=" + (sqlQuery != null ? "'" + sqlQuery + "'" : null)
+            + ", htmlContent=" + (htmlContent != null ? "'" + htmlContent + "'" : null)
+            + "}";
+    }
+
+    /** Returns a TestMessage with every field at its zero value, unlike new TestMessage(), which applies the field defaults. */
+    public static TestMessage zeroValue() {
+        TestMessage zero = new TestMessage();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new TestMessage.
+     */
+    public void applyMask(TestMessage src, Collection<String> paths) {
+        TestMessage source = src != null ? src : new TestMessage();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case TestMessage_APIHost_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestMessage: field " + name + " has no subfields");
+                    }
+                    this.apiHost = source.apiHost;
+                    break;
+                case TestMessage_TPMData_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestMessage: field " + name + " has no subfields");
+                    }
+                    this.tpmData = source.tpmData;
+                    break;
+                case TestMessage_XMLContent_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestMessage: field " + name + " has no subfields");
+                    }
+                    this.xmlContent = source.xmlContent;
+                    break;
+                case TestMessage_URLPath_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestMessage: field " + name + " has no subfields");
+                    }
+                    this.urlPath = source.urlPath;
+                    break;
+                case TestMessage_HTTPSEnabled_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestMessage: field " + name + " has no subfields");
+                    }
+                    this.httpsEnabled = source.httpsEnabled;
+                    break;
+                case TestMessage_UUIDValue_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestMessage: field " + name + " has no subfields");
+                    }
+                    this.uuidValue = source.uuidValue;
+                    break;
+                case TestMessage_JSONData_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestMessage: field " + name + " has no subfields");
+                    }
+                    this.jsonData = source.jsonData;
+                    break;
+                case TestMessage_APIKey_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestMessage: field " + name + " has no subfields");
+                    }
+                    this.apiKey = source.apiKey;
+                    break;
+                case TestMessage_SQLQuery_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestMessage: field " + name + " has no subfields");
+                    }
+                    this.sqlQuery = source.sqlQuery;
+                    break;
+                case TestMessage_HTMLContent_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestMessage: field " + name + " has no subfields");
+                    }
+                    this.htmlContent = source.htmlContent;
+                    break;
+                default:
+                    throw new IllegalArgumentException("TestMessage: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(TestMessage src) {
+        if (src == null) {
+            return;
+        }
+        if (src.apiHost != null && !src.apiHost.isEmpty()) {
+            this.apiHost = src.apiHost;
+        }
+        if (src.tpmData != null && !src.tpmData.isEmpty()) {
+            this.tpmData = src.tpmData;
+        }
+        if (src.xmlContent != null && !src.xmlContent.isEmpty()) {
+            this.xmlContent = src.xmlContent;
+        }
+        if (src.urlPath != null && !src.urlPath.isEmpty()) {
+            this.urlPath = src.urlPath;
+        }
+        if (src.httpsEnabled != null && !src.httpsEnabled.isEmpty()) {
+            this.httpsEnabled = src.httpsEnabled;
+        }
+        if (src.uuidValue != null && !src.uuidValue.isEmpty()) {
+            this.uuidValue = src.uuidValue;
+        }
+        if (src.jsonData != null && !src.jsonData.isEmpty()) {
+            this.jsonData = src.jsonData;
+        }
+        if (src.apiKey != null && !src.apiKey.isEmpty()) {
+            this.apiKey = src.apiKey;
+        }
+        if (src.sqlQuery != null && !src.sqlQuery.isEmpty()) {
+            this.sqlQuery = src.sqlQuery;
+        }
+        if (src.htmlContent != null && !src.htmlContent.isEmpty()) {
+            this.htmlContent = src.htmlContent;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(TestMessage src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(TestMessage src, PuregenFieldMask mask) {
+        TestMessage masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public TestMessage redacted() {
+        TestMessage copy = new TestMessage();
+        copy.apiHost = apiHost;
+        copy.tpmData = tpmData;
+        copy.xmlContent = xmlContent;
+        copy.urlPath = urlPath;
+        copy.httpsEnabled = httpsEnabled;
+        copy.uuidValue = uuidValue;
+        copy.jsonData = jsonData;
+        copy.apiKey = apiKey;
+        copy.sqlQuery = sqlQuery;
+        copy.htmlContent = htmlContent;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static TestMessage fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, TestMessage.class);
+        return PuregenJson.fromJson(json, TestMessage.class);
     }
 
 }
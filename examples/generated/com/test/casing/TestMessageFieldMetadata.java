@@ -10,9 +10,9 @@ public final class TestMessageFieldMetadata {
     // Field name constants
     public static final String TestMessage_APIHost_FIELD = "TestMessage_APIHost";
 
-    public static final Map<String, Map<String, String>> FIELD_METADATA = new HashMap<>();
+    public static final Map<String, Map<String, Object>> FIELD_METADATA = new HashMap<>();
     static {
-        Map<String, String> apihostMeta = new HashMap<>();
+        Map<String, Object> apihostMeta = new HashMap<>();
         apihostMeta.put("urls", "http://example.com/api/test");
         FIELD_METADATA.put(TestMessage_APIHost_FIELD, apihostMeta);
     }
@@ -1,15 +1,34 @@
 # Code generated by protoc-gen-puregen. DO NOT EDIT.
 
 from dataclasses import dataclass, field
-from typing import Optional, List, Dict, Any
+from typing import Optional, List, Dict, Any, ClassVar, Final, Literal, Union
 from abc import ABC, abstractmethod
+import base64
+import copy
 import json
+import sys
+import warnings
+from .puregen_descriptors import PuregenFieldDescriptor, PuregenMessageDescriptor, register_message_descriptors
+from .puregen_registry import PuregenEnvelope, register_message_types
+from .puregen_fieldmask import PuregenFieldMask
+
+if sys.version_info >= (3, 11):
+    from typing import Self
+else:
+    from typing_extensions import Self
 
 # Messages
 
 @dataclass
 class Error:
     """Generated message class for Error"""
+    class Paths:
+        """Field paths of Error, for apply_mask and field masks"""
+        CODE = "code"
+        MESSAGE = "message"
+        DETAILS = "details"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Error code
     code: int = 0
     # Human-readable error message
@@ -22,13 +41,65 @@ class Error:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a Error with every field at its zero value, unlike
+        Error(), which applies the field defaults."""
+        return cls(code=0, message="", details="")
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new Error."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == Error.Paths.CODE:
+                if dot:
+                    raise ValueError(f"Error: field {name!r} has no subfields")
+                self.code = source.code
+            elif name == Error.Paths.MESSAGE:
+                if dot:
+                    raise ValueError(f"Error: field {name!r} has no subfields")
+                self.message = source.message
+            elif name == Error.Paths.DETAILS:
+                if dot:
+                    raise ValueError(f"Error: field {name!r} has no subfields")
+                self.details = source.details
+            else:
+                raise ValueError(f"Error: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.code:
+            self.code = src.code
+        if src.message:
+            self.message = src.message
+        if src.details:
+            self.details = src.details
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.code is not None:
             result['code'] = self.code
         if self.message is not None:
@@ -38,15 +109,15 @@ class Error:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'Error':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'Error':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'code' in data:
             kwargs['code'] = data['code']
         if 'message' in data:
@@ -55,3 +126,26 @@ class Error:
             kwargs['details'] = data['details']
         return cls(**kwargs)
 
+# Descriptors
+
+Error.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="company.examples.proto.error.v1.Error",
+    name="Error",
+    python_name="Error",
+    fields=[
+        PuregenFieldDescriptor(name="code", python_name="code", json_name="code", number=1, kind="int32"),
+        PuregenFieldDescriptor(name="message", python_name="message", json_name="message", number=2, kind="string"),
+        PuregenFieldDescriptor(name="details", python_name="details", json_name="details", number=3, kind="string"),
+    ],
+)
+
+register_message_descriptors(
+    Error.DESCRIPTOR,
+)
+
+# Message types
+
+register_message_types({
+    "company.examples.proto.error.v1.Error": Error,
+})
+
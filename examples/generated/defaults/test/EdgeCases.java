@@ -9,32 +9,68 @@ import com.fasterxml.jackson.databind.*;
 
     // Test message with various edge cases for default values
 public class EdgeCases {
+    // Field paths, for applyMask and field masks
+    public static final String EdgeCases_SimpleString_PATH = "simple_string";
+    public static final String EdgeCases_EmptyString_PATH = "empty_string";
+    public static final String EdgeCases_ZeroInt_PATH = "zero_int";
+    public static final String EdgeCases_ZeroFloat_PATH = "zero_float";
+    public static final String EdgeCases_FalseBool_PATH = "false_bool";
+    public static final String EdgeCases_LargeInt_PATH = "large_int";
+    public static final String EdgeCases_NegativeInt_PATH = "negative_int";
+    public static final String EdgeCases_Scientific_PATH = "scientific";
+    public static final String EdgeCases_NoDirective_PATH = "no_directive";
+    public static final String EdgeCases_UnsignedValue_PATH = "unsigned_value";
+    public static final String EdgeCases_SignedValue_PATH = "signed_value";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "test.defaults.EdgeCases", "EdgeCases", "EdgeCases",
+        Arrays.asList(
+            new PuregenFieldDescriptor("simple_string", "simpleString", "simpleString", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("empty_string", "emptyString", "emptyString", 2, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("zero_int", "zeroInt", "zeroInt", 3, "int32", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("zero_float", "zeroFloat", "zeroFloat", 4, "float", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("false_bool", "falseBool", "falseBool", 5, "bool", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("large_int", "largeInt", "largeInt", 6, "int64", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("negative_int", "negativeInt", "negativeInt", 7, "int32", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("scientific", "scientific", "scientific", 8, "double", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("no_directive", "noDirective", "noDirective", 9, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("unsigned_value", "unsignedValue", "unsignedValue", 10, "uint32", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("signed_value", "signedValue", "signedValue", 11, "sint32", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // String with simple text
     @JsonProperty("simpleString")
+    @JsonAlias({"simple_string"})
     private String simpleString;
 
     // Empty string default
     @JsonProperty("emptyString")
+    @JsonAlias({"empty_string"})
     private String emptyString;
 
     // Zero values
     @JsonProperty("zeroInt")
+    @JsonAlias({"zero_int"})
     private int zeroInt;
 
     // 
     @JsonProperty("zeroFloat")
+    @JsonAlias({"zero_float"})
     private float zeroFloat;
 
     // 
     @JsonProperty("falseBool")
+    @JsonAlias({"false_bool"})
     private boolean falseBool;
 
     // Large numbers
     @JsonProperty("largeInt")
+    @JsonAlias({"large_int"})
     private long largeInt;
 
     // Negative numbers
     @JsonProperty("negativeInt")
+    @JsonAlias({"negative_int"})
     private int negativeInt;
 
     // Scientific notation
@@ -43,14 +79,17 @@ public class EdgeCases {
 
     // Field without directive (should use language defaults)
     @JsonProperty("noDirective")
+    @JsonAlias({"no_directive"})
     private String noDirective;
 
     // Different numeric types
     @JsonProperty("unsignedValue")
+    @JsonAlias({"unsigned_value"})
     private int unsignedValue;
 
     // 
     @JsonProperty("signedValue")
+    @JsonAlias({"signed_value"})
     private int signedValue;
 
     public EdgeCases() {
@@ -217,19 +256,251 @@ public class EdgeCases {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        EdgeCases other = (EdgeCases) o;
+        return Objects.equals(simpleString, other.simpleString)
+            && Objects.equals(emptyString, other.emptyString)
+            && zeroInt == other.zeroInt
+            && Float.compare(zeroFloat, other.zeroFloat) == 0
+            && falseBool == other.falseBool
+            && largeInt == other.largeInt
+            && negativeInt == other.negativeInt
+            && Double.compare(scientific, other.scientific) == 0
+            && Objects.equals(noDirective, other.noDirective)
+            && unsignedValue == other.unsignedValue
+            && signedValue == other.signedValue;
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(simpleString, emptyString, zeroInt, zeroFloat, falseBool, largeInt, negativeInt, scientific, noDirective, unsignedValue, signedValue);
+    }
+
+    @Override
+    public String toString() {
+        return "EdgeCases{"
+            + "simpleString=" + (simpleString != null ? "'" + simpleString + "'" : null)
+            + ", emptyString=" + (emptyString != null ? "'" + emptyString + "'" : null)
+            + ", zeroInt=" + zeroInt
+            + ", zeroFloat=" + zeroFloat
+            + ", falseBool=" + falseBool
+            + ", largeInt=" + largeInt
+            + ", negativeInt=" + negativeInt
+            + ", scientific=" + scientific
+            + ", noDirective=" + (noDirective != null ? "'" + noDirective + "'" : null)
+            + ", unsignedValue=" + unsignedValue
+            + ", signedValue=" + signedValue
+            + "}";
+    }
+
+    /** Returns a EdgeCases with every field at its zero value, unlike new EdgeCases(), which applies the field defaults. */
+    public static EdgeCases zeroValue() {
+        EdgeCases zero = new EdgeCases();
+        zero.simpleString = null;
+        zero.emptyString = null;
+        zero.zeroInt = 0;
+        zero.zeroFloat = 0.0f;
+        zero.falseBool = false;
+        zero.largeInt = 0L;
+        zero.negativeInt = 0;
+        zero.scientific = 0.0;
+        zero.unsignedValue = 0;
+        zero.signedValue = 0;
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new EdgeCases.
+     */
+    public void applyMask(EdgeCases src, Collection<String> paths) {
+        EdgeCases source = src != null ? src : new EdgeCases();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case EdgeCases_SimpleString_PATH:
+                case "simpleString":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("EdgeCases: field " + name + " has no subfields");
+                    }
+                    this.simpleString = source.simpleString;
+                    break;
+                case EdgeCases_EmptyString_PATH:
+                case "emptyString":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("EdgeCases: field " + name + " has no subfields");
+                    }
+                    this.emptyString = source.emptyString;
+                    break;
+                case EdgeCases_ZeroInt_PATH:
+                case "zeroInt":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("EdgeCases: field " + name + " has no subfields");
+                    }
+                    this.zeroInt = source.zeroInt;
+                    break;
+                case EdgeCases_ZeroFloat_PATH:
+                case "zeroFloat":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("EdgeCases: field " + name + " has no subfields");
+                    }
+                    this.zeroFloat = source.zeroFloat;
+                    break;
+                case EdgeCases_FalseBool_PATH:
+                case "falseBool":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("EdgeCases: field " + name + " has no subfields");
+                    }
+                    this.falseBool = source.falseBool;
+                    break;
+                case EdgeCases_LargeInt_PATH:
+                case "largeInt":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("EdgeCases: field " + name + " has no subfields");
+                    }
+                    this.largeInt = source.largeInt;
+                    break;
+                case EdgeCases_NegativeInt_PATH:
+                case "negativeInt":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("EdgeCases: field " + name + " has no subfields");
+                    }
+                    this.negativeInt = source.negativeInt;
+                    break;
+                case EdgeCases_Scientific_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("EdgeCases: field " + name + " has no subfields");
+                    }
+                    this.scientific = source.scientific;
+                    break;
+                case EdgeCases_NoDirective_PATH:
+                case "noDirective":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("EdgeCases: field " + name + " has no subfields");
+                    }
+                    this.noDirective = source.noDirective;
+                    break;
+                case EdgeCases_UnsignedValue_PATH:
+                case "unsignedValue":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("EdgeCases: field " + name + " has no subfields");
+                    }
+                    this.unsignedValue = source.unsignedValue;
+                    break;
+                case EdgeCases_SignedValue_PATH:
+                case "signedValue":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("EdgeCases: field " + name + " has no subfields");
+                    }
+                    this.signedValue = source.signedValue;
+                    break;
+                default:
+                    throw new IllegalArgumentException("EdgeCases: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(EdgeCases src) {
+        if (src == null) {
+            return;
+        }
+        if (src.simpleString != null && !src.simpleString.isEmpty()) {
+            this.simpleString = src.simpleString;
+        }
+        if (src.emptyString != null && !src.emptyString.isEmpty()) {
+            this.emptyString = src.emptyString;
+        }
+        if (src.zeroInt != 0) {
+            this.zeroInt = src.zeroInt;
+        }
+        if (src.zeroFloat != 0) {
+            this.zeroFloat = src.zeroFloat;
+        }
+        if (src.falseBool) {
+            this.falseBool = src.falseBool;
+        }
+        if (src.largeInt != 0) {
+            this.largeInt = src.largeInt;
+        }
+        if (src.negativeInt != 0) {
+            this.negativeInt = src.negativeInt;
+        }
+        if (src.scientific != 0) {
+            this.scientific = src.scientific;
+        }
+        if (src.noDirective != null && !src.noDirective.isEmpty()) {
+            this.noDirective = src.noDirective;
+        }
+        if (src.unsignedValue != 0) {
+            this.unsignedValue = src.unsignedValue;
+        }
+        if (src.signedValue != 0) {
+            this.signedValue = src.signedValue;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(EdgeCases src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(EdgeCases src, PuregenFieldMask mask) {
+        EdgeCases masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public EdgeCases redacted() {
+        EdgeCases copy = new EdgeCases();
+        copy.simpleString = simpleString;
+        copy.emptyString = emptyString;
+        copy.zeroInt = zeroInt;
+        copy.zeroFloat = zeroFloat;
+        copy.falseBool = falseBool;
+        copy.largeInt = largeInt;
+        copy.negativeInt = negativeInt;
+        copy.scientific = scientific;
+        copy.noDirective = noDirective;
+        copy.unsignedValue = unsignedValue;
+        copy.signedValue = signedValue;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static EdgeCases fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, EdgeCases.class);
+        return PuregenJson.fromJson(json, EdgeCases.class);
     }
 
 }
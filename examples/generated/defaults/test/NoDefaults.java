@@ -9,6 +9,19 @@ import com.fasterxml.jackson.databind.*;
 
     // Test message without any default values
 public class NoDefaults {
+    // Field paths, for applyMask and field masks
+    public static final String NoDefaults_Name_PATH = "name";
+    public static final String NoDefaults_Value_PATH = "value";
+    public static final String NoDefaults_Flag_PATH = "flag";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "test.defaults.NoDefaults", "NoDefaults", "NoDefaults",
+        Arrays.asList(
+            new PuregenFieldDescriptor("name", "name", "name", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("value", "value", "value", 2, "int32", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("flag", "flag", "flag", 3, "bool", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     @JsonProperty("name")
     private String name;
 
@@ -68,19 +81,135 @@ public class NoDefaults {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        NoDefaults other = (NoDefaults) o;
+        return Objects.equals(name, other.name)
+            && value == other.value
+            && flag == other.flag;
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(name, value, flag);
+    }
+
+    @Override
+    public String toString() {
+        return "NoDefaults{"
+            + "name=" + (name != null ? "'" + name + "'" : null)
+            + ", value=" + value
+            + ", flag=" + flag
+            + "}";
+    }
+
+    /** Returns a NoDefaults with every field at its zero value, unlike new NoDefaults(), which applies the field defaults. */
+    public static NoDefaults zeroValue() {
+        NoDefaults zero = new NoDefaults();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new NoDefaults.
+     */
+    public void applyMask(NoDefaults src, Collection<String> paths) {
+        NoDefaults source = src != null ? src : new NoDefaults();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case NoDefaults_Name_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("NoDefaults: field " + name + " has no subfields");
+                    }
+                    this.name = source.name;
+                    break;
+                case NoDefaults_Value_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("NoDefaults: field " + name + " has no subfields");
+                    }
+                    this.value = source.value;
+                    break;
+                case NoDefaults_Flag_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("NoDefaults: field " + name + " has no subfields");
+                    }
+                    this.flag = source.flag;
+                    break;
+                default:
+                    throw new IllegalArgumentException("NoDefaults: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(NoDefaults src) {
+        if (src == null) {
+            return;
+        }
+        if (src.name != null && !src.name.isEmpty()) {
+            this.name = src.name;
+        }
+        if (src.value != 0) {
+            this.value = src.value;
+        }
+        if (src.flag) {
+            this.flag = src.flag;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(NoDefaults src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(NoDefaults src, PuregenFieldMask mask) {
+        NoDefaults masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public NoDefaults redacted() {
+        NoDefaults copy = new NoDefaults();
+        copy.name = name;
+        copy.value = value;
+        copy.flag = flag;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static NoDefaults fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, NoDefaults.class);
+        return PuregenJson.fromJson(json, NoDefaults.class);
     }
 
 }
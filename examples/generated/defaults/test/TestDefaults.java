@@ -9,6 +9,25 @@ import com.fasterxml.jackson.databind.*;
 
     // 
 public class TestDefaults {
+    // Field paths, for applyMask and field masks
+    public static final String TestDefaults_Message_PATH = "message";
+    public static final String TestDefaults_Count_PATH = "count";
+    public static final String TestDefaults_Enabled_PATH = "enabled";
+    public static final String TestDefaults_Ratio_PATH = "ratio";
+    public static final String TestDefaults_Description_PATH = "description";
+    public static final String TestDefaults_Age_PATH = "age";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "test.defaults.TestDefaults", "TestDefaults", "TestDefaults",
+        Arrays.asList(
+            new PuregenFieldDescriptor("message", "message", "message", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("count", "count", "count", 2, "int32", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("enabled", "enabled", "enabled", 3, "bool", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("ratio", "ratio", "ratio", 4, "float", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("description", "description", "description", 5, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("age", "age", "age", 6, "int32", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // String field with default value
     @JsonProperty("message")
     private String message;
@@ -126,19 +145,175 @@ public class TestDefaults {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        TestDefaults other = (TestDefaults) o;
+        return Objects.equals(message, other.message)
+            && count == other.count
+            && enabled == other.enabled
+            && Float.compare(ratio, other.ratio) == 0
+            && Objects.equals(description, other.description)
+            && age == other.age;
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(message, count, enabled, ratio, description, age);
+    }
+
+    @Override
+    public String toString() {
+        return "TestDefaults{"
+            + "message=" + (message != null ? "'" + message + "'" : null)
+            + ", count=" + count
+            + ", enabled=" + enabled
+            + ", ratio=" + ratio
+            + ", description=" + (description != null ? "'" + description + "'" : null)
+            + ", age=" + age
+            + "}";
+    }
+
+    /** Returns a TestDefaults with every field at its zero value, unlike new TestDefaults(), which applies the field defaults. */
+    public static TestDefaults zeroValue() {
+        TestDefaults zero = new TestDefaults();
+        zero.message = null;
+        zero.count = 0;
+        zero.enabled = false;
+        zero.ratio = 0.0f;
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new TestDefaults.
+     */
+    public void applyMask(TestDefaults src, Collection<String> paths) {
+        TestDefaults source = src != null ? src : new TestDefaults();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case TestDefaults_Message_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestDefaults: field " + name + " has no subfields");
+                    }
+                    this.message = source.message;
+                    break;
+                case TestDefaults_Count_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestDefaults: field " + name + " has no subfields");
+                    }
+                    this.count = source.count;
+                    break;
+                case TestDefaults_Enabled_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestDefaults: field " + name + " has no subfields");
+                    }
+                    this.enabled = source.enabled;
+                    break;
+                case TestDefaults_Ratio_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestDefaults: field " + name + " has no subfields");
+                    }
+                    this.ratio = source.ratio;
+                    break;
+                case TestDefaults_Description_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestDefaults: field " + name + " has no subfields");
+                    }
+                    this.description = source.description;
+                    break;
+                case TestDefaults_Age_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestDefaults: field " + name + " has no subfields");
+                    }
+                    this.age = source.age;
+                    break;
+                default:
+                    throw new IllegalArgumentException("TestDefaults: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(TestDefaults src) {
+        if (src == null) {
+            return;
+        }
+        if (src.message != null && !src.message.isEmpty()) {
+            this.message = src.message;
+        }
+        if (src.count != 0) {
+            this.count = src.count;
+        }
+        if (src.enabled) {
+            this.enabled = src.enabled;
+        }
+        if (src.ratio != 0) {
+            this.ratio = src.ratio;
+        }
+        if (src.description != null && !src.description.isEmpty()) {
+            this.description = src.description;
+        }
+        if (src.age != 0) {
+            this.age = src.age;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(TestDefaults src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(TestDefaults src, PuregenFieldMask mask) {
+        TestDefaults masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public TestDefaults redacted() {
+        TestDefaults copy = new TestDefaults();
+        copy.message = message;
+        copy.count = count;
+        copy.enabled = enabled;
+        copy.ratio = ratio;
+        copy.description = description;
+        copy.age = age;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static TestDefaults fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, TestDefaults.class);
+        return PuregenJson.fromJson(json, TestDefaults.class);
     }
 
 }
@@ -3,9 +3,11 @@
 package enums
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
+	context "context"
+	json "encoding/json"
+	fmt "fmt"
+	slog "log/slog"
+	strings "strings"
 )
 
 // Enums
@@ -14,9 +16,9 @@ type Status int32
 
 const (
 	Status_STATUS_UNKNOWN   Status = 0
-	Status_STATUS_ACTIVE           = 1
-	Status_STATUS_INACTIVE         = 2
-	Status_STATUS_SUSPENDED        = 3
+	Status_STATUS_ACTIVE    Status = 1
+	Status_STATUS_INACTIVE  Status = 2
+	Status_STATUS_SUSPENDED Status = 3
 )
 
 var Status_name = map[int32]string{
@@ -52,6 +54,29 @@ func (x Status) IsValid() bool {
 	return ok
 }
 
+// UnmarshalJSON accepts a Status as its number or its name. Unknown
+// numbers are kept as is; IsValid reports them.
+func (x *Status) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var number int32
+	if err := json.Unmarshal(data, &number); err == nil {
+		*x = Status(number)
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return fmt.Errorf("invalid Status value: %s", data)
+	}
+	value, err := ParseStatus(name)
+	if err != nil {
+		return err
+	}
+	*x = value
+	return nil
+}
+
 // Priority enum values as string constants
 const (
 	Priority_PRIORITY_LOW      = "PRIORITY_LOW"
@@ -80,9 +105,9 @@ type Task_Type int32
 
 const (
 	Task_TYPE_UNKNOWN     Task_Type = 0
-	Task_TYPE_BUG                   = 1
-	Task_TYPE_FEATURE               = 2
-	Task_TYPE_ENHANCEMENT           = 3
+	Task_TYPE_BUG         Task_Type = 1
+	Task_TYPE_FEATURE     Task_Type = 2
+	Task_TYPE_ENHANCEMENT Task_Type = 3
 )
 
 var Task_Type_name = map[int32]string{
@@ -118,6 +143,29 @@ func (x Task_Type) IsValid() bool {
 	return ok
 }
 
+// UnmarshalJSON accepts a Task_Type as its number or its name. Unknown
+// numbers are kept as is; IsValid reports them.
+func (x *Task_Type) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var number int32
+	if err := json.Unmarshal(data, &number); err == nil {
+		*x = Task_Type(number)
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return fmt.Errorf("invalid Task_Type value: %s", data)
+	}
+	value, err := ParseTask_Type(name)
+	if err != nil {
+		return err
+	}
+	*x = value
+	return nil
+}
+
 // Messages
 
 // Type enum nested in message should also be integers
@@ -129,8 +177,250 @@ type Task struct {
 	Type     Task_Type `json:"type"`
 }
 
-func NewTask() *Task {
-	return &Task{}
+// TaskOption sets a field of a new Task
+type TaskOption func(*Task)
+
+// WithTaskId sets the Id field
+func WithTaskId(value string) TaskOption {
+	return func(m *Task) {
+		m.Id = value
+	}
+}
+
+// WithTaskTitle sets the Title field
+func WithTaskTitle(value string) TaskOption {
+	return func(m *Task) {
+		m.Title = value
+	}
+}
+
+// WithTaskStatus sets the Status field
+func WithTaskStatus(value Status) TaskOption {
+	return func(m *Task) {
+		m.Status = value
+	}
+}
+
+// WithTaskPriority sets the Priority field
+func WithTaskPriority(value string) TaskOption {
+	return func(m *Task) {
+		m.Priority = value
+	}
+}
+
+// WithTaskType sets the Type field
+func WithTaskType(value Task_Type) TaskOption {
+	return func(m *Task) {
+		m.Type = value
+	}
+}
+
+func NewTask(opts ...TaskOption) *Task {
+	m := &Task{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetId returns the Id field, or its zero value if m is nil
+func (m *Task) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// GetTitle returns the Title field, or its zero value if m is nil
+func (m *Task) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+// GetStatus returns the Status field, or its zero value if m is nil
+func (m *Task) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+// GetPriority returns the Priority field, or its zero value if m is nil
+func (m *Task) GetPriority() string {
+	if m != nil {
+		return m.Priority
+	}
+	return ""
+}
+
+// GetType returns the Type field, or its zero value if m is nil
+func (m *Task) GetType() Task_Type {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+// Clone returns a deep copy of m
+func (m *Task) Clone() *Task {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *Task) Equal(other *Task) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	if m.Title != other.Title {
+		return false
+	}
+	if m.Status != other.Status {
+		return false
+	}
+	if m.Priority != other.Priority {
+		return false
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	return true
+}
+
+// Field paths of Task, for ApplyMask and field masks
+const (
+	Task_Id_PATH       = "id"
+	Task_Title_PATH    = "title"
+	Task_Status_PATH   = "status"
+	Task_Priority_PATH = "priority"
+	Task_Type_PATH     = "type"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewTask().
+func (m *Task) ApplyMask(src *Task, paths []string) error {
+	if src == nil {
+		src = NewTask()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case Task_Id_PATH:
+			if nested {
+				return fmt.Errorf("Task: field %q has no subfields", name)
+			}
+			m.Id = src.Id
+		case Task_Title_PATH:
+			if nested {
+				return fmt.Errorf("Task: field %q has no subfields", name)
+			}
+			m.Title = src.Title
+		case Task_Status_PATH:
+			if nested {
+				return fmt.Errorf("Task: field %q has no subfields", name)
+			}
+			m.Status = src.Status
+		case Task_Priority_PATH:
+			if nested {
+				return fmt.Errorf("Task: field %q has no subfields", name)
+			}
+			m.Priority = src.Priority
+		case Task_Type_PATH:
+			if nested {
+				return fmt.Errorf("Task: field %q has no subfields", name)
+			}
+			m.Type = src.Type
+		default:
+			return fmt.Errorf("Task: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *Task) Merge(src *Task) {
+	if src == nil {
+		return
+	}
+	if src.Id != "" {
+		m.Id = src.Id
+	}
+	if src.Title != "" {
+		m.Title = src.Title
+	}
+	if src.Status != 0 {
+		m.Status = src.Status
+	}
+	if src.Priority != "" {
+		m.Priority = src.Priority
+	}
+	if src.Type != 0 {
+		m.Type = src.Type
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *Task) ApplyFieldMask(src *Task, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *Task) MergeFieldMask(src *Task, mask *PuregenFieldMask) error {
+	masked := &Task{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *Task) Redacted() *Task {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *Task) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *Task) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("id", r.Id),
+		slog.Any("title", r.Title),
+		slog.Any("status", r.Status),
+		slog.Any("priority", r.Priority),
+		slog.Any("type", r.Type),
+	)
 }
 
 func (m *Task) Validate() error {
@@ -150,8 +440,155 @@ type TaskList struct {
 	Tasks []*Task `json:"tasks"`
 }
 
-func NewTaskList() *TaskList {
-	return &TaskList{}
+// TaskListOption sets a field of a new TaskList
+type TaskListOption func(*TaskList)
+
+// WithTaskListTasks sets the Tasks field
+func WithTaskListTasks(value []*Task) TaskListOption {
+	return func(m *TaskList) {
+		m.Tasks = value
+	}
+}
+
+func NewTaskList(opts ...TaskListOption) *TaskList {
+	m := &TaskList{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetTasks returns the Tasks field, or its zero value if m is nil
+func (m *TaskList) GetTasks() []*Task {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *TaskList) Clone() *TaskList {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	if m.Tasks != nil {
+		c.Tasks = make([]*Task, len(m.Tasks))
+		for i, v := range m.Tasks {
+			c.Tasks[i] = v.Clone()
+		}
+	}
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *TaskList) Equal(other *TaskList) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Tasks) != len(other.Tasks) {
+		return false
+	}
+	for i := range m.Tasks {
+		if !m.Tasks[i].Equal(other.Tasks[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Field paths of TaskList, for ApplyMask and field masks
+const (
+	TaskList_Tasks_PATH = "tasks"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewTaskList().
+func (m *TaskList) ApplyMask(src *TaskList, paths []string) error {
+	if src == nil {
+		src = NewTaskList()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case TaskList_Tasks_PATH:
+			if nested {
+				return fmt.Errorf("TaskList: field %q has no subfields", name)
+			}
+			m.Tasks = make([]*Task, len(src.Tasks))
+			for i, v := range src.Tasks {
+				m.Tasks[i] = v.Clone()
+			}
+		default:
+			return fmt.Errorf("TaskList: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *TaskList) Merge(src *TaskList) {
+	if src == nil {
+		return
+	}
+	for _, v := range src.Tasks {
+		m.Tasks = append(m.Tasks, v.Clone())
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *TaskList) ApplyFieldMask(src *TaskList, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *TaskList) MergeFieldMask(src *TaskList, mask *PuregenFieldMask) error {
+	masked := &TaskList{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *TaskList) Redacted() *TaskList {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	for i, v := range r.Tasks {
+		r.Tasks[i] = v.Redacted()
+	}
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *TaskList) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *TaskList) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("tasks", r.Tasks),
+	)
 }
 
 func (m *TaskList) Validate() error {
@@ -167,6 +604,106 @@ func (m *TaskList) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
+// Descriptors
+
+// TaskDescriptor describes Task and its fields
+var TaskDescriptor = &PuregenMessageDescriptor{
+	FullName: "demo.enums.Task",
+	Name:     "Task",
+	GoName:   "Task",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "id",
+			GoName:   "Id",
+			JSONName: "id",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "title",
+			GoName:   "Title",
+			JSONName: "title",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "status",
+			GoName:   "Status",
+			JSONName: "status",
+			Number:   3,
+			Kind:     "enum",
+			TypeName: "demo.enums.Status",
+		},
+		{
+			Name:     "priority",
+			GoName:   "Priority",
+			JSONName: "priority",
+			Number:   4,
+			Kind:     "enum",
+			TypeName: "demo.enums.Priority",
+		},
+		{
+			Name:     "type",
+			GoName:   "Type",
+			JSONName: "type",
+			Number:   5,
+			Kind:     "enum",
+			TypeName: "demo.enums.Task.Type",
+		},
+	},
+}
+
+// Descriptor returns TaskDescriptor
+func (*Task) Descriptor() *PuregenMessageDescriptor {
+	return TaskDescriptor
+}
+
+// TaskListDescriptor describes TaskList and its fields
+var TaskListDescriptor = &PuregenMessageDescriptor{
+	FullName: "demo.enums.TaskList",
+	Name:     "TaskList",
+	GoName:   "TaskList",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "tasks",
+			GoName:   "Tasks",
+			JSONName: "tasks",
+			Number:   1,
+			Kind:     "message",
+			TypeName: "demo.enums.Task",
+			Repeated: true,
+		},
+	},
+}
+
+// Descriptor returns TaskListDescriptor
+func (*TaskList) Descriptor() *PuregenMessageDescriptor {
+	return TaskListDescriptor
+}
+
+func init() {
+	registerMessageDescriptors(TaskDescriptor, TaskListDescriptor)
+}
+
+// Message types
+
+// ProtoName returns the full proto name of Task
+func (*Task) ProtoName() string {
+	return "demo.enums.Task"
+}
+
+// ProtoName returns the full proto name of TaskList
+func (*TaskList) ProtoName() string {
+	return "demo.enums.TaskList"
+}
+
+func init() {
+	registerMessageTypes(map[string]func() PuregenMessage{
+		"demo.enums.Task":     func() PuregenMessage { return NewTask() },
+		"demo.enums.TaskList": func() PuregenMessage { return NewTaskList() },
+	})
+}
+
 // Services
 
 type TaskServiceService interface {
@@ -193,7 +730,7 @@ const (
 	TaskService_ListTasks  = "TaskService_ListTasks"
 )
 
-var TaskServiceMethodMetadata = map[string]map[string]string{}
+var TaskServiceMethodMetadata = map[string]map[string]any{}
 
 // Client
 
@@ -1,11 +1,23 @@
 # Code generated by protoc-gen-puregen. DO NOT EDIT.
 
 from dataclasses import dataclass, field
-from typing import Optional, List, Dict, Any
+from typing import Optional, List, Dict, Any, ClassVar, Final, Literal, Union
 from abc import ABC, abstractmethod
+import base64
+import copy
 import json
+import sys
+import warnings
 from enum import IntEnum
-from .puregen_transport import PuregenTransport
+from .puregen_transport import PuregenTransport, AsyncPuregenTransport
+from .puregen_descriptors import PuregenFieldDescriptor, PuregenMessageDescriptor, register_message_descriptors
+from .puregen_registry import PuregenEnvelope, register_message_types
+from .puregen_fieldmask import PuregenFieldMask
+
+if sys.version_info >= (3, 11):
+    from typing import Self
+else:
+    from typing_extensions import Self
 
 # Enums
 
@@ -17,6 +29,18 @@ class Status(IntEnum):
     STATUS_INACTIVE = 2
     STATUS_SUSPENDED = 3
 
+    @classmethod
+    def _missing_(cls, value: object) -> Optional['Status']:
+        """Look up names, and keep numbers without a value as UNRECOGNIZED members"""
+        if isinstance(value, str):
+            return cls.__members__.get(value)
+        if isinstance(value, int):
+            member = int.__new__(cls, value)
+            member._name_ = "UNRECOGNIZED"
+            member._value_ = value
+            return member
+        return None
+
     @classmethod
     def is_valid(cls, value: int) -> bool:
         """Check if value is a valid Status"""
@@ -25,12 +49,12 @@ class Status(IntEnum):
 # Priority enum should be generated as string constants (default)
 class Priority:
     """Priority enum values as string constants"""
-    PRIORITY_LOW = "PRIORITY_LOW"
-    PRIORITY_MEDIUM = "PRIORITY_MEDIUM"
-    PRIORITY_HIGH = "PRIORITY_HIGH"
-    PRIORITY_CRITICAL = "PRIORITY_CRITICAL"
+    PRIORITY_LOW: Final = "PRIORITY_LOW"
+    PRIORITY_MEDIUM: Final = "PRIORITY_MEDIUM"
+    PRIORITY_HIGH: Final = "PRIORITY_HIGH"
+    PRIORITY_CRITICAL: Final = "PRIORITY_CRITICAL"
 
-    VALUES = [
+    VALUES: Final[List[str]] = [
         PRIORITY_LOW,
         PRIORITY_MEDIUM,
         PRIORITY_HIGH,
@@ -50,6 +74,18 @@ class Task_Type(IntEnum):
     TYPE_FEATURE = 2
     TYPE_ENHANCEMENT = 3
 
+    @classmethod
+    def _missing_(cls, value: object) -> Optional['Task_Type']:
+        """Look up names, and keep numbers without a value as UNRECOGNIZED members"""
+        if isinstance(value, str):
+            return cls.__members__.get(value)
+        if isinstance(value, int):
+            member = int.__new__(cls, value)
+            member._name_ = "UNRECOGNIZED"
+            member._value_ = value
+            return member
+        return None
+
     @classmethod
     def is_valid(cls, value: int) -> bool:
         """Check if value is a valid Task_Type"""
@@ -61,10 +97,19 @@ class Task_Type(IntEnum):
 @dataclass
 class Task:
     """Generated message class for Task"""
+    class Paths:
+        """Field paths of Task, for apply_mask and field masks"""
+        ID = "id"
+        TITLE = "title"
+        STATUS = "status"
+        PRIORITY = "priority"
+        TYPE = "type"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     id: str = ""
     title: str = ""
     status: int = 0
-    priority: str = 0
+    priority: Literal["PRIORITY_LOW", "PRIORITY_MEDIUM", "PRIORITY_HIGH", "PRIORITY_CRITICAL"] = "PRIORITY_LOW"
     type: int = 0
 
     def validate(self) -> bool:
@@ -72,13 +117,77 @@ class Task:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a Task with every field at its zero value, unlike
+        Task(), which applies the field defaults."""
+        return cls(id="", title="", status=0, priority="PRIORITY_LOW", type=0)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new Task."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == Task.Paths.ID:
+                if dot:
+                    raise ValueError(f"Task: field {name!r} has no subfields")
+                self.id = source.id
+            elif name == Task.Paths.TITLE:
+                if dot:
+                    raise ValueError(f"Task: field {name!r} has no subfields")
+                self.title = source.title
+            elif name == Task.Paths.STATUS:
+                if dot:
+                    raise ValueError(f"Task: field {name!r} has no subfields")
+                self.status = source.status
+            elif name == Task.Paths.PRIORITY:
+                if dot:
+                    raise ValueError(f"Task: field {name!r} has no subfields")
+                self.priority = source.priority
+            elif name == Task.Paths.TYPE:
+                if dot:
+                    raise ValueError(f"Task: field {name!r} has no subfields")
+                self.type = source.type
+            else:
+                raise ValueError(f"Task: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.id:
+            self.id = src.id
+        if src.title:
+            self.title = src.title
+        if src.status:
+            self.status = src.status
+        if src.priority != "PRIORITY_LOW":
+            self.priority = src.priority
+        if src.type:
+            self.type = src.type
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.id is not None:
             result['id'] = self.id
         if self.title is not None:
@@ -92,30 +201,35 @@ class Task:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'Task':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'Task':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'id' in data:
             kwargs['id'] = data['id']
         if 'title' in data:
             kwargs['title'] = data['title']
-        if 'status' in data:
-            kwargs['status'] = data['status']
+        if data.get('status') is not None:
+            kwargs['status'] = Status(data['status'])
         if 'priority' in data:
             kwargs['priority'] = data['priority']
-        if 'type' in data:
-            kwargs['type'] = data['type']
+        if data.get('type') is not None:
+            kwargs['type'] = Task_Type(data['type'])
         return cls(**kwargs)
 
 @dataclass
 class TaskList:
     """Generated message class for TaskList"""
+    class Paths:
+        """Field paths of TaskList, for apply_mask and field masks"""
+        TASKS = "tasks"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     tasks: List['Task'] = field(default_factory=list)
 
     def validate(self) -> bool:
@@ -123,31 +237,107 @@ class TaskList:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a TaskList with every field at its zero value, unlike
+        TaskList(), which applies the field defaults."""
+        return cls(tasks=[])
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new TaskList."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == TaskList.Paths.TASKS:
+                if dot:
+                    raise ValueError(f"TaskList: field {name!r} has no subfields")
+                self.tasks = copy.deepcopy(source.tasks)
+            else:
+                raise ValueError(f"TaskList: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        self.tasks.extend(copy.deepcopy(src.tasks))
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        result.tasks = [item.redacted() for item in result.tasks]
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.tasks is not None:
             result['tasks'] = [item.to_dict() if hasattr(item, 'to_dict') else item for item in self.tasks]
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'TaskList':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'TaskList':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'tasks' in data:
             kwargs['tasks'] = [Task.from_dict(item) if isinstance(item, dict) else item for item in data['tasks']]
         return cls(**kwargs)
 
+# Descriptors
+
+Task.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="demo.enums.Task",
+    name="Task",
+    python_name="Task",
+    fields=[
+        PuregenFieldDescriptor(name="id", python_name="id", json_name="id", number=1, kind="string"),
+        PuregenFieldDescriptor(name="title", python_name="title", json_name="title", number=2, kind="string"),
+        PuregenFieldDescriptor(name="status", python_name="status", json_name="status", number=3, kind="enum", type_name="demo.enums.Status"),
+        PuregenFieldDescriptor(name="priority", python_name="priority", json_name="priority", number=4, kind="enum", type_name="demo.enums.Priority"),
+        PuregenFieldDescriptor(name="type", python_name="type", json_name="type", number=5, kind="enum", type_name="demo.enums.Task.Type"),
+    ],
+)
+
+TaskList.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="demo.enums.TaskList",
+    name="TaskList",
+    python_name="TaskList",
+    fields=[
+        PuregenFieldDescriptor(name="tasks", python_name="tasks", json_name="tasks", number=1, kind="message", type_name="demo.enums.Task", repeated=True),
+    ],
+)
+
+register_message_descriptors(
+    Task.DESCRIPTOR,
+    TaskList.DESCRIPTOR,
+)
+
+# Message types
+
+register_message_types({
+    "demo.enums.Task": Task,
+    "demo.enums.TaskList": TaskList,
+})
+
 # Services
 
 class TaskServiceService(ABC):
@@ -183,7 +373,7 @@ class TaskServiceMethods:
     TaskService_CreateTask = "TaskService_CreateTask"
     TaskService_ListTasks = "TaskService_ListTasks"
 
-    METHOD_METADATA = {
+    METHOD_METADATA: Dict[str, Dict[str, Any]] = {
     }
 
 # Client
@@ -191,7 +381,7 @@ class TaskServiceMethods:
 class TaskServiceClient:
     """Client for TaskService service"""
 
-    def __init__(self, transport: PuregenTransport):
+    def __init__(self, transport: PuregenTransport) -> None:
         self.transport = transport
 
     def create_task(self, ctx: Dict[str, Any], request: Task) -> Task:
@@ -218,3 +408,33 @@ class TaskServiceClient:
             return TaskList.from_dict(result)
         raise ValueError(f"Invalid response type for list_tasks: {type(result)}")
 
+class AsyncTaskServiceClient:
+    """Asyncio client for TaskService service"""
+
+    def __init__(self, transport: AsyncPuregenTransport) -> None:
+        self.transport = transport
+
+    async def create_task(self, ctx: Dict[str, Any], request: Task) -> Task:
+        """CreateTask client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = TaskServiceMethods.METHOD_METADATA.get(TaskServiceMethods.TaskService_CreateTask, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, TaskServiceMethods.TaskService_CreateTask, request, Task)
+        if isinstance(result, Task):
+            return result
+        if isinstance(result, dict):
+            return Task.from_dict(result)
+        raise ValueError(f"Invalid response type for create_task: {type(result)}")
+
+    async def list_tasks(self, ctx: Dict[str, Any], request: TaskList) -> TaskList:
+        """ListTasks client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = TaskServiceMethods.METHOD_METADATA.get(TaskServiceMethods.TaskService_ListTasks, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, TaskServiceMethods.TaskService_ListTasks, request, TaskList)
+        if isinstance(result, TaskList):
+            return result
+        if isinstance(result, dict):
+            return TaskList.from_dict(result)
+        raise ValueError(f"Invalid response type for list_tasks: {type(result)}")
+
@@ -4,7 +4,7 @@
 package enums
 
 import (
-	"context"
+	context "context"
 )
 
 // PuregenTransport defines the interface for client communication
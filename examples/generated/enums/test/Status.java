@@ -2,11 +2,14 @@
 
 package enums.test;
 
+import com.fasterxml.jackson.annotation.*;
+
     // Test enum that should be generated as integers
 public enum Status {
     STATUS_UNKNOWN(0),
     STATUS_ACTIVE(1),
-    STATUS_INACTIVE(2);
+    STATUS_INACTIVE(2),
+    UNRECOGNIZED(-1);
 
     private final int value;
 
@@ -14,22 +17,44 @@ public enum Status {
         this.value = value;
     }
 
+    @JsonValue
     public int getValue() {
         return value;
     }
 
     public static Status fromValue(int value) {
         for (Status e : values()) {
-            if (e.value == value) {
+            if (e != UNRECOGNIZED && e.value == value) {
                 return e;
             }
         }
-        throw new IllegalArgumentException("Invalid Status value: " + value);
+        return UNRECOGNIZED;
+    }
+
+    public static Status fromName(String name) {
+        switch (name) {
+            case "STATUS_UNKNOWN":
+                return STATUS_UNKNOWN;
+            case "STATUS_ACTIVE":
+                return STATUS_ACTIVE;
+            case "STATUS_INACTIVE":
+                return STATUS_INACTIVE;
+            default:
+                return UNRECOGNIZED;
+        }
+    }
+
+    @JsonCreator(mode = JsonCreator.Mode.DELEGATING)
+    public static Status fromJsonValue(Object value) {
+        if (value instanceof Number) {
+            return fromValue(((Number) value).intValue());
+        }
+        return fromName(String.valueOf(value));
     }
 
     public static boolean isValid(int value) {
         for (Status e : values()) {
-            if (e.value == value) {
+            if (e != UNRECOGNIZED && e.value == value) {
                 return true;
             }
         }
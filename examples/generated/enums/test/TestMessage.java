@@ -8,6 +8,17 @@ import com.fasterxml.jackson.annotation.*;
 import com.fasterxml.jackson.databind.*;
 
 public class TestMessage {
+    // Field paths, for applyMask and field masks
+    public static final String TestMessage_Status_PATH = "status";
+    public static final String TestMessage_Priority_PATH = "priority";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "test.enums.TestMessage", "TestMessage", "TestMessage",
+        Arrays.asList(
+            new PuregenFieldDescriptor("status", "status", "status", 1, "enum", "test.enums.Status", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("priority", "priority", "priority", 2, "enum", "test.enums.Priority", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     @JsonProperty("status")
     private Status status;
 
@@ -51,19 +62,123 @@ public class TestMessage {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        TestMessage other = (TestMessage) o;
+        return Objects.equals(status, other.status)
+            && Objects.equals(priority, other.priority);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(status, priority);
+    }
+
+    @Override
+    public String toString() {
+        return "TestMessage{"
+            + "status=" + status
+            + ", priority=" + (priority != null ? "'" + priority + "'" : null)
+            + "}";
+    }
+
+    /** Returns a TestMessage with every field at its zero value, unlike new TestMessage(), which applies the field defaults. */
+    public static TestMessage zeroValue() {
+        TestMessage zero = new TestMessage();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new TestMessage.
+     */
+    public void applyMask(TestMessage src, Collection<String> paths) {
+        TestMessage source = src != null ? src : new TestMessage();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case TestMessage_Status_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestMessage: field " + name + " has no subfields");
+                    }
+                    this.status = source.status;
+                    break;
+                case TestMessage_Priority_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("TestMessage: field " + name + " has no subfields");
+                    }
+                    this.priority = source.priority;
+                    break;
+                default:
+                    throw new IllegalArgumentException("TestMessage: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(TestMessage src) {
+        if (src == null) {
+            return;
+        }
+        if (src.status != null) {
+            this.status = src.status;
+        }
+        if (src.priority != null && !src.priority.isEmpty()) {
+            this.priority = src.priority;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(TestMessage src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(TestMessage src, PuregenFieldMask mask) {
+        TestMessage masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public TestMessage redacted() {
+        TestMessage copy = new TestMessage();
+        copy.status = status;
+        copy.priority = priority;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static TestMessage fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, TestMessage.class);
+        return PuregenJson.fromJson(json, TestMessage.class);
     }
 
 }
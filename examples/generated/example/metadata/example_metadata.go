@@ -3,9 +3,12 @@
 package metadata
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
+	context "context"
+	sql "database/sql"
+	json "encoding/json"
+	fmt "fmt"
+	slog "log/slog"
+	strings "strings"
 )
 
 // Enums
@@ -37,12 +40,51 @@ func IsValidTaskStatus(value string) bool {
 }
 
 // TaskStatusMetadata contains metadata for TaskStatus
-var TaskStatusMetadata = map[string]string{
+var TaskStatusMetadata = map[string]any{
 	"category":   "status",
 	"ui_type":    "dropdown",
 	"validation": "required",
 }
 
+// TaskStatusValueMetadata contains metadata for each TaskStatus value
+var TaskStatusValueMetadata = map[string]map[string]any{
+	TaskStatus_PENDING: {
+		"display_name": "Pending",
+		"order":        1,
+	},
+	TaskStatus_IN_PROGRESS: {
+		"display_name": "In progress",
+		"order":        2,
+	},
+	TaskStatus_COMPLETED: {
+		"display_name": "Completed",
+		"order":        3,
+		"terminal":     true,
+	},
+	TaskStatus_CANCELLED: {
+		"display_name": "Cancelled",
+		"order":        4,
+		"terminal":     true,
+	},
+}
+
+// TaskStatusDisplayName returns the display name of a TaskStatus value, or the value itself
+func TaskStatusDisplayName(value string) string {
+	switch value {
+	case TaskStatus_UNKNOWN:
+		return "UNKNOWN"
+	case TaskStatus_PENDING:
+		return "Pending"
+	case TaskStatus_IN_PROGRESS:
+		return "In progress"
+	case TaskStatus_COMPLETED:
+		return "Completed"
+	case TaskStatus_CANCELLED:
+		return "Cancelled"
+	}
+	return value
+}
+
 // Messages
 
 // Example message with metadata for database mapping
@@ -59,8 +101,250 @@ type Task struct {
 	CreatedAt int64 `json:"createdAt"`
 }
 
-func NewTask() *Task {
-	return &Task{}
+// TaskOption sets a field of a new Task
+type TaskOption func(*Task)
+
+// WithTaskId sets the Id field
+func WithTaskId(value string) TaskOption {
+	return func(m *Task) {
+		m.Id = value
+	}
+}
+
+// WithTaskTitle sets the Title field
+func WithTaskTitle(value string) TaskOption {
+	return func(m *Task) {
+		m.Title = value
+	}
+}
+
+// WithTaskDescription sets the Description field
+func WithTaskDescription(value string) TaskOption {
+	return func(m *Task) {
+		m.Description = value
+	}
+}
+
+// WithTaskStatus sets the Status field
+func WithTaskStatus(value string) TaskOption {
+	return func(m *Task) {
+		m.Status = value
+	}
+}
+
+// WithTaskCreatedAt sets the CreatedAt field
+func WithTaskCreatedAt(value int64) TaskOption {
+	return func(m *Task) {
+		m.CreatedAt = value
+	}
+}
+
+func NewTask(opts ...TaskOption) *Task {
+	m := &Task{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetId returns the Id field, or its zero value if m is nil
+func (m *Task) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// GetTitle returns the Title field, or its zero value if m is nil
+func (m *Task) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+// GetDescription returns the Description field, or its zero value if m is nil
+func (m *Task) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// GetStatus returns the Status field, or its zero value if m is nil
+func (m *Task) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+// GetCreatedAt returns the CreatedAt field, or its zero value if m is nil
+func (m *Task) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// Clone returns a deep copy of m
+func (m *Task) Clone() *Task {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *Task) Equal(other *Task) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	if m.Title != other.Title {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.Status != other.Status {
+		return false
+	}
+	if m.CreatedAt != other.CreatedAt {
+		return false
+	}
+	return true
+}
+
+// Field paths of Task, for ApplyMask and field masks
+const (
+	Task_Id_PATH          = "id"
+	Task_Title_PATH       = "title"
+	Task_Description_PATH = "description"
+	Task_Status_PATH      = "status"
+	Task_CreatedAt_PATH   = "created_at"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewTask().
+func (m *Task) ApplyMask(src *Task, paths []string) error {
+	if src == nil {
+		src = NewTask()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case Task_Id_PATH:
+			if nested {
+				return fmt.Errorf("Task: field %q has no subfields", name)
+			}
+			m.Id = src.Id
+		case Task_Title_PATH:
+			if nested {
+				return fmt.Errorf("Task: field %q has no subfields", name)
+			}
+			m.Title = src.Title
+		case Task_Description_PATH:
+			if nested {
+				return fmt.Errorf("Task: field %q has no subfields", name)
+			}
+			m.Description = src.Description
+		case Task_Status_PATH:
+			if nested {
+				return fmt.Errorf("Task: field %q has no subfields", name)
+			}
+			m.Status = src.Status
+		case Task_CreatedAt_PATH, "createdAt":
+			if nested {
+				return fmt.Errorf("Task: field %q has no subfields", name)
+			}
+			m.CreatedAt = src.CreatedAt
+		default:
+			return fmt.Errorf("Task: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *Task) Merge(src *Task) {
+	if src == nil {
+		return
+	}
+	if src.Id != "" {
+		m.Id = src.Id
+	}
+	if src.Title != "" {
+		m.Title = src.Title
+	}
+	if src.Description != "" {
+		m.Description = src.Description
+	}
+	if src.Status != "" {
+		m.Status = src.Status
+	}
+	if src.CreatedAt != 0 {
+		m.CreatedAt = src.CreatedAt
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *Task) ApplyFieldMask(src *Task, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *Task) MergeFieldMask(src *Task, mask *PuregenFieldMask) error {
+	masked := &Task{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *Task) Redacted() *Task {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *Task) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *Task) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("id", r.Id),
+		slog.Any("title", r.Title),
+		slog.Any("description", r.Description),
+		slog.Any("status", r.Status),
+		slog.Any("createdAt", r.CreatedAt),
+	)
 }
 
 func (m *Task) Validate() error {
@@ -76,10 +360,36 @@ func (m *Task) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
+// UnmarshalJSON accepts both the proto field names and the JSON names of the fields
+func (m *Task) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for alias, name := range map[string]string{
+		"created_at": "createdAt",
+	} {
+		if value, ok := fields[alias]; ok {
+			delete(fields, alias)
+			if _, ok := fields[name]; !ok {
+				fields[name] = value
+			}
+		}
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	// plain has the fields of Task but not this method
+	type plain Task
+	return json.Unmarshal(data, (*plain)(m))
+}
+
 // TaskMetadata contains metadata for Task
-var TaskMetadata = map[string]string{
-	"cache":         "true",
+var TaskMetadata = map[string]any{
+	"cache":         true,
 	"partition_key": "user_id",
+	"schema":        "tasks",
 	"table":         "tasks",
 }
 
@@ -93,15 +403,15 @@ const (
 )
 
 // MessageField metadata for Task
-var TaskFieldMetadata = map[string]map[string]string{
+var TaskFieldMetadata = map[string]map[string]any{
 	Task_Id_FIELD: {
 		"db_column":  "task_id",
 		"index":      "primary",
 		"validation": "uuid",
 	},
 	Task_Title_FIELD: {
-		"max_length": "200",
-		"min_length": "1",
+		"max_length": 200,
+		"min_length": 1,
 		"validation": "required",
 	},
 	Task_Description_FIELD: {
@@ -114,8 +424,9 @@ var TaskFieldMetadata = map[string]map[string]string{
 		"validation": "enum",
 	},
 	Task_CreatedAt_FIELD: {
-		"format": "unix_timestamp",
-		"index":  "secondary",
+		"format":   "unix_timestamp",
+		"index":    "secondary",
+		"sortable": "true",
 	},
 }
 
@@ -125,8 +436,166 @@ type CreateTaskRequest struct {
 	Description string `json:"description"`
 }
 
-func NewCreateTaskRequest() *CreateTaskRequest {
-	return &CreateTaskRequest{}
+// CreateTaskRequestOption sets a field of a new CreateTaskRequest
+type CreateTaskRequestOption func(*CreateTaskRequest)
+
+// WithCreateTaskRequestTitle sets the Title field
+func WithCreateTaskRequestTitle(value string) CreateTaskRequestOption {
+	return func(m *CreateTaskRequest) {
+		m.Title = value
+	}
+}
+
+// WithCreateTaskRequestDescription sets the Description field
+func WithCreateTaskRequestDescription(value string) CreateTaskRequestOption {
+	return func(m *CreateTaskRequest) {
+		m.Description = value
+	}
+}
+
+func NewCreateTaskRequest(opts ...CreateTaskRequestOption) *CreateTaskRequest {
+	m := &CreateTaskRequest{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetTitle returns the Title field, or its zero value if m is nil
+func (m *CreateTaskRequest) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+// GetDescription returns the Description field, or its zero value if m is nil
+func (m *CreateTaskRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// Clone returns a deep copy of m
+func (m *CreateTaskRequest) Clone() *CreateTaskRequest {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *CreateTaskRequest) Equal(other *CreateTaskRequest) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Title != other.Title {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	return true
+}
+
+// Field paths of CreateTaskRequest, for ApplyMask and field masks
+const (
+	CreateTaskRequest_Title_PATH       = "title"
+	CreateTaskRequest_Description_PATH = "description"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewCreateTaskRequest().
+func (m *CreateTaskRequest) ApplyMask(src *CreateTaskRequest, paths []string) error {
+	if src == nil {
+		src = NewCreateTaskRequest()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case CreateTaskRequest_Title_PATH:
+			if nested {
+				return fmt.Errorf("CreateTaskRequest: field %q has no subfields", name)
+			}
+			m.Title = src.Title
+		case CreateTaskRequest_Description_PATH:
+			if nested {
+				return fmt.Errorf("CreateTaskRequest: field %q has no subfields", name)
+			}
+			m.Description = src.Description
+		default:
+			return fmt.Errorf("CreateTaskRequest: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *CreateTaskRequest) Merge(src *CreateTaskRequest) {
+	if src == nil {
+		return
+	}
+	if src.Title != "" {
+		m.Title = src.Title
+	}
+	if src.Description != "" {
+		m.Description = src.Description
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *CreateTaskRequest) ApplyFieldMask(src *CreateTaskRequest, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *CreateTaskRequest) MergeFieldMask(src *CreateTaskRequest, mask *PuregenFieldMask) error {
+	masked := &CreateTaskRequest{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *CreateTaskRequest) Redacted() *CreateTaskRequest {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *CreateTaskRequest) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *CreateTaskRequest) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("title", r.Title),
+		slog.Any("description", r.Description),
+	)
 }
 
 func (m *CreateTaskRequest) Validate() error {
@@ -142,13 +611,18 @@ func (m *CreateTaskRequest) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
+// CreateTaskRequestMetadata contains metadata for CreateTaskRequest
+var CreateTaskRequestMetadata = map[string]any{
+	"schema": "tasks",
+}
+
 // Field name constants for CreateTaskRequest
 const (
 	CreateTaskRequest_Title_FIELD = "CreateTaskRequest_Title"
 )
 
 // MessageField metadata for CreateTaskRequest
-var CreateTaskRequestFieldMetadata = map[string]map[string]string{
+var CreateTaskRequestFieldMetadata = map[string]map[string]any{
 	CreateTaskRequest_Title_FIELD: {
 		"trim_whitespace": "true",
 		"validation":      "required",
@@ -159,8 +633,149 @@ type CreateTaskResponse struct {
 	Task *Task `json:"task"`
 }
 
-func NewCreateTaskResponse() *CreateTaskResponse {
-	return &CreateTaskResponse{}
+// CreateTaskResponseOption sets a field of a new CreateTaskResponse
+type CreateTaskResponseOption func(*CreateTaskResponse)
+
+// WithCreateTaskResponseTask sets the Task field
+func WithCreateTaskResponseTask(value *Task) CreateTaskResponseOption {
+	return func(m *CreateTaskResponse) {
+		m.Task = value
+	}
+}
+
+func NewCreateTaskResponse(opts ...CreateTaskResponseOption) *CreateTaskResponse {
+	m := &CreateTaskResponse{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetTask returns the Task field, or its zero value if m is nil
+func (m *CreateTaskResponse) GetTask() *Task {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *CreateTaskResponse) Clone() *CreateTaskResponse {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	c.Task = m.Task.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *CreateTaskResponse) Equal(other *CreateTaskResponse) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.Task.Equal(other.Task) {
+		return false
+	}
+	return true
+}
+
+// Field paths of CreateTaskResponse, for ApplyMask and field masks
+const (
+	CreateTaskResponse_Task_PATH = "task"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewCreateTaskResponse().
+func (m *CreateTaskResponse) ApplyMask(src *CreateTaskResponse, paths []string) error {
+	if src == nil {
+		src = NewCreateTaskResponse()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case CreateTaskResponse_Task_PATH:
+			if !nested {
+				m.Task = src.Task.Clone()
+				continue
+			}
+			if m.Task == nil {
+				m.Task = &Task{}
+			}
+			if err := m.Task.ApplyMask(src.Task, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("CreateTaskResponse: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *CreateTaskResponse) Merge(src *CreateTaskResponse) {
+	if src == nil {
+		return
+	}
+	if src.Task != nil {
+		if m.Task == nil {
+			m.Task = &Task{}
+		}
+		m.Task.Merge(src.Task)
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *CreateTaskResponse) ApplyFieldMask(src *CreateTaskResponse, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *CreateTaskResponse) MergeFieldMask(src *CreateTaskResponse, mask *PuregenFieldMask) error {
+	masked := &CreateTaskResponse{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *CreateTaskResponse) Redacted() *CreateTaskResponse {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.Task = r.Task.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *CreateTaskResponse) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *CreateTaskResponse) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("task", r.Task),
+	)
 }
 
 func (m *CreateTaskResponse) Validate() error {
@@ -176,13 +791,148 @@ func (m *CreateTaskResponse) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
+// CreateTaskResponseMetadata contains metadata for CreateTaskResponse
+var CreateTaskResponseMetadata = map[string]any{
+	"schema": "tasks",
+}
+
 type GetTaskRequest struct {
 	//
 	Id string `json:"id"`
 }
 
-func NewGetTaskRequest() *GetTaskRequest {
-	return &GetTaskRequest{}
+// GetTaskRequestOption sets a field of a new GetTaskRequest
+type GetTaskRequestOption func(*GetTaskRequest)
+
+// WithGetTaskRequestId sets the Id field
+func WithGetTaskRequestId(value string) GetTaskRequestOption {
+	return func(m *GetTaskRequest) {
+		m.Id = value
+	}
+}
+
+func NewGetTaskRequest(opts ...GetTaskRequestOption) *GetTaskRequest {
+	m := &GetTaskRequest{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetId returns the Id field, or its zero value if m is nil
+func (m *GetTaskRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// Clone returns a deep copy of m
+func (m *GetTaskRequest) Clone() *GetTaskRequest {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *GetTaskRequest) Equal(other *GetTaskRequest) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	return true
+}
+
+// Field paths of GetTaskRequest, for ApplyMask and field masks
+const (
+	GetTaskRequest_Id_PATH = "id"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewGetTaskRequest().
+func (m *GetTaskRequest) ApplyMask(src *GetTaskRequest, paths []string) error {
+	if src == nil {
+		src = NewGetTaskRequest()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case GetTaskRequest_Id_PATH:
+			if nested {
+				return fmt.Errorf("GetTaskRequest: field %q has no subfields", name)
+			}
+			m.Id = src.Id
+		default:
+			return fmt.Errorf("GetTaskRequest: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *GetTaskRequest) Merge(src *GetTaskRequest) {
+	if src == nil {
+		return
+	}
+	if src.Id != "" {
+		m.Id = src.Id
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *GetTaskRequest) ApplyFieldMask(src *GetTaskRequest, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *GetTaskRequest) MergeFieldMask(src *GetTaskRequest, mask *PuregenFieldMask) error {
+	masked := &GetTaskRequest{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *GetTaskRequest) Redacted() *GetTaskRequest {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *GetTaskRequest) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *GetTaskRequest) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("id", r.Id),
+	)
 }
 
 func (m *GetTaskRequest) Validate() error {
@@ -198,13 +948,18 @@ func (m *GetTaskRequest) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
+// GetTaskRequestMetadata contains metadata for GetTaskRequest
+var GetTaskRequestMetadata = map[string]any{
+	"schema": "tasks",
+}
+
 // Field name constants for GetTaskRequest
 const (
 	GetTaskRequest_Id_FIELD = "GetTaskRequest_Id"
 )
 
 // MessageField metadata for GetTaskRequest
-var GetTaskRequestFieldMetadata = map[string]map[string]string{
+var GetTaskRequestFieldMetadata = map[string]map[string]any{
 	GetTaskRequest_Id_FIELD: {
 		"validation": "uuid",
 	},
@@ -214,8 +969,149 @@ type GetTaskResponse struct {
 	Task *Task `json:"task"`
 }
 
-func NewGetTaskResponse() *GetTaskResponse {
-	return &GetTaskResponse{}
+// GetTaskResponseOption sets a field of a new GetTaskResponse
+type GetTaskResponseOption func(*GetTaskResponse)
+
+// WithGetTaskResponseTask sets the Task field
+func WithGetTaskResponseTask(value *Task) GetTaskResponseOption {
+	return func(m *GetTaskResponse) {
+		m.Task = value
+	}
+}
+
+func NewGetTaskResponse(opts ...GetTaskResponseOption) *GetTaskResponse {
+	m := &GetTaskResponse{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetTask returns the Task field, or its zero value if m is nil
+func (m *GetTaskResponse) GetTask() *Task {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *GetTaskResponse) Clone() *GetTaskResponse {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	c.Task = m.Task.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *GetTaskResponse) Equal(other *GetTaskResponse) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.Task.Equal(other.Task) {
+		return false
+	}
+	return true
+}
+
+// Field paths of GetTaskResponse, for ApplyMask and field masks
+const (
+	GetTaskResponse_Task_PATH = "task"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewGetTaskResponse().
+func (m *GetTaskResponse) ApplyMask(src *GetTaskResponse, paths []string) error {
+	if src == nil {
+		src = NewGetTaskResponse()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case GetTaskResponse_Task_PATH:
+			if !nested {
+				m.Task = src.Task.Clone()
+				continue
+			}
+			if m.Task == nil {
+				m.Task = &Task{}
+			}
+			if err := m.Task.ApplyMask(src.Task, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("GetTaskResponse: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *GetTaskResponse) Merge(src *GetTaskResponse) {
+	if src == nil {
+		return
+	}
+	if src.Task != nil {
+		if m.Task == nil {
+			m.Task = &Task{}
+		}
+		m.Task.Merge(src.Task)
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *GetTaskResponse) ApplyFieldMask(src *GetTaskResponse, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *GetTaskResponse) MergeFieldMask(src *GetTaskResponse, mask *PuregenFieldMask) error {
+	masked := &GetTaskResponse{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *GetTaskResponse) Redacted() *GetTaskResponse {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.Task = r.Task.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *GetTaskResponse) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *GetTaskResponse) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("task", r.Task),
+	)
 }
 
 func (m *GetTaskResponse) Validate() error {
@@ -231,6 +1127,243 @@ func (m *GetTaskResponse) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
+// GetTaskResponseMetadata contains metadata for GetTaskResponse
+var GetTaskResponseMetadata = map[string]any{
+	"schema": "tasks",
+}
+
+// Descriptors
+
+// TaskDescriptor describes Task and its fields
+var TaskDescriptor = &PuregenMessageDescriptor{
+	FullName: "example.metadata.Task",
+	Name:     "Task",
+	GoName:   "Task",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "id",
+			GoName:   "Id",
+			JSONName: "id",
+			Number:   1,
+			Kind:     "string",
+			Metadata: TaskFieldMetadata[Task_Id_FIELD],
+		},
+		{
+			Name:     "title",
+			GoName:   "Title",
+			JSONName: "title",
+			Number:   2,
+			Kind:     "string",
+			Metadata: TaskFieldMetadata[Task_Title_FIELD],
+		},
+		{
+			Name:     "description",
+			GoName:   "Description",
+			JSONName: "description",
+			Number:   3,
+			Kind:     "string",
+			Metadata: TaskFieldMetadata[Task_Description_FIELD],
+		},
+		{
+			Name:     "status",
+			GoName:   "Status",
+			JSONName: "status",
+			Number:   4,
+			Kind:     "enum",
+			TypeName: "example.metadata.TaskStatus",
+			Metadata: TaskFieldMetadata[Task_Status_FIELD],
+		},
+		{
+			Name:     "created_at",
+			GoName:   "CreatedAt",
+			JSONName: "createdAt",
+			Number:   5,
+			Kind:     "int64",
+			Metadata: TaskFieldMetadata[Task_CreatedAt_FIELD],
+		},
+	},
+	Metadata: TaskMetadata,
+}
+
+// Descriptor returns TaskDescriptor
+func (*Task) Descriptor() *PuregenMessageDescriptor {
+	return TaskDescriptor
+}
+
+// CreateTaskRequestDescriptor describes CreateTaskRequest and its fields
+var CreateTaskRequestDescriptor = &PuregenMessageDescriptor{
+	FullName: "example.metadata.CreateTaskRequest",
+	Name:     "CreateTaskRequest",
+	GoName:   "CreateTaskRequest",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "title",
+			GoName:   "Title",
+			JSONName: "title",
+			Number:   1,
+			Kind:     "string",
+			Metadata: CreateTaskRequestFieldMetadata[CreateTaskRequest_Title_FIELD],
+		},
+		{
+			Name:     "description",
+			GoName:   "Description",
+			JSONName: "description",
+			Number:   2,
+			Kind:     "string",
+		},
+	},
+	Metadata: CreateTaskRequestMetadata,
+}
+
+// Descriptor returns CreateTaskRequestDescriptor
+func (*CreateTaskRequest) Descriptor() *PuregenMessageDescriptor {
+	return CreateTaskRequestDescriptor
+}
+
+// CreateTaskResponseDescriptor describes CreateTaskResponse and its fields
+var CreateTaskResponseDescriptor = &PuregenMessageDescriptor{
+	FullName: "example.metadata.CreateTaskResponse",
+	Name:     "CreateTaskResponse",
+	GoName:   "CreateTaskResponse",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "task",
+			GoName:   "Task",
+			JSONName: "task",
+			Number:   1,
+			Kind:     "message",
+			TypeName: "example.metadata.Task",
+		},
+	},
+	Metadata: CreateTaskResponseMetadata,
+}
+
+// Descriptor returns CreateTaskResponseDescriptor
+func (*CreateTaskResponse) Descriptor() *PuregenMessageDescriptor {
+	return CreateTaskResponseDescriptor
+}
+
+// GetTaskRequestDescriptor describes GetTaskRequest and its fields
+var GetTaskRequestDescriptor = &PuregenMessageDescriptor{
+	FullName: "example.metadata.GetTaskRequest",
+	Name:     "GetTaskRequest",
+	GoName:   "GetTaskRequest",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "id",
+			GoName:   "Id",
+			JSONName: "id",
+			Number:   1,
+			Kind:     "string",
+			Metadata: GetTaskRequestFieldMetadata[GetTaskRequest_Id_FIELD],
+		},
+	},
+	Metadata: GetTaskRequestMetadata,
+}
+
+// Descriptor returns GetTaskRequestDescriptor
+func (*GetTaskRequest) Descriptor() *PuregenMessageDescriptor {
+	return GetTaskRequestDescriptor
+}
+
+// GetTaskResponseDescriptor describes GetTaskResponse and its fields
+var GetTaskResponseDescriptor = &PuregenMessageDescriptor{
+	FullName: "example.metadata.GetTaskResponse",
+	Name:     "GetTaskResponse",
+	GoName:   "GetTaskResponse",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "task",
+			GoName:   "Task",
+			JSONName: "task",
+			Number:   1,
+			Kind:     "message",
+			TypeName: "example.metadata.Task",
+		},
+	},
+	Metadata: GetTaskResponseMetadata,
+}
+
+// Descriptor returns GetTaskResponseDescriptor
+func (*GetTaskResponse) Descriptor() *PuregenMessageDescriptor {
+	return GetTaskResponseDescriptor
+}
+
+func init() {
+	registerMessageDescriptors(TaskDescriptor, CreateTaskRequestDescriptor, CreateTaskResponseDescriptor, GetTaskRequestDescriptor, GetTaskResponseDescriptor)
+}
+
+// Message types
+
+// ProtoName returns the full proto name of Task
+func (*Task) ProtoName() string {
+	return "example.metadata.Task"
+}
+
+// ProtoName returns the full proto name of CreateTaskRequest
+func (*CreateTaskRequest) ProtoName() string {
+	return "example.metadata.CreateTaskRequest"
+}
+
+// ProtoName returns the full proto name of CreateTaskResponse
+func (*CreateTaskResponse) ProtoName() string {
+	return "example.metadata.CreateTaskResponse"
+}
+
+// ProtoName returns the full proto name of GetTaskRequest
+func (*GetTaskRequest) ProtoName() string {
+	return "example.metadata.GetTaskRequest"
+}
+
+// ProtoName returns the full proto name of GetTaskResponse
+func (*GetTaskResponse) ProtoName() string {
+	return "example.metadata.GetTaskResponse"
+}
+
+func init() {
+	registerMessageTypes(map[string]func() PuregenMessage{
+		"example.metadata.Task":               func() PuregenMessage { return NewTask() },
+		"example.metadata.CreateTaskRequest":  func() PuregenMessage { return NewCreateTaskRequest() },
+		"example.metadata.CreateTaskResponse": func() PuregenMessage { return NewCreateTaskResponse() },
+		"example.metadata.GetTaskRequest":     func() PuregenMessage { return NewGetTaskRequest() },
+		"example.metadata.GetTaskResponse":    func() PuregenMessage { return NewGetTaskResponse() },
+	})
+}
+
+// Tables
+
+// TaskColumns are the columns of the "tasks" table, in the order
+// ScanTask reads them
+var TaskColumns = []string{
+	"task_id",
+	"title",
+	"description",
+	"status",
+	"created_at",
+}
+
+// SelectTaskSQL selects the columns of Task rows, for ScanTask
+const SelectTaskSQL = `SELECT "task_id", "title", "description", "status", "created_at" FROM "tasks"."tasks"`
+
+// InsertTaskSQL inserts a row of the "tasks" table
+const InsertTaskSQL = `INSERT INTO "tasks"."tasks" ("task_id", "title", "description", "status", "created_at") VALUES ($1, $2, $3, $4, $5)`
+
+// ScanTask reads the current row of rows, selected with SelectTaskSQL,
+// into a new Task
+func ScanTask(rows *sql.Rows) (*Task, error) {
+	m := NewTask()
+	if err := rows.Scan(&m.Id, &m.Title, &m.Description, &m.Status, &m.CreatedAt); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// InsertTask inserts m as a row of the "tasks" table
+func InsertTask(ctx context.Context, db *sql.DB, m *Task) error {
+	_, err := db.ExecContext(ctx, InsertTaskSQL, m.Id, m.Title, m.Description, m.Status, m.CreatedAt)
+	return err
+}
+
 // Services
 
 // Example service with method metadata
@@ -262,17 +1395,20 @@ const (
 	TaskService_GetTask    = "TaskService_GetTask"
 )
 
-var TaskServiceMethodMetadata = map[string]map[string]string{
+var TaskServiceMethodMetadata = map[string]map[string]any{
 	TaskService_CreateTask: {
 		"auth":   "required",
 		"method": "POST",
 		"path":   "/api/v1/tasks",
+		"roles":  []any{"admin", "ops"},
 	},
 	TaskService_GetTask: {
-		"cache":     "true",
-		"cache_ttl": "300",
-		"method":    "GET",
-		"path":      "/api/v1/tasks/{id}",
+		"auth":       "required",
+		"cache":      true,
+		"cache_ttl":  300,
+		"method":     "GET",
+		"path":       "/api/v1/tasks/{id}",
+		"rate_limit": map[string]any{"requests": 100, "window": "1m"},
 	},
 }
 
@@ -1,23 +1,35 @@
 # Code generated by protoc-gen-puregen. DO NOT EDIT.
 
 from dataclasses import dataclass, field
-from typing import Optional, List, Dict, Any
+from typing import Optional, List, Dict, Any, ClassVar, Final, Literal, Union
 from abc import ABC, abstractmethod
+import base64
+import copy
 import json
-from .puregen_transport import PuregenTransport
+import sys
+import warnings
+from .puregen_transport import PuregenTransport, AsyncPuregenTransport
+from .puregen_descriptors import PuregenFieldDescriptor, PuregenMessageDescriptor, register_message_descriptors
+from .puregen_registry import PuregenEnvelope, register_message_types
+from .puregen_fieldmask import PuregenFieldMask
+
+if sys.version_info >= (3, 11):
+    from typing import Self
+else:
+    from typing_extensions import Self
 
 # Enums
 
 # Example enum with metadata for validation and UI
 class TaskStatus:
     """TaskStatus enum values as string constants"""
-    UNKNOWN = "UNKNOWN"
-    PENDING = "PENDING"
-    IN_PROGRESS = "IN_PROGRESS"
-    COMPLETED = "COMPLETED"
-    CANCELLED = "CANCELLED"
+    UNKNOWN: Final = "UNKNOWN"
+    PENDING: Final = "PENDING"
+    IN_PROGRESS: Final = "IN_PROGRESS"
+    COMPLETED: Final = "COMPLETED"
+    CANCELLED: Final = "CANCELLED"
 
-    VALUES = [
+    VALUES: Final[List[str]] = [
         UNKNOWN,
         PENDING,
         IN_PROGRESS,
@@ -30,19 +42,61 @@ class TaskStatus:
         """Check if value is a valid TaskStatus"""
         return value in cls.VALUES
 
+    @classmethod
+    def display_name(cls, value: str) -> str:
+        """Display name of a TaskStatus value, or the value itself"""
+        return {
+            "UNKNOWN": "UNKNOWN",
+            "PENDING": "Pending",
+            "IN_PROGRESS": "In progress",
+            "COMPLETED": "Completed",
+            "CANCELLED": "Cancelled",
+        }.get(value, value)
+
 # Metadata for TaskStatus
-TaskStatusMetadata = {
+TaskStatusMetadata: Dict[str, Any] = {
     "category": "status",
     "ui_type": "dropdown",
     "validation": "required",
 }
 
+# Metadata for each TaskStatus value
+TaskStatusValueMetadata: Dict[str, Dict[str, Any]] = {
+    "PENDING": {
+        "display_name": "Pending",
+        "order": 1,
+    },
+    "IN_PROGRESS": {
+        "display_name": "In progress",
+        "order": 2,
+    },
+    "COMPLETED": {
+        "display_name": "Completed",
+        "order": 3,
+        "terminal": True,
+    },
+    "CANCELLED": {
+        "display_name": "Cancelled",
+        "order": 4,
+        "terminal": True,
+    },
+}
+
 # Messages
 
 # Example message with metadata for database mapping
 @dataclass
 class Task:
     """Generated message class for Task"""
+    class Paths:
+        """Field paths of Task, for apply_mask and field masks"""
+        ID = "id"
+        TITLE = "title"
+        DESCRIPTION = "description"
+        STATUS = "status"
+        CREATED_AT = "created_at"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Primary key field with validation metadata
     id: str = ""
     # Required field with length constraints
@@ -50,7 +104,7 @@ class Task:
     # Optional field with UI metadata
     description: str = ""
     # Status field with validation and default value
-    status: str = 0
+    status: Literal["UNKNOWN", "PENDING", "IN_PROGRESS", "COMPLETED", "CANCELLED"] = "UNKNOWN"
     # Timestamp field with format metadata
     created_at: int = 0
 
@@ -59,13 +113,77 @@ class Task:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a Task with every field at its zero value, unlike
+        Task(), which applies the field defaults."""
+        return cls(id="", title="", description="", status="UNKNOWN", created_at=0)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new Task."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == Task.Paths.ID:
+                if dot:
+                    raise ValueError(f"Task: field {name!r} has no subfields")
+                self.id = source.id
+            elif name == Task.Paths.TITLE:
+                if dot:
+                    raise ValueError(f"Task: field {name!r} has no subfields")
+                self.title = source.title
+            elif name == Task.Paths.DESCRIPTION:
+                if dot:
+                    raise ValueError(f"Task: field {name!r} has no subfields")
+                self.description = source.description
+            elif name == Task.Paths.STATUS:
+                if dot:
+                    raise ValueError(f"Task: field {name!r} has no subfields")
+                self.status = source.status
+            elif name in (Task.Paths.CREATED_AT, "createdAt"):
+                if dot:
+                    raise ValueError(f"Task: field {name!r} has no subfields")
+                self.created_at = source.created_at
+            else:
+                raise ValueError(f"Task: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.id:
+            self.id = src.id
+        if src.title:
+            self.title = src.title
+        if src.description:
+            self.description = src.description
+        if src.status != "UNKNOWN":
+            self.status = src.status
+        if src.created_at:
+            self.created_at = src.created_at
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.id is not None:
             result['id'] = self.id
         if self.title is not None:
@@ -79,15 +197,19 @@ class Task:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'Task':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'Task':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
+        data = dict(data)
+        for alias, name in [("created_at", "createdAt")]:
+            if alias in data:
+                data.setdefault(name, data.pop(alias))
         if 'id' in data:
             kwargs['id'] = data['id']
         if 'title' in data:
@@ -101,9 +223,10 @@ class Task:
         return cls(**kwargs)
 
 # Metadata for Task
-TaskMetadata = {
-    "cache": "true",
+TaskMetadata: Dict[str, Any] = {
+    "cache": True,
     "partition_key": "user_id",
+    "schema": "tasks",
     "table": "tasks",
 }
 
@@ -115,15 +238,15 @@ Task_Status_FIELD = "Task_Status"
 Task_CreatedAt_FIELD = "Task_CreatedAt"
 
 # MessageField metadata for Task
-TaskFieldMetadata = {
+TaskFieldMetadata: Dict[str, Dict[str, Any]] = {
     Task_Id_FIELD: {
         "db_column": "task_id",
         "index": "primary",
         "validation": "uuid",
     },
     Task_Title_FIELD: {
-        "max_length": "200",
-        "min_length": "1",
+        "max_length": 200,
+        "min_length": 1,
         "validation": "required",
     },
     Task_Description_FIELD: {
@@ -138,12 +261,19 @@ TaskFieldMetadata = {
     Task_CreatedAt_FIELD: {
         "format": "unix_timestamp",
         "index": "secondary",
+        "sortable": "true",
     },
 }
 
 @dataclass
 class CreateTaskRequest:
     """Generated message class for CreateTaskRequest"""
+    class Paths:
+        """Field paths of CreateTaskRequest, for apply_mask and field masks"""
+        TITLE = "title"
+        DESCRIPTION = "description"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Required fields for task creation
     title: str = ""
     description: str = ""
@@ -153,13 +283,59 @@ class CreateTaskRequest:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a CreateTaskRequest with every field at its zero value, unlike
+        CreateTaskRequest(), which applies the field defaults."""
+        return cls(title="", description="")
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new CreateTaskRequest."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == CreateTaskRequest.Paths.TITLE:
+                if dot:
+                    raise ValueError(f"CreateTaskRequest: field {name!r} has no subfields")
+                self.title = source.title
+            elif name == CreateTaskRequest.Paths.DESCRIPTION:
+                if dot:
+                    raise ValueError(f"CreateTaskRequest: field {name!r} has no subfields")
+                self.description = source.description
+            else:
+                raise ValueError(f"CreateTaskRequest: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.title:
+            self.title = src.title
+        if src.description:
+            self.description = src.description
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.title is not None:
             result['title'] = self.title
         if self.description is not None:
@@ -167,26 +343,31 @@ class CreateTaskRequest:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'CreateTaskRequest':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'CreateTaskRequest':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'title' in data:
             kwargs['title'] = data['title']
         if 'description' in data:
             kwargs['description'] = data['description']
         return cls(**kwargs)
 
+# Metadata for CreateTaskRequest
+CreateTaskRequestMetadata: Dict[str, Any] = {
+    "schema": "tasks",
+}
+
 # Field name constants for CreateTaskRequest
 CreateTaskRequest_Title_FIELD = "CreateTaskRequest_Title"
 
 # MessageField metadata for CreateTaskRequest
-CreateTaskRequestFieldMetadata = {
+CreateTaskRequestFieldMetadata: Dict[str, Dict[str, Any]] = {
     CreateTaskRequest_Title_FIELD: {
         "trim_whitespace": "true",
         "validation": "required",
@@ -196,6 +377,11 @@ CreateTaskRequestFieldMetadata = {
 @dataclass
 class CreateTaskResponse:
     """Generated message class for CreateTaskResponse"""
+    class Paths:
+        """Field paths of CreateTaskResponse, for apply_mask and field masks"""
+        TASK = "task"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     task: Optional['Task'] = None
 
     def validate(self) -> bool:
@@ -203,34 +389,91 @@ class CreateTaskResponse:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a CreateTaskResponse with every field at its zero value, unlike
+        CreateTaskResponse(), which applies the field defaults."""
+        return cls(task=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new CreateTaskResponse."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == CreateTaskResponse.Paths.TASK:
+                if not dot:
+                    self.task = copy.deepcopy(source.task)
+                else:
+                    if self.task is None:
+                        self.task = Task.zero_value()
+                    self.task.apply_mask(source.task, [rest])
+            else:
+                raise ValueError(f"CreateTaskResponse: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.task is not None:
+            if self.task is None:
+                self.task = Task.zero_value()
+            self.task.merge(src.task)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.task is not None:
+            result.task = result.task.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.task is not None:
             result['task'] = self.task.to_dict() if hasattr(self.task, 'to_dict') else self.task
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'CreateTaskResponse':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'CreateTaskResponse':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'task' in data:
             kwargs['task'] = Task.from_dict(data['task']) if isinstance(data['task'], dict) else data['task']
         return cls(**kwargs)
 
+# Metadata for CreateTaskResponse
+CreateTaskResponseMetadata: Dict[str, Any] = {
+    "schema": "tasks",
+}
+
 @dataclass
 class GetTaskRequest:
     """Generated message class for GetTaskRequest"""
+    class Paths:
+        """Field paths of GetTaskRequest, for apply_mask and field masks"""
+        ID = "id"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # 
     id: str = ""
 
@@ -239,36 +482,81 @@ class GetTaskRequest:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a GetTaskRequest with every field at its zero value, unlike
+        GetTaskRequest(), which applies the field defaults."""
+        return cls(id="")
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new GetTaskRequest."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == GetTaskRequest.Paths.ID:
+                if dot:
+                    raise ValueError(f"GetTaskRequest: field {name!r} has no subfields")
+                self.id = source.id
+            else:
+                raise ValueError(f"GetTaskRequest: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.id:
+            self.id = src.id
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.id is not None:
             result['id'] = self.id
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'GetTaskRequest':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'GetTaskRequest':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'id' in data:
             kwargs['id'] = data['id']
         return cls(**kwargs)
 
+# Metadata for GetTaskRequest
+GetTaskRequestMetadata: Dict[str, Any] = {
+    "schema": "tasks",
+}
+
 # Field name constants for GetTaskRequest
 GetTaskRequest_Id_FIELD = "GetTaskRequest_Id"
 
 # MessageField metadata for GetTaskRequest
-GetTaskRequestFieldMetadata = {
+GetTaskRequestFieldMetadata: Dict[str, Dict[str, Any]] = {
     GetTaskRequest_Id_FIELD: {
         "validation": "uuid",
     },
@@ -277,6 +565,11 @@ GetTaskRequestFieldMetadata = {
 @dataclass
 class GetTaskResponse:
     """Generated message class for GetTaskResponse"""
+    class Paths:
+        """Field paths of GetTaskResponse, for apply_mask and field masks"""
+        TASK = "task"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     task: Optional['Task'] = None
 
     def validate(self) -> bool:
@@ -284,31 +577,158 @@ class GetTaskResponse:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a GetTaskResponse with every field at its zero value, unlike
+        GetTaskResponse(), which applies the field defaults."""
+        return cls(task=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new GetTaskResponse."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == GetTaskResponse.Paths.TASK:
+                if not dot:
+                    self.task = copy.deepcopy(source.task)
+                else:
+                    if self.task is None:
+                        self.task = Task.zero_value()
+                    self.task.apply_mask(source.task, [rest])
+            else:
+                raise ValueError(f"GetTaskResponse: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.task is not None:
+            if self.task is None:
+                self.task = Task.zero_value()
+            self.task.merge(src.task)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.task is not None:
+            result.task = result.task.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.task is not None:
             result['task'] = self.task.to_dict() if hasattr(self.task, 'to_dict') else self.task
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'GetTaskResponse':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'GetTaskResponse':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'task' in data:
             kwargs['task'] = Task.from_dict(data['task']) if isinstance(data['task'], dict) else data['task']
         return cls(**kwargs)
 
+# Metadata for GetTaskResponse
+GetTaskResponseMetadata: Dict[str, Any] = {
+    "schema": "tasks",
+}
+
+# Descriptors
+
+Task.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="example.metadata.Task",
+    name="Task",
+    python_name="Task",
+    fields=[
+        PuregenFieldDescriptor(name="id", python_name="id", json_name="id", number=1, kind="string", metadata=TaskFieldMetadata[Task_Id_FIELD]),
+        PuregenFieldDescriptor(name="title", python_name="title", json_name="title", number=2, kind="string", metadata=TaskFieldMetadata[Task_Title_FIELD]),
+        PuregenFieldDescriptor(name="description", python_name="description", json_name="description", number=3, kind="string", metadata=TaskFieldMetadata[Task_Description_FIELD]),
+        PuregenFieldDescriptor(name="status", python_name="status", json_name="status", number=4, kind="enum", type_name="example.metadata.TaskStatus", metadata=TaskFieldMetadata[Task_Status_FIELD]),
+        PuregenFieldDescriptor(name="created_at", python_name="created_at", json_name="createdAt", number=5, kind="int64", metadata=TaskFieldMetadata[Task_CreatedAt_FIELD]),
+    ],
+    metadata=TaskMetadata,
+)
+
+CreateTaskRequest.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="example.metadata.CreateTaskRequest",
+    name="CreateTaskRequest",
+    python_name="CreateTaskRequest",
+    fields=[
+        PuregenFieldDescriptor(name="title", python_name="title", json_name="title", number=1, kind="string", metadata=CreateTaskRequestFieldMetadata[CreateTaskRequest_Title_FIELD]),
+        PuregenFieldDescriptor(name="description", python_name="description", json_name="description", number=2, kind="string"),
+    ],
+    metadata=CreateTaskRequestMetadata,
+)
+
+CreateTaskResponse.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="example.metadata.CreateTaskResponse",
+    name="CreateTaskResponse",
+    python_name="CreateTaskResponse",
+    fields=[
+        PuregenFieldDescriptor(name="task", python_name="task", json_name="task", number=1, kind="message", type_name="example.metadata.Task"),
+    ],
+    metadata=CreateTaskResponseMetadata,
+)
+
+GetTaskRequest.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="example.metadata.GetTaskRequest",
+    name="GetTaskRequest",
+    python_name="GetTaskRequest",
+    fields=[
+        PuregenFieldDescriptor(name="id", python_name="id", json_name="id", number=1, kind="string", metadata=GetTaskRequestFieldMetadata[GetTaskRequest_Id_FIELD]),
+    ],
+    metadata=GetTaskRequestMetadata,
+)
+
+GetTaskResponse.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="example.metadata.GetTaskResponse",
+    name="GetTaskResponse",
+    python_name="GetTaskResponse",
+    fields=[
+        PuregenFieldDescriptor(name="task", python_name="task", json_name="task", number=1, kind="message", type_name="example.metadata.Task"),
+    ],
+    metadata=GetTaskResponseMetadata,
+)
+
+register_message_descriptors(
+    Task.DESCRIPTOR,
+    CreateTaskRequest.DESCRIPTOR,
+    CreateTaskResponse.DESCRIPTOR,
+    GetTaskRequest.DESCRIPTOR,
+    GetTaskResponse.DESCRIPTOR,
+)
+
+# Message types
+
+register_message_types({
+    "example.metadata.Task": Task,
+    "example.metadata.CreateTaskRequest": CreateTaskRequest,
+    "example.metadata.CreateTaskResponse": CreateTaskResponse,
+    "example.metadata.GetTaskRequest": GetTaskRequest,
+    "example.metadata.GetTaskResponse": GetTaskResponse,
+})
+
 # Services
 
 # Example service with method metadata
@@ -349,17 +769,20 @@ class TaskServiceMethods:
     TaskService_CreateTask = "TaskService_CreateTask"
     TaskService_GetTask = "TaskService_GetTask"
 
-    METHOD_METADATA = {
+    METHOD_METADATA: Dict[str, Dict[str, Any]] = {
         TaskService_CreateTask: {
             "auth": "required",
             "method": "POST",
             "path": "/api/v1/tasks",
+            "roles": ["admin", "ops"],
         },
         TaskService_GetTask: {
-            "cache": "true",
-            "cache_ttl": "300",
+            "auth": "required",
+            "cache": True,
+            "cache_ttl": 300,
             "method": "GET",
             "path": "/api/v1/tasks/{id}",
+            "rate_limit": {"requests": 100, "window": "1m"},
         },
     }
 
@@ -368,7 +791,7 @@ class TaskServiceMethods:
 class TaskServiceClient:
     """Client for TaskService service"""
 
-    def __init__(self, transport: PuregenTransport):
+    def __init__(self, transport: PuregenTransport) -> None:
         self.transport = transport
 
     def create_task(self, ctx: Dict[str, Any], request: CreateTaskRequest) -> CreateTaskResponse:
@@ -395,3 +818,33 @@ class TaskServiceClient:
             return GetTaskResponse.from_dict(result)
         raise ValueError(f"Invalid response type for get_task: {type(result)}")
 
+class AsyncTaskServiceClient:
+    """Asyncio client for TaskService service"""
+
+    def __init__(self, transport: AsyncPuregenTransport) -> None:
+        self.transport = transport
+
+    async def create_task(self, ctx: Dict[str, Any], request: CreateTaskRequest) -> CreateTaskResponse:
+        """CreateTask client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = TaskServiceMethods.METHOD_METADATA.get(TaskServiceMethods.TaskService_CreateTask, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, TaskServiceMethods.TaskService_CreateTask, request, CreateTaskResponse)
+        if isinstance(result, CreateTaskResponse):
+            return result
+        if isinstance(result, dict):
+            return CreateTaskResponse.from_dict(result)
+        raise ValueError(f"Invalid response type for create_task: {type(result)}")
+
+    async def get_task(self, ctx: Dict[str, Any], request: GetTaskRequest) -> GetTaskResponse:
+        """GetTask client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = TaskServiceMethods.METHOD_METADATA.get(TaskServiceMethods.TaskService_GetTask, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, TaskServiceMethods.TaskService_GetTask, request, GetTaskResponse)
+        if isinstance(result, GetTaskResponse):
+            return result
+        if isinstance(result, dict):
+            return GetTaskResponse.from_dict(result)
+        raise ValueError(f"Invalid response type for get_task: {type(result)}")
+
@@ -4,7 +4,7 @@
 package metadata
 
 import (
-	"context"
+	context "context"
 )
 
 // PuregenTransport defines the interface for client communication
@@ -0,0 +1,36 @@
+# Code generated by protoc-gen-puregen. DO NOT EDIT.
+# Package initialization file
+
+from .puregen_transport import PuregenTransport, AsyncPuregenTransport
+from .puregen_descriptors import PuregenFieldDescriptor, PuregenMessageDescriptor, find_message_descriptor, message_descriptors
+from .puregen_registry import PuregenEnvelope, find_message_type, pack, unpack, unpack_as
+from .puregen_fieldmask import PuregenFieldMask
+from .options_example import (
+    Visibility,
+    Color,
+    Article,
+    GetArticleRequest,
+    ArticleServiceClient,
+    AsyncArticleServiceClient,
+)
+
+__all__ = [
+    "PuregenTransport",
+    "AsyncPuregenTransport",
+    "PuregenFieldDescriptor",
+    "PuregenMessageDescriptor",
+    "find_message_descriptor",
+    "message_descriptors",
+    "PuregenEnvelope",
+    "find_message_type",
+    "pack",
+    "unpack",
+    "unpack_as",
+    "PuregenFieldMask",
+    "Visibility",
+    "Color",
+    "Article",
+    "GetArticleRequest",
+    "ArticleServiceClient",
+    "AsyncArticleServiceClient",
+]
@@ -0,0 +1,735 @@
+// Code generated by protoc-gen-puregen. DO NOT EDIT.
+
+package options
+
+import (
+	context "context"
+	sql "database/sql"
+	json "encoding/json"
+	fmt "fmt"
+	slog "log/slog"
+	strings "strings"
+)
+
+// Enums
+
+type Visibility int32
+
+const (
+	Visibility_VISIBILITY_UNSPECIFIED Visibility = 0
+	Visibility_VISIBILITY_PUBLIC      Visibility = 1
+	Visibility_VISIBILITY_PRIVATE     Visibility = 2
+)
+
+var Visibility_name = map[int32]string{
+	0: "VISIBILITY_UNSPECIFIED",
+	1: "VISIBILITY_PUBLIC",
+	2: "VISIBILITY_PRIVATE",
+}
+
+var Visibility_value = map[string]int32{
+	"VISIBILITY_UNSPECIFIED": 0,
+	"VISIBILITY_PUBLIC":      1,
+	"VISIBILITY_PRIVATE":     2,
+}
+
+func (x Visibility) String() string {
+	if name, ok := Visibility_name[int32(x)]; ok {
+		return name
+	}
+	return fmt.Sprintf("Visibility(%d)", x)
+}
+
+func ParseVisibility(s string) (Visibility, error) {
+	if value, ok := Visibility_value[s]; ok {
+		return Visibility(value), nil
+	}
+	return 0, fmt.Errorf("invalid Visibility value: %s", s)
+}
+
+func (x Visibility) IsValid() bool {
+	_, ok := Visibility_name[int32(x)]
+	return ok
+}
+
+// UnmarshalJSON accepts a Visibility as its number or its name. Unknown
+// numbers are kept as is; IsValid reports them.
+func (x *Visibility) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var number int32
+	if err := json.Unmarshal(data, &number); err == nil {
+		*x = Visibility(number)
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return fmt.Errorf("invalid Visibility value: %s", data)
+	}
+	value, err := ParseVisibility(name)
+	if err != nil {
+		return err
+	}
+	*x = value
+	return nil
+}
+
+// Color enum values as string constants
+const (
+	Color_COLOR_UNSPECIFIED = "COLOR_UNSPECIFIED"
+	Color_COLOR_RED         = "COLOR_RED"
+	Color_COLOR_GREEN       = "COLOR_GREEN"
+)
+
+var ColorValues = []string{
+	Color_COLOR_UNSPECIFIED,
+	Color_COLOR_RED,
+	Color_COLOR_GREEN,
+}
+
+func IsValidColor(value string) bool {
+	for _, v := range ColorValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ColorMetadata contains metadata for Color
+var ColorMetadata = map[string]any{
+	"ui_type": "palette",
+}
+
+// Messages
+
+// Article stored in the content database
+type Article struct {
+	Id    string `json:"id"`
+	Title string `json:"title"`
+	// The option overrides the default from the comment directive
+	State      string     `json:"state"`
+	Visibility Visibility `json:"visibility"`
+	Color      string     `json:"color"`
+}
+
+// ArticleOption sets a field of a new Article
+type ArticleOption func(*Article)
+
+// WithArticleId sets the Id field
+func WithArticleId(value string) ArticleOption {
+	return func(m *Article) {
+		m.Id = value
+	}
+}
+
+// WithArticleTitle sets the Title field
+func WithArticleTitle(value string) ArticleOption {
+	return func(m *Article) {
+		m.Title = value
+	}
+}
+
+// WithArticleState sets the State field
+func WithArticleState(value string) ArticleOption {
+	return func(m *Article) {
+		m.State = value
+	}
+}
+
+// WithArticleVisibility sets the Visibility field
+func WithArticleVisibility(value Visibility) ArticleOption {
+	return func(m *Article) {
+		m.Visibility = value
+	}
+}
+
+// WithArticleColor sets the Color field
+func WithArticleColor(value string) ArticleOption {
+	return func(m *Article) {
+		m.Color = value
+	}
+}
+
+func NewArticle(opts ...ArticleOption) *Article {
+	m := &Article{
+		Title: "Untitled",
+		State: "new",
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetId returns the Id field, or its zero value if m is nil
+func (m *Article) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// GetTitle returns the Title field, or its zero value if m is nil
+func (m *Article) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+// GetState returns the State field, or its zero value if m is nil
+func (m *Article) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+// GetVisibility returns the Visibility field, or its zero value if m is nil
+func (m *Article) GetVisibility() Visibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return 0
+}
+
+// GetColor returns the Color field, or its zero value if m is nil
+func (m *Article) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+// Clone returns a deep copy of m
+func (m *Article) Clone() *Article {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *Article) Equal(other *Article) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	if m.Title != other.Title {
+		return false
+	}
+	if m.State != other.State {
+		return false
+	}
+	if m.Visibility != other.Visibility {
+		return false
+	}
+	if m.Color != other.Color {
+		return false
+	}
+	return true
+}
+
+// Field paths of Article, for ApplyMask and field masks
+const (
+	Article_Id_PATH         = "id"
+	Article_Title_PATH      = "title"
+	Article_State_PATH      = "state"
+	Article_Visibility_PATH = "visibility"
+	Article_Color_PATH      = "color"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewArticle().
+func (m *Article) ApplyMask(src *Article, paths []string) error {
+	if src == nil {
+		src = NewArticle()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case Article_Id_PATH:
+			if nested {
+				return fmt.Errorf("Article: field %q has no subfields", name)
+			}
+			m.Id = src.Id
+		case Article_Title_PATH:
+			if nested {
+				return fmt.Errorf("Article: field %q has no subfields", name)
+			}
+			m.Title = src.Title
+		case Article_State_PATH:
+			if nested {
+				return fmt.Errorf("Article: field %q has no subfields", name)
+			}
+			m.State = src.State
+		case Article_Visibility_PATH:
+			if nested {
+				return fmt.Errorf("Article: field %q has no subfields", name)
+			}
+			m.Visibility = src.Visibility
+		case Article_Color_PATH:
+			if nested {
+				return fmt.Errorf("Article: field %q has no subfields", name)
+			}
+			m.Color = src.Color
+		default:
+			return fmt.Errorf("Article: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *Article) Merge(src *Article) {
+	if src == nil {
+		return
+	}
+	if src.Id != "" {
+		m.Id = src.Id
+	}
+	if src.Title != "" {
+		m.Title = src.Title
+	}
+	if src.State != "" {
+		m.State = src.State
+	}
+	if src.Visibility != 0 {
+		m.Visibility = src.Visibility
+	}
+	if src.Color != "" {
+		m.Color = src.Color
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *Article) ApplyFieldMask(src *Article, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *Article) MergeFieldMask(src *Article, mask *PuregenFieldMask) error {
+	masked := &Article{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *Article) Redacted() *Article {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *Article) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *Article) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("id", r.Id),
+		slog.Any("title", r.Title),
+		slog.Any("state", r.State),
+		slog.Any("visibility", r.Visibility),
+		slog.Any("color", r.Color),
+	)
+}
+
+func (m *Article) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *Article) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *Article) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+// ArticleMetadata contains metadata for Article
+var ArticleMetadata = map[string]any{
+	"cache": true,
+	"table": "articles",
+}
+
+// Field name constants for Article
+const (
+	Article_Id_FIELD = "Article_Id"
+)
+
+// MessageField metadata for Article
+var ArticleFieldMetadata = map[string]map[string]any{
+	Article_Id_FIELD: {
+		"column":      "article_id",
+		"primary_key": true,
+	},
+}
+
+type GetArticleRequest struct {
+	Id string `json:"id"`
+}
+
+// GetArticleRequestOption sets a field of a new GetArticleRequest
+type GetArticleRequestOption func(*GetArticleRequest)
+
+// WithGetArticleRequestId sets the Id field
+func WithGetArticleRequestId(value string) GetArticleRequestOption {
+	return func(m *GetArticleRequest) {
+		m.Id = value
+	}
+}
+
+func NewGetArticleRequest(opts ...GetArticleRequestOption) *GetArticleRequest {
+	m := &GetArticleRequest{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetId returns the Id field, or its zero value if m is nil
+func (m *GetArticleRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// Clone returns a deep copy of m
+func (m *GetArticleRequest) Clone() *GetArticleRequest {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *GetArticleRequest) Equal(other *GetArticleRequest) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	return true
+}
+
+// Field paths of GetArticleRequest, for ApplyMask and field masks
+const (
+	GetArticleRequest_Id_PATH = "id"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewGetArticleRequest().
+func (m *GetArticleRequest) ApplyMask(src *GetArticleRequest, paths []string) error {
+	if src == nil {
+		src = NewGetArticleRequest()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case GetArticleRequest_Id_PATH:
+			if nested {
+				return fmt.Errorf("GetArticleRequest: field %q has no subfields", name)
+			}
+			m.Id = src.Id
+		default:
+			return fmt.Errorf("GetArticleRequest: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *GetArticleRequest) Merge(src *GetArticleRequest) {
+	if src == nil {
+		return
+	}
+	if src.Id != "" {
+		m.Id = src.Id
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *GetArticleRequest) ApplyFieldMask(src *GetArticleRequest, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *GetArticleRequest) MergeFieldMask(src *GetArticleRequest, mask *PuregenFieldMask) error {
+	masked := &GetArticleRequest{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *GetArticleRequest) Redacted() *GetArticleRequest {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *GetArticleRequest) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *GetArticleRequest) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("id", r.Id),
+	)
+}
+
+func (m *GetArticleRequest) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *GetArticleRequest) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *GetArticleRequest) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+// Descriptors
+
+// ArticleDescriptor describes Article and its fields
+var ArticleDescriptor = &PuregenMessageDescriptor{
+	FullName: "example.options.Article",
+	Name:     "Article",
+	GoName:   "Article",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "id",
+			GoName:   "Id",
+			JSONName: "id",
+			Number:   1,
+			Kind:     "string",
+			Metadata: ArticleFieldMetadata[Article_Id_FIELD],
+		},
+		{
+			Name:     "title",
+			GoName:   "Title",
+			JSONName: "title",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "state",
+			GoName:   "State",
+			JSONName: "state",
+			Number:   3,
+			Kind:     "string",
+		},
+		{
+			Name:     "visibility",
+			GoName:   "Visibility",
+			JSONName: "visibility",
+			Number:   4,
+			Kind:     "enum",
+			TypeName: "example.options.Visibility",
+		},
+		{
+			Name:     "color",
+			GoName:   "Color",
+			JSONName: "color",
+			Number:   5,
+			Kind:     "enum",
+			TypeName: "example.options.Color",
+		},
+	},
+	Metadata: ArticleMetadata,
+}
+
+// Descriptor returns ArticleDescriptor
+func (*Article) Descriptor() *PuregenMessageDescriptor {
+	return ArticleDescriptor
+}
+
+// GetArticleRequestDescriptor describes GetArticleRequest and its fields
+var GetArticleRequestDescriptor = &PuregenMessageDescriptor{
+	FullName: "example.options.GetArticleRequest",
+	Name:     "GetArticleRequest",
+	GoName:   "GetArticleRequest",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "id",
+			GoName:   "Id",
+			JSONName: "id",
+			Number:   1,
+			Kind:     "string",
+		},
+	},
+}
+
+// Descriptor returns GetArticleRequestDescriptor
+func (*GetArticleRequest) Descriptor() *PuregenMessageDescriptor {
+	return GetArticleRequestDescriptor
+}
+
+func init() {
+	registerMessageDescriptors(ArticleDescriptor, GetArticleRequestDescriptor)
+}
+
+// Message types
+
+// ProtoName returns the full proto name of Article
+func (*Article) ProtoName() string {
+	return "example.options.Article"
+}
+
+// ProtoName returns the full proto name of GetArticleRequest
+func (*GetArticleRequest) ProtoName() string {
+	return "example.options.GetArticleRequest"
+}
+
+func init() {
+	registerMessageTypes(map[string]func() PuregenMessage{
+		"example.options.Article":           func() PuregenMessage { return NewArticle() },
+		"example.options.GetArticleRequest": func() PuregenMessage { return NewGetArticleRequest() },
+	})
+}
+
+// Tables
+
+// ArticleColumns are the columns of the "articles" table, in the order
+// ScanArticle reads them
+var ArticleColumns = []string{
+	"article_id",
+	"title",
+	"state",
+	"visibility",
+	"color",
+}
+
+// SelectArticleSQL selects the columns of Article rows, for ScanArticle
+const SelectArticleSQL = `SELECT "article_id", "title", "state", "visibility", "color" FROM "articles"`
+
+// InsertArticleSQL inserts a row of the "articles" table
+const InsertArticleSQL = `INSERT INTO "articles" ("article_id", "title", "state", "visibility", "color") VALUES ($1, $2, $3, $4, $5)`
+
+// ScanArticle reads the current row of rows, selected with SelectArticleSQL,
+// into a new Article
+func ScanArticle(rows *sql.Rows) (*Article, error) {
+	m := NewArticle()
+	if err := rows.Scan(&m.Id, &m.Title, &m.State, &m.Visibility, &m.Color); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// InsertArticle inserts m as a row of the "articles" table
+func InsertArticle(ctx context.Context, db *sql.DB, m *Article) error {
+	_, err := db.ExecContext(ctx, InsertArticleSQL, m.Id, m.Title, m.State, m.Visibility, m.Color)
+	return err
+}
+
+// Services
+
+type ArticleServiceService interface {
+	// Options and comment metadata are merged
+	GetArticle(ctx context.Context, req *GetArticleRequest) (*Article, error)
+}
+
+type DefaultArticleServiceService struct{}
+
+// Options and comment metadata are merged
+func (s *DefaultArticleServiceService) GetArticle(ctx context.Context, req *GetArticleRequest) (*Article, error) {
+	// TODO: Implement GetArticle
+	return &Article{}, fmt.Errorf("method GetArticle not implemented")
+}
+
+// Method name constants
+
+const (
+	ArticleService_GetArticle = "ArticleService_GetArticle"
+)
+
+var ArticleServiceMethodMetadata = map[string]map[string]any{
+	ArticleService_GetArticle: {
+		"auth":      "required",
+		"cache_ttl": 60,
+		"method":    "GET",
+		"path":      "/articles/{id}",
+	},
+}
+
+// Client
+
+type ArticleServiceClient struct {
+	transport PuregenTransport
+}
+
+func NewArticleServiceClient(transport PuregenTransport) *ArticleServiceClient {
+	return &ArticleServiceClient{transport: transport}
+}
+
+// Options and comment metadata are merged
+func (c *ArticleServiceClient) GetArticle(ctx context.Context, req *GetArticleRequest) (*Article, error) {
+	if metadata, exists := ArticleServiceMethodMetadata[ArticleService_GetArticle]; exists {
+		ctx = context.WithValue(ctx, "method_metadata", metadata)
+	}
+	result, err := c.transport.Send(ctx, ArticleService_GetArticle, req, (*Article)(nil))
+	if err != nil {
+		return nil, err
+	}
+	if response, ok := result.(*Article); ok {
+		return response, nil
+	}
+	return nil, fmt.Errorf("invalid response type for GetArticle")
+}
@@ -0,0 +1,409 @@
+# Code generated by protoc-gen-puregen. DO NOT EDIT.
+
+from dataclasses import dataclass, field
+from typing import Optional, List, Dict, Any, ClassVar, Final, Literal, Union
+from abc import ABC, abstractmethod
+import base64
+import copy
+import json
+import sys
+import warnings
+from enum import IntEnum
+from .puregen_transport import PuregenTransport, AsyncPuregenTransport
+from .puregen_descriptors import PuregenFieldDescriptor, PuregenMessageDescriptor, register_message_descriptors
+from .puregen_registry import PuregenEnvelope, register_message_types
+from .puregen_fieldmask import PuregenFieldMask
+
+if sys.version_info >= (3, 11):
+    from typing import Self
+else:
+    from typing_extensions import Self
+
+# Enums
+
+# Inherits the integer enum type from the file option
+class Visibility(IntEnum):
+    """Visibility enum values as integers"""
+    VISIBILITY_UNSPECIFIED = 0
+    VISIBILITY_PUBLIC = 1
+    VISIBILITY_PRIVATE = 2
+
+    @classmethod
+    def _missing_(cls, value: object) -> Optional['Visibility']:
+        """Look up names, and keep numbers without a value as UNRECOGNIZED members"""
+        if isinstance(value, str):
+            return cls.__members__.get(value)
+        if isinstance(value, int):
+            member = int.__new__(cls, value)
+            member._name_ = "UNRECOGNIZED"
+            member._value_ = value
+            return member
+        return None
+
+    @classmethod
+    def is_valid(cls, value: int) -> bool:
+        """Check if value is a valid Visibility"""
+        return value in [item.value for item in cls]
+
+# Overrides the file option with string constants
+class Color:
+    """Color enum values as string constants"""
+    COLOR_UNSPECIFIED: Final = "COLOR_UNSPECIFIED"
+    COLOR_RED: Final = "COLOR_RED"
+    COLOR_GREEN: Final = "COLOR_GREEN"
+
+    VALUES: Final[List[str]] = [
+        COLOR_UNSPECIFIED,
+        COLOR_RED,
+        COLOR_GREEN,
+    ]
+
+    @classmethod
+    def is_valid(cls, value: str) -> bool:
+        """Check if value is a valid Color"""
+        return value in cls.VALUES
+
+# Metadata for Color
+ColorMetadata: Dict[str, Any] = {
+    "ui_type": "palette",
+}
+
+# Messages
+
+# Article stored in the content database
+@dataclass
+class Article:
+    """Generated message class for Article"""
+    class Paths:
+        """Field paths of Article, for apply_mask and field masks"""
+        ID = "id"
+        TITLE = "title"
+        STATE = "state"
+        VISIBILITY = "visibility"
+        COLOR = "color"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
+    id: str = ""
+    title: str = "Untitled"
+    # The option overrides the default from the comment directive
+    state: str = "new"
+    visibility: int = 0
+    color: Literal["COLOR_UNSPECIFIED", "COLOR_RED", "COLOR_GREEN"] = "COLOR_UNSPECIFIED"
+
+    def validate(self) -> bool:
+        """Validate the message fields"""
+        # Add custom validation logic here
+        return True
+
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a Article with every field at its zero value, unlike
+        Article(), which applies the field defaults."""
+        return cls(id="", title="", state="", visibility=0, color="COLOR_UNSPECIFIED")
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new Article."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == Article.Paths.ID:
+                if dot:
+                    raise ValueError(f"Article: field {name!r} has no subfields")
+                self.id = source.id
+            elif name == Article.Paths.TITLE:
+                if dot:
+                    raise ValueError(f"Article: field {name!r} has no subfields")
+                self.title = source.title
+            elif name == Article.Paths.STATE:
+                if dot:
+                    raise ValueError(f"Article: field {name!r} has no subfields")
+                self.state = source.state
+            elif name == Article.Paths.VISIBILITY:
+                if dot:
+                    raise ValueError(f"Article: field {name!r} has no subfields")
+                self.visibility = source.visibility
+            elif name == Article.Paths.COLOR:
+                if dot:
+                    raise ValueError(f"Article: field {name!r} has no subfields")
+                self.color = source.color
+            else:
+                raise ValueError(f"Article: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.id:
+            self.id = src.id
+        if src.title:
+            self.title = src.title
+        if src.state:
+            self.state = src.state
+        if src.visibility:
+            self.visibility = src.visibility
+        if src.color != "COLOR_UNSPECIFIED":
+            self.color = src.color
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
+    def to_json(self) -> str:
+        """Convert message to JSON string"""
+        return json.dumps(self.to_dict())
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert message to dictionary"""
+        result: Dict[str, Any] = {}
+        if self.id is not None:
+            result['id'] = self.id
+        if self.title is not None:
+            result['title'] = self.title
+        if self.state is not None:
+            result['state'] = self.state
+        if self.visibility is not None:
+            result['visibility'] = self.visibility
+        if self.color is not None:
+            result['color'] = self.color
+        return result
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Self:
+        """Create message from JSON string"""
+        data = json.loads(json_str)
+        return cls.from_dict(data)
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
+        """Create message from dictionary"""
+        kwargs: Dict[str, Any] = {}
+        if 'id' in data:
+            kwargs['id'] = data['id']
+        if 'title' in data:
+            kwargs['title'] = data['title']
+        if 'state' in data:
+            kwargs['state'] = data['state']
+        if data.get('visibility') is not None:
+            kwargs['visibility'] = Visibility(data['visibility'])
+        if 'color' in data:
+            kwargs['color'] = data['color']
+        return cls(**kwargs)
+
+# Metadata for Article
+ArticleMetadata: Dict[str, Any] = {
+    "cache": True,
+    "table": "articles",
+}
+
+# Field name constants for Article
+Article_Id_FIELD = "Article_Id"
+
+# MessageField metadata for Article
+ArticleFieldMetadata: Dict[str, Dict[str, Any]] = {
+    Article_Id_FIELD: {
+        "column": "article_id",
+        "primary_key": True,
+    },
+}
+
+@dataclass
+class GetArticleRequest:
+    """Generated message class for GetArticleRequest"""
+    class Paths:
+        """Field paths of GetArticleRequest, for apply_mask and field masks"""
+        ID = "id"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
+    id: str = ""
+
+    def validate(self) -> bool:
+        """Validate the message fields"""
+        # Add custom validation logic here
+        return True
+
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a GetArticleRequest with every field at its zero value, unlike
+        GetArticleRequest(), which applies the field defaults."""
+        return cls(id="")
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new GetArticleRequest."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == GetArticleRequest.Paths.ID:
+                if dot:
+                    raise ValueError(f"GetArticleRequest: field {name!r} has no subfields")
+                self.id = source.id
+            else:
+                raise ValueError(f"GetArticleRequest: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.id:
+            self.id = src.id
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
+    def to_json(self) -> str:
+        """Convert message to JSON string"""
+        return json.dumps(self.to_dict())
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert message to dictionary"""
+        result: Dict[str, Any] = {}
+        if self.id is not None:
+            result['id'] = self.id
+        return result
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Self:
+        """Create message from JSON string"""
+        data = json.loads(json_str)
+        return cls.from_dict(data)
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
+        """Create message from dictionary"""
+        kwargs: Dict[str, Any] = {}
+        if 'id' in data:
+            kwargs['id'] = data['id']
+        return cls(**kwargs)
+
+# Descriptors
+
+Article.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="example.options.Article",
+    name="Article",
+    python_name="Article",
+    fields=[
+        PuregenFieldDescriptor(name="id", python_name="id", json_name="id", number=1, kind="string", metadata=ArticleFieldMetadata[Article_Id_FIELD]),
+        PuregenFieldDescriptor(name="title", python_name="title", json_name="title", number=2, kind="string"),
+        PuregenFieldDescriptor(name="state", python_name="state", json_name="state", number=3, kind="string"),
+        PuregenFieldDescriptor(name="visibility", python_name="visibility", json_name="visibility", number=4, kind="enum", type_name="example.options.Visibility"),
+        PuregenFieldDescriptor(name="color", python_name="color", json_name="color", number=5, kind="enum", type_name="example.options.Color"),
+    ],
+    metadata=ArticleMetadata,
+)
+
+GetArticleRequest.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="example.options.GetArticleRequest",
+    name="GetArticleRequest",
+    python_name="GetArticleRequest",
+    fields=[
+        PuregenFieldDescriptor(name="id", python_name="id", json_name="id", number=1, kind="string"),
+    ],
+)
+
+register_message_descriptors(
+    Article.DESCRIPTOR,
+    GetArticleRequest.DESCRIPTOR,
+)
+
+# Message types
+
+register_message_types({
+    "example.options.Article": Article,
+    "example.options.GetArticleRequest": GetArticleRequest,
+})
+
+# Services
+
+class ArticleServiceService(ABC):
+    """Abstract service interface for ArticleService"""
+
+    # Options and comment metadata are merged
+    @abstractmethod
+    def get_article(self, ctx: Dict[str, Any], request: GetArticleRequest) -> Article:
+        """GetArticle method"""
+        pass
+
+class DefaultArticleServiceService(ArticleServiceService):
+    """Default implementation of ArticleServiceService"""
+
+    # Options and comment metadata are merged
+    def get_article(self, ctx: Dict[str, Any], request: GetArticleRequest) -> Article:
+        """GetArticle method implementation"""
+        # TODO: Implement get_article
+        raise NotImplementedError("Method get_article not implemented")
+
+# Method name constants
+
+class ArticleServiceMethods:
+    """Method name constants for ArticleService"""
+    ArticleService_GetArticle = "ArticleService_GetArticle"
+
+    METHOD_METADATA: Dict[str, Dict[str, Any]] = {
+        ArticleService_GetArticle: {
+            "auth": "required",
+            "cache_ttl": 60,
+            "method": "GET",
+            "path": "/articles/{id}",
+        },
+    }
+
+# Client
+
+class ArticleServiceClient:
+    """Client for ArticleService service"""
+
+    def __init__(self, transport: PuregenTransport) -> None:
+        self.transport = transport
+
+    def get_article(self, ctx: Dict[str, Any], request: GetArticleRequest) -> Article:
+        """GetArticle client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = ArticleServiceMethods.METHOD_METADATA.get(ArticleServiceMethods.ArticleService_GetArticle, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = self.transport.send(enhanced_ctx, ArticleServiceMethods.ArticleService_GetArticle, request, Article)
+        if isinstance(result, Article):
+            return result
+        if isinstance(result, dict):
+            return Article.from_dict(result)
+        raise ValueError(f"Invalid response type for get_article: {type(result)}")
+
+class AsyncArticleServiceClient:
+    """Asyncio client for ArticleService service"""
+
+    def __init__(self, transport: AsyncPuregenTransport) -> None:
+        self.transport = transport
+
+    async def get_article(self, ctx: Dict[str, Any], request: GetArticleRequest) -> Article:
+        """GetArticle client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = ArticleServiceMethods.METHOD_METADATA.get(ArticleServiceMethods.ArticleService_GetArticle, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, ArticleServiceMethods.ArticleService_GetArticle, request, Article)
+        if isinstance(result, Article):
+            return result
+        if isinstance(result, dict):
+            return Article.from_dict(result)
+        raise ValueError(f"Invalid response type for get_article: {type(result)}")
+
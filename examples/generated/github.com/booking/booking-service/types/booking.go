@@ -3,9 +3,11 @@
 package types
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
+	context "context"
+	json "encoding/json"
+	fmt "fmt"
+	slog "log/slog"
+	strings "strings"
 )
 
 // Enums
@@ -14,9 +16,9 @@ type OperationType int32
 
 const (
 	OperationType_OperationType_UNKNOWN           OperationType = 0
-	OperationType_OperationType_HOTEL_RESERVATION               = 1
-	OperationType_OperationType_FLIGHT_BOOKING                  = 2
-	OperationType_OperationType_TRAVEL_PACKAGE                  = 3
+	OperationType_OperationType_HOTEL_RESERVATION OperationType = 1
+	OperationType_OperationType_FLIGHT_BOOKING    OperationType = 2
+	OperationType_OperationType_TRAVEL_PACKAGE    OperationType = 3
 )
 
 var OperationType_name = map[int32]string{
@@ -52,6 +54,29 @@ func (x OperationType) IsValid() bool {
 	return ok
 }
 
+// UnmarshalJSON accepts a OperationType as its number or its name. Unknown
+// numbers are kept as is; IsValid reports them.
+func (x *OperationType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var number int32
+	if err := json.Unmarshal(data, &number); err == nil {
+		*x = OperationType(number)
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return fmt.Errorf("invalid OperationType value: %s", data)
+	}
+	value, err := ParseOperationType(name)
+	if err != nil {
+		return err
+	}
+	*x = value
+	return nil
+}
+
 // BookingStatus enum values as string constants
 const (
 	BookingStatus_BookingStatus_UNKNOWN              = "BookingStatus_UNKNOWN"
@@ -117,8 +142,194 @@ type PaymentInfo struct {
 	OperationType OperationType `json:"operationType"`
 }
 
-func NewPaymentInfo() *PaymentInfo {
-	return &PaymentInfo{}
+// PaymentInfoOption sets a field of a new PaymentInfo
+type PaymentInfoOption func(*PaymentInfo)
+
+// WithPaymentInfoPaymentMethod sets the PaymentMethod field
+func WithPaymentInfoPaymentMethod(value string) PaymentInfoOption {
+	return func(m *PaymentInfo) {
+		m.PaymentMethod = value
+	}
+}
+
+// WithPaymentInfoPaymentToken sets the PaymentToken field
+func WithPaymentInfoPaymentToken(value string) PaymentInfoOption {
+	return func(m *PaymentInfo) {
+		m.PaymentToken = value
+	}
+}
+
+// WithPaymentInfoOperationType sets the OperationType field
+func WithPaymentInfoOperationType(value OperationType) PaymentInfoOption {
+	return func(m *PaymentInfo) {
+		m.OperationType = value
+	}
+}
+
+func NewPaymentInfo(opts ...PaymentInfoOption) *PaymentInfo {
+	m := &PaymentInfo{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetPaymentMethod returns the PaymentMethod field, or its zero value if m is nil
+func (m *PaymentInfo) GetPaymentMethod() string {
+	if m != nil {
+		return m.PaymentMethod
+	}
+	return ""
+}
+
+// GetPaymentToken returns the PaymentToken field, or its zero value if m is nil
+func (m *PaymentInfo) GetPaymentToken() string {
+	if m != nil {
+		return m.PaymentToken
+	}
+	return ""
+}
+
+// GetOperationType returns the OperationType field, or its zero value if m is nil
+func (m *PaymentInfo) GetOperationType() OperationType {
+	if m != nil {
+		return m.OperationType
+	}
+	return 0
+}
+
+// Clone returns a deep copy of m
+func (m *PaymentInfo) Clone() *PaymentInfo {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *PaymentInfo) Equal(other *PaymentInfo) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.PaymentMethod != other.PaymentMethod {
+		return false
+	}
+	if m.PaymentToken != other.PaymentToken {
+		return false
+	}
+	if m.OperationType != other.OperationType {
+		return false
+	}
+	return true
+}
+
+// Field paths of PaymentInfo, for ApplyMask and field masks
+const (
+	PaymentInfo_PaymentMethod_PATH = "paymentMethod"
+	PaymentInfo_PaymentToken_PATH  = "paymentToken"
+	PaymentInfo_OperationType_PATH = "operationType"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewPaymentInfo().
+func (m *PaymentInfo) ApplyMask(src *PaymentInfo, paths []string) error {
+	if src == nil {
+		src = NewPaymentInfo()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case PaymentInfo_PaymentMethod_PATH:
+			if nested {
+				return fmt.Errorf("PaymentInfo: field %q has no subfields", name)
+			}
+			m.PaymentMethod = src.PaymentMethod
+		case PaymentInfo_PaymentToken_PATH:
+			if nested {
+				return fmt.Errorf("PaymentInfo: field %q has no subfields", name)
+			}
+			m.PaymentToken = src.PaymentToken
+		case PaymentInfo_OperationType_PATH:
+			if nested {
+				return fmt.Errorf("PaymentInfo: field %q has no subfields", name)
+			}
+			m.OperationType = src.OperationType
+		default:
+			return fmt.Errorf("PaymentInfo: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *PaymentInfo) Merge(src *PaymentInfo) {
+	if src == nil {
+		return
+	}
+	if src.PaymentMethod != "" {
+		m.PaymentMethod = src.PaymentMethod
+	}
+	if src.PaymentToken != "" {
+		m.PaymentToken = src.PaymentToken
+	}
+	if src.OperationType != 0 {
+		m.OperationType = src.OperationType
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *PaymentInfo) ApplyFieldMask(src *PaymentInfo, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *PaymentInfo) MergeFieldMask(src *PaymentInfo, mask *PuregenFieldMask) error {
+	masked := &PaymentInfo{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *PaymentInfo) Redacted() *PaymentInfo {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *PaymentInfo) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *PaymentInfo) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("paymentMethod", r.PaymentMethod),
+		slog.Any("paymentToken", r.PaymentToken),
+		slog.Any("operationType", r.OperationType),
+	)
 }
 
 func (m *PaymentInfo) Validate() error {
@@ -142,8 +353,166 @@ type Error struct {
 	Code string `json:"code"`
 }
 
-func NewError() *Error {
-	return &Error{}
+// ErrorOption sets a field of a new Error
+type ErrorOption func(*Error)
+
+// WithErrorMessage sets the Message field
+func WithErrorMessage(value string) ErrorOption {
+	return func(m *Error) {
+		m.Message = value
+	}
+}
+
+// WithErrorCode sets the Code field
+func WithErrorCode(value string) ErrorOption {
+	return func(m *Error) {
+		m.Code = value
+	}
+}
+
+func NewError(opts ...ErrorOption) *Error {
+	m := &Error{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetMessage returns the Message field, or its zero value if m is nil
+func (m *Error) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// GetCode returns the Code field, or its zero value if m is nil
+func (m *Error) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+// Clone returns a deep copy of m
+func (m *Error) Clone() *Error {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *Error) Equal(other *Error) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Message != other.Message {
+		return false
+	}
+	if m.Code != other.Code {
+		return false
+	}
+	return true
+}
+
+// Field paths of Error, for ApplyMask and field masks
+const (
+	Error_Message_PATH = "message"
+	Error_Code_PATH    = "code"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewError().
+func (m *Error) ApplyMask(src *Error, paths []string) error {
+	if src == nil {
+		src = NewError()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case Error_Message_PATH:
+			if nested {
+				return fmt.Errorf("Error: field %q has no subfields", name)
+			}
+			m.Message = src.Message
+		case Error_Code_PATH:
+			if nested {
+				return fmt.Errorf("Error: field %q has no subfields", name)
+			}
+			m.Code = src.Code
+		default:
+			return fmt.Errorf("Error: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *Error) Merge(src *Error) {
+	if src == nil {
+		return
+	}
+	if src.Message != "" {
+		m.Message = src.Message
+	}
+	if src.Code != "" {
+		m.Code = src.Code
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *Error) ApplyFieldMask(src *Error, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *Error) MergeFieldMask(src *Error, mask *PuregenFieldMask) error {
+	masked := &Error{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *Error) Redacted() *Error {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *Error) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *Error) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("message", r.Message),
+		slog.Any("code", r.Code),
+	)
 }
 
 func (m *Error) Validate() error {
@@ -171,498 +540,5494 @@ type BookingHeader struct {
 	RequestTimestamp int64 `json:"requestTimestamp"`
 }
 
-func NewBookingHeader() *BookingHeader {
-	return &BookingHeader{}
-}
+// BookingHeaderOption sets a field of a new BookingHeader
+type BookingHeaderOption func(*BookingHeader)
 
-func (m *BookingHeader) Validate() error {
-	// Add custom validation logic here
-	return nil
+// WithBookingHeaderUserId sets the UserId field
+func WithBookingHeaderUserId(value string) BookingHeaderOption {
+	return func(m *BookingHeader) {
+		m.UserId = value
+	}
 }
 
-func (m *BookingHeader) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
+// WithBookingHeaderApplicationName sets the ApplicationName field
+func WithBookingHeaderApplicationName(value string) BookingHeaderOption {
+	return func(m *BookingHeader) {
+		m.ApplicationName = value
+	}
 }
 
-func (m *BookingHeader) FromJSON(data []byte) error {
-	return json.Unmarshal(data, m)
+// WithBookingHeaderRequestId sets the RequestId field
+func WithBookingHeaderRequestId(value string) BookingHeaderOption {
+	return func(m *BookingHeader) {
+		m.RequestId = value
+	}
 }
 
-type BookingOperationRequest struct {
-	// Operation ID
-	OperationId string `json:"operationId"`
-	// Payment info used during original request
-	PaymentInfo *PaymentInfo `json:"paymentInfo"`
-	// Confirm the booking
-	Confirm bool `json:"confirm"`
+// WithBookingHeaderRequestTimestamp sets the RequestTimestamp field
+func WithBookingHeaderRequestTimestamp(value int64) BookingHeaderOption {
+	return func(m *BookingHeader) {
+		m.RequestTimestamp = value
+	}
 }
 
-func NewBookingOperationRequest() *BookingOperationRequest {
-	return &BookingOperationRequest{}
+func NewBookingHeader(opts ...BookingHeaderOption) *BookingHeader {
+	m := &BookingHeader{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-func (m *BookingOperationRequest) Validate() error {
-	// Add custom validation logic here
-	return nil
+// GetUserId returns the UserId field, or its zero value if m is nil
+func (m *BookingHeader) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
 }
 
-func (m *BookingOperationRequest) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
+// GetApplicationName returns the ApplicationName field, or its zero value if m is nil
+func (m *BookingHeader) GetApplicationName() string {
+	if m != nil {
+		return m.ApplicationName
+	}
+	return ""
 }
 
-func (m *BookingOperationRequest) FromJSON(data []byte) error {
-	return json.Unmarshal(data, m)
+// GetRequestId returns the RequestId field, or its zero value if m is nil
+func (m *BookingHeader) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
 }
 
-// Response for booking operations
-type BookingOperationResponse struct {
-	// Operation ID
-	OperationId string `json:"operationId"`
-	// Status of the booking
-	Status string `json:"status"`
-	// Error message
-	Error *Error `json:"error"`
+// GetRequestTimestamp returns the RequestTimestamp field, or its zero value if m is nil
+func (m *BookingHeader) GetRequestTimestamp() int64 {
+	if m != nil {
+		return m.RequestTimestamp
+	}
+	return 0
 }
 
-func NewBookingOperationResponse() *BookingOperationResponse {
-	return &BookingOperationResponse{}
+// Clone returns a deep copy of m
+func (m *BookingHeader) Clone() *BookingHeader {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
 }
 
-func (m *BookingOperationResponse) Validate() error {
-	// Add custom validation logic here
-	return nil
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *BookingHeader) Equal(other *BookingHeader) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.UserId != other.UserId {
+		return false
+	}
+	if m.ApplicationName != other.ApplicationName {
+		return false
+	}
+	if m.RequestId != other.RequestId {
+		return false
+	}
+	if m.RequestTimestamp != other.RequestTimestamp {
+		return false
+	}
+	return true
 }
 
-func (m *BookingOperationResponse) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
-}
+// Field paths of BookingHeader, for ApplyMask and field masks
+const (
+	BookingHeader_UserId_PATH           = "userId"
+	BookingHeader_ApplicationName_PATH  = "applicationName"
+	BookingHeader_RequestId_PATH        = "requestId"
+	BookingHeader_RequestTimestamp_PATH = "requestTimestamp"
+)
 
-func (m *BookingOperationResponse) FromJSON(data []byte) error {
-	return json.Unmarshal(data, m)
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewBookingHeader().
+func (m *BookingHeader) ApplyMask(src *BookingHeader, paths []string) error {
+	if src == nil {
+		src = NewBookingHeader()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case BookingHeader_UserId_PATH:
+			if nested {
+				return fmt.Errorf("BookingHeader: field %q has no subfields", name)
+			}
+			m.UserId = src.UserId
+		case BookingHeader_ApplicationName_PATH:
+			if nested {
+				return fmt.Errorf("BookingHeader: field %q has no subfields", name)
+			}
+			m.ApplicationName = src.ApplicationName
+		case BookingHeader_RequestId_PATH:
+			if nested {
+				return fmt.Errorf("BookingHeader: field %q has no subfields", name)
+			}
+			m.RequestId = src.RequestId
+		case BookingHeader_RequestTimestamp_PATH:
+			if nested {
+				return fmt.Errorf("BookingHeader: field %q has no subfields", name)
+			}
+			m.RequestTimestamp = src.RequestTimestamp
+		default:
+			return fmt.Errorf("BookingHeader: unknown field path %q", path)
+		}
+	}
+	return nil
 }
 
-type ListBookingsRequest struct {
-	// Payment info used during original request
-	PaymentInfo *PaymentInfo `json:"paymentInfo"`
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *BookingHeader) Merge(src *BookingHeader) {
+	if src == nil {
+		return
+	}
+	if src.UserId != "" {
+		m.UserId = src.UserId
+	}
+	if src.ApplicationName != "" {
+		m.ApplicationName = src.ApplicationName
+	}
+	if src.RequestId != "" {
+		m.RequestId = src.RequestId
+	}
+	if src.RequestTimestamp != 0 {
+		m.RequestTimestamp = src.RequestTimestamp
+	}
 }
 
-func NewListBookingsRequest() *ListBookingsRequest {
-	return &ListBookingsRequest{}
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *BookingHeader) ApplyFieldMask(src *BookingHeader, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
 }
 
-func (m *ListBookingsRequest) Validate() error {
-	// Add custom validation logic here
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *BookingHeader) MergeFieldMask(src *BookingHeader, mask *PuregenFieldMask) error {
+	masked := &BookingHeader{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
 	return nil
 }
 
-func (m *ListBookingsRequest) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
-}
-
-func (m *ListBookingsRequest) FromJSON(data []byte) error {
-	return json.Unmarshal(data, m)
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *BookingHeader) Redacted() *BookingHeader {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
 }
 
-// Response for list bookings
-type ListBookingsResponse struct {
-	// List of confirmed booking IDs
-	ConfirmedBookingIds []string `json:"confirmedBookingIds"`
-	// Pending booking IDs
-	PendingBookingIds []string `json:"pendingBookingIds"`
-	// Error message
-	Error *Error `json:"error"`
+// String renders m with sensitive fields redacted
+func (m *BookingHeader) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
 }
 
-func NewListBookingsResponse() *ListBookingsResponse {
-	return &ListBookingsResponse{}
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *BookingHeader) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("userId", r.UserId),
+		slog.Any("applicationName", r.ApplicationName),
+		slog.Any("requestId", r.RequestId),
+		slog.Any("requestTimestamp", r.RequestTimestamp),
+	)
 }
 
-func (m *ListBookingsResponse) Validate() error {
+func (m *BookingHeader) Validate() error {
 	// Add custom validation logic here
 	return nil
 }
 
-func (m *ListBookingsResponse) ToJSON() ([]byte, error) {
+func (m *BookingHeader) ToJSON() ([]byte, error) {
 	return json.Marshal(m)
 }
 
-func (m *ListBookingsResponse) FromJSON(data []byte) error {
+func (m *BookingHeader) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
-type BookingConfirmationRequest struct {
-	// Booking ID
-	BookingIds []string `json:"bookingIds"`
+type BookingOperationRequest struct {
+	// Operation ID
+	OperationId string `json:"operationId"`
 	// Payment info used during original request
 	PaymentInfo *PaymentInfo `json:"paymentInfo"`
+	// Confirm the booking
+	Confirm bool `json:"confirm"`
 }
 
-func NewBookingConfirmationRequest() *BookingConfirmationRequest {
-	return &BookingConfirmationRequest{}
-}
+// BookingOperationRequestOption sets a field of a new BookingOperationRequest
+type BookingOperationRequestOption func(*BookingOperationRequest)
 
-func (m *BookingConfirmationRequest) Validate() error {
-	// Add custom validation logic here
-	return nil
+// WithBookingOperationRequestOperationId sets the OperationId field
+func WithBookingOperationRequestOperationId(value string) BookingOperationRequestOption {
+	return func(m *BookingOperationRequest) {
+		m.OperationId = value
+	}
 }
 
-func (m *BookingConfirmationRequest) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
+// WithBookingOperationRequestPaymentInfo sets the PaymentInfo field
+func WithBookingOperationRequestPaymentInfo(value *PaymentInfo) BookingOperationRequestOption {
+	return func(m *BookingOperationRequest) {
+		m.PaymentInfo = value
+	}
 }
 
-func (m *BookingConfirmationRequest) FromJSON(data []byte) error {
-	return json.Unmarshal(data, m)
+// WithBookingOperationRequestConfirm sets the Confirm field
+func WithBookingOperationRequestConfirm(value bool) BookingOperationRequestOption {
+	return func(m *BookingOperationRequest) {
+		m.Confirm = value
+	}
 }
 
-type BookingStatsResponse struct {
-	// Total amount charged
-	TotalAmountCharged float64 `json:"totalAmountCharged"`
-	// Total number of guests
-	TotalGuests int32 `json:"totalGuests"`
-	// Total bookings
-	TotalBookings int32 `json:"totalBookings"`
+func NewBookingOperationRequest(opts ...BookingOperationRequestOption) *BookingOperationRequest {
+	m := &BookingOperationRequest{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-func NewBookingStatsResponse() *BookingStatsResponse {
-	return &BookingStatsResponse{}
+// GetOperationId returns the OperationId field, or its zero value if m is nil
+func (m *BookingOperationRequest) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
 }
 
-func (m *BookingStatsResponse) Validate() error {
-	// Add custom validation logic here
+// GetPaymentInfo returns the PaymentInfo field, or its zero value if m is nil
+func (m *BookingOperationRequest) GetPaymentInfo() *PaymentInfo {
+	if m != nil {
+		return m.PaymentInfo
+	}
 	return nil
 }
 
-func (m *BookingStatsResponse) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
+// GetConfirm returns the Confirm field, or its zero value if m is nil
+func (m *BookingOperationRequest) GetConfirm() bool {
+	if m != nil {
+		return m.Confirm
+	}
+	return false
 }
 
-func (m *BookingStatsResponse) FromJSON(data []byte) error {
-	return json.Unmarshal(data, m)
+// Clone returns a deep copy of m
+func (m *BookingOperationRequest) Clone() *BookingOperationRequest {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	c.PaymentInfo = m.PaymentInfo.Clone()
+	return &c
 }
 
-// Request for hotel reservation
-type HotelReservationRequest struct {
-	// Hotel search criteria
-	HotelLocations []string `json:"hotelLocations"`
-	// List of preferred room types
-	RoomTypes []string `json:"roomTypes"`
-	// Maximum price per night
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *BookingOperationRequest) Equal(other *BookingOperationRequest) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.OperationId != other.OperationId {
+		return false
+	}
+	if !m.PaymentInfo.Equal(other.PaymentInfo) {
+		return false
+	}
+	if m.Confirm != other.Confirm {
+		return false
+	}
+	return true
+}
+
+// Field paths of BookingOperationRequest, for ApplyMask and field masks
+const (
+	BookingOperationRequest_OperationId_PATH = "operationId"
+	BookingOperationRequest_PaymentInfo_PATH = "paymentInfo"
+	BookingOperationRequest_Confirm_PATH     = "confirm"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewBookingOperationRequest().
+func (m *BookingOperationRequest) ApplyMask(src *BookingOperationRequest, paths []string) error {
+	if src == nil {
+		src = NewBookingOperationRequest()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case BookingOperationRequest_OperationId_PATH:
+			if nested {
+				return fmt.Errorf("BookingOperationRequest: field %q has no subfields", name)
+			}
+			m.OperationId = src.OperationId
+		case BookingOperationRequest_PaymentInfo_PATH:
+			if !nested {
+				m.PaymentInfo = src.PaymentInfo.Clone()
+				continue
+			}
+			if m.PaymentInfo == nil {
+				m.PaymentInfo = &PaymentInfo{}
+			}
+			if err := m.PaymentInfo.ApplyMask(src.PaymentInfo, []string{rest}); err != nil {
+				return err
+			}
+		case BookingOperationRequest_Confirm_PATH:
+			if nested {
+				return fmt.Errorf("BookingOperationRequest: field %q has no subfields", name)
+			}
+			m.Confirm = src.Confirm
+		default:
+			return fmt.Errorf("BookingOperationRequest: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *BookingOperationRequest) Merge(src *BookingOperationRequest) {
+	if src == nil {
+		return
+	}
+	if src.OperationId != "" {
+		m.OperationId = src.OperationId
+	}
+	if src.PaymentInfo != nil {
+		if m.PaymentInfo == nil {
+			m.PaymentInfo = &PaymentInfo{}
+		}
+		m.PaymentInfo.Merge(src.PaymentInfo)
+	}
+	if src.Confirm {
+		m.Confirm = true
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *BookingOperationRequest) ApplyFieldMask(src *BookingOperationRequest, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *BookingOperationRequest) MergeFieldMask(src *BookingOperationRequest, mask *PuregenFieldMask) error {
+	masked := &BookingOperationRequest{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *BookingOperationRequest) Redacted() *BookingOperationRequest {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.PaymentInfo = r.PaymentInfo.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *BookingOperationRequest) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *BookingOperationRequest) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("operationId", r.OperationId),
+		slog.Any("paymentInfo", r.PaymentInfo),
+		slog.Any("confirm", r.Confirm),
+	)
+}
+
+func (m *BookingOperationRequest) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *BookingOperationRequest) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *BookingOperationRequest) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+// Response for booking operations
+type BookingOperationResponse struct {
+	// Operation ID
+	OperationId string `json:"operationId"`
+	// Status of the booking
+	Status string `json:"status"`
+	// Error message
+	Error *Error `json:"error"`
+}
+
+// BookingOperationResponseOption sets a field of a new BookingOperationResponse
+type BookingOperationResponseOption func(*BookingOperationResponse)
+
+// WithBookingOperationResponseOperationId sets the OperationId field
+func WithBookingOperationResponseOperationId(value string) BookingOperationResponseOption {
+	return func(m *BookingOperationResponse) {
+		m.OperationId = value
+	}
+}
+
+// WithBookingOperationResponseStatus sets the Status field
+func WithBookingOperationResponseStatus(value string) BookingOperationResponseOption {
+	return func(m *BookingOperationResponse) {
+		m.Status = value
+	}
+}
+
+// WithBookingOperationResponseError sets the Error field
+func WithBookingOperationResponseError(value *Error) BookingOperationResponseOption {
+	return func(m *BookingOperationResponse) {
+		m.Error = value
+	}
+}
+
+func NewBookingOperationResponse(opts ...BookingOperationResponseOption) *BookingOperationResponse {
+	m := &BookingOperationResponse{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetOperationId returns the OperationId field, or its zero value if m is nil
+func (m *BookingOperationResponse) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// GetStatus returns the Status field, or its zero value if m is nil
+func (m *BookingOperationResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+// GetError returns the Error field, or its zero value if m is nil
+func (m *BookingOperationResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *BookingOperationResponse) Clone() *BookingOperationResponse {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	c.Error = m.Error.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *BookingOperationResponse) Equal(other *BookingOperationResponse) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.OperationId != other.OperationId {
+		return false
+	}
+	if m.Status != other.Status {
+		return false
+	}
+	if !m.Error.Equal(other.Error) {
+		return false
+	}
+	return true
+}
+
+// Field paths of BookingOperationResponse, for ApplyMask and field masks
+const (
+	BookingOperationResponse_OperationId_PATH = "operationId"
+	BookingOperationResponse_Status_PATH      = "status"
+	BookingOperationResponse_Error_PATH       = "error"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewBookingOperationResponse().
+func (m *BookingOperationResponse) ApplyMask(src *BookingOperationResponse, paths []string) error {
+	if src == nil {
+		src = NewBookingOperationResponse()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case BookingOperationResponse_OperationId_PATH:
+			if nested {
+				return fmt.Errorf("BookingOperationResponse: field %q has no subfields", name)
+			}
+			m.OperationId = src.OperationId
+		case BookingOperationResponse_Status_PATH:
+			if nested {
+				return fmt.Errorf("BookingOperationResponse: field %q has no subfields", name)
+			}
+			m.Status = src.Status
+		case BookingOperationResponse_Error_PATH:
+			if !nested {
+				m.Error = src.Error.Clone()
+				continue
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.ApplyMask(src.Error, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("BookingOperationResponse: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *BookingOperationResponse) Merge(src *BookingOperationResponse) {
+	if src == nil {
+		return
+	}
+	if src.OperationId != "" {
+		m.OperationId = src.OperationId
+	}
+	if src.Status != "" {
+		m.Status = src.Status
+	}
+	if src.Error != nil {
+		if m.Error == nil {
+			m.Error = &Error{}
+		}
+		m.Error.Merge(src.Error)
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *BookingOperationResponse) ApplyFieldMask(src *BookingOperationResponse, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *BookingOperationResponse) MergeFieldMask(src *BookingOperationResponse, mask *PuregenFieldMask) error {
+	masked := &BookingOperationResponse{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *BookingOperationResponse) Redacted() *BookingOperationResponse {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.Error = r.Error.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *BookingOperationResponse) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *BookingOperationResponse) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("operationId", r.OperationId),
+		slog.Any("status", r.Status),
+		slog.Any("error", r.Error),
+	)
+}
+
+func (m *BookingOperationResponse) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *BookingOperationResponse) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *BookingOperationResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+type ListBookingsRequest struct {
+	// Payment info used during original request
+	PaymentInfo *PaymentInfo `json:"paymentInfo"`
+}
+
+// ListBookingsRequestOption sets a field of a new ListBookingsRequest
+type ListBookingsRequestOption func(*ListBookingsRequest)
+
+// WithListBookingsRequestPaymentInfo sets the PaymentInfo field
+func WithListBookingsRequestPaymentInfo(value *PaymentInfo) ListBookingsRequestOption {
+	return func(m *ListBookingsRequest) {
+		m.PaymentInfo = value
+	}
+}
+
+func NewListBookingsRequest(opts ...ListBookingsRequestOption) *ListBookingsRequest {
+	m := &ListBookingsRequest{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetPaymentInfo returns the PaymentInfo field, or its zero value if m is nil
+func (m *ListBookingsRequest) GetPaymentInfo() *PaymentInfo {
+	if m != nil {
+		return m.PaymentInfo
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *ListBookingsRequest) Clone() *ListBookingsRequest {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	c.PaymentInfo = m.PaymentInfo.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *ListBookingsRequest) Equal(other *ListBookingsRequest) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.PaymentInfo.Equal(other.PaymentInfo) {
+		return false
+	}
+	return true
+}
+
+// Field paths of ListBookingsRequest, for ApplyMask and field masks
+const (
+	ListBookingsRequest_PaymentInfo_PATH = "paymentInfo"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewListBookingsRequest().
+func (m *ListBookingsRequest) ApplyMask(src *ListBookingsRequest, paths []string) error {
+	if src == nil {
+		src = NewListBookingsRequest()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case ListBookingsRequest_PaymentInfo_PATH:
+			if !nested {
+				m.PaymentInfo = src.PaymentInfo.Clone()
+				continue
+			}
+			if m.PaymentInfo == nil {
+				m.PaymentInfo = &PaymentInfo{}
+			}
+			if err := m.PaymentInfo.ApplyMask(src.PaymentInfo, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("ListBookingsRequest: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *ListBookingsRequest) Merge(src *ListBookingsRequest) {
+	if src == nil {
+		return
+	}
+	if src.PaymentInfo != nil {
+		if m.PaymentInfo == nil {
+			m.PaymentInfo = &PaymentInfo{}
+		}
+		m.PaymentInfo.Merge(src.PaymentInfo)
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *ListBookingsRequest) ApplyFieldMask(src *ListBookingsRequest, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *ListBookingsRequest) MergeFieldMask(src *ListBookingsRequest, mask *PuregenFieldMask) error {
+	masked := &ListBookingsRequest{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *ListBookingsRequest) Redacted() *ListBookingsRequest {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.PaymentInfo = r.PaymentInfo.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *ListBookingsRequest) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *ListBookingsRequest) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("paymentInfo", r.PaymentInfo),
+	)
+}
+
+func (m *ListBookingsRequest) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *ListBookingsRequest) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *ListBookingsRequest) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+// Response for list bookings
+type ListBookingsResponse struct {
+	// List of confirmed booking IDs
+	ConfirmedBookingIds []string `json:"confirmedBookingIds"`
+	// Pending booking IDs
+	PendingBookingIds []string `json:"pendingBookingIds"`
+	// Error message
+	Error *Error `json:"error"`
+}
+
+// ListBookingsResponseOption sets a field of a new ListBookingsResponse
+type ListBookingsResponseOption func(*ListBookingsResponse)
+
+// WithListBookingsResponseConfirmedBookingIds sets the ConfirmedBookingIds field
+func WithListBookingsResponseConfirmedBookingIds(value []string) ListBookingsResponseOption {
+	return func(m *ListBookingsResponse) {
+		m.ConfirmedBookingIds = value
+	}
+}
+
+// WithListBookingsResponsePendingBookingIds sets the PendingBookingIds field
+func WithListBookingsResponsePendingBookingIds(value []string) ListBookingsResponseOption {
+	return func(m *ListBookingsResponse) {
+		m.PendingBookingIds = value
+	}
+}
+
+// WithListBookingsResponseError sets the Error field
+func WithListBookingsResponseError(value *Error) ListBookingsResponseOption {
+	return func(m *ListBookingsResponse) {
+		m.Error = value
+	}
+}
+
+func NewListBookingsResponse(opts ...ListBookingsResponseOption) *ListBookingsResponse {
+	m := &ListBookingsResponse{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetConfirmedBookingIds returns the ConfirmedBookingIds field, or its zero value if m is nil
+func (m *ListBookingsResponse) GetConfirmedBookingIds() []string {
+	if m != nil {
+		return m.ConfirmedBookingIds
+	}
+	return nil
+}
+
+// GetPendingBookingIds returns the PendingBookingIds field, or its zero value if m is nil
+func (m *ListBookingsResponse) GetPendingBookingIds() []string {
+	if m != nil {
+		return m.PendingBookingIds
+	}
+	return nil
+}
+
+// GetError returns the Error field, or its zero value if m is nil
+func (m *ListBookingsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *ListBookingsResponse) Clone() *ListBookingsResponse {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	if m.ConfirmedBookingIds != nil {
+		c.ConfirmedBookingIds = make([]string, len(m.ConfirmedBookingIds))
+		copy(c.ConfirmedBookingIds, m.ConfirmedBookingIds)
+	}
+	if m.PendingBookingIds != nil {
+		c.PendingBookingIds = make([]string, len(m.PendingBookingIds))
+		copy(c.PendingBookingIds, m.PendingBookingIds)
+	}
+	c.Error = m.Error.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *ListBookingsResponse) Equal(other *ListBookingsResponse) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.ConfirmedBookingIds) != len(other.ConfirmedBookingIds) {
+		return false
+	}
+	for i := range m.ConfirmedBookingIds {
+		if m.ConfirmedBookingIds[i] != other.ConfirmedBookingIds[i] {
+			return false
+		}
+	}
+	if len(m.PendingBookingIds) != len(other.PendingBookingIds) {
+		return false
+	}
+	for i := range m.PendingBookingIds {
+		if m.PendingBookingIds[i] != other.PendingBookingIds[i] {
+			return false
+		}
+	}
+	if !m.Error.Equal(other.Error) {
+		return false
+	}
+	return true
+}
+
+// Field paths of ListBookingsResponse, for ApplyMask and field masks
+const (
+	ListBookingsResponse_ConfirmedBookingIds_PATH = "confirmedBookingIds"
+	ListBookingsResponse_PendingBookingIds_PATH   = "pendingBookingIds"
+	ListBookingsResponse_Error_PATH               = "error"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewListBookingsResponse().
+func (m *ListBookingsResponse) ApplyMask(src *ListBookingsResponse, paths []string) error {
+	if src == nil {
+		src = NewListBookingsResponse()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case ListBookingsResponse_ConfirmedBookingIds_PATH:
+			if nested {
+				return fmt.Errorf("ListBookingsResponse: field %q has no subfields", name)
+			}
+			m.ConfirmedBookingIds = append([]string(nil), src.ConfirmedBookingIds...)
+		case ListBookingsResponse_PendingBookingIds_PATH:
+			if nested {
+				return fmt.Errorf("ListBookingsResponse: field %q has no subfields", name)
+			}
+			m.PendingBookingIds = append([]string(nil), src.PendingBookingIds...)
+		case ListBookingsResponse_Error_PATH:
+			if !nested {
+				m.Error = src.Error.Clone()
+				continue
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.ApplyMask(src.Error, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("ListBookingsResponse: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *ListBookingsResponse) Merge(src *ListBookingsResponse) {
+	if src == nil {
+		return
+	}
+	m.ConfirmedBookingIds = append(m.ConfirmedBookingIds, src.ConfirmedBookingIds...)
+	m.PendingBookingIds = append(m.PendingBookingIds, src.PendingBookingIds...)
+	if src.Error != nil {
+		if m.Error == nil {
+			m.Error = &Error{}
+		}
+		m.Error.Merge(src.Error)
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *ListBookingsResponse) ApplyFieldMask(src *ListBookingsResponse, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *ListBookingsResponse) MergeFieldMask(src *ListBookingsResponse, mask *PuregenFieldMask) error {
+	masked := &ListBookingsResponse{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *ListBookingsResponse) Redacted() *ListBookingsResponse {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.Error = r.Error.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *ListBookingsResponse) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *ListBookingsResponse) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("confirmedBookingIds", r.ConfirmedBookingIds),
+		slog.Any("pendingBookingIds", r.PendingBookingIds),
+		slog.Any("error", r.Error),
+	)
+}
+
+func (m *ListBookingsResponse) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *ListBookingsResponse) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *ListBookingsResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+type BookingConfirmationRequest struct {
+	// Booking ID
+	BookingIds []string `json:"bookingIds"`
+	// Payment info used during original request
+	PaymentInfo *PaymentInfo `json:"paymentInfo"`
+}
+
+// BookingConfirmationRequestOption sets a field of a new BookingConfirmationRequest
+type BookingConfirmationRequestOption func(*BookingConfirmationRequest)
+
+// WithBookingConfirmationRequestBookingIds sets the BookingIds field
+func WithBookingConfirmationRequestBookingIds(value []string) BookingConfirmationRequestOption {
+	return func(m *BookingConfirmationRequest) {
+		m.BookingIds = value
+	}
+}
+
+// WithBookingConfirmationRequestPaymentInfo sets the PaymentInfo field
+func WithBookingConfirmationRequestPaymentInfo(value *PaymentInfo) BookingConfirmationRequestOption {
+	return func(m *BookingConfirmationRequest) {
+		m.PaymentInfo = value
+	}
+}
+
+func NewBookingConfirmationRequest(opts ...BookingConfirmationRequestOption) *BookingConfirmationRequest {
+	m := &BookingConfirmationRequest{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetBookingIds returns the BookingIds field, or its zero value if m is nil
+func (m *BookingConfirmationRequest) GetBookingIds() []string {
+	if m != nil {
+		return m.BookingIds
+	}
+	return nil
+}
+
+// GetPaymentInfo returns the PaymentInfo field, or its zero value if m is nil
+func (m *BookingConfirmationRequest) GetPaymentInfo() *PaymentInfo {
+	if m != nil {
+		return m.PaymentInfo
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *BookingConfirmationRequest) Clone() *BookingConfirmationRequest {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	if m.BookingIds != nil {
+		c.BookingIds = make([]string, len(m.BookingIds))
+		copy(c.BookingIds, m.BookingIds)
+	}
+	c.PaymentInfo = m.PaymentInfo.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *BookingConfirmationRequest) Equal(other *BookingConfirmationRequest) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.BookingIds) != len(other.BookingIds) {
+		return false
+	}
+	for i := range m.BookingIds {
+		if m.BookingIds[i] != other.BookingIds[i] {
+			return false
+		}
+	}
+	if !m.PaymentInfo.Equal(other.PaymentInfo) {
+		return false
+	}
+	return true
+}
+
+// Field paths of BookingConfirmationRequest, for ApplyMask and field masks
+const (
+	BookingConfirmationRequest_BookingIds_PATH  = "bookingIds"
+	BookingConfirmationRequest_PaymentInfo_PATH = "paymentInfo"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewBookingConfirmationRequest().
+func (m *BookingConfirmationRequest) ApplyMask(src *BookingConfirmationRequest, paths []string) error {
+	if src == nil {
+		src = NewBookingConfirmationRequest()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case BookingConfirmationRequest_BookingIds_PATH:
+			if nested {
+				return fmt.Errorf("BookingConfirmationRequest: field %q has no subfields", name)
+			}
+			m.BookingIds = append([]string(nil), src.BookingIds...)
+		case BookingConfirmationRequest_PaymentInfo_PATH:
+			if !nested {
+				m.PaymentInfo = src.PaymentInfo.Clone()
+				continue
+			}
+			if m.PaymentInfo == nil {
+				m.PaymentInfo = &PaymentInfo{}
+			}
+			if err := m.PaymentInfo.ApplyMask(src.PaymentInfo, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("BookingConfirmationRequest: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *BookingConfirmationRequest) Merge(src *BookingConfirmationRequest) {
+	if src == nil {
+		return
+	}
+	m.BookingIds = append(m.BookingIds, src.BookingIds...)
+	if src.PaymentInfo != nil {
+		if m.PaymentInfo == nil {
+			m.PaymentInfo = &PaymentInfo{}
+		}
+		m.PaymentInfo.Merge(src.PaymentInfo)
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *BookingConfirmationRequest) ApplyFieldMask(src *BookingConfirmationRequest, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *BookingConfirmationRequest) MergeFieldMask(src *BookingConfirmationRequest, mask *PuregenFieldMask) error {
+	masked := &BookingConfirmationRequest{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *BookingConfirmationRequest) Redacted() *BookingConfirmationRequest {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.PaymentInfo = r.PaymentInfo.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *BookingConfirmationRequest) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *BookingConfirmationRequest) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("bookingIds", r.BookingIds),
+		slog.Any("paymentInfo", r.PaymentInfo),
+	)
+}
+
+func (m *BookingConfirmationRequest) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *BookingConfirmationRequest) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *BookingConfirmationRequest) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+type BookingStatsResponse struct {
+	// Total amount charged
+	TotalAmountCharged float64 `json:"totalAmountCharged"`
+	// Total number of guests
+	TotalGuests int32 `json:"totalGuests"`
+	// Total bookings
+	TotalBookings int32 `json:"totalBookings"`
+}
+
+// BookingStatsResponseOption sets a field of a new BookingStatsResponse
+type BookingStatsResponseOption func(*BookingStatsResponse)
+
+// WithBookingStatsResponseTotalAmountCharged sets the TotalAmountCharged field
+func WithBookingStatsResponseTotalAmountCharged(value float64) BookingStatsResponseOption {
+	return func(m *BookingStatsResponse) {
+		m.TotalAmountCharged = value
+	}
+}
+
+// WithBookingStatsResponseTotalGuests sets the TotalGuests field
+func WithBookingStatsResponseTotalGuests(value int32) BookingStatsResponseOption {
+	return func(m *BookingStatsResponse) {
+		m.TotalGuests = value
+	}
+}
+
+// WithBookingStatsResponseTotalBookings sets the TotalBookings field
+func WithBookingStatsResponseTotalBookings(value int32) BookingStatsResponseOption {
+	return func(m *BookingStatsResponse) {
+		m.TotalBookings = value
+	}
+}
+
+func NewBookingStatsResponse(opts ...BookingStatsResponseOption) *BookingStatsResponse {
+	m := &BookingStatsResponse{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetTotalAmountCharged returns the TotalAmountCharged field, or its zero value if m is nil
+func (m *BookingStatsResponse) GetTotalAmountCharged() float64 {
+	if m != nil {
+		return m.TotalAmountCharged
+	}
+	return 0
+}
+
+// GetTotalGuests returns the TotalGuests field, or its zero value if m is nil
+func (m *BookingStatsResponse) GetTotalGuests() int32 {
+	if m != nil {
+		return m.TotalGuests
+	}
+	return 0
+}
+
+// GetTotalBookings returns the TotalBookings field, or its zero value if m is nil
+func (m *BookingStatsResponse) GetTotalBookings() int32 {
+	if m != nil {
+		return m.TotalBookings
+	}
+	return 0
+}
+
+// Clone returns a deep copy of m
+func (m *BookingStatsResponse) Clone() *BookingStatsResponse {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *BookingStatsResponse) Equal(other *BookingStatsResponse) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.TotalAmountCharged != other.TotalAmountCharged {
+		return false
+	}
+	if m.TotalGuests != other.TotalGuests {
+		return false
+	}
+	if m.TotalBookings != other.TotalBookings {
+		return false
+	}
+	return true
+}
+
+// Field paths of BookingStatsResponse, for ApplyMask and field masks
+const (
+	BookingStatsResponse_TotalAmountCharged_PATH = "totalAmountCharged"
+	BookingStatsResponse_TotalGuests_PATH        = "totalGuests"
+	BookingStatsResponse_TotalBookings_PATH      = "totalBookings"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewBookingStatsResponse().
+func (m *BookingStatsResponse) ApplyMask(src *BookingStatsResponse, paths []string) error {
+	if src == nil {
+		src = NewBookingStatsResponse()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case BookingStatsResponse_TotalAmountCharged_PATH:
+			if nested {
+				return fmt.Errorf("BookingStatsResponse: field %q has no subfields", name)
+			}
+			m.TotalAmountCharged = src.TotalAmountCharged
+		case BookingStatsResponse_TotalGuests_PATH:
+			if nested {
+				return fmt.Errorf("BookingStatsResponse: field %q has no subfields", name)
+			}
+			m.TotalGuests = src.TotalGuests
+		case BookingStatsResponse_TotalBookings_PATH:
+			if nested {
+				return fmt.Errorf("BookingStatsResponse: field %q has no subfields", name)
+			}
+			m.TotalBookings = src.TotalBookings
+		default:
+			return fmt.Errorf("BookingStatsResponse: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *BookingStatsResponse) Merge(src *BookingStatsResponse) {
+	if src == nil {
+		return
+	}
+	if src.TotalAmountCharged != 0 {
+		m.TotalAmountCharged = src.TotalAmountCharged
+	}
+	if src.TotalGuests != 0 {
+		m.TotalGuests = src.TotalGuests
+	}
+	if src.TotalBookings != 0 {
+		m.TotalBookings = src.TotalBookings
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *BookingStatsResponse) ApplyFieldMask(src *BookingStatsResponse, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *BookingStatsResponse) MergeFieldMask(src *BookingStatsResponse, mask *PuregenFieldMask) error {
+	masked := &BookingStatsResponse{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *BookingStatsResponse) Redacted() *BookingStatsResponse {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *BookingStatsResponse) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *BookingStatsResponse) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("totalAmountCharged", r.TotalAmountCharged),
+		slog.Any("totalGuests", r.TotalGuests),
+		slog.Any("totalBookings", r.TotalBookings),
+	)
+}
+
+func (m *BookingStatsResponse) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *BookingStatsResponse) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *BookingStatsResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+// Request for hotel reservation
+type HotelReservationRequest struct {
+	// Hotel search criteria
+	HotelLocations []string `json:"hotelLocations"`
+	// List of preferred room types
+	RoomTypes []string `json:"roomTypes"`
+	// Maximum price per night
 	MaxPricePerNight float64 `json:"maxPricePerNight"`
 	// Required payment information
 	PaymentInfo *PaymentInfo `json:"paymentInfo"`
-	// Check-in and check-out dates (Unix timestamp)
-	CheckInDate  int64 `json:"checkInDate"`
-	CheckOutDate int64 `json:"checkOutDate"`
-	// Number of guests
-	NumberOfGuests int32 `json:"numberOfGuests"`
+	// Check-in and check-out dates (Unix timestamp)
+	CheckInDate  int64 `json:"checkInDate"`
+	CheckOutDate int64 `json:"checkOutDate"`
+	// Number of guests
+	NumberOfGuests int32 `json:"numberOfGuests"`
+}
+
+// HotelReservationRequestOption sets a field of a new HotelReservationRequest
+type HotelReservationRequestOption func(*HotelReservationRequest)
+
+// WithHotelReservationRequestHotelLocations sets the HotelLocations field
+func WithHotelReservationRequestHotelLocations(value []string) HotelReservationRequestOption {
+	return func(m *HotelReservationRequest) {
+		m.HotelLocations = value
+	}
+}
+
+// WithHotelReservationRequestRoomTypes sets the RoomTypes field
+func WithHotelReservationRequestRoomTypes(value []string) HotelReservationRequestOption {
+	return func(m *HotelReservationRequest) {
+		m.RoomTypes = value
+	}
+}
+
+// WithHotelReservationRequestMaxPricePerNight sets the MaxPricePerNight field
+func WithHotelReservationRequestMaxPricePerNight(value float64) HotelReservationRequestOption {
+	return func(m *HotelReservationRequest) {
+		m.MaxPricePerNight = value
+	}
+}
+
+// WithHotelReservationRequestPaymentInfo sets the PaymentInfo field
+func WithHotelReservationRequestPaymentInfo(value *PaymentInfo) HotelReservationRequestOption {
+	return func(m *HotelReservationRequest) {
+		m.PaymentInfo = value
+	}
+}
+
+// WithHotelReservationRequestCheckInDate sets the CheckInDate field
+func WithHotelReservationRequestCheckInDate(value int64) HotelReservationRequestOption {
+	return func(m *HotelReservationRequest) {
+		m.CheckInDate = value
+	}
+}
+
+// WithHotelReservationRequestCheckOutDate sets the CheckOutDate field
+func WithHotelReservationRequestCheckOutDate(value int64) HotelReservationRequestOption {
+	return func(m *HotelReservationRequest) {
+		m.CheckOutDate = value
+	}
+}
+
+// WithHotelReservationRequestNumberOfGuests sets the NumberOfGuests field
+func WithHotelReservationRequestNumberOfGuests(value int32) HotelReservationRequestOption {
+	return func(m *HotelReservationRequest) {
+		m.NumberOfGuests = value
+	}
+}
+
+func NewHotelReservationRequest(opts ...HotelReservationRequestOption) *HotelReservationRequest {
+	m := &HotelReservationRequest{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetHotelLocations returns the HotelLocations field, or its zero value if m is nil
+func (m *HotelReservationRequest) GetHotelLocations() []string {
+	if m != nil {
+		return m.HotelLocations
+	}
+	return nil
+}
+
+// GetRoomTypes returns the RoomTypes field, or its zero value if m is nil
+func (m *HotelReservationRequest) GetRoomTypes() []string {
+	if m != nil {
+		return m.RoomTypes
+	}
+	return nil
+}
+
+// GetMaxPricePerNight returns the MaxPricePerNight field, or its zero value if m is nil
+func (m *HotelReservationRequest) GetMaxPricePerNight() float64 {
+	if m != nil {
+		return m.MaxPricePerNight
+	}
+	return 0
+}
+
+// GetPaymentInfo returns the PaymentInfo field, or its zero value if m is nil
+func (m *HotelReservationRequest) GetPaymentInfo() *PaymentInfo {
+	if m != nil {
+		return m.PaymentInfo
+	}
+	return nil
+}
+
+// GetCheckInDate returns the CheckInDate field, or its zero value if m is nil
+func (m *HotelReservationRequest) GetCheckInDate() int64 {
+	if m != nil {
+		return m.CheckInDate
+	}
+	return 0
+}
+
+// GetCheckOutDate returns the CheckOutDate field, or its zero value if m is nil
+func (m *HotelReservationRequest) GetCheckOutDate() int64 {
+	if m != nil {
+		return m.CheckOutDate
+	}
+	return 0
+}
+
+// GetNumberOfGuests returns the NumberOfGuests field, or its zero value if m is nil
+func (m *HotelReservationRequest) GetNumberOfGuests() int32 {
+	if m != nil {
+		return m.NumberOfGuests
+	}
+	return 0
+}
+
+// Clone returns a deep copy of m
+func (m *HotelReservationRequest) Clone() *HotelReservationRequest {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	if m.HotelLocations != nil {
+		c.HotelLocations = make([]string, len(m.HotelLocations))
+		copy(c.HotelLocations, m.HotelLocations)
+	}
+	if m.RoomTypes != nil {
+		c.RoomTypes = make([]string, len(m.RoomTypes))
+		copy(c.RoomTypes, m.RoomTypes)
+	}
+	c.PaymentInfo = m.PaymentInfo.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *HotelReservationRequest) Equal(other *HotelReservationRequest) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.HotelLocations) != len(other.HotelLocations) {
+		return false
+	}
+	for i := range m.HotelLocations {
+		if m.HotelLocations[i] != other.HotelLocations[i] {
+			return false
+		}
+	}
+	if len(m.RoomTypes) != len(other.RoomTypes) {
+		return false
+	}
+	for i := range m.RoomTypes {
+		if m.RoomTypes[i] != other.RoomTypes[i] {
+			return false
+		}
+	}
+	if m.MaxPricePerNight != other.MaxPricePerNight {
+		return false
+	}
+	if !m.PaymentInfo.Equal(other.PaymentInfo) {
+		return false
+	}
+	if m.CheckInDate != other.CheckInDate {
+		return false
+	}
+	if m.CheckOutDate != other.CheckOutDate {
+		return false
+	}
+	if m.NumberOfGuests != other.NumberOfGuests {
+		return false
+	}
+	return true
+}
+
+// Field paths of HotelReservationRequest, for ApplyMask and field masks
+const (
+	HotelReservationRequest_HotelLocations_PATH   = "hotelLocations"
+	HotelReservationRequest_RoomTypes_PATH        = "roomTypes"
+	HotelReservationRequest_MaxPricePerNight_PATH = "maxPricePerNight"
+	HotelReservationRequest_PaymentInfo_PATH      = "paymentInfo"
+	HotelReservationRequest_CheckInDate_PATH      = "checkInDate"
+	HotelReservationRequest_CheckOutDate_PATH     = "checkOutDate"
+	HotelReservationRequest_NumberOfGuests_PATH   = "numberOfGuests"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewHotelReservationRequest().
+func (m *HotelReservationRequest) ApplyMask(src *HotelReservationRequest, paths []string) error {
+	if src == nil {
+		src = NewHotelReservationRequest()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case HotelReservationRequest_HotelLocations_PATH:
+			if nested {
+				return fmt.Errorf("HotelReservationRequest: field %q has no subfields", name)
+			}
+			m.HotelLocations = append([]string(nil), src.HotelLocations...)
+		case HotelReservationRequest_RoomTypes_PATH:
+			if nested {
+				return fmt.Errorf("HotelReservationRequest: field %q has no subfields", name)
+			}
+			m.RoomTypes = append([]string(nil), src.RoomTypes...)
+		case HotelReservationRequest_MaxPricePerNight_PATH:
+			if nested {
+				return fmt.Errorf("HotelReservationRequest: field %q has no subfields", name)
+			}
+			m.MaxPricePerNight = src.MaxPricePerNight
+		case HotelReservationRequest_PaymentInfo_PATH:
+			if !nested {
+				m.PaymentInfo = src.PaymentInfo.Clone()
+				continue
+			}
+			if m.PaymentInfo == nil {
+				m.PaymentInfo = &PaymentInfo{}
+			}
+			if err := m.PaymentInfo.ApplyMask(src.PaymentInfo, []string{rest}); err != nil {
+				return err
+			}
+		case HotelReservationRequest_CheckInDate_PATH:
+			if nested {
+				return fmt.Errorf("HotelReservationRequest: field %q has no subfields", name)
+			}
+			m.CheckInDate = src.CheckInDate
+		case HotelReservationRequest_CheckOutDate_PATH:
+			if nested {
+				return fmt.Errorf("HotelReservationRequest: field %q has no subfields", name)
+			}
+			m.CheckOutDate = src.CheckOutDate
+		case HotelReservationRequest_NumberOfGuests_PATH:
+			if nested {
+				return fmt.Errorf("HotelReservationRequest: field %q has no subfields", name)
+			}
+			m.NumberOfGuests = src.NumberOfGuests
+		default:
+			return fmt.Errorf("HotelReservationRequest: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *HotelReservationRequest) Merge(src *HotelReservationRequest) {
+	if src == nil {
+		return
+	}
+	m.HotelLocations = append(m.HotelLocations, src.HotelLocations...)
+	m.RoomTypes = append(m.RoomTypes, src.RoomTypes...)
+	if src.MaxPricePerNight != 0 {
+		m.MaxPricePerNight = src.MaxPricePerNight
+	}
+	if src.PaymentInfo != nil {
+		if m.PaymentInfo == nil {
+			m.PaymentInfo = &PaymentInfo{}
+		}
+		m.PaymentInfo.Merge(src.PaymentInfo)
+	}
+	if src.CheckInDate != 0 {
+		m.CheckInDate = src.CheckInDate
+	}
+	if src.CheckOutDate != 0 {
+		m.CheckOutDate = src.CheckOutDate
+	}
+	if src.NumberOfGuests != 0 {
+		m.NumberOfGuests = src.NumberOfGuests
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *HotelReservationRequest) ApplyFieldMask(src *HotelReservationRequest, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *HotelReservationRequest) MergeFieldMask(src *HotelReservationRequest, mask *PuregenFieldMask) error {
+	masked := &HotelReservationRequest{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *HotelReservationRequest) Redacted() *HotelReservationRequest {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.PaymentInfo = r.PaymentInfo.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *HotelReservationRequest) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *HotelReservationRequest) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("hotelLocations", r.HotelLocations),
+		slog.Any("roomTypes", r.RoomTypes),
+		slog.Any("maxPricePerNight", r.MaxPricePerNight),
+		slog.Any("paymentInfo", r.PaymentInfo),
+		slog.Any("checkInDate", r.CheckInDate),
+		slog.Any("checkOutDate", r.CheckOutDate),
+		slog.Any("numberOfGuests", r.NumberOfGuests),
+	)
+}
+
+func (m *HotelReservationRequest) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *HotelReservationRequest) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *HotelReservationRequest) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+// Response for hotel reservation
+type HotelReservationResponse struct {
+	// List of results for each search location
+	Result []*HotelReservationResponse_SingleHotelReservationResponse `json:"result"`
+	// Status of the request
+	Status string `json:"status"`
+	// Error message
+	Error *Error `json:"error"`
+	// Booking stats
+	BookingStats *BookingStatsResponse `json:"bookingStats"`
+}
+
+// HotelReservationResponseOption sets a field of a new HotelReservationResponse
+type HotelReservationResponseOption func(*HotelReservationResponse)
+
+// WithHotelReservationResponseResult sets the Result field
+func WithHotelReservationResponseResult(value []*HotelReservationResponse_SingleHotelReservationResponse) HotelReservationResponseOption {
+	return func(m *HotelReservationResponse) {
+		m.Result = value
+	}
+}
+
+// WithHotelReservationResponseStatus sets the Status field
+func WithHotelReservationResponseStatus(value string) HotelReservationResponseOption {
+	return func(m *HotelReservationResponse) {
+		m.Status = value
+	}
+}
+
+// WithHotelReservationResponseError sets the Error field
+func WithHotelReservationResponseError(value *Error) HotelReservationResponseOption {
+	return func(m *HotelReservationResponse) {
+		m.Error = value
+	}
+}
+
+// WithHotelReservationResponseBookingStats sets the BookingStats field
+func WithHotelReservationResponseBookingStats(value *BookingStatsResponse) HotelReservationResponseOption {
+	return func(m *HotelReservationResponse) {
+		m.BookingStats = value
+	}
+}
+
+func NewHotelReservationResponse(opts ...HotelReservationResponseOption) *HotelReservationResponse {
+	m := &HotelReservationResponse{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetResult returns the Result field, or its zero value if m is nil
+func (m *HotelReservationResponse) GetResult() []*HotelReservationResponse_SingleHotelReservationResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+// GetStatus returns the Status field, or its zero value if m is nil
+func (m *HotelReservationResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+// GetError returns the Error field, or its zero value if m is nil
+func (m *HotelReservationResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+// GetBookingStats returns the BookingStats field, or its zero value if m is nil
+func (m *HotelReservationResponse) GetBookingStats() *BookingStatsResponse {
+	if m != nil {
+		return m.BookingStats
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *HotelReservationResponse) Clone() *HotelReservationResponse {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	if m.Result != nil {
+		c.Result = make([]*HotelReservationResponse_SingleHotelReservationResponse, len(m.Result))
+		for i, v := range m.Result {
+			c.Result[i] = v.Clone()
+		}
+	}
+	c.Error = m.Error.Clone()
+	c.BookingStats = m.BookingStats.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *HotelReservationResponse) Equal(other *HotelReservationResponse) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Result) != len(other.Result) {
+		return false
+	}
+	for i := range m.Result {
+		if !m.Result[i].Equal(other.Result[i]) {
+			return false
+		}
+	}
+	if m.Status != other.Status {
+		return false
+	}
+	if !m.Error.Equal(other.Error) {
+		return false
+	}
+	if !m.BookingStats.Equal(other.BookingStats) {
+		return false
+	}
+	return true
+}
+
+// Field paths of HotelReservationResponse, for ApplyMask and field masks
+const (
+	HotelReservationResponse_Result_PATH       = "result"
+	HotelReservationResponse_Status_PATH       = "status"
+	HotelReservationResponse_Error_PATH        = "error"
+	HotelReservationResponse_BookingStats_PATH = "bookingStats"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewHotelReservationResponse().
+func (m *HotelReservationResponse) ApplyMask(src *HotelReservationResponse, paths []string) error {
+	if src == nil {
+		src = NewHotelReservationResponse()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case HotelReservationResponse_Result_PATH:
+			if nested {
+				return fmt.Errorf("HotelReservationResponse: field %q has no subfields", name)
+			}
+			m.Result = make([]*HotelReservationResponse_SingleHotelReservationResponse, len(src.Result))
+			for i, v := range src.Result {
+				m.Result[i] = v.Clone()
+			}
+		case HotelReservationResponse_Status_PATH:
+			if nested {
+				return fmt.Errorf("HotelReservationResponse: field %q has no subfields", name)
+			}
+			m.Status = src.Status
+		case HotelReservationResponse_Error_PATH:
+			if !nested {
+				m.Error = src.Error.Clone()
+				continue
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.ApplyMask(src.Error, []string{rest}); err != nil {
+				return err
+			}
+		case HotelReservationResponse_BookingStats_PATH:
+			if !nested {
+				m.BookingStats = src.BookingStats.Clone()
+				continue
+			}
+			if m.BookingStats == nil {
+				m.BookingStats = &BookingStatsResponse{}
+			}
+			if err := m.BookingStats.ApplyMask(src.BookingStats, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("HotelReservationResponse: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *HotelReservationResponse) Merge(src *HotelReservationResponse) {
+	if src == nil {
+		return
+	}
+	for _, v := range src.Result {
+		m.Result = append(m.Result, v.Clone())
+	}
+	if src.Status != "" {
+		m.Status = src.Status
+	}
+	if src.Error != nil {
+		if m.Error == nil {
+			m.Error = &Error{}
+		}
+		m.Error.Merge(src.Error)
+	}
+	if src.BookingStats != nil {
+		if m.BookingStats == nil {
+			m.BookingStats = &BookingStatsResponse{}
+		}
+		m.BookingStats.Merge(src.BookingStats)
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *HotelReservationResponse) ApplyFieldMask(src *HotelReservationResponse, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *HotelReservationResponse) MergeFieldMask(src *HotelReservationResponse, mask *PuregenFieldMask) error {
+	masked := &HotelReservationResponse{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *HotelReservationResponse) Redacted() *HotelReservationResponse {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	for i, v := range r.Result {
+		r.Result[i] = v.Redacted()
+	}
+	r.Error = r.Error.Redacted()
+	r.BookingStats = r.BookingStats.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *HotelReservationResponse) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *HotelReservationResponse) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("result", r.Result),
+		slog.Any("status", r.Status),
+		slog.Any("error", r.Error),
+		slog.Any("bookingStats", r.BookingStats),
+	)
+}
+
+func (m *HotelReservationResponse) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *HotelReservationResponse) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *HotelReservationResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+// Hotel information
+type HotelReservationResponse_Hotel struct {
+	// Name of the hotel
+	Name string `json:"name"`
+	// Hotel rating (1-5 stars)
+	Rating float64 `json:"rating"`
+	// Price per night
+	PricePerNight float64 `json:"pricePerNight"`
+	// Hotel address
+	Address string `json:"address"`
+}
+
+// HotelReservationResponse_HotelOption sets a field of a new HotelReservationResponse_Hotel
+type HotelReservationResponse_HotelOption func(*HotelReservationResponse_Hotel)
+
+// WithHotelReservationResponse_HotelName sets the Name field
+func WithHotelReservationResponse_HotelName(value string) HotelReservationResponse_HotelOption {
+	return func(m *HotelReservationResponse_Hotel) {
+		m.Name = value
+	}
+}
+
+// WithHotelReservationResponse_HotelRating sets the Rating field
+func WithHotelReservationResponse_HotelRating(value float64) HotelReservationResponse_HotelOption {
+	return func(m *HotelReservationResponse_Hotel) {
+		m.Rating = value
+	}
+}
+
+// WithHotelReservationResponse_HotelPricePerNight sets the PricePerNight field
+func WithHotelReservationResponse_HotelPricePerNight(value float64) HotelReservationResponse_HotelOption {
+	return func(m *HotelReservationResponse_Hotel) {
+		m.PricePerNight = value
+	}
+}
+
+// WithHotelReservationResponse_HotelAddress sets the Address field
+func WithHotelReservationResponse_HotelAddress(value string) HotelReservationResponse_HotelOption {
+	return func(m *HotelReservationResponse_Hotel) {
+		m.Address = value
+	}
+}
+
+func NewHotelReservationResponse_Hotel(opts ...HotelReservationResponse_HotelOption) *HotelReservationResponse_Hotel {
+	m := &HotelReservationResponse_Hotel{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetName returns the Name field, or its zero value if m is nil
+func (m *HotelReservationResponse_Hotel) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// GetRating returns the Rating field, or its zero value if m is nil
+func (m *HotelReservationResponse_Hotel) GetRating() float64 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+// GetPricePerNight returns the PricePerNight field, or its zero value if m is nil
+func (m *HotelReservationResponse_Hotel) GetPricePerNight() float64 {
+	if m != nil {
+		return m.PricePerNight
+	}
+	return 0
+}
+
+// GetAddress returns the Address field, or its zero value if m is nil
+func (m *HotelReservationResponse_Hotel) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// Clone returns a deep copy of m
+func (m *HotelReservationResponse_Hotel) Clone() *HotelReservationResponse_Hotel {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *HotelReservationResponse_Hotel) Equal(other *HotelReservationResponse_Hotel) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Rating != other.Rating {
+		return false
+	}
+	if m.PricePerNight != other.PricePerNight {
+		return false
+	}
+	if m.Address != other.Address {
+		return false
+	}
+	return true
+}
+
+// Field paths of HotelReservationResponse_Hotel, for ApplyMask and field masks
+const (
+	HotelReservationResponse_Hotel_Name_PATH          = "name"
+	HotelReservationResponse_Hotel_Rating_PATH        = "rating"
+	HotelReservationResponse_Hotel_PricePerNight_PATH = "pricePerNight"
+	HotelReservationResponse_Hotel_Address_PATH       = "address"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewHotelReservationResponse_Hotel().
+func (m *HotelReservationResponse_Hotel) ApplyMask(src *HotelReservationResponse_Hotel, paths []string) error {
+	if src == nil {
+		src = NewHotelReservationResponse_Hotel()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case HotelReservationResponse_Hotel_Name_PATH:
+			if nested {
+				return fmt.Errorf("HotelReservationResponse_Hotel: field %q has no subfields", name)
+			}
+			m.Name = src.Name
+		case HotelReservationResponse_Hotel_Rating_PATH:
+			if nested {
+				return fmt.Errorf("HotelReservationResponse_Hotel: field %q has no subfields", name)
+			}
+			m.Rating = src.Rating
+		case HotelReservationResponse_Hotel_PricePerNight_PATH:
+			if nested {
+				return fmt.Errorf("HotelReservationResponse_Hotel: field %q has no subfields", name)
+			}
+			m.PricePerNight = src.PricePerNight
+		case HotelReservationResponse_Hotel_Address_PATH:
+			if nested {
+				return fmt.Errorf("HotelReservationResponse_Hotel: field %q has no subfields", name)
+			}
+			m.Address = src.Address
+		default:
+			return fmt.Errorf("HotelReservationResponse_Hotel: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *HotelReservationResponse_Hotel) Merge(src *HotelReservationResponse_Hotel) {
+	if src == nil {
+		return
+	}
+	if src.Name != "" {
+		m.Name = src.Name
+	}
+	if src.Rating != 0 {
+		m.Rating = src.Rating
+	}
+	if src.PricePerNight != 0 {
+		m.PricePerNight = src.PricePerNight
+	}
+	if src.Address != "" {
+		m.Address = src.Address
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *HotelReservationResponse_Hotel) ApplyFieldMask(src *HotelReservationResponse_Hotel, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *HotelReservationResponse_Hotel) MergeFieldMask(src *HotelReservationResponse_Hotel, mask *PuregenFieldMask) error {
+	masked := &HotelReservationResponse_Hotel{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *HotelReservationResponse_Hotel) Redacted() *HotelReservationResponse_Hotel {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *HotelReservationResponse_Hotel) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *HotelReservationResponse_Hotel) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("name", r.Name),
+		slog.Any("rating", r.Rating),
+		slog.Any("pricePerNight", r.PricePerNight),
+		slog.Any("address", r.Address),
+	)
+}
+
+func (m *HotelReservationResponse_Hotel) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *HotelReservationResponse_Hotel) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *HotelReservationResponse_Hotel) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+// Room availability with hotel details
+type HotelReservationResponse_AvailableRoom struct {
+	// Hotel information
+	Hotel *HotelReservationResponse_Hotel `json:"hotel"`
+	// Room type
+	RoomType string `json:"roomType"`
+	// Available rooms count
+	AvailableRooms int32 `json:"availableRooms"`
+}
+
+// HotelReservationResponse_AvailableRoomOption sets a field of a new HotelReservationResponse_AvailableRoom
+type HotelReservationResponse_AvailableRoomOption func(*HotelReservationResponse_AvailableRoom)
+
+// WithHotelReservationResponse_AvailableRoomHotel sets the Hotel field
+func WithHotelReservationResponse_AvailableRoomHotel(value *HotelReservationResponse_Hotel) HotelReservationResponse_AvailableRoomOption {
+	return func(m *HotelReservationResponse_AvailableRoom) {
+		m.Hotel = value
+	}
+}
+
+// WithHotelReservationResponse_AvailableRoomRoomType sets the RoomType field
+func WithHotelReservationResponse_AvailableRoomRoomType(value string) HotelReservationResponse_AvailableRoomOption {
+	return func(m *HotelReservationResponse_AvailableRoom) {
+		m.RoomType = value
+	}
+}
+
+// WithHotelReservationResponse_AvailableRoomAvailableRooms sets the AvailableRooms field
+func WithHotelReservationResponse_AvailableRoomAvailableRooms(value int32) HotelReservationResponse_AvailableRoomOption {
+	return func(m *HotelReservationResponse_AvailableRoom) {
+		m.AvailableRooms = value
+	}
+}
+
+func NewHotelReservationResponse_AvailableRoom(opts ...HotelReservationResponse_AvailableRoomOption) *HotelReservationResponse_AvailableRoom {
+	m := &HotelReservationResponse_AvailableRoom{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetHotel returns the Hotel field, or its zero value if m is nil
+func (m *HotelReservationResponse_AvailableRoom) GetHotel() *HotelReservationResponse_Hotel {
+	if m != nil {
+		return m.Hotel
+	}
+	return nil
+}
+
+// GetRoomType returns the RoomType field, or its zero value if m is nil
+func (m *HotelReservationResponse_AvailableRoom) GetRoomType() string {
+	if m != nil {
+		return m.RoomType
+	}
+	return ""
+}
+
+// GetAvailableRooms returns the AvailableRooms field, or its zero value if m is nil
+func (m *HotelReservationResponse_AvailableRoom) GetAvailableRooms() int32 {
+	if m != nil {
+		return m.AvailableRooms
+	}
+	return 0
+}
+
+// Clone returns a deep copy of m
+func (m *HotelReservationResponse_AvailableRoom) Clone() *HotelReservationResponse_AvailableRoom {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	c.Hotel = m.Hotel.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *HotelReservationResponse_AvailableRoom) Equal(other *HotelReservationResponse_AvailableRoom) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.Hotel.Equal(other.Hotel) {
+		return false
+	}
+	if m.RoomType != other.RoomType {
+		return false
+	}
+	if m.AvailableRooms != other.AvailableRooms {
+		return false
+	}
+	return true
+}
+
+// Field paths of HotelReservationResponse_AvailableRoom, for ApplyMask and field masks
+const (
+	HotelReservationResponse_AvailableRoom_Hotel_PATH          = "hotel"
+	HotelReservationResponse_AvailableRoom_RoomType_PATH       = "roomType"
+	HotelReservationResponse_AvailableRoom_AvailableRooms_PATH = "availableRooms"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewHotelReservationResponse_AvailableRoom().
+func (m *HotelReservationResponse_AvailableRoom) ApplyMask(src *HotelReservationResponse_AvailableRoom, paths []string) error {
+	if src == nil {
+		src = NewHotelReservationResponse_AvailableRoom()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case HotelReservationResponse_AvailableRoom_Hotel_PATH:
+			if !nested {
+				m.Hotel = src.Hotel.Clone()
+				continue
+			}
+			if m.Hotel == nil {
+				m.Hotel = &HotelReservationResponse_Hotel{}
+			}
+			if err := m.Hotel.ApplyMask(src.Hotel, []string{rest}); err != nil {
+				return err
+			}
+		case HotelReservationResponse_AvailableRoom_RoomType_PATH:
+			if nested {
+				return fmt.Errorf("HotelReservationResponse_AvailableRoom: field %q has no subfields", name)
+			}
+			m.RoomType = src.RoomType
+		case HotelReservationResponse_AvailableRoom_AvailableRooms_PATH:
+			if nested {
+				return fmt.Errorf("HotelReservationResponse_AvailableRoom: field %q has no subfields", name)
+			}
+			m.AvailableRooms = src.AvailableRooms
+		default:
+			return fmt.Errorf("HotelReservationResponse_AvailableRoom: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *HotelReservationResponse_AvailableRoom) Merge(src *HotelReservationResponse_AvailableRoom) {
+	if src == nil {
+		return
+	}
+	if src.Hotel != nil {
+		if m.Hotel == nil {
+			m.Hotel = &HotelReservationResponse_Hotel{}
+		}
+		m.Hotel.Merge(src.Hotel)
+	}
+	if src.RoomType != "" {
+		m.RoomType = src.RoomType
+	}
+	if src.AvailableRooms != 0 {
+		m.AvailableRooms = src.AvailableRooms
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *HotelReservationResponse_AvailableRoom) ApplyFieldMask(src *HotelReservationResponse_AvailableRoom, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *HotelReservationResponse_AvailableRoom) MergeFieldMask(src *HotelReservationResponse_AvailableRoom, mask *PuregenFieldMask) error {
+	masked := &HotelReservationResponse_AvailableRoom{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *HotelReservationResponse_AvailableRoom) Redacted() *HotelReservationResponse_AvailableRoom {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.Hotel = r.Hotel.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *HotelReservationResponse_AvailableRoom) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *HotelReservationResponse_AvailableRoom) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("hotel", r.Hotel),
+		slog.Any("roomType", r.RoomType),
+		slog.Any("availableRooms", r.AvailableRooms),
+	)
+}
+
+func (m *HotelReservationResponse_AvailableRoom) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *HotelReservationResponse_AvailableRoom) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *HotelReservationResponse_AvailableRoom) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+// Hotel reservation result for single location
+type HotelReservationResponse_SingleHotelReservationResponse struct {
+	// List of available rooms
+	AvailableRooms []*HotelReservationResponse_AvailableRoom `json:"availableRooms"`
+	// Error message
+	Error *Error `json:"error"`
+}
+
+// HotelReservationResponse_SingleHotelReservationResponseOption sets a field of a new HotelReservationResponse_SingleHotelReservationResponse
+type HotelReservationResponse_SingleHotelReservationResponseOption func(*HotelReservationResponse_SingleHotelReservationResponse)
+
+// WithHotelReservationResponse_SingleHotelReservationResponseAvailableRooms sets the AvailableRooms field
+func WithHotelReservationResponse_SingleHotelReservationResponseAvailableRooms(value []*HotelReservationResponse_AvailableRoom) HotelReservationResponse_SingleHotelReservationResponseOption {
+	return func(m *HotelReservationResponse_SingleHotelReservationResponse) {
+		m.AvailableRooms = value
+	}
+}
+
+// WithHotelReservationResponse_SingleHotelReservationResponseError sets the Error field
+func WithHotelReservationResponse_SingleHotelReservationResponseError(value *Error) HotelReservationResponse_SingleHotelReservationResponseOption {
+	return func(m *HotelReservationResponse_SingleHotelReservationResponse) {
+		m.Error = value
+	}
+}
+
+func NewHotelReservationResponse_SingleHotelReservationResponse(opts ...HotelReservationResponse_SingleHotelReservationResponseOption) *HotelReservationResponse_SingleHotelReservationResponse {
+	m := &HotelReservationResponse_SingleHotelReservationResponse{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetAvailableRooms returns the AvailableRooms field, or its zero value if m is nil
+func (m *HotelReservationResponse_SingleHotelReservationResponse) GetAvailableRooms() []*HotelReservationResponse_AvailableRoom {
+	if m != nil {
+		return m.AvailableRooms
+	}
+	return nil
+}
+
+// GetError returns the Error field, or its zero value if m is nil
+func (m *HotelReservationResponse_SingleHotelReservationResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *HotelReservationResponse_SingleHotelReservationResponse) Clone() *HotelReservationResponse_SingleHotelReservationResponse {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	if m.AvailableRooms != nil {
+		c.AvailableRooms = make([]*HotelReservationResponse_AvailableRoom, len(m.AvailableRooms))
+		for i, v := range m.AvailableRooms {
+			c.AvailableRooms[i] = v.Clone()
+		}
+	}
+	c.Error = m.Error.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *HotelReservationResponse_SingleHotelReservationResponse) Equal(other *HotelReservationResponse_SingleHotelReservationResponse) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AvailableRooms) != len(other.AvailableRooms) {
+		return false
+	}
+	for i := range m.AvailableRooms {
+		if !m.AvailableRooms[i].Equal(other.AvailableRooms[i]) {
+			return false
+		}
+	}
+	if !m.Error.Equal(other.Error) {
+		return false
+	}
+	return true
+}
+
+// Field paths of HotelReservationResponse_SingleHotelReservationResponse, for ApplyMask and field masks
+const (
+	HotelReservationResponse_SingleHotelReservationResponse_AvailableRooms_PATH = "availableRooms"
+	HotelReservationResponse_SingleHotelReservationResponse_Error_PATH          = "error"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewHotelReservationResponse_SingleHotelReservationResponse().
+func (m *HotelReservationResponse_SingleHotelReservationResponse) ApplyMask(src *HotelReservationResponse_SingleHotelReservationResponse, paths []string) error {
+	if src == nil {
+		src = NewHotelReservationResponse_SingleHotelReservationResponse()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case HotelReservationResponse_SingleHotelReservationResponse_AvailableRooms_PATH:
+			if nested {
+				return fmt.Errorf("HotelReservationResponse_SingleHotelReservationResponse: field %q has no subfields", name)
+			}
+			m.AvailableRooms = make([]*HotelReservationResponse_AvailableRoom, len(src.AvailableRooms))
+			for i, v := range src.AvailableRooms {
+				m.AvailableRooms[i] = v.Clone()
+			}
+		case HotelReservationResponse_SingleHotelReservationResponse_Error_PATH:
+			if !nested {
+				m.Error = src.Error.Clone()
+				continue
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.ApplyMask(src.Error, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("HotelReservationResponse_SingleHotelReservationResponse: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *HotelReservationResponse_SingleHotelReservationResponse) Merge(src *HotelReservationResponse_SingleHotelReservationResponse) {
+	if src == nil {
+		return
+	}
+	for _, v := range src.AvailableRooms {
+		m.AvailableRooms = append(m.AvailableRooms, v.Clone())
+	}
+	if src.Error != nil {
+		if m.Error == nil {
+			m.Error = &Error{}
+		}
+		m.Error.Merge(src.Error)
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *HotelReservationResponse_SingleHotelReservationResponse) ApplyFieldMask(src *HotelReservationResponse_SingleHotelReservationResponse, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *HotelReservationResponse_SingleHotelReservationResponse) MergeFieldMask(src *HotelReservationResponse_SingleHotelReservationResponse, mask *PuregenFieldMask) error {
+	masked := &HotelReservationResponse_SingleHotelReservationResponse{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *HotelReservationResponse_SingleHotelReservationResponse) Redacted() *HotelReservationResponse_SingleHotelReservationResponse {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	for i, v := range r.AvailableRooms {
+		r.AvailableRooms[i] = v.Redacted()
+	}
+	r.Error = r.Error.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *HotelReservationResponse_SingleHotelReservationResponse) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *HotelReservationResponse_SingleHotelReservationResponse) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("availableRooms", r.AvailableRooms),
+		slog.Any("error", r.Error),
+	)
+}
+
+func (m *HotelReservationResponse_SingleHotelReservationResponse) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *HotelReservationResponse_SingleHotelReservationResponse) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *HotelReservationResponse_SingleHotelReservationResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+// Request for flight booking
+type FlightBookingRequest struct {
+	// Flight search criteria
+	FlightRoutes []string `json:"flightRoutes"`
+	// Required payment information
+	PaymentInfo *PaymentInfo `json:"paymentInfo"`
+	// Include hotel recommendations
+	IncludeHotelRecommendations bool `json:"includeHotelRecommendations"`
+	// Departure and return dates (Unix timestamp)
+	DepartureDate int64 `json:"departureDate"`
+	ReturnDate    int64 `json:"returnDate"`
+	// Number of passengers
+	NumberOfPassengers int32 `json:"numberOfPassengers"`
+}
+
+// FlightBookingRequestOption sets a field of a new FlightBookingRequest
+type FlightBookingRequestOption func(*FlightBookingRequest)
+
+// WithFlightBookingRequestFlightRoutes sets the FlightRoutes field
+func WithFlightBookingRequestFlightRoutes(value []string) FlightBookingRequestOption {
+	return func(m *FlightBookingRequest) {
+		m.FlightRoutes = value
+	}
+}
+
+// WithFlightBookingRequestPaymentInfo sets the PaymentInfo field
+func WithFlightBookingRequestPaymentInfo(value *PaymentInfo) FlightBookingRequestOption {
+	return func(m *FlightBookingRequest) {
+		m.PaymentInfo = value
+	}
+}
+
+// WithFlightBookingRequestIncludeHotelRecommendations sets the IncludeHotelRecommendations field
+func WithFlightBookingRequestIncludeHotelRecommendations(value bool) FlightBookingRequestOption {
+	return func(m *FlightBookingRequest) {
+		m.IncludeHotelRecommendations = value
+	}
+}
+
+// WithFlightBookingRequestDepartureDate sets the DepartureDate field
+func WithFlightBookingRequestDepartureDate(value int64) FlightBookingRequestOption {
+	return func(m *FlightBookingRequest) {
+		m.DepartureDate = value
+	}
+}
+
+// WithFlightBookingRequestReturnDate sets the ReturnDate field
+func WithFlightBookingRequestReturnDate(value int64) FlightBookingRequestOption {
+	return func(m *FlightBookingRequest) {
+		m.ReturnDate = value
+	}
+}
+
+// WithFlightBookingRequestNumberOfPassengers sets the NumberOfPassengers field
+func WithFlightBookingRequestNumberOfPassengers(value int32) FlightBookingRequestOption {
+	return func(m *FlightBookingRequest) {
+		m.NumberOfPassengers = value
+	}
+}
+
+func NewFlightBookingRequest(opts ...FlightBookingRequestOption) *FlightBookingRequest {
+	m := &FlightBookingRequest{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetFlightRoutes returns the FlightRoutes field, or its zero value if m is nil
+func (m *FlightBookingRequest) GetFlightRoutes() []string {
+	if m != nil {
+		return m.FlightRoutes
+	}
+	return nil
+}
+
+// GetPaymentInfo returns the PaymentInfo field, or its zero value if m is nil
+func (m *FlightBookingRequest) GetPaymentInfo() *PaymentInfo {
+	if m != nil {
+		return m.PaymentInfo
+	}
+	return nil
+}
+
+// GetIncludeHotelRecommendations returns the IncludeHotelRecommendations field, or its zero value if m is nil
+func (m *FlightBookingRequest) GetIncludeHotelRecommendations() bool {
+	if m != nil {
+		return m.IncludeHotelRecommendations
+	}
+	return false
+}
+
+// GetDepartureDate returns the DepartureDate field, or its zero value if m is nil
+func (m *FlightBookingRequest) GetDepartureDate() int64 {
+	if m != nil {
+		return m.DepartureDate
+	}
+	return 0
+}
+
+// GetReturnDate returns the ReturnDate field, or its zero value if m is nil
+func (m *FlightBookingRequest) GetReturnDate() int64 {
+	if m != nil {
+		return m.ReturnDate
+	}
+	return 0
+}
+
+// GetNumberOfPassengers returns the NumberOfPassengers field, or its zero value if m is nil
+func (m *FlightBookingRequest) GetNumberOfPassengers() int32 {
+	if m != nil {
+		return m.NumberOfPassengers
+	}
+	return 0
+}
+
+// Clone returns a deep copy of m
+func (m *FlightBookingRequest) Clone() *FlightBookingRequest {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	if m.FlightRoutes != nil {
+		c.FlightRoutes = make([]string, len(m.FlightRoutes))
+		copy(c.FlightRoutes, m.FlightRoutes)
+	}
+	c.PaymentInfo = m.PaymentInfo.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *FlightBookingRequest) Equal(other *FlightBookingRequest) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.FlightRoutes) != len(other.FlightRoutes) {
+		return false
+	}
+	for i := range m.FlightRoutes {
+		if m.FlightRoutes[i] != other.FlightRoutes[i] {
+			return false
+		}
+	}
+	if !m.PaymentInfo.Equal(other.PaymentInfo) {
+		return false
+	}
+	if m.IncludeHotelRecommendations != other.IncludeHotelRecommendations {
+		return false
+	}
+	if m.DepartureDate != other.DepartureDate {
+		return false
+	}
+	if m.ReturnDate != other.ReturnDate {
+		return false
+	}
+	if m.NumberOfPassengers != other.NumberOfPassengers {
+		return false
+	}
+	return true
+}
+
+// Field paths of FlightBookingRequest, for ApplyMask and field masks
+const (
+	FlightBookingRequest_FlightRoutes_PATH                = "flightRoutes"
+	FlightBookingRequest_PaymentInfo_PATH                 = "paymentInfo"
+	FlightBookingRequest_IncludeHotelRecommendations_PATH = "includeHotelRecommendations"
+	FlightBookingRequest_DepartureDate_PATH               = "departureDate"
+	FlightBookingRequest_ReturnDate_PATH                  = "returnDate"
+	FlightBookingRequest_NumberOfPassengers_PATH          = "numberOfPassengers"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewFlightBookingRequest().
+func (m *FlightBookingRequest) ApplyMask(src *FlightBookingRequest, paths []string) error {
+	if src == nil {
+		src = NewFlightBookingRequest()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case FlightBookingRequest_FlightRoutes_PATH:
+			if nested {
+				return fmt.Errorf("FlightBookingRequest: field %q has no subfields", name)
+			}
+			m.FlightRoutes = append([]string(nil), src.FlightRoutes...)
+		case FlightBookingRequest_PaymentInfo_PATH:
+			if !nested {
+				m.PaymentInfo = src.PaymentInfo.Clone()
+				continue
+			}
+			if m.PaymentInfo == nil {
+				m.PaymentInfo = &PaymentInfo{}
+			}
+			if err := m.PaymentInfo.ApplyMask(src.PaymentInfo, []string{rest}); err != nil {
+				return err
+			}
+		case FlightBookingRequest_IncludeHotelRecommendations_PATH:
+			if nested {
+				return fmt.Errorf("FlightBookingRequest: field %q has no subfields", name)
+			}
+			m.IncludeHotelRecommendations = src.IncludeHotelRecommendations
+		case FlightBookingRequest_DepartureDate_PATH:
+			if nested {
+				return fmt.Errorf("FlightBookingRequest: field %q has no subfields", name)
+			}
+			m.DepartureDate = src.DepartureDate
+		case FlightBookingRequest_ReturnDate_PATH:
+			if nested {
+				return fmt.Errorf("FlightBookingRequest: field %q has no subfields", name)
+			}
+			m.ReturnDate = src.ReturnDate
+		case FlightBookingRequest_NumberOfPassengers_PATH:
+			if nested {
+				return fmt.Errorf("FlightBookingRequest: field %q has no subfields", name)
+			}
+			m.NumberOfPassengers = src.NumberOfPassengers
+		default:
+			return fmt.Errorf("FlightBookingRequest: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *FlightBookingRequest) Merge(src *FlightBookingRequest) {
+	if src == nil {
+		return
+	}
+	m.FlightRoutes = append(m.FlightRoutes, src.FlightRoutes...)
+	if src.PaymentInfo != nil {
+		if m.PaymentInfo == nil {
+			m.PaymentInfo = &PaymentInfo{}
+		}
+		m.PaymentInfo.Merge(src.PaymentInfo)
+	}
+	if src.IncludeHotelRecommendations {
+		m.IncludeHotelRecommendations = true
+	}
+	if src.DepartureDate != 0 {
+		m.DepartureDate = src.DepartureDate
+	}
+	if src.ReturnDate != 0 {
+		m.ReturnDate = src.ReturnDate
+	}
+	if src.NumberOfPassengers != 0 {
+		m.NumberOfPassengers = src.NumberOfPassengers
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *FlightBookingRequest) ApplyFieldMask(src *FlightBookingRequest, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *FlightBookingRequest) MergeFieldMask(src *FlightBookingRequest, mask *PuregenFieldMask) error {
+	masked := &FlightBookingRequest{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *FlightBookingRequest) Redacted() *FlightBookingRequest {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.PaymentInfo = r.PaymentInfo.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *FlightBookingRequest) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *FlightBookingRequest) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("flightRoutes", r.FlightRoutes),
+		slog.Any("paymentInfo", r.PaymentInfo),
+		slog.Any("includeHotelRecommendations", r.IncludeHotelRecommendations),
+		slog.Any("departureDate", r.DepartureDate),
+		slog.Any("returnDate", r.ReturnDate),
+		slog.Any("numberOfPassengers", r.NumberOfPassengers),
+	)
+}
+
+func (m *FlightBookingRequest) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *FlightBookingRequest) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *FlightBookingRequest) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+// Response for flight booking
+type FlightBookingResponse struct {
+	// List of flight bookings for each route
+	FlightBooking []*FlightBookingResponse_SingleFlightBooking `json:"FlightBooking"`
+	// Error message
+	Error *Error `json:"error"`
+	// Status of the request
+	Status string `json:"status"`
+	// Booking stats
+	BookingStats *BookingStatsResponse `json:"bookingStats"`
+}
+
+// FlightBookingResponseOption sets a field of a new FlightBookingResponse
+type FlightBookingResponseOption func(*FlightBookingResponse)
+
+// WithFlightBookingResponseFlightBooking sets the FlightBooking field
+func WithFlightBookingResponseFlightBooking(value []*FlightBookingResponse_SingleFlightBooking) FlightBookingResponseOption {
+	return func(m *FlightBookingResponse) {
+		m.FlightBooking = value
+	}
+}
+
+// WithFlightBookingResponseError sets the Error field
+func WithFlightBookingResponseError(value *Error) FlightBookingResponseOption {
+	return func(m *FlightBookingResponse) {
+		m.Error = value
+	}
+}
+
+// WithFlightBookingResponseStatus sets the Status field
+func WithFlightBookingResponseStatus(value string) FlightBookingResponseOption {
+	return func(m *FlightBookingResponse) {
+		m.Status = value
+	}
+}
+
+// WithFlightBookingResponseBookingStats sets the BookingStats field
+func WithFlightBookingResponseBookingStats(value *BookingStatsResponse) FlightBookingResponseOption {
+	return func(m *FlightBookingResponse) {
+		m.BookingStats = value
+	}
+}
+
+func NewFlightBookingResponse(opts ...FlightBookingResponseOption) *FlightBookingResponse {
+	m := &FlightBookingResponse{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetFlightBooking returns the FlightBooking field, or its zero value if m is nil
+func (m *FlightBookingResponse) GetFlightBooking() []*FlightBookingResponse_SingleFlightBooking {
+	if m != nil {
+		return m.FlightBooking
+	}
+	return nil
+}
+
+// GetError returns the Error field, or its zero value if m is nil
+func (m *FlightBookingResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+// GetStatus returns the Status field, or its zero value if m is nil
+func (m *FlightBookingResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+// GetBookingStats returns the BookingStats field, or its zero value if m is nil
+func (m *FlightBookingResponse) GetBookingStats() *BookingStatsResponse {
+	if m != nil {
+		return m.BookingStats
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *FlightBookingResponse) Clone() *FlightBookingResponse {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	if m.FlightBooking != nil {
+		c.FlightBooking = make([]*FlightBookingResponse_SingleFlightBooking, len(m.FlightBooking))
+		for i, v := range m.FlightBooking {
+			c.FlightBooking[i] = v.Clone()
+		}
+	}
+	c.Error = m.Error.Clone()
+	c.BookingStats = m.BookingStats.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *FlightBookingResponse) Equal(other *FlightBookingResponse) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.FlightBooking) != len(other.FlightBooking) {
+		return false
+	}
+	for i := range m.FlightBooking {
+		if !m.FlightBooking[i].Equal(other.FlightBooking[i]) {
+			return false
+		}
+	}
+	if !m.Error.Equal(other.Error) {
+		return false
+	}
+	if m.Status != other.Status {
+		return false
+	}
+	if !m.BookingStats.Equal(other.BookingStats) {
+		return false
+	}
+	return true
+}
+
+// Field paths of FlightBookingResponse, for ApplyMask and field masks
+const (
+	FlightBookingResponse_FlightBooking_PATH = "FlightBooking"
+	FlightBookingResponse_Error_PATH         = "error"
+	FlightBookingResponse_Status_PATH        = "status"
+	FlightBookingResponse_BookingStats_PATH  = "bookingStats"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewFlightBookingResponse().
+func (m *FlightBookingResponse) ApplyMask(src *FlightBookingResponse, paths []string) error {
+	if src == nil {
+		src = NewFlightBookingResponse()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case FlightBookingResponse_FlightBooking_PATH:
+			if nested {
+				return fmt.Errorf("FlightBookingResponse: field %q has no subfields", name)
+			}
+			m.FlightBooking = make([]*FlightBookingResponse_SingleFlightBooking, len(src.FlightBooking))
+			for i, v := range src.FlightBooking {
+				m.FlightBooking[i] = v.Clone()
+			}
+		case FlightBookingResponse_Error_PATH:
+			if !nested {
+				m.Error = src.Error.Clone()
+				continue
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.ApplyMask(src.Error, []string{rest}); err != nil {
+				return err
+			}
+		case FlightBookingResponse_Status_PATH:
+			if nested {
+				return fmt.Errorf("FlightBookingResponse: field %q has no subfields", name)
+			}
+			m.Status = src.Status
+		case FlightBookingResponse_BookingStats_PATH:
+			if !nested {
+				m.BookingStats = src.BookingStats.Clone()
+				continue
+			}
+			if m.BookingStats == nil {
+				m.BookingStats = &BookingStatsResponse{}
+			}
+			if err := m.BookingStats.ApplyMask(src.BookingStats, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("FlightBookingResponse: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *FlightBookingResponse) Merge(src *FlightBookingResponse) {
+	if src == nil {
+		return
+	}
+	for _, v := range src.FlightBooking {
+		m.FlightBooking = append(m.FlightBooking, v.Clone())
+	}
+	if src.Error != nil {
+		if m.Error == nil {
+			m.Error = &Error{}
+		}
+		m.Error.Merge(src.Error)
+	}
+	if src.Status != "" {
+		m.Status = src.Status
+	}
+	if src.BookingStats != nil {
+		if m.BookingStats == nil {
+			m.BookingStats = &BookingStatsResponse{}
+		}
+		m.BookingStats.Merge(src.BookingStats)
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *FlightBookingResponse) ApplyFieldMask(src *FlightBookingResponse, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *FlightBookingResponse) MergeFieldMask(src *FlightBookingResponse, mask *PuregenFieldMask) error {
+	masked := &FlightBookingResponse{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *FlightBookingResponse) Redacted() *FlightBookingResponse {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	for i, v := range r.FlightBooking {
+		r.FlightBooking[i] = v.Redacted()
+	}
+	r.Error = r.Error.Redacted()
+	r.BookingStats = r.BookingStats.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *FlightBookingResponse) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *FlightBookingResponse) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("FlightBooking", r.FlightBooking),
+		slog.Any("error", r.Error),
+		slog.Any("status", r.Status),
+		slog.Any("bookingStats", r.BookingStats),
+	)
+}
+
+func (m *FlightBookingResponse) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *FlightBookingResponse) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *FlightBookingResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+// Response for single flight booking
+type FlightBookingResponse_SingleFlightBooking struct {
+	// Flight details
+	FlightNumber string `json:"flightNumber"`
+	// Airline name
+	Airline string `json:"airline"`
+	// Flight price
+	Price float64 `json:"price"`
+	// Departure time
+	DepartureTime int64 `json:"departureTime"`
+	// Arrival time
+	ArrivalTime int64 `json:"arrivalTime"`
+	// Error message
+	Error *Error `json:"error"`
+	// Hotel recommendations associated with the flight
+	HotelRecommendations *HotelReservationResponse_SingleHotelReservationResponse `json:"hotelRecommendations"`
+}
+
+// FlightBookingResponse_SingleFlightBookingOption sets a field of a new FlightBookingResponse_SingleFlightBooking
+type FlightBookingResponse_SingleFlightBookingOption func(*FlightBookingResponse_SingleFlightBooking)
+
+// WithFlightBookingResponse_SingleFlightBookingFlightNumber sets the FlightNumber field
+func WithFlightBookingResponse_SingleFlightBookingFlightNumber(value string) FlightBookingResponse_SingleFlightBookingOption {
+	return func(m *FlightBookingResponse_SingleFlightBooking) {
+		m.FlightNumber = value
+	}
+}
+
+// WithFlightBookingResponse_SingleFlightBookingAirline sets the Airline field
+func WithFlightBookingResponse_SingleFlightBookingAirline(value string) FlightBookingResponse_SingleFlightBookingOption {
+	return func(m *FlightBookingResponse_SingleFlightBooking) {
+		m.Airline = value
+	}
+}
+
+// WithFlightBookingResponse_SingleFlightBookingPrice sets the Price field
+func WithFlightBookingResponse_SingleFlightBookingPrice(value float64) FlightBookingResponse_SingleFlightBookingOption {
+	return func(m *FlightBookingResponse_SingleFlightBooking) {
+		m.Price = value
+	}
+}
+
+// WithFlightBookingResponse_SingleFlightBookingDepartureTime sets the DepartureTime field
+func WithFlightBookingResponse_SingleFlightBookingDepartureTime(value int64) FlightBookingResponse_SingleFlightBookingOption {
+	return func(m *FlightBookingResponse_SingleFlightBooking) {
+		m.DepartureTime = value
+	}
+}
+
+// WithFlightBookingResponse_SingleFlightBookingArrivalTime sets the ArrivalTime field
+func WithFlightBookingResponse_SingleFlightBookingArrivalTime(value int64) FlightBookingResponse_SingleFlightBookingOption {
+	return func(m *FlightBookingResponse_SingleFlightBooking) {
+		m.ArrivalTime = value
+	}
+}
+
+// WithFlightBookingResponse_SingleFlightBookingError sets the Error field
+func WithFlightBookingResponse_SingleFlightBookingError(value *Error) FlightBookingResponse_SingleFlightBookingOption {
+	return func(m *FlightBookingResponse_SingleFlightBooking) {
+		m.Error = value
+	}
+}
+
+// WithFlightBookingResponse_SingleFlightBookingHotelRecommendations sets the HotelRecommendations field
+func WithFlightBookingResponse_SingleFlightBookingHotelRecommendations(value *HotelReservationResponse_SingleHotelReservationResponse) FlightBookingResponse_SingleFlightBookingOption {
+	return func(m *FlightBookingResponse_SingleFlightBooking) {
+		m.HotelRecommendations = value
+	}
+}
+
+func NewFlightBookingResponse_SingleFlightBooking(opts ...FlightBookingResponse_SingleFlightBookingOption) *FlightBookingResponse_SingleFlightBooking {
+	m := &FlightBookingResponse_SingleFlightBooking{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetFlightNumber returns the FlightNumber field, or its zero value if m is nil
+func (m *FlightBookingResponse_SingleFlightBooking) GetFlightNumber() string {
+	if m != nil {
+		return m.FlightNumber
+	}
+	return ""
+}
+
+// GetAirline returns the Airline field, or its zero value if m is nil
+func (m *FlightBookingResponse_SingleFlightBooking) GetAirline() string {
+	if m != nil {
+		return m.Airline
+	}
+	return ""
+}
+
+// GetPrice returns the Price field, or its zero value if m is nil
+func (m *FlightBookingResponse_SingleFlightBooking) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+// GetDepartureTime returns the DepartureTime field, or its zero value if m is nil
+func (m *FlightBookingResponse_SingleFlightBooking) GetDepartureTime() int64 {
+	if m != nil {
+		return m.DepartureTime
+	}
+	return 0
+}
+
+// GetArrivalTime returns the ArrivalTime field, or its zero value if m is nil
+func (m *FlightBookingResponse_SingleFlightBooking) GetArrivalTime() int64 {
+	if m != nil {
+		return m.ArrivalTime
+	}
+	return 0
+}
+
+// GetError returns the Error field, or its zero value if m is nil
+func (m *FlightBookingResponse_SingleFlightBooking) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+// GetHotelRecommendations returns the HotelRecommendations field, or its zero value if m is nil
+func (m *FlightBookingResponse_SingleFlightBooking) GetHotelRecommendations() *HotelReservationResponse_SingleHotelReservationResponse {
+	if m != nil {
+		return m.HotelRecommendations
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *FlightBookingResponse_SingleFlightBooking) Clone() *FlightBookingResponse_SingleFlightBooking {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	c.Error = m.Error.Clone()
+	c.HotelRecommendations = m.HotelRecommendations.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *FlightBookingResponse_SingleFlightBooking) Equal(other *FlightBookingResponse_SingleFlightBooking) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.FlightNumber != other.FlightNumber {
+		return false
+	}
+	if m.Airline != other.Airline {
+		return false
+	}
+	if m.Price != other.Price {
+		return false
+	}
+	if m.DepartureTime != other.DepartureTime {
+		return false
+	}
+	if m.ArrivalTime != other.ArrivalTime {
+		return false
+	}
+	if !m.Error.Equal(other.Error) {
+		return false
+	}
+	if !m.HotelRecommendations.Equal(other.HotelRecommendations) {
+		return false
+	}
+	return true
+}
+
+// Field paths of FlightBookingResponse_SingleFlightBooking, for ApplyMask and field masks
+const (
+	FlightBookingResponse_SingleFlightBooking_FlightNumber_PATH         = "flightNumber"
+	FlightBookingResponse_SingleFlightBooking_Airline_PATH              = "airline"
+	FlightBookingResponse_SingleFlightBooking_Price_PATH                = "price"
+	FlightBookingResponse_SingleFlightBooking_DepartureTime_PATH        = "departureTime"
+	FlightBookingResponse_SingleFlightBooking_ArrivalTime_PATH          = "arrivalTime"
+	FlightBookingResponse_SingleFlightBooking_Error_PATH                = "error"
+	FlightBookingResponse_SingleFlightBooking_HotelRecommendations_PATH = "hotelRecommendations"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewFlightBookingResponse_SingleFlightBooking().
+func (m *FlightBookingResponse_SingleFlightBooking) ApplyMask(src *FlightBookingResponse_SingleFlightBooking, paths []string) error {
+	if src == nil {
+		src = NewFlightBookingResponse_SingleFlightBooking()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case FlightBookingResponse_SingleFlightBooking_FlightNumber_PATH:
+			if nested {
+				return fmt.Errorf("FlightBookingResponse_SingleFlightBooking: field %q has no subfields", name)
+			}
+			m.FlightNumber = src.FlightNumber
+		case FlightBookingResponse_SingleFlightBooking_Airline_PATH:
+			if nested {
+				return fmt.Errorf("FlightBookingResponse_SingleFlightBooking: field %q has no subfields", name)
+			}
+			m.Airline = src.Airline
+		case FlightBookingResponse_SingleFlightBooking_Price_PATH:
+			if nested {
+				return fmt.Errorf("FlightBookingResponse_SingleFlightBooking: field %q has no subfields", name)
+			}
+			m.Price = src.Price
+		case FlightBookingResponse_SingleFlightBooking_DepartureTime_PATH:
+			if nested {
+				return fmt.Errorf("FlightBookingResponse_SingleFlightBooking: field %q has no subfields", name)
+			}
+			m.DepartureTime = src.DepartureTime
+		case FlightBookingResponse_SingleFlightBooking_ArrivalTime_PATH:
+			if nested {
+				return fmt.Errorf("FlightBookingResponse_SingleFlightBooking: field %q has no subfields", name)
+			}
+			m.ArrivalTime = src.ArrivalTime
+		case FlightBookingResponse_SingleFlightBooking_Error_PATH:
+			if !nested {
+				m.Error = src.Error.Clone()
+				continue
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.ApplyMask(src.Error, []string{rest}); err != nil {
+				return err
+			}
+		case FlightBookingResponse_SingleFlightBooking_HotelRecommendations_PATH:
+			if !nested {
+				m.HotelRecommendations = src.HotelRecommendations.Clone()
+				continue
+			}
+			if m.HotelRecommendations == nil {
+				m.HotelRecommendations = &HotelReservationResponse_SingleHotelReservationResponse{}
+			}
+			if err := m.HotelRecommendations.ApplyMask(src.HotelRecommendations, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("FlightBookingResponse_SingleFlightBooking: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *FlightBookingResponse_SingleFlightBooking) Merge(src *FlightBookingResponse_SingleFlightBooking) {
+	if src == nil {
+		return
+	}
+	if src.FlightNumber != "" {
+		m.FlightNumber = src.FlightNumber
+	}
+	if src.Airline != "" {
+		m.Airline = src.Airline
+	}
+	if src.Price != 0 {
+		m.Price = src.Price
+	}
+	if src.DepartureTime != 0 {
+		m.DepartureTime = src.DepartureTime
+	}
+	if src.ArrivalTime != 0 {
+		m.ArrivalTime = src.ArrivalTime
+	}
+	if src.Error != nil {
+		if m.Error == nil {
+			m.Error = &Error{}
+		}
+		m.Error.Merge(src.Error)
+	}
+	if src.HotelRecommendations != nil {
+		if m.HotelRecommendations == nil {
+			m.HotelRecommendations = &HotelReservationResponse_SingleHotelReservationResponse{}
+		}
+		m.HotelRecommendations.Merge(src.HotelRecommendations)
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *FlightBookingResponse_SingleFlightBooking) ApplyFieldMask(src *FlightBookingResponse_SingleFlightBooking, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *FlightBookingResponse_SingleFlightBooking) MergeFieldMask(src *FlightBookingResponse_SingleFlightBooking, mask *PuregenFieldMask) error {
+	masked := &FlightBookingResponse_SingleFlightBooking{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *FlightBookingResponse_SingleFlightBooking) Redacted() *FlightBookingResponse_SingleFlightBooking {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.Error = r.Error.Redacted()
+	r.HotelRecommendations = r.HotelRecommendations.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *FlightBookingResponse_SingleFlightBooking) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *FlightBookingResponse_SingleFlightBooking) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("flightNumber", r.FlightNumber),
+		slog.Any("airline", r.Airline),
+		slog.Any("price", r.Price),
+		slog.Any("departureTime", r.DepartureTime),
+		slog.Any("arrivalTime", r.ArrivalTime),
+		slog.Any("error", r.Error),
+		slog.Any("hotelRecommendations", r.HotelRecommendations),
+	)
+}
+
+func (m *FlightBookingResponse_SingleFlightBooking) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *FlightBookingResponse_SingleFlightBooking) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *FlightBookingResponse_SingleFlightBooking) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+// Request for travel package booking
+type TravelPackageBookingRequest struct {
+	// Travel destinations
+	Destinations []string `json:"destinations"`
+	// Required payment information
+	PaymentInfo *PaymentInfo `json:"paymentInfo"`
+}
+
+// TravelPackageBookingRequestOption sets a field of a new TravelPackageBookingRequest
+type TravelPackageBookingRequestOption func(*TravelPackageBookingRequest)
+
+// WithTravelPackageBookingRequestDestinations sets the Destinations field
+func WithTravelPackageBookingRequestDestinations(value []string) TravelPackageBookingRequestOption {
+	return func(m *TravelPackageBookingRequest) {
+		m.Destinations = value
+	}
+}
+
+// WithTravelPackageBookingRequestPaymentInfo sets the PaymentInfo field
+func WithTravelPackageBookingRequestPaymentInfo(value *PaymentInfo) TravelPackageBookingRequestOption {
+	return func(m *TravelPackageBookingRequest) {
+		m.PaymentInfo = value
+	}
+}
+
+func NewTravelPackageBookingRequest(opts ...TravelPackageBookingRequestOption) *TravelPackageBookingRequest {
+	m := &TravelPackageBookingRequest{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetDestinations returns the Destinations field, or its zero value if m is nil
+func (m *TravelPackageBookingRequest) GetDestinations() []string {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+// GetPaymentInfo returns the PaymentInfo field, or its zero value if m is nil
+func (m *TravelPackageBookingRequest) GetPaymentInfo() *PaymentInfo {
+	if m != nil {
+		return m.PaymentInfo
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *TravelPackageBookingRequest) Clone() *TravelPackageBookingRequest {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	if m.Destinations != nil {
+		c.Destinations = make([]string, len(m.Destinations))
+		copy(c.Destinations, m.Destinations)
+	}
+	c.PaymentInfo = m.PaymentInfo.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *TravelPackageBookingRequest) Equal(other *TravelPackageBookingRequest) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Destinations) != len(other.Destinations) {
+		return false
+	}
+	for i := range m.Destinations {
+		if m.Destinations[i] != other.Destinations[i] {
+			return false
+		}
+	}
+	if !m.PaymentInfo.Equal(other.PaymentInfo) {
+		return false
+	}
+	return true
+}
+
+// Field paths of TravelPackageBookingRequest, for ApplyMask and field masks
+const (
+	TravelPackageBookingRequest_Destinations_PATH = "destinations"
+	TravelPackageBookingRequest_PaymentInfo_PATH  = "paymentInfo"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewTravelPackageBookingRequest().
+func (m *TravelPackageBookingRequest) ApplyMask(src *TravelPackageBookingRequest, paths []string) error {
+	if src == nil {
+		src = NewTravelPackageBookingRequest()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case TravelPackageBookingRequest_Destinations_PATH:
+			if nested {
+				return fmt.Errorf("TravelPackageBookingRequest: field %q has no subfields", name)
+			}
+			m.Destinations = append([]string(nil), src.Destinations...)
+		case TravelPackageBookingRequest_PaymentInfo_PATH:
+			if !nested {
+				m.PaymentInfo = src.PaymentInfo.Clone()
+				continue
+			}
+			if m.PaymentInfo == nil {
+				m.PaymentInfo = &PaymentInfo{}
+			}
+			if err := m.PaymentInfo.ApplyMask(src.PaymentInfo, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("TravelPackageBookingRequest: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *TravelPackageBookingRequest) Merge(src *TravelPackageBookingRequest) {
+	if src == nil {
+		return
+	}
+	m.Destinations = append(m.Destinations, src.Destinations...)
+	if src.PaymentInfo != nil {
+		if m.PaymentInfo == nil {
+			m.PaymentInfo = &PaymentInfo{}
+		}
+		m.PaymentInfo.Merge(src.PaymentInfo)
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *TravelPackageBookingRequest) ApplyFieldMask(src *TravelPackageBookingRequest, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *TravelPackageBookingRequest) MergeFieldMask(src *TravelPackageBookingRequest, mask *PuregenFieldMask) error {
+	masked := &TravelPackageBookingRequest{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *TravelPackageBookingRequest) Redacted() *TravelPackageBookingRequest {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.PaymentInfo = r.PaymentInfo.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *TravelPackageBookingRequest) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *TravelPackageBookingRequest) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("destinations", r.Destinations),
+		slog.Any("paymentInfo", r.PaymentInfo),
+	)
+}
+
+func (m *TravelPackageBookingRequest) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
+
+func (m *TravelPackageBookingRequest) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *TravelPackageBookingRequest) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+// Response for travel package booking
+type TravelPackageBookingResponse struct {
+	// List of travel packages for each destination
+	TravelPackages []*TravelPackageBookingResponse_SingleTravelPackageResponse `json:"travelPackages"`
+	// Error message
+	Error *Error `json:"error"`
+	// Status of the request
+	Status string `json:"status"`
+	// Booking stats
+	BookingStats *BookingStatsResponse `json:"bookingStats"`
+}
+
+// TravelPackageBookingResponseOption sets a field of a new TravelPackageBookingResponse
+type TravelPackageBookingResponseOption func(*TravelPackageBookingResponse)
+
+// WithTravelPackageBookingResponseTravelPackages sets the TravelPackages field
+func WithTravelPackageBookingResponseTravelPackages(value []*TravelPackageBookingResponse_SingleTravelPackageResponse) TravelPackageBookingResponseOption {
+	return func(m *TravelPackageBookingResponse) {
+		m.TravelPackages = value
+	}
+}
+
+// WithTravelPackageBookingResponseError sets the Error field
+func WithTravelPackageBookingResponseError(value *Error) TravelPackageBookingResponseOption {
+	return func(m *TravelPackageBookingResponse) {
+		m.Error = value
+	}
+}
+
+// WithTravelPackageBookingResponseStatus sets the Status field
+func WithTravelPackageBookingResponseStatus(value string) TravelPackageBookingResponseOption {
+	return func(m *TravelPackageBookingResponse) {
+		m.Status = value
+	}
+}
+
+// WithTravelPackageBookingResponseBookingStats sets the BookingStats field
+func WithTravelPackageBookingResponseBookingStats(value *BookingStatsResponse) TravelPackageBookingResponseOption {
+	return func(m *TravelPackageBookingResponse) {
+		m.BookingStats = value
+	}
+}
+
+func NewTravelPackageBookingResponse(opts ...TravelPackageBookingResponseOption) *TravelPackageBookingResponse {
+	m := &TravelPackageBookingResponse{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetTravelPackages returns the TravelPackages field, or its zero value if m is nil
+func (m *TravelPackageBookingResponse) GetTravelPackages() []*TravelPackageBookingResponse_SingleTravelPackageResponse {
+	if m != nil {
+		return m.TravelPackages
+	}
+	return nil
+}
+
+// GetError returns the Error field, or its zero value if m is nil
+func (m *TravelPackageBookingResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+// GetStatus returns the Status field, or its zero value if m is nil
+func (m *TravelPackageBookingResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+// GetBookingStats returns the BookingStats field, or its zero value if m is nil
+func (m *TravelPackageBookingResponse) GetBookingStats() *BookingStatsResponse {
+	if m != nil {
+		return m.BookingStats
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *TravelPackageBookingResponse) Clone() *TravelPackageBookingResponse {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	if m.TravelPackages != nil {
+		c.TravelPackages = make([]*TravelPackageBookingResponse_SingleTravelPackageResponse, len(m.TravelPackages))
+		for i, v := range m.TravelPackages {
+			c.TravelPackages[i] = v.Clone()
+		}
+	}
+	c.Error = m.Error.Clone()
+	c.BookingStats = m.BookingStats.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *TravelPackageBookingResponse) Equal(other *TravelPackageBookingResponse) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.TravelPackages) != len(other.TravelPackages) {
+		return false
+	}
+	for i := range m.TravelPackages {
+		if !m.TravelPackages[i].Equal(other.TravelPackages[i]) {
+			return false
+		}
+	}
+	if !m.Error.Equal(other.Error) {
+		return false
+	}
+	if m.Status != other.Status {
+		return false
+	}
+	if !m.BookingStats.Equal(other.BookingStats) {
+		return false
+	}
+	return true
 }
 
-func NewHotelReservationRequest() *HotelReservationRequest {
-	return &HotelReservationRequest{}
-}
+// Field paths of TravelPackageBookingResponse, for ApplyMask and field masks
+const (
+	TravelPackageBookingResponse_TravelPackages_PATH = "travelPackages"
+	TravelPackageBookingResponse_Error_PATH          = "error"
+	TravelPackageBookingResponse_Status_PATH         = "status"
+	TravelPackageBookingResponse_BookingStats_PATH   = "bookingStats"
+)
 
-func (m *HotelReservationRequest) Validate() error {
-	// Add custom validation logic here
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewTravelPackageBookingResponse().
+func (m *TravelPackageBookingResponse) ApplyMask(src *TravelPackageBookingResponse, paths []string) error {
+	if src == nil {
+		src = NewTravelPackageBookingResponse()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case TravelPackageBookingResponse_TravelPackages_PATH:
+			if nested {
+				return fmt.Errorf("TravelPackageBookingResponse: field %q has no subfields", name)
+			}
+			m.TravelPackages = make([]*TravelPackageBookingResponse_SingleTravelPackageResponse, len(src.TravelPackages))
+			for i, v := range src.TravelPackages {
+				m.TravelPackages[i] = v.Clone()
+			}
+		case TravelPackageBookingResponse_Error_PATH:
+			if !nested {
+				m.Error = src.Error.Clone()
+				continue
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.ApplyMask(src.Error, []string{rest}); err != nil {
+				return err
+			}
+		case TravelPackageBookingResponse_Status_PATH:
+			if nested {
+				return fmt.Errorf("TravelPackageBookingResponse: field %q has no subfields", name)
+			}
+			m.Status = src.Status
+		case TravelPackageBookingResponse_BookingStats_PATH:
+			if !nested {
+				m.BookingStats = src.BookingStats.Clone()
+				continue
+			}
+			if m.BookingStats == nil {
+				m.BookingStats = &BookingStatsResponse{}
+			}
+			if err := m.BookingStats.ApplyMask(src.BookingStats, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("TravelPackageBookingResponse: unknown field path %q", path)
+		}
+	}
 	return nil
 }
 
-func (m *HotelReservationRequest) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *TravelPackageBookingResponse) Merge(src *TravelPackageBookingResponse) {
+	if src == nil {
+		return
+	}
+	for _, v := range src.TravelPackages {
+		m.TravelPackages = append(m.TravelPackages, v.Clone())
+	}
+	if src.Error != nil {
+		if m.Error == nil {
+			m.Error = &Error{}
+		}
+		m.Error.Merge(src.Error)
+	}
+	if src.Status != "" {
+		m.Status = src.Status
+	}
+	if src.BookingStats != nil {
+		if m.BookingStats == nil {
+			m.BookingStats = &BookingStatsResponse{}
+		}
+		m.BookingStats.Merge(src.BookingStats)
+	}
 }
 
-func (m *HotelReservationRequest) FromJSON(data []byte) error {
-	return json.Unmarshal(data, m)
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *TravelPackageBookingResponse) ApplyFieldMask(src *TravelPackageBookingResponse, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
 }
 
-// Response for hotel reservation
-type HotelReservationResponse struct {
-	// List of results for each search location
-	Result []*HotelReservationResponse_SingleHotelReservationResponse `json:"result"`
-	// Status of the request
-	Status string `json:"status"`
-	// Error message
-	Error *Error `json:"error"`
-	// Booking stats
-	BookingStats *BookingStatsResponse `json:"bookingStats"`
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *TravelPackageBookingResponse) MergeFieldMask(src *TravelPackageBookingResponse, mask *PuregenFieldMask) error {
+	masked := &TravelPackageBookingResponse{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *TravelPackageBookingResponse) Redacted() *TravelPackageBookingResponse {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	for i, v := range r.TravelPackages {
+		r.TravelPackages[i] = v.Redacted()
+	}
+	r.Error = r.Error.Redacted()
+	r.BookingStats = r.BookingStats.Redacted()
+	return r
 }
 
-func NewHotelReservationResponse() *HotelReservationResponse {
-	return &HotelReservationResponse{}
+// String renders m with sensitive fields redacted
+func (m *TravelPackageBookingResponse) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
 }
 
-func (m *HotelReservationResponse) Validate() error {
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *TravelPackageBookingResponse) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("travelPackages", r.TravelPackages),
+		slog.Any("error", r.Error),
+		slog.Any("status", r.Status),
+		slog.Any("bookingStats", r.BookingStats),
+	)
+}
+
+func (m *TravelPackageBookingResponse) Validate() error {
 	// Add custom validation logic here
 	return nil
 }
 
-func (m *HotelReservationResponse) ToJSON() ([]byte, error) {
+func (m *TravelPackageBookingResponse) ToJSON() ([]byte, error) {
 	return json.Marshal(m)
 }
 
-func (m *HotelReservationResponse) FromJSON(data []byte) error {
+func (m *TravelPackageBookingResponse) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
-// Hotel information
-type HotelReservationResponse_Hotel struct {
-	// Name of the hotel
-	Name string `json:"name"`
-	// Hotel rating (1-5 stars)
-	Rating float64 `json:"rating"`
-	// Price per night
-	PricePerNight float64 `json:"pricePerNight"`
-	// Hotel address
-	Address string `json:"address"`
+// Response for single travel package
+type TravelPackageBookingResponse_SingleTravelPackageResponse struct {
+	// Package name
+	PackageName string `json:"packageName"`
+	// Package description
+	Description string `json:"description"`
+	// Total package price
+	TotalPrice float64 `json:"totalPrice"`
+	// Package duration in days
+	DurationDays int32 `json:"durationDays"`
+	// Error message
+	Error *Error `json:"error"`
 }
 
-func NewHotelReservationResponse_Hotel() *HotelReservationResponse_Hotel {
-	return &HotelReservationResponse_Hotel{}
-}
+// TravelPackageBookingResponse_SingleTravelPackageResponseOption sets a field of a new TravelPackageBookingResponse_SingleTravelPackageResponse
+type TravelPackageBookingResponse_SingleTravelPackageResponseOption func(*TravelPackageBookingResponse_SingleTravelPackageResponse)
 
-func (m *HotelReservationResponse_Hotel) Validate() error {
-	// Add custom validation logic here
-	return nil
+// WithTravelPackageBookingResponse_SingleTravelPackageResponsePackageName sets the PackageName field
+func WithTravelPackageBookingResponse_SingleTravelPackageResponsePackageName(value string) TravelPackageBookingResponse_SingleTravelPackageResponseOption {
+	return func(m *TravelPackageBookingResponse_SingleTravelPackageResponse) {
+		m.PackageName = value
+	}
 }
 
-func (m *HotelReservationResponse_Hotel) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
+// WithTravelPackageBookingResponse_SingleTravelPackageResponseDescription sets the Description field
+func WithTravelPackageBookingResponse_SingleTravelPackageResponseDescription(value string) TravelPackageBookingResponse_SingleTravelPackageResponseOption {
+	return func(m *TravelPackageBookingResponse_SingleTravelPackageResponse) {
+		m.Description = value
+	}
 }
 
-func (m *HotelReservationResponse_Hotel) FromJSON(data []byte) error {
-	return json.Unmarshal(data, m)
+// WithTravelPackageBookingResponse_SingleTravelPackageResponseTotalPrice sets the TotalPrice field
+func WithTravelPackageBookingResponse_SingleTravelPackageResponseTotalPrice(value float64) TravelPackageBookingResponse_SingleTravelPackageResponseOption {
+	return func(m *TravelPackageBookingResponse_SingleTravelPackageResponse) {
+		m.TotalPrice = value
+	}
 }
 
-// Room availability with hotel details
-type HotelReservationResponse_AvailableRoom struct {
-	// Hotel information
-	Hotel *HotelReservationResponse_Hotel `json:"hotel"`
-	// Room type
-	RoomType string `json:"roomType"`
-	// Available rooms count
-	AvailableRooms int32 `json:"availableRooms"`
+// WithTravelPackageBookingResponse_SingleTravelPackageResponseDurationDays sets the DurationDays field
+func WithTravelPackageBookingResponse_SingleTravelPackageResponseDurationDays(value int32) TravelPackageBookingResponse_SingleTravelPackageResponseOption {
+	return func(m *TravelPackageBookingResponse_SingleTravelPackageResponse) {
+		m.DurationDays = value
+	}
 }
 
-func NewHotelReservationResponse_AvailableRoom() *HotelReservationResponse_AvailableRoom {
-	return &HotelReservationResponse_AvailableRoom{}
+// WithTravelPackageBookingResponse_SingleTravelPackageResponseError sets the Error field
+func WithTravelPackageBookingResponse_SingleTravelPackageResponseError(value *Error) TravelPackageBookingResponse_SingleTravelPackageResponseOption {
+	return func(m *TravelPackageBookingResponse_SingleTravelPackageResponse) {
+		m.Error = value
+	}
 }
 
-func (m *HotelReservationResponse_AvailableRoom) Validate() error {
-	// Add custom validation logic here
-	return nil
+func NewTravelPackageBookingResponse_SingleTravelPackageResponse(opts ...TravelPackageBookingResponse_SingleTravelPackageResponseOption) *TravelPackageBookingResponse_SingleTravelPackageResponse {
+	m := &TravelPackageBookingResponse_SingleTravelPackageResponse{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-func (m *HotelReservationResponse_AvailableRoom) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
+// GetPackageName returns the PackageName field, or its zero value if m is nil
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) GetPackageName() string {
+	if m != nil {
+		return m.PackageName
+	}
+	return ""
 }
 
-func (m *HotelReservationResponse_AvailableRoom) FromJSON(data []byte) error {
-	return json.Unmarshal(data, m)
+// GetDescription returns the Description field, or its zero value if m is nil
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-// Hotel reservation result for single location
-type HotelReservationResponse_SingleHotelReservationResponse struct {
-	// List of available rooms
-	AvailableRooms []*HotelReservationResponse_AvailableRoom `json:"availableRooms"`
-	// Error message
-	Error *Error `json:"error"`
+// GetTotalPrice returns the TotalPrice field, or its zero value if m is nil
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) GetTotalPrice() float64 {
+	if m != nil {
+		return m.TotalPrice
+	}
+	return 0
 }
 
-func NewHotelReservationResponse_SingleHotelReservationResponse() *HotelReservationResponse_SingleHotelReservationResponse {
-	return &HotelReservationResponse_SingleHotelReservationResponse{}
+// GetDurationDays returns the DurationDays field, or its zero value if m is nil
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) GetDurationDays() int32 {
+	if m != nil {
+		return m.DurationDays
+	}
+	return 0
 }
 
-func (m *HotelReservationResponse_SingleHotelReservationResponse) Validate() error {
-	// Add custom validation logic here
+// GetError returns the Error field, or its zero value if m is nil
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
 	return nil
 }
 
-func (m *HotelReservationResponse_SingleHotelReservationResponse) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
+// Clone returns a deep copy of m
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) Clone() *TravelPackageBookingResponse_SingleTravelPackageResponse {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	c.Error = m.Error.Clone()
+	return &c
 }
 
-func (m *HotelReservationResponse_SingleHotelReservationResponse) FromJSON(data []byte) error {
-	return json.Unmarshal(data, m)
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) Equal(other *TravelPackageBookingResponse_SingleTravelPackageResponse) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.PackageName != other.PackageName {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.TotalPrice != other.TotalPrice {
+		return false
+	}
+	if m.DurationDays != other.DurationDays {
+		return false
+	}
+	if !m.Error.Equal(other.Error) {
+		return false
+	}
+	return true
 }
 
-// Request for flight booking
-type FlightBookingRequest struct {
-	// Flight search criteria
-	FlightRoutes []string `json:"flightRoutes"`
-	// Required payment information
-	PaymentInfo *PaymentInfo `json:"paymentInfo"`
-	// Include hotel recommendations
-	IncludeHotelRecommendations bool `json:"includeHotelRecommendations"`
-	// Departure and return dates (Unix timestamp)
-	DepartureDate int64 `json:"departureDate"`
-	ReturnDate    int64 `json:"returnDate"`
-	// Number of passengers
-	NumberOfPassengers int32 `json:"numberOfPassengers"`
+// Field paths of TravelPackageBookingResponse_SingleTravelPackageResponse, for ApplyMask and field masks
+const (
+	TravelPackageBookingResponse_SingleTravelPackageResponse_PackageName_PATH  = "packageName"
+	TravelPackageBookingResponse_SingleTravelPackageResponse_Description_PATH  = "description"
+	TravelPackageBookingResponse_SingleTravelPackageResponse_TotalPrice_PATH   = "totalPrice"
+	TravelPackageBookingResponse_SingleTravelPackageResponse_DurationDays_PATH = "durationDays"
+	TravelPackageBookingResponse_SingleTravelPackageResponse_Error_PATH        = "error"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewTravelPackageBookingResponse_SingleTravelPackageResponse().
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) ApplyMask(src *TravelPackageBookingResponse_SingleTravelPackageResponse, paths []string) error {
+	if src == nil {
+		src = NewTravelPackageBookingResponse_SingleTravelPackageResponse()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case TravelPackageBookingResponse_SingleTravelPackageResponse_PackageName_PATH:
+			if nested {
+				return fmt.Errorf("TravelPackageBookingResponse_SingleTravelPackageResponse: field %q has no subfields", name)
+			}
+			m.PackageName = src.PackageName
+		case TravelPackageBookingResponse_SingleTravelPackageResponse_Description_PATH:
+			if nested {
+				return fmt.Errorf("TravelPackageBookingResponse_SingleTravelPackageResponse: field %q has no subfields", name)
+			}
+			m.Description = src.Description
+		case TravelPackageBookingResponse_SingleTravelPackageResponse_TotalPrice_PATH:
+			if nested {
+				return fmt.Errorf("TravelPackageBookingResponse_SingleTravelPackageResponse: field %q has no subfields", name)
+			}
+			m.TotalPrice = src.TotalPrice
+		case TravelPackageBookingResponse_SingleTravelPackageResponse_DurationDays_PATH:
+			if nested {
+				return fmt.Errorf("TravelPackageBookingResponse_SingleTravelPackageResponse: field %q has no subfields", name)
+			}
+			m.DurationDays = src.DurationDays
+		case TravelPackageBookingResponse_SingleTravelPackageResponse_Error_PATH:
+			if !nested {
+				m.Error = src.Error.Clone()
+				continue
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.ApplyMask(src.Error, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("TravelPackageBookingResponse_SingleTravelPackageResponse: unknown field path %q", path)
+		}
+	}
+	return nil
 }
 
-func NewFlightBookingRequest() *FlightBookingRequest {
-	return &FlightBookingRequest{}
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) Merge(src *TravelPackageBookingResponse_SingleTravelPackageResponse) {
+	if src == nil {
+		return
+	}
+	if src.PackageName != "" {
+		m.PackageName = src.PackageName
+	}
+	if src.Description != "" {
+		m.Description = src.Description
+	}
+	if src.TotalPrice != 0 {
+		m.TotalPrice = src.TotalPrice
+	}
+	if src.DurationDays != 0 {
+		m.DurationDays = src.DurationDays
+	}
+	if src.Error != nil {
+		if m.Error == nil {
+			m.Error = &Error{}
+		}
+		m.Error.Merge(src.Error)
+	}
 }
 
-func (m *FlightBookingRequest) Validate() error {
-	// Add custom validation logic here
-	return nil
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) ApplyFieldMask(src *TravelPackageBookingResponse_SingleTravelPackageResponse, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
 }
 
-func (m *FlightBookingRequest) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) MergeFieldMask(src *TravelPackageBookingResponse_SingleTravelPackageResponse, mask *PuregenFieldMask) error {
+	masked := &TravelPackageBookingResponse_SingleTravelPackageResponse{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
 }
 
-func (m *FlightBookingRequest) FromJSON(data []byte) error {
-	return json.Unmarshal(data, m)
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) Redacted() *TravelPackageBookingResponse_SingleTravelPackageResponse {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.Error = r.Error.Redacted()
+	return r
 }
 
-// Response for flight booking
-type FlightBookingResponse struct {
-	// List of flight bookings for each route
-	FlightBooking []*FlightBookingResponse_SingleFlightBooking `json:"FlightBooking"`
-	// Error message
-	Error *Error `json:"error"`
-	// Status of the request
-	Status string `json:"status"`
-	// Booking stats
-	BookingStats *BookingStatsResponse `json:"bookingStats"`
+// String renders m with sensitive fields redacted
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
 }
 
-func NewFlightBookingResponse() *FlightBookingResponse {
-	return &FlightBookingResponse{}
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("packageName", r.PackageName),
+		slog.Any("description", r.Description),
+		slog.Any("totalPrice", r.TotalPrice),
+		slog.Any("durationDays", r.DurationDays),
+		slog.Any("error", r.Error),
+	)
 }
 
-func (m *FlightBookingResponse) Validate() error {
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) Validate() error {
 	// Add custom validation logic here
 	return nil
 }
 
-func (m *FlightBookingResponse) ToJSON() ([]byte, error) {
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) ToJSON() ([]byte, error) {
 	return json.Marshal(m)
 }
 
-func (m *FlightBookingResponse) FromJSON(data []byte) error {
+func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
-// Response for single flight booking
-type FlightBookingResponse_SingleFlightBooking struct {
-	// Flight details
-	FlightNumber string `json:"flightNumber"`
-	// Airline name
-	Airline string `json:"airline"`
-	// Flight price
-	Price float64 `json:"price"`
-	// Departure time
-	DepartureTime int64 `json:"departureTime"`
-	// Arrival time
-	ArrivalTime int64 `json:"arrivalTime"`
-	// Error message
-	Error *Error `json:"error"`
-	// Hotel recommendations associated with the flight
-	HotelRecommendations *HotelReservationResponse_SingleHotelReservationResponse `json:"hotelRecommendations"`
+// Descriptors
+
+// PaymentInfoDescriptor describes PaymentInfo and its fields
+var PaymentInfoDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.PaymentInfo",
+	Name:     "PaymentInfo",
+	GoName:   "PaymentInfo",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "paymentMethod",
+			GoName:   "PaymentMethod",
+			JSONName: "paymentMethod",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "paymentToken",
+			GoName:   "PaymentToken",
+			JSONName: "paymentToken",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "operationType",
+			GoName:   "OperationType",
+			JSONName: "operationType",
+			Number:   3,
+			Kind:     "enum",
+			TypeName: "puregen.booking.reservations.OperationType",
+		},
+	},
+}
+
+// Descriptor returns PaymentInfoDescriptor
+func (*PaymentInfo) Descriptor() *PuregenMessageDescriptor {
+	return PaymentInfoDescriptor
+}
+
+// ErrorDescriptor describes Error and its fields
+var ErrorDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.Error",
+	Name:     "Error",
+	GoName:   "Error",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "message",
+			GoName:   "Message",
+			JSONName: "message",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "code",
+			GoName:   "Code",
+			JSONName: "code",
+			Number:   2,
+			Kind:     "string",
+		},
+	},
+}
+
+// Descriptor returns ErrorDescriptor
+func (*Error) Descriptor() *PuregenMessageDescriptor {
+	return ErrorDescriptor
+}
+
+// BookingHeaderDescriptor describes BookingHeader and its fields
+var BookingHeaderDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.BookingHeader",
+	Name:     "BookingHeader",
+	GoName:   "BookingHeader",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "userId",
+			GoName:   "UserId",
+			JSONName: "userId",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "applicationName",
+			GoName:   "ApplicationName",
+			JSONName: "applicationName",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "requestId",
+			GoName:   "RequestId",
+			JSONName: "requestId",
+			Number:   3,
+			Kind:     "string",
+		},
+		{
+			Name:     "requestTimestamp",
+			GoName:   "RequestTimestamp",
+			JSONName: "requestTimestamp",
+			Number:   4,
+			Kind:     "int64",
+		},
+	},
+}
+
+// Descriptor returns BookingHeaderDescriptor
+func (*BookingHeader) Descriptor() *PuregenMessageDescriptor {
+	return BookingHeaderDescriptor
+}
+
+// BookingOperationRequestDescriptor describes BookingOperationRequest and its fields
+var BookingOperationRequestDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.BookingOperationRequest",
+	Name:     "BookingOperationRequest",
+	GoName:   "BookingOperationRequest",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "operationId",
+			GoName:   "OperationId",
+			JSONName: "operationId",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "paymentInfo",
+			GoName:   "PaymentInfo",
+			JSONName: "paymentInfo",
+			Number:   2,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.PaymentInfo",
+		},
+		{
+			Name:     "confirm",
+			GoName:   "Confirm",
+			JSONName: "confirm",
+			Number:   3,
+			Kind:     "bool",
+		},
+	},
+}
+
+// Descriptor returns BookingOperationRequestDescriptor
+func (*BookingOperationRequest) Descriptor() *PuregenMessageDescriptor {
+	return BookingOperationRequestDescriptor
+}
+
+// BookingOperationResponseDescriptor describes BookingOperationResponse and its fields
+var BookingOperationResponseDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.BookingOperationResponse",
+	Name:     "BookingOperationResponse",
+	GoName:   "BookingOperationResponse",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "operationId",
+			GoName:   "OperationId",
+			JSONName: "operationId",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "status",
+			GoName:   "Status",
+			JSONName: "status",
+			Number:   2,
+			Kind:     "enum",
+			TypeName: "puregen.booking.reservations.BookingStatus",
+		},
+		{
+			Name:     "error",
+			GoName:   "Error",
+			JSONName: "error",
+			Number:   3,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.Error",
+		},
+	},
+}
+
+// Descriptor returns BookingOperationResponseDescriptor
+func (*BookingOperationResponse) Descriptor() *PuregenMessageDescriptor {
+	return BookingOperationResponseDescriptor
+}
+
+// ListBookingsRequestDescriptor describes ListBookingsRequest and its fields
+var ListBookingsRequestDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.ListBookingsRequest",
+	Name:     "ListBookingsRequest",
+	GoName:   "ListBookingsRequest",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "paymentInfo",
+			GoName:   "PaymentInfo",
+			JSONName: "paymentInfo",
+			Number:   1,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.PaymentInfo",
+		},
+	},
+}
+
+// Descriptor returns ListBookingsRequestDescriptor
+func (*ListBookingsRequest) Descriptor() *PuregenMessageDescriptor {
+	return ListBookingsRequestDescriptor
+}
+
+// ListBookingsResponseDescriptor describes ListBookingsResponse and its fields
+var ListBookingsResponseDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.ListBookingsResponse",
+	Name:     "ListBookingsResponse",
+	GoName:   "ListBookingsResponse",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "confirmedBookingIds",
+			GoName:   "ConfirmedBookingIds",
+			JSONName: "confirmedBookingIds",
+			Number:   1,
+			Kind:     "string",
+			Repeated: true,
+		},
+		{
+			Name:     "pendingBookingIds",
+			GoName:   "PendingBookingIds",
+			JSONName: "pendingBookingIds",
+			Number:   2,
+			Kind:     "string",
+			Repeated: true,
+		},
+		{
+			Name:     "error",
+			GoName:   "Error",
+			JSONName: "error",
+			Number:   3,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.Error",
+		},
+	},
+}
+
+// Descriptor returns ListBookingsResponseDescriptor
+func (*ListBookingsResponse) Descriptor() *PuregenMessageDescriptor {
+	return ListBookingsResponseDescriptor
+}
+
+// BookingConfirmationRequestDescriptor describes BookingConfirmationRequest and its fields
+var BookingConfirmationRequestDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.BookingConfirmationRequest",
+	Name:     "BookingConfirmationRequest",
+	GoName:   "BookingConfirmationRequest",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "bookingIds",
+			GoName:   "BookingIds",
+			JSONName: "bookingIds",
+			Number:   1,
+			Kind:     "string",
+			Repeated: true,
+		},
+		{
+			Name:     "paymentInfo",
+			GoName:   "PaymentInfo",
+			JSONName: "paymentInfo",
+			Number:   2,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.PaymentInfo",
+		},
+	},
+}
+
+// Descriptor returns BookingConfirmationRequestDescriptor
+func (*BookingConfirmationRequest) Descriptor() *PuregenMessageDescriptor {
+	return BookingConfirmationRequestDescriptor
+}
+
+// BookingStatsResponseDescriptor describes BookingStatsResponse and its fields
+var BookingStatsResponseDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.BookingStatsResponse",
+	Name:     "BookingStatsResponse",
+	GoName:   "BookingStatsResponse",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "totalAmountCharged",
+			GoName:   "TotalAmountCharged",
+			JSONName: "totalAmountCharged",
+			Number:   1,
+			Kind:     "double",
+		},
+		{
+			Name:     "totalGuests",
+			GoName:   "TotalGuests",
+			JSONName: "totalGuests",
+			Number:   2,
+			Kind:     "int32",
+		},
+		{
+			Name:     "totalBookings",
+			GoName:   "TotalBookings",
+			JSONName: "totalBookings",
+			Number:   3,
+			Kind:     "int32",
+		},
+	},
+}
+
+// Descriptor returns BookingStatsResponseDescriptor
+func (*BookingStatsResponse) Descriptor() *PuregenMessageDescriptor {
+	return BookingStatsResponseDescriptor
+}
+
+// HotelReservationRequestDescriptor describes HotelReservationRequest and its fields
+var HotelReservationRequestDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.HotelReservationRequest",
+	Name:     "HotelReservationRequest",
+	GoName:   "HotelReservationRequest",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "hotelLocations",
+			GoName:   "HotelLocations",
+			JSONName: "hotelLocations",
+			Number:   1,
+			Kind:     "string",
+			Repeated: true,
+		},
+		{
+			Name:     "roomTypes",
+			GoName:   "RoomTypes",
+			JSONName: "roomTypes",
+			Number:   2,
+			Kind:     "enum",
+			TypeName: "puregen.booking.reservations.HotelReservationRequest.RoomType",
+			Repeated: true,
+		},
+		{
+			Name:     "maxPricePerNight",
+			GoName:   "MaxPricePerNight",
+			JSONName: "maxPricePerNight",
+			Number:   3,
+			Kind:     "double",
+		},
+		{
+			Name:     "paymentInfo",
+			GoName:   "PaymentInfo",
+			JSONName: "paymentInfo",
+			Number:   4,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.PaymentInfo",
+		},
+		{
+			Name:     "checkInDate",
+			GoName:   "CheckInDate",
+			JSONName: "checkInDate",
+			Number:   5,
+			Kind:     "int64",
+		},
+		{
+			Name:     "checkOutDate",
+			GoName:   "CheckOutDate",
+			JSONName: "checkOutDate",
+			Number:   6,
+			Kind:     "int64",
+		},
+		{
+			Name:     "numberOfGuests",
+			GoName:   "NumberOfGuests",
+			JSONName: "numberOfGuests",
+			Number:   7,
+			Kind:     "int32",
+		},
+	},
+}
+
+// Descriptor returns HotelReservationRequestDescriptor
+func (*HotelReservationRequest) Descriptor() *PuregenMessageDescriptor {
+	return HotelReservationRequestDescriptor
+}
+
+// HotelReservationResponseDescriptor describes HotelReservationResponse and its fields
+var HotelReservationResponseDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.HotelReservationResponse",
+	Name:     "HotelReservationResponse",
+	GoName:   "HotelReservationResponse",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "result",
+			GoName:   "Result",
+			JSONName: "result",
+			Number:   2,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.HotelReservationResponse.SingleHotelReservationResponse",
+			Repeated: true,
+		},
+		{
+			Name:     "status",
+			GoName:   "Status",
+			JSONName: "status",
+			Number:   3,
+			Kind:     "enum",
+			TypeName: "puregen.booking.reservations.BookingStatus",
+		},
+		{
+			Name:     "error",
+			GoName:   "Error",
+			JSONName: "error",
+			Number:   4,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.Error",
+		},
+		{
+			Name:     "bookingStats",
+			GoName:   "BookingStats",
+			JSONName: "bookingStats",
+			Number:   5,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.BookingStatsResponse",
+		},
+	},
+}
+
+// Descriptor returns HotelReservationResponseDescriptor
+func (*HotelReservationResponse) Descriptor() *PuregenMessageDescriptor {
+	return HotelReservationResponseDescriptor
+}
+
+// HotelReservationResponse_HotelDescriptor describes HotelReservationResponse_Hotel and its fields
+var HotelReservationResponse_HotelDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.HotelReservationResponse.Hotel",
+	Name:     "Hotel",
+	GoName:   "HotelReservationResponse_Hotel",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "name",
+			GoName:   "Name",
+			JSONName: "name",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "rating",
+			GoName:   "Rating",
+			JSONName: "rating",
+			Number:   2,
+			Kind:     "double",
+		},
+		{
+			Name:     "pricePerNight",
+			GoName:   "PricePerNight",
+			JSONName: "pricePerNight",
+			Number:   3,
+			Kind:     "double",
+		},
+		{
+			Name:     "address",
+			GoName:   "Address",
+			JSONName: "address",
+			Number:   4,
+			Kind:     "string",
+		},
+	},
+}
+
+// Descriptor returns HotelReservationResponse_HotelDescriptor
+func (*HotelReservationResponse_Hotel) Descriptor() *PuregenMessageDescriptor {
+	return HotelReservationResponse_HotelDescriptor
+}
+
+// HotelReservationResponse_AvailableRoomDescriptor describes HotelReservationResponse_AvailableRoom and its fields
+var HotelReservationResponse_AvailableRoomDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.HotelReservationResponse.AvailableRoom",
+	Name:     "AvailableRoom",
+	GoName:   "HotelReservationResponse_AvailableRoom",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "hotel",
+			GoName:   "Hotel",
+			JSONName: "hotel",
+			Number:   1,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.HotelReservationResponse.Hotel",
+		},
+		{
+			Name:     "roomType",
+			GoName:   "RoomType",
+			JSONName: "roomType",
+			Number:   2,
+			Kind:     "enum",
+			TypeName: "puregen.booking.reservations.HotelReservationRequest.RoomType",
+		},
+		{
+			Name:     "availableRooms",
+			GoName:   "AvailableRooms",
+			JSONName: "availableRooms",
+			Number:   3,
+			Kind:     "int32",
+		},
+	},
+}
+
+// Descriptor returns HotelReservationResponse_AvailableRoomDescriptor
+func (*HotelReservationResponse_AvailableRoom) Descriptor() *PuregenMessageDescriptor {
+	return HotelReservationResponse_AvailableRoomDescriptor
+}
+
+// HotelReservationResponse_SingleHotelReservationResponseDescriptor describes HotelReservationResponse_SingleHotelReservationResponse and its fields
+var HotelReservationResponse_SingleHotelReservationResponseDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.HotelReservationResponse.SingleHotelReservationResponse",
+	Name:     "SingleHotelReservationResponse",
+	GoName:   "HotelReservationResponse_SingleHotelReservationResponse",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "availableRooms",
+			GoName:   "AvailableRooms",
+			JSONName: "availableRooms",
+			Number:   1,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.HotelReservationResponse.AvailableRoom",
+			Repeated: true,
+		},
+		{
+			Name:     "error",
+			GoName:   "Error",
+			JSONName: "error",
+			Number:   2,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.Error",
+		},
+	},
+}
+
+// Descriptor returns HotelReservationResponse_SingleHotelReservationResponseDescriptor
+func (*HotelReservationResponse_SingleHotelReservationResponse) Descriptor() *PuregenMessageDescriptor {
+	return HotelReservationResponse_SingleHotelReservationResponseDescriptor
+}
+
+// FlightBookingRequestDescriptor describes FlightBookingRequest and its fields
+var FlightBookingRequestDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.FlightBookingRequest",
+	Name:     "FlightBookingRequest",
+	GoName:   "FlightBookingRequest",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "flightRoutes",
+			GoName:   "FlightRoutes",
+			JSONName: "flightRoutes",
+			Number:   1,
+			Kind:     "string",
+			Repeated: true,
+		},
+		{
+			Name:     "paymentInfo",
+			GoName:   "PaymentInfo",
+			JSONName: "paymentInfo",
+			Number:   2,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.PaymentInfo",
+		},
+		{
+			Name:     "includeHotelRecommendations",
+			GoName:   "IncludeHotelRecommendations",
+			JSONName: "includeHotelRecommendations",
+			Number:   3,
+			Kind:     "bool",
+		},
+		{
+			Name:     "departureDate",
+			GoName:   "DepartureDate",
+			JSONName: "departureDate",
+			Number:   4,
+			Kind:     "int64",
+		},
+		{
+			Name:     "returnDate",
+			GoName:   "ReturnDate",
+			JSONName: "returnDate",
+			Number:   5,
+			Kind:     "int64",
+		},
+		{
+			Name:     "numberOfPassengers",
+			GoName:   "NumberOfPassengers",
+			JSONName: "numberOfPassengers",
+			Number:   6,
+			Kind:     "int32",
+		},
+	},
+}
+
+// Descriptor returns FlightBookingRequestDescriptor
+func (*FlightBookingRequest) Descriptor() *PuregenMessageDescriptor {
+	return FlightBookingRequestDescriptor
+}
+
+// FlightBookingResponseDescriptor describes FlightBookingResponse and its fields
+var FlightBookingResponseDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.FlightBookingResponse",
+	Name:     "FlightBookingResponse",
+	GoName:   "FlightBookingResponse",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "FlightBooking",
+			GoName:   "FlightBooking",
+			JSONName: "FlightBooking",
+			Number:   1,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.FlightBookingResponse.SingleFlightBooking",
+			Repeated: true,
+		},
+		{
+			Name:     "error",
+			GoName:   "Error",
+			JSONName: "error",
+			Number:   3,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.Error",
+		},
+		{
+			Name:     "status",
+			GoName:   "Status",
+			JSONName: "status",
+			Number:   4,
+			Kind:     "enum",
+			TypeName: "puregen.booking.reservations.BookingStatus",
+		},
+		{
+			Name:     "bookingStats",
+			GoName:   "BookingStats",
+			JSONName: "bookingStats",
+			Number:   5,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.BookingStatsResponse",
+		},
+	},
+}
+
+// Descriptor returns FlightBookingResponseDescriptor
+func (*FlightBookingResponse) Descriptor() *PuregenMessageDescriptor {
+	return FlightBookingResponseDescriptor
+}
+
+// FlightBookingResponse_SingleFlightBookingDescriptor describes FlightBookingResponse_SingleFlightBooking and its fields
+var FlightBookingResponse_SingleFlightBookingDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.FlightBookingResponse.SingleFlightBooking",
+	Name:     "SingleFlightBooking",
+	GoName:   "FlightBookingResponse_SingleFlightBooking",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "flightNumber",
+			GoName:   "FlightNumber",
+			JSONName: "flightNumber",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "airline",
+			GoName:   "Airline",
+			JSONName: "airline",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "price",
+			GoName:   "Price",
+			JSONName: "price",
+			Number:   3,
+			Kind:     "double",
+		},
+		{
+			Name:     "departureTime",
+			GoName:   "DepartureTime",
+			JSONName: "departureTime",
+			Number:   4,
+			Kind:     "int64",
+		},
+		{
+			Name:     "arrivalTime",
+			GoName:   "ArrivalTime",
+			JSONName: "arrivalTime",
+			Number:   5,
+			Kind:     "int64",
+		},
+		{
+			Name:     "error",
+			GoName:   "Error",
+			JSONName: "error",
+			Number:   6,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.Error",
+		},
+		{
+			Name:     "hotelRecommendations",
+			GoName:   "HotelRecommendations",
+			JSONName: "hotelRecommendations",
+			Number:   7,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.HotelReservationResponse.SingleHotelReservationResponse",
+		},
+	},
+}
+
+// Descriptor returns FlightBookingResponse_SingleFlightBookingDescriptor
+func (*FlightBookingResponse_SingleFlightBooking) Descriptor() *PuregenMessageDescriptor {
+	return FlightBookingResponse_SingleFlightBookingDescriptor
+}
+
+// TravelPackageBookingRequestDescriptor describes TravelPackageBookingRequest and its fields
+var TravelPackageBookingRequestDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.TravelPackageBookingRequest",
+	Name:     "TravelPackageBookingRequest",
+	GoName:   "TravelPackageBookingRequest",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "destinations",
+			GoName:   "Destinations",
+			JSONName: "destinations",
+			Number:   1,
+			Kind:     "string",
+			Repeated: true,
+		},
+		{
+			Name:     "paymentInfo",
+			GoName:   "PaymentInfo",
+			JSONName: "paymentInfo",
+			Number:   2,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.PaymentInfo",
+		},
+	},
+}
+
+// Descriptor returns TravelPackageBookingRequestDescriptor
+func (*TravelPackageBookingRequest) Descriptor() *PuregenMessageDescriptor {
+	return TravelPackageBookingRequestDescriptor
+}
+
+// TravelPackageBookingResponseDescriptor describes TravelPackageBookingResponse and its fields
+var TravelPackageBookingResponseDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.TravelPackageBookingResponse",
+	Name:     "TravelPackageBookingResponse",
+	GoName:   "TravelPackageBookingResponse",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "travelPackages",
+			GoName:   "TravelPackages",
+			JSONName: "travelPackages",
+			Number:   1,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.TravelPackageBookingResponse.SingleTravelPackageResponse",
+			Repeated: true,
+		},
+		{
+			Name:     "error",
+			GoName:   "Error",
+			JSONName: "error",
+			Number:   3,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.Error",
+		},
+		{
+			Name:     "status",
+			GoName:   "Status",
+			JSONName: "status",
+			Number:   4,
+			Kind:     "enum",
+			TypeName: "puregen.booking.reservations.BookingStatus",
+		},
+		{
+			Name:     "bookingStats",
+			GoName:   "BookingStats",
+			JSONName: "bookingStats",
+			Number:   5,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.BookingStatsResponse",
+		},
+	},
+}
+
+// Descriptor returns TravelPackageBookingResponseDescriptor
+func (*TravelPackageBookingResponse) Descriptor() *PuregenMessageDescriptor {
+	return TravelPackageBookingResponseDescriptor
+}
+
+// TravelPackageBookingResponse_SingleTravelPackageResponseDescriptor describes TravelPackageBookingResponse_SingleTravelPackageResponse and its fields
+var TravelPackageBookingResponse_SingleTravelPackageResponseDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.booking.reservations.TravelPackageBookingResponse.SingleTravelPackageResponse",
+	Name:     "SingleTravelPackageResponse",
+	GoName:   "TravelPackageBookingResponse_SingleTravelPackageResponse",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "packageName",
+			GoName:   "PackageName",
+			JSONName: "packageName",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "description",
+			GoName:   "Description",
+			JSONName: "description",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "totalPrice",
+			GoName:   "TotalPrice",
+			JSONName: "totalPrice",
+			Number:   3,
+			Kind:     "double",
+		},
+		{
+			Name:     "durationDays",
+			GoName:   "DurationDays",
+			JSONName: "durationDays",
+			Number:   4,
+			Kind:     "int32",
+		},
+		{
+			Name:     "error",
+			GoName:   "Error",
+			JSONName: "error",
+			Number:   5,
+			Kind:     "message",
+			TypeName: "puregen.booking.reservations.Error",
+		},
+	},
+}
+
+// Descriptor returns TravelPackageBookingResponse_SingleTravelPackageResponseDescriptor
+func (*TravelPackageBookingResponse_SingleTravelPackageResponse) Descriptor() *PuregenMessageDescriptor {
+	return TravelPackageBookingResponse_SingleTravelPackageResponseDescriptor
+}
+
+func init() {
+	registerMessageDescriptors(PaymentInfoDescriptor, ErrorDescriptor, BookingHeaderDescriptor, BookingOperationRequestDescriptor, BookingOperationResponseDescriptor, ListBookingsRequestDescriptor, ListBookingsResponseDescriptor, BookingConfirmationRequestDescriptor, BookingStatsResponseDescriptor, HotelReservationRequestDescriptor, HotelReservationResponseDescriptor, HotelReservationResponse_HotelDescriptor, HotelReservationResponse_AvailableRoomDescriptor, HotelReservationResponse_SingleHotelReservationResponseDescriptor, FlightBookingRequestDescriptor, FlightBookingResponseDescriptor, FlightBookingResponse_SingleFlightBookingDescriptor, TravelPackageBookingRequestDescriptor, TravelPackageBookingResponseDescriptor, TravelPackageBookingResponse_SingleTravelPackageResponseDescriptor)
 }
 
-func NewFlightBookingResponse_SingleFlightBooking() *FlightBookingResponse_SingleFlightBooking {
-	return &FlightBookingResponse_SingleFlightBooking{}
+// Message types
+
+// ProtoName returns the full proto name of PaymentInfo
+func (*PaymentInfo) ProtoName() string {
+	return "puregen.booking.reservations.PaymentInfo"
 }
 
-func (m *FlightBookingResponse_SingleFlightBooking) Validate() error {
-	// Add custom validation logic here
-	return nil
+// ProtoName returns the full proto name of Error
+func (*Error) ProtoName() string {
+	return "puregen.booking.reservations.Error"
 }
 
-func (m *FlightBookingResponse_SingleFlightBooking) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
+// ProtoName returns the full proto name of BookingHeader
+func (*BookingHeader) ProtoName() string {
+	return "puregen.booking.reservations.BookingHeader"
 }
 
-func (m *FlightBookingResponse_SingleFlightBooking) FromJSON(data []byte) error {
-	return json.Unmarshal(data, m)
+// ProtoName returns the full proto name of BookingOperationRequest
+func (*BookingOperationRequest) ProtoName() string {
+	return "puregen.booking.reservations.BookingOperationRequest"
 }
 
-// Request for travel package booking
-type TravelPackageBookingRequest struct {
-	// Travel destinations
-	Destinations []string `json:"destinations"`
-	// Required payment information
-	PaymentInfo *PaymentInfo `json:"paymentInfo"`
+// ProtoName returns the full proto name of BookingOperationResponse
+func (*BookingOperationResponse) ProtoName() string {
+	return "puregen.booking.reservations.BookingOperationResponse"
 }
 
-func NewTravelPackageBookingRequest() *TravelPackageBookingRequest {
-	return &TravelPackageBookingRequest{}
+// ProtoName returns the full proto name of ListBookingsRequest
+func (*ListBookingsRequest) ProtoName() string {
+	return "puregen.booking.reservations.ListBookingsRequest"
 }
 
-func (m *TravelPackageBookingRequest) Validate() error {
-	// Add custom validation logic here
-	return nil
+// ProtoName returns the full proto name of ListBookingsResponse
+func (*ListBookingsResponse) ProtoName() string {
+	return "puregen.booking.reservations.ListBookingsResponse"
 }
 
-func (m *TravelPackageBookingRequest) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
+// ProtoName returns the full proto name of BookingConfirmationRequest
+func (*BookingConfirmationRequest) ProtoName() string {
+	return "puregen.booking.reservations.BookingConfirmationRequest"
 }
 
-func (m *TravelPackageBookingRequest) FromJSON(data []byte) error {
-	return json.Unmarshal(data, m)
+// ProtoName returns the full proto name of BookingStatsResponse
+func (*BookingStatsResponse) ProtoName() string {
+	return "puregen.booking.reservations.BookingStatsResponse"
 }
 
-// Response for travel package booking
-type TravelPackageBookingResponse struct {
-	// List of travel packages for each destination
-	TravelPackages []*TravelPackageBookingResponse_SingleTravelPackageResponse `json:"travelPackages"`
-	// Error message
-	Error *Error `json:"error"`
-	// Status of the request
-	Status string `json:"status"`
-	// Booking stats
-	BookingStats *BookingStatsResponse `json:"bookingStats"`
+// ProtoName returns the full proto name of HotelReservationRequest
+func (*HotelReservationRequest) ProtoName() string {
+	return "puregen.booking.reservations.HotelReservationRequest"
 }
 
-func NewTravelPackageBookingResponse() *TravelPackageBookingResponse {
-	return &TravelPackageBookingResponse{}
+// ProtoName returns the full proto name of HotelReservationResponse
+func (*HotelReservationResponse) ProtoName() string {
+	return "puregen.booking.reservations.HotelReservationResponse"
 }
 
-func (m *TravelPackageBookingResponse) Validate() error {
-	// Add custom validation logic here
-	return nil
+// ProtoName returns the full proto name of HotelReservationResponse_Hotel
+func (*HotelReservationResponse_Hotel) ProtoName() string {
+	return "puregen.booking.reservations.HotelReservationResponse.Hotel"
 }
 
-func (m *TravelPackageBookingResponse) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
+// ProtoName returns the full proto name of HotelReservationResponse_AvailableRoom
+func (*HotelReservationResponse_AvailableRoom) ProtoName() string {
+	return "puregen.booking.reservations.HotelReservationResponse.AvailableRoom"
 }
 
-func (m *TravelPackageBookingResponse) FromJSON(data []byte) error {
-	return json.Unmarshal(data, m)
+// ProtoName returns the full proto name of HotelReservationResponse_SingleHotelReservationResponse
+func (*HotelReservationResponse_SingleHotelReservationResponse) ProtoName() string {
+	return "puregen.booking.reservations.HotelReservationResponse.SingleHotelReservationResponse"
 }
 
-// Response for single travel package
-type TravelPackageBookingResponse_SingleTravelPackageResponse struct {
-	// Package name
-	PackageName string `json:"packageName"`
-	// Package description
-	Description string `json:"description"`
-	// Total package price
-	TotalPrice float64 `json:"totalPrice"`
-	// Package duration in days
-	DurationDays int32 `json:"durationDays"`
-	// Error message
-	Error *Error `json:"error"`
+// ProtoName returns the full proto name of FlightBookingRequest
+func (*FlightBookingRequest) ProtoName() string {
+	return "puregen.booking.reservations.FlightBookingRequest"
 }
 
-func NewTravelPackageBookingResponse_SingleTravelPackageResponse() *TravelPackageBookingResponse_SingleTravelPackageResponse {
-	return &TravelPackageBookingResponse_SingleTravelPackageResponse{}
+// ProtoName returns the full proto name of FlightBookingResponse
+func (*FlightBookingResponse) ProtoName() string {
+	return "puregen.booking.reservations.FlightBookingResponse"
 }
 
-func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) Validate() error {
-	// Add custom validation logic here
-	return nil
+// ProtoName returns the full proto name of FlightBookingResponse_SingleFlightBooking
+func (*FlightBookingResponse_SingleFlightBooking) ProtoName() string {
+	return "puregen.booking.reservations.FlightBookingResponse.SingleFlightBooking"
 }
 
-func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) ToJSON() ([]byte, error) {
-	return json.Marshal(m)
+// ProtoName returns the full proto name of TravelPackageBookingRequest
+func (*TravelPackageBookingRequest) ProtoName() string {
+	return "puregen.booking.reservations.TravelPackageBookingRequest"
 }
 
-func (m *TravelPackageBookingResponse_SingleTravelPackageResponse) FromJSON(data []byte) error {
-	return json.Unmarshal(data, m)
+// ProtoName returns the full proto name of TravelPackageBookingResponse
+func (*TravelPackageBookingResponse) ProtoName() string {
+	return "puregen.booking.reservations.TravelPackageBookingResponse"
+}
+
+// ProtoName returns the full proto name of TravelPackageBookingResponse_SingleTravelPackageResponse
+func (*TravelPackageBookingResponse_SingleTravelPackageResponse) ProtoName() string {
+	return "puregen.booking.reservations.TravelPackageBookingResponse.SingleTravelPackageResponse"
+}
+
+func init() {
+	registerMessageTypes(map[string]func() PuregenMessage{
+		"puregen.booking.reservations.PaymentInfo":                                              func() PuregenMessage { return NewPaymentInfo() },
+		"puregen.booking.reservations.Error":                                                    func() PuregenMessage { return NewError() },
+		"puregen.booking.reservations.BookingHeader":                                            func() PuregenMessage { return NewBookingHeader() },
+		"puregen.booking.reservations.BookingOperationRequest":                                  func() PuregenMessage { return NewBookingOperationRequest() },
+		"puregen.booking.reservations.BookingOperationResponse":                                 func() PuregenMessage { return NewBookingOperationResponse() },
+		"puregen.booking.reservations.ListBookingsRequest":                                      func() PuregenMessage { return NewListBookingsRequest() },
+		"puregen.booking.reservations.ListBookingsResponse":                                     func() PuregenMessage { return NewListBookingsResponse() },
+		"puregen.booking.reservations.BookingConfirmationRequest":                               func() PuregenMessage { return NewBookingConfirmationRequest() },
+		"puregen.booking.reservations.BookingStatsResponse":                                     func() PuregenMessage { return NewBookingStatsResponse() },
+		"puregen.booking.reservations.HotelReservationRequest":                                  func() PuregenMessage { return NewHotelReservationRequest() },
+		"puregen.booking.reservations.HotelReservationResponse":                                 func() PuregenMessage { return NewHotelReservationResponse() },
+		"puregen.booking.reservations.HotelReservationResponse.Hotel":                           func() PuregenMessage { return NewHotelReservationResponse_Hotel() },
+		"puregen.booking.reservations.HotelReservationResponse.AvailableRoom":                   func() PuregenMessage { return NewHotelReservationResponse_AvailableRoom() },
+		"puregen.booking.reservations.HotelReservationResponse.SingleHotelReservationResponse":  func() PuregenMessage { return NewHotelReservationResponse_SingleHotelReservationResponse() },
+		"puregen.booking.reservations.FlightBookingRequest":                                     func() PuregenMessage { return NewFlightBookingRequest() },
+		"puregen.booking.reservations.FlightBookingResponse":                                    func() PuregenMessage { return NewFlightBookingResponse() },
+		"puregen.booking.reservations.FlightBookingResponse.SingleFlightBooking":                func() PuregenMessage { return NewFlightBookingResponse_SingleFlightBooking() },
+		"puregen.booking.reservations.TravelPackageBookingRequest":                              func() PuregenMessage { return NewTravelPackageBookingRequest() },
+		"puregen.booking.reservations.TravelPackageBookingResponse":                             func() PuregenMessage { return NewTravelPackageBookingResponse() },
+		"puregen.booking.reservations.TravelPackageBookingResponse.SingleTravelPackageResponse": func() PuregenMessage { return NewTravelPackageBookingResponse_SingleTravelPackageResponse() },
+	})
 }
 
 // Services
@@ -760,7 +6125,7 @@ const (
 	BookingService_GetTravelPackageBookingResult = "BookingService_GetTravelPackageBookingResult"
 )
 
-var BookingServiceMethodMetadata = map[string]map[string]string{}
+var BookingServiceMethodMetadata = map[string]map[string]any{}
 
 // Client
 
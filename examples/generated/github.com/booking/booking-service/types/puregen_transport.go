@@ -4,7 +4,7 @@
 package types
 
 import (
-	"context"
+	context "context"
 )
 
 // PuregenTransport defines the interface for client communication
@@ -3,7 +3,10 @@
 package errorv1
 
 import (
-	"encoding/json"
+	json "encoding/json"
+	fmt "fmt"
+	slog "log/slog"
+	strings "strings"
 )
 
 // Messages
@@ -17,8 +20,194 @@ type Error struct {
 	Details string `json:"details"`
 }
 
-func NewError() *Error {
-	return &Error{}
+// ErrorOption sets a field of a new Error
+type ErrorOption func(*Error)
+
+// WithErrorCode sets the Code field
+func WithErrorCode(value int32) ErrorOption {
+	return func(m *Error) {
+		m.Code = value
+	}
+}
+
+// WithErrorMessage sets the Message field
+func WithErrorMessage(value string) ErrorOption {
+	return func(m *Error) {
+		m.Message = value
+	}
+}
+
+// WithErrorDetails sets the Details field
+func WithErrorDetails(value string) ErrorOption {
+	return func(m *Error) {
+		m.Details = value
+	}
+}
+
+func NewError(opts ...ErrorOption) *Error {
+	m := &Error{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetCode returns the Code field, or its zero value if m is nil
+func (m *Error) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+// GetMessage returns the Message field, or its zero value if m is nil
+func (m *Error) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// GetDetails returns the Details field, or its zero value if m is nil
+func (m *Error) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+// Clone returns a deep copy of m
+func (m *Error) Clone() *Error {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *Error) Equal(other *Error) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Code != other.Code {
+		return false
+	}
+	if m.Message != other.Message {
+		return false
+	}
+	if m.Details != other.Details {
+		return false
+	}
+	return true
+}
+
+// Field paths of Error, for ApplyMask and field masks
+const (
+	Error_Code_PATH    = "code"
+	Error_Message_PATH = "message"
+	Error_Details_PATH = "details"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewError().
+func (m *Error) ApplyMask(src *Error, paths []string) error {
+	if src == nil {
+		src = NewError()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case Error_Code_PATH:
+			if nested {
+				return fmt.Errorf("Error: field %q has no subfields", name)
+			}
+			m.Code = src.Code
+		case Error_Message_PATH:
+			if nested {
+				return fmt.Errorf("Error: field %q has no subfields", name)
+			}
+			m.Message = src.Message
+		case Error_Details_PATH:
+			if nested {
+				return fmt.Errorf("Error: field %q has no subfields", name)
+			}
+			m.Details = src.Details
+		default:
+			return fmt.Errorf("Error: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *Error) Merge(src *Error) {
+	if src == nil {
+		return
+	}
+	if src.Code != 0 {
+		m.Code = src.Code
+	}
+	if src.Message != "" {
+		m.Message = src.Message
+	}
+	if src.Details != "" {
+		m.Details = src.Details
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *Error) ApplyFieldMask(src *Error, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *Error) MergeFieldMask(src *Error, mask *PuregenFieldMask) error {
+	masked := &Error{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *Error) Redacted() *Error {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *Error) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *Error) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("code", r.Code),
+		slog.Any("message", r.Message),
+		slog.Any("details", r.Details),
+	)
 }
 
 func (m *Error) Validate() error {
@@ -33,3 +222,57 @@ func (m *Error) ToJSON() ([]byte, error) {
 func (m *Error) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
+
+// Descriptors
+
+// ErrorDescriptor describes Error and its fields
+var ErrorDescriptor = &PuregenMessageDescriptor{
+	FullName: "company.examples.proto.error.v1.Error",
+	Name:     "Error",
+	GoName:   "Error",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "code",
+			GoName:   "Code",
+			JSONName: "code",
+			Number:   1,
+			Kind:     "int32",
+		},
+		{
+			Name:     "message",
+			GoName:   "Message",
+			JSONName: "message",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "details",
+			GoName:   "Details",
+			JSONName: "details",
+			Number:   3,
+			Kind:     "string",
+		},
+	},
+}
+
+// Descriptor returns ErrorDescriptor
+func (*Error) Descriptor() *PuregenMessageDescriptor {
+	return ErrorDescriptor
+}
+
+func init() {
+	registerMessageDescriptors(ErrorDescriptor)
+}
+
+// Message types
+
+// ProtoName returns the full proto name of Error
+func (*Error) ProtoName() string {
+	return "company.examples.proto.error.v1.Error"
+}
+
+func init() {
+	registerMessageTypes(map[string]func() PuregenMessage{
+		"company.examples.proto.error.v1.Error": func() PuregenMessage { return NewError() },
+	})
+}
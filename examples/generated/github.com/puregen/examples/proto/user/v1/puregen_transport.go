@@ -4,7 +4,7 @@
 package userv1
 
 import (
-	"context"
+	context "context"
 )
 
 // PuregenTransport defines the interface for client communication
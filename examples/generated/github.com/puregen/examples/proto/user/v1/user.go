@@ -3,25 +3,321 @@
 package userv1
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
+	context "context"
+	json "encoding/json"
+	fmt "fmt"
+	slog "log/slog"
+	strings "strings"
 )
 
 // Messages
 
 // User message represents a user in the system
 type User struct {
-	Id       int32        `json:"id"`
-	Name     string       `json:"name"`
+	Id   int32  `json:"id"`
+	Name string `json:"name"`
+	//
 	Email    string       `json:"email"`
 	IsActive bool         `json:"isActive"`
 	Tags     []string     `json:"tags"`
 	Profile  *UserProfile `json:"profile"`
 }
 
-func NewUser() *User {
-	return &User{}
+// UserOption sets a field of a new User
+type UserOption func(*User)
+
+// WithUserId sets the Id field
+func WithUserId(value int32) UserOption {
+	return func(m *User) {
+		m.Id = value
+	}
+}
+
+// WithUserName sets the Name field
+func WithUserName(value string) UserOption {
+	return func(m *User) {
+		m.Name = value
+	}
+}
+
+// WithUserEmail sets the Email field
+func WithUserEmail(value string) UserOption {
+	return func(m *User) {
+		m.Email = value
+	}
+}
+
+// WithUserIsActive sets the IsActive field
+func WithUserIsActive(value bool) UserOption {
+	return func(m *User) {
+		m.IsActive = value
+	}
+}
+
+// WithUserTags sets the Tags field
+func WithUserTags(value []string) UserOption {
+	return func(m *User) {
+		m.Tags = value
+	}
+}
+
+// WithUserProfile sets the Profile field
+func WithUserProfile(value *UserProfile) UserOption {
+	return func(m *User) {
+		m.Profile = value
+	}
+}
+
+func NewUser(opts ...UserOption) *User {
+	m := &User{
+		Email: "",
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetId returns the Id field, or its zero value if m is nil
+func (m *User) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// GetName returns the Name field, or its zero value if m is nil
+func (m *User) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// GetEmail returns the Email field, or its zero value if m is nil
+func (m *User) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+// GetIsActive returns the IsActive field, or its zero value if m is nil
+func (m *User) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+// GetTags returns the Tags field, or its zero value if m is nil
+func (m *User) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+// GetProfile returns the Profile field, or its zero value if m is nil
+func (m *User) GetProfile() *UserProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *User) Clone() *User {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	if m.Tags != nil {
+		c.Tags = make([]string, len(m.Tags))
+		copy(c.Tags, m.Tags)
+	}
+	c.Profile = m.Profile.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *User) Equal(other *User) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Email != other.Email {
+		return false
+	}
+	if m.IsActive != other.IsActive {
+		return false
+	}
+	if len(m.Tags) != len(other.Tags) {
+		return false
+	}
+	for i := range m.Tags {
+		if m.Tags[i] != other.Tags[i] {
+			return false
+		}
+	}
+	if !m.Profile.Equal(other.Profile) {
+		return false
+	}
+	return true
+}
+
+// Field paths of User, for ApplyMask and field masks
+const (
+	User_Id_PATH       = "id"
+	User_Name_PATH     = "name"
+	User_Email_PATH    = "email"
+	User_IsActive_PATH = "is_active"
+	User_Tags_PATH     = "tags"
+	User_Profile_PATH  = "profile"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewUser().
+func (m *User) ApplyMask(src *User, paths []string) error {
+	if src == nil {
+		src = NewUser()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case User_Id_PATH:
+			if nested {
+				return fmt.Errorf("User: field %q has no subfields", name)
+			}
+			m.Id = src.Id
+		case User_Name_PATH:
+			if nested {
+				return fmt.Errorf("User: field %q has no subfields", name)
+			}
+			m.Name = src.Name
+		case User_Email_PATH:
+			if nested {
+				return fmt.Errorf("User: field %q has no subfields", name)
+			}
+			m.Email = src.Email
+		case User_IsActive_PATH, "isActive":
+			if nested {
+				return fmt.Errorf("User: field %q has no subfields", name)
+			}
+			m.IsActive = src.IsActive
+		case User_Tags_PATH:
+			if nested {
+				return fmt.Errorf("User: field %q has no subfields", name)
+			}
+			m.Tags = append([]string(nil), src.Tags...)
+		case User_Profile_PATH:
+			if !nested {
+				m.Profile = src.Profile.Clone()
+				continue
+			}
+			if m.Profile == nil {
+				m.Profile = &UserProfile{}
+			}
+			if err := m.Profile.ApplyMask(src.Profile, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("User: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *User) Merge(src *User) {
+	if src == nil {
+		return
+	}
+	if src.Id != 0 {
+		m.Id = src.Id
+	}
+	if src.Name != "" {
+		m.Name = src.Name
+	}
+	if src.Email != "" {
+		m.Email = src.Email
+	}
+	if src.IsActive {
+		m.IsActive = true
+	}
+	m.Tags = append(m.Tags, src.Tags...)
+	if src.Profile != nil {
+		if m.Profile == nil {
+			m.Profile = &UserProfile{}
+		}
+		m.Profile.Merge(src.Profile)
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *User) ApplyFieldMask(src *User, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *User) MergeFieldMask(src *User, mask *PuregenFieldMask) error {
+	masked := &User{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *User) Redacted() *User {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	if r.Email != "" {
+		r.Email = "[REDACTED]"
+	}
+	r.Profile = r.Profile.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *User) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *User) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("id", r.Id),
+		slog.Any("name", r.Name),
+		slog.Any("email", r.Email),
+		slog.Any("isActive", r.IsActive),
+		slog.Any("tags", r.Tags),
+		slog.Any("profile", r.Profile),
+	)
 }
 
 func (m *User) Validate() error {
@@ -37,6 +333,31 @@ func (m *User) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
+// UnmarshalJSON accepts both the proto field names and the JSON names of the fields
+func (m *User) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for alias, name := range map[string]string{
+		"is_active": "isActive",
+	} {
+		if value, ok := fields[alias]; ok {
+			delete(fields, alias)
+			if _, ok := fields[name]; !ok {
+				fields[name] = value
+			}
+		}
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	// plain has the fields of User but not this method
+	type plain User
+	return json.Unmarshal(data, (*plain)(m))
+}
+
 // UserProfile contains additional user information
 type UserProfile struct {
 	Bio       string `json:"bio"`
@@ -44,8 +365,194 @@ type UserProfile struct {
 	CreatedAt int64  `json:"createdAt"`
 }
 
-func NewUserProfile() *UserProfile {
-	return &UserProfile{}
+// UserProfileOption sets a field of a new UserProfile
+type UserProfileOption func(*UserProfile)
+
+// WithUserProfileBio sets the Bio field
+func WithUserProfileBio(value string) UserProfileOption {
+	return func(m *UserProfile) {
+		m.Bio = value
+	}
+}
+
+// WithUserProfileAvatarUrl sets the AvatarUrl field
+func WithUserProfileAvatarUrl(value string) UserProfileOption {
+	return func(m *UserProfile) {
+		m.AvatarUrl = value
+	}
+}
+
+// WithUserProfileCreatedAt sets the CreatedAt field
+func WithUserProfileCreatedAt(value int64) UserProfileOption {
+	return func(m *UserProfile) {
+		m.CreatedAt = value
+	}
+}
+
+func NewUserProfile(opts ...UserProfileOption) *UserProfile {
+	m := &UserProfile{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetBio returns the Bio field, or its zero value if m is nil
+func (m *UserProfile) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+// GetAvatarUrl returns the AvatarUrl field, or its zero value if m is nil
+func (m *UserProfile) GetAvatarUrl() string {
+	if m != nil {
+		return m.AvatarUrl
+	}
+	return ""
+}
+
+// GetCreatedAt returns the CreatedAt field, or its zero value if m is nil
+func (m *UserProfile) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// Clone returns a deep copy of m
+func (m *UserProfile) Clone() *UserProfile {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *UserProfile) Equal(other *UserProfile) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Bio != other.Bio {
+		return false
+	}
+	if m.AvatarUrl != other.AvatarUrl {
+		return false
+	}
+	if m.CreatedAt != other.CreatedAt {
+		return false
+	}
+	return true
+}
+
+// Field paths of UserProfile, for ApplyMask and field masks
+const (
+	UserProfile_Bio_PATH       = "bio"
+	UserProfile_AvatarUrl_PATH = "avatar_url"
+	UserProfile_CreatedAt_PATH = "created_at"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewUserProfile().
+func (m *UserProfile) ApplyMask(src *UserProfile, paths []string) error {
+	if src == nil {
+		src = NewUserProfile()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case UserProfile_Bio_PATH:
+			if nested {
+				return fmt.Errorf("UserProfile: field %q has no subfields", name)
+			}
+			m.Bio = src.Bio
+		case UserProfile_AvatarUrl_PATH, "avatarUrl":
+			if nested {
+				return fmt.Errorf("UserProfile: field %q has no subfields", name)
+			}
+			m.AvatarUrl = src.AvatarUrl
+		case UserProfile_CreatedAt_PATH, "createdAt":
+			if nested {
+				return fmt.Errorf("UserProfile: field %q has no subfields", name)
+			}
+			m.CreatedAt = src.CreatedAt
+		default:
+			return fmt.Errorf("UserProfile: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *UserProfile) Merge(src *UserProfile) {
+	if src == nil {
+		return
+	}
+	if src.Bio != "" {
+		m.Bio = src.Bio
+	}
+	if src.AvatarUrl != "" {
+		m.AvatarUrl = src.AvatarUrl
+	}
+	if src.CreatedAt != 0 {
+		m.CreatedAt = src.CreatedAt
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *UserProfile) ApplyFieldMask(src *UserProfile, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *UserProfile) MergeFieldMask(src *UserProfile, mask *PuregenFieldMask) error {
+	masked := &UserProfile{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *UserProfile) Redacted() *UserProfile {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *UserProfile) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *UserProfile) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("bio", r.Bio),
+		slog.Any("avatarUrl", r.AvatarUrl),
+		slog.Any("createdAt", r.CreatedAt),
+	)
 }
 
 func (m *UserProfile) Validate() error {
@@ -61,6 +568,32 @@ func (m *UserProfile) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
+// UnmarshalJSON accepts both the proto field names and the JSON names of the fields
+func (m *UserProfile) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for alias, name := range map[string]string{
+		"avatar_url": "avatarUrl",
+		"created_at": "createdAt",
+	} {
+		if value, ok := fields[alias]; ok {
+			delete(fields, alias)
+			if _, ok := fields[name]; !ok {
+				fields[name] = value
+			}
+		}
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	// plain has the fields of UserProfile but not this method
+	type plain UserProfile
+	return json.Unmarshal(data, (*plain)(m))
+}
+
 // CreateUserRequest is the request for creating a user
 type CreateUserRequest struct {
 	Name    string       `json:"name"`
@@ -68,8 +601,205 @@ type CreateUserRequest struct {
 	Profile *UserProfile `json:"profile"`
 }
 
-func NewCreateUserRequest() *CreateUserRequest {
-	return &CreateUserRequest{}
+// CreateUserRequestOption sets a field of a new CreateUserRequest
+type CreateUserRequestOption func(*CreateUserRequest)
+
+// WithCreateUserRequestName sets the Name field
+func WithCreateUserRequestName(value string) CreateUserRequestOption {
+	return func(m *CreateUserRequest) {
+		m.Name = value
+	}
+}
+
+// WithCreateUserRequestEmail sets the Email field
+func WithCreateUserRequestEmail(value string) CreateUserRequestOption {
+	return func(m *CreateUserRequest) {
+		m.Email = value
+	}
+}
+
+// WithCreateUserRequestProfile sets the Profile field
+func WithCreateUserRequestProfile(value *UserProfile) CreateUserRequestOption {
+	return func(m *CreateUserRequest) {
+		m.Profile = value
+	}
+}
+
+func NewCreateUserRequest(opts ...CreateUserRequestOption) *CreateUserRequest {
+	m := &CreateUserRequest{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetName returns the Name field, or its zero value if m is nil
+func (m *CreateUserRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// GetEmail returns the Email field, or its zero value if m is nil
+func (m *CreateUserRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+// GetProfile returns the Profile field, or its zero value if m is nil
+func (m *CreateUserRequest) GetProfile() *UserProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *CreateUserRequest) Clone() *CreateUserRequest {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	c.Profile = m.Profile.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *CreateUserRequest) Equal(other *CreateUserRequest) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Email != other.Email {
+		return false
+	}
+	if !m.Profile.Equal(other.Profile) {
+		return false
+	}
+	return true
+}
+
+// Field paths of CreateUserRequest, for ApplyMask and field masks
+const (
+	CreateUserRequest_Name_PATH    = "name"
+	CreateUserRequest_Email_PATH   = "email"
+	CreateUserRequest_Profile_PATH = "profile"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewCreateUserRequest().
+func (m *CreateUserRequest) ApplyMask(src *CreateUserRequest, paths []string) error {
+	if src == nil {
+		src = NewCreateUserRequest()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case CreateUserRequest_Name_PATH:
+			if nested {
+				return fmt.Errorf("CreateUserRequest: field %q has no subfields", name)
+			}
+			m.Name = src.Name
+		case CreateUserRequest_Email_PATH:
+			if nested {
+				return fmt.Errorf("CreateUserRequest: field %q has no subfields", name)
+			}
+			m.Email = src.Email
+		case CreateUserRequest_Profile_PATH:
+			if !nested {
+				m.Profile = src.Profile.Clone()
+				continue
+			}
+			if m.Profile == nil {
+				m.Profile = &UserProfile{}
+			}
+			if err := m.Profile.ApplyMask(src.Profile, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("CreateUserRequest: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *CreateUserRequest) Merge(src *CreateUserRequest) {
+	if src == nil {
+		return
+	}
+	if src.Name != "" {
+		m.Name = src.Name
+	}
+	if src.Email != "" {
+		m.Email = src.Email
+	}
+	if src.Profile != nil {
+		if m.Profile == nil {
+			m.Profile = &UserProfile{}
+		}
+		m.Profile.Merge(src.Profile)
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *CreateUserRequest) ApplyFieldMask(src *CreateUserRequest, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *CreateUserRequest) MergeFieldMask(src *CreateUserRequest, mask *PuregenFieldMask) error {
+	masked := &CreateUserRequest{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *CreateUserRequest) Redacted() *CreateUserRequest {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.Profile = r.Profile.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *CreateUserRequest) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *CreateUserRequest) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("name", r.Name),
+		slog.Any("email", r.Email),
+		slog.Any("profile", r.Profile),
+	)
 }
 
 func (m *CreateUserRequest) Validate() error {
@@ -92,8 +822,205 @@ type CreateUserResponse struct {
 	Message string `json:"message"`
 }
 
-func NewCreateUserResponse() *CreateUserResponse {
-	return &CreateUserResponse{}
+// CreateUserResponseOption sets a field of a new CreateUserResponse
+type CreateUserResponseOption func(*CreateUserResponse)
+
+// WithCreateUserResponseUser sets the User field
+func WithCreateUserResponseUser(value *User) CreateUserResponseOption {
+	return func(m *CreateUserResponse) {
+		m.User = value
+	}
+}
+
+// WithCreateUserResponseSuccess sets the Success field
+func WithCreateUserResponseSuccess(value bool) CreateUserResponseOption {
+	return func(m *CreateUserResponse) {
+		m.Success = value
+	}
+}
+
+// WithCreateUserResponseMessage sets the Message field
+func WithCreateUserResponseMessage(value string) CreateUserResponseOption {
+	return func(m *CreateUserResponse) {
+		m.Message = value
+	}
+}
+
+func NewCreateUserResponse(opts ...CreateUserResponseOption) *CreateUserResponse {
+	m := &CreateUserResponse{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetUser returns the User field, or its zero value if m is nil
+func (m *CreateUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+// GetSuccess returns the Success field, or its zero value if m is nil
+func (m *CreateUserResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+// GetMessage returns the Message field, or its zero value if m is nil
+func (m *CreateUserResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// Clone returns a deep copy of m
+func (m *CreateUserResponse) Clone() *CreateUserResponse {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	c.User = m.User.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *CreateUserResponse) Equal(other *CreateUserResponse) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.User.Equal(other.User) {
+		return false
+	}
+	if m.Success != other.Success {
+		return false
+	}
+	if m.Message != other.Message {
+		return false
+	}
+	return true
+}
+
+// Field paths of CreateUserResponse, for ApplyMask and field masks
+const (
+	CreateUserResponse_User_PATH    = "user"
+	CreateUserResponse_Success_PATH = "success"
+	CreateUserResponse_Message_PATH = "message"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewCreateUserResponse().
+func (m *CreateUserResponse) ApplyMask(src *CreateUserResponse, paths []string) error {
+	if src == nil {
+		src = NewCreateUserResponse()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case CreateUserResponse_User_PATH:
+			if !nested {
+				m.User = src.User.Clone()
+				continue
+			}
+			if m.User == nil {
+				m.User = &User{}
+			}
+			if err := m.User.ApplyMask(src.User, []string{rest}); err != nil {
+				return err
+			}
+		case CreateUserResponse_Success_PATH:
+			if nested {
+				return fmt.Errorf("CreateUserResponse: field %q has no subfields", name)
+			}
+			m.Success = src.Success
+		case CreateUserResponse_Message_PATH:
+			if nested {
+				return fmt.Errorf("CreateUserResponse: field %q has no subfields", name)
+			}
+			m.Message = src.Message
+		default:
+			return fmt.Errorf("CreateUserResponse: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *CreateUserResponse) Merge(src *CreateUserResponse) {
+	if src == nil {
+		return
+	}
+	if src.User != nil {
+		if m.User == nil {
+			m.User = &User{}
+		}
+		m.User.Merge(src.User)
+	}
+	if src.Success {
+		m.Success = true
+	}
+	if src.Message != "" {
+		m.Message = src.Message
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *CreateUserResponse) ApplyFieldMask(src *CreateUserResponse, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *CreateUserResponse) MergeFieldMask(src *CreateUserResponse, mask *PuregenFieldMask) error {
+	masked := &CreateUserResponse{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *CreateUserResponse) Redacted() *CreateUserResponse {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.User = r.User.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *CreateUserResponse) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *CreateUserResponse) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("user", r.User),
+		slog.Any("success", r.Success),
+		slog.Any("message", r.Message),
+	)
 }
 
 func (m *CreateUserResponse) Validate() error {
@@ -114,8 +1041,138 @@ type GetUserRequest struct {
 	Id int32 `json:"id"`
 }
 
-func NewGetUserRequest() *GetUserRequest {
-	return &GetUserRequest{}
+// GetUserRequestOption sets a field of a new GetUserRequest
+type GetUserRequestOption func(*GetUserRequest)
+
+// WithGetUserRequestId sets the Id field
+func WithGetUserRequestId(value int32) GetUserRequestOption {
+	return func(m *GetUserRequest) {
+		m.Id = value
+	}
+}
+
+func NewGetUserRequest(opts ...GetUserRequestOption) *GetUserRequest {
+	m := &GetUserRequest{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetId returns the Id field, or its zero value if m is nil
+func (m *GetUserRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Clone returns a deep copy of m
+func (m *GetUserRequest) Clone() *GetUserRequest {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *GetUserRequest) Equal(other *GetUserRequest) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	return true
+}
+
+// Field paths of GetUserRequest, for ApplyMask and field masks
+const (
+	GetUserRequest_Id_PATH = "id"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewGetUserRequest().
+func (m *GetUserRequest) ApplyMask(src *GetUserRequest, paths []string) error {
+	if src == nil {
+		src = NewGetUserRequest()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case GetUserRequest_Id_PATH:
+			if nested {
+				return fmt.Errorf("GetUserRequest: field %q has no subfields", name)
+			}
+			m.Id = src.Id
+		default:
+			return fmt.Errorf("GetUserRequest: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *GetUserRequest) Merge(src *GetUserRequest) {
+	if src == nil {
+		return
+	}
+	if src.Id != 0 {
+		m.Id = src.Id
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *GetUserRequest) ApplyFieldMask(src *GetUserRequest, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *GetUserRequest) MergeFieldMask(src *GetUserRequest, mask *PuregenFieldMask) error {
+	masked := &GetUserRequest{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *GetUserRequest) Redacted() *GetUserRequest {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *GetUserRequest) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *GetUserRequest) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("id", r.Id),
+	)
 }
 
 func (m *GetUserRequest) Validate() error {
@@ -137,8 +1194,177 @@ type GetUserResponse struct {
 	Found bool  `json:"found"`
 }
 
-func NewGetUserResponse() *GetUserResponse {
-	return &GetUserResponse{}
+// GetUserResponseOption sets a field of a new GetUserResponse
+type GetUserResponseOption func(*GetUserResponse)
+
+// WithGetUserResponseUser sets the User field
+func WithGetUserResponseUser(value *User) GetUserResponseOption {
+	return func(m *GetUserResponse) {
+		m.User = value
+	}
+}
+
+// WithGetUserResponseFound sets the Found field
+func WithGetUserResponseFound(value bool) GetUserResponseOption {
+	return func(m *GetUserResponse) {
+		m.Found = value
+	}
+}
+
+func NewGetUserResponse(opts ...GetUserResponseOption) *GetUserResponse {
+	m := &GetUserResponse{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetUser returns the User field, or its zero value if m is nil
+func (m *GetUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+// GetFound returns the Found field, or its zero value if m is nil
+func (m *GetUserResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+// Clone returns a deep copy of m
+func (m *GetUserResponse) Clone() *GetUserResponse {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	c.User = m.User.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *GetUserResponse) Equal(other *GetUserResponse) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.User.Equal(other.User) {
+		return false
+	}
+	if m.Found != other.Found {
+		return false
+	}
+	return true
+}
+
+// Field paths of GetUserResponse, for ApplyMask and field masks
+const (
+	GetUserResponse_User_PATH  = "user"
+	GetUserResponse_Found_PATH = "found"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewGetUserResponse().
+func (m *GetUserResponse) ApplyMask(src *GetUserResponse, paths []string) error {
+	if src == nil {
+		src = NewGetUserResponse()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case GetUserResponse_User_PATH:
+			if !nested {
+				m.User = src.User.Clone()
+				continue
+			}
+			if m.User == nil {
+				m.User = &User{}
+			}
+			if err := m.User.ApplyMask(src.User, []string{rest}); err != nil {
+				return err
+			}
+		case GetUserResponse_Found_PATH:
+			if nested {
+				return fmt.Errorf("GetUserResponse: field %q has no subfields", name)
+			}
+			m.Found = src.Found
+		default:
+			return fmt.Errorf("GetUserResponse: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *GetUserResponse) Merge(src *GetUserResponse) {
+	if src == nil {
+		return
+	}
+	if src.User != nil {
+		if m.User == nil {
+			m.User = &User{}
+		}
+		m.User.Merge(src.User)
+	}
+	if src.Found {
+		m.Found = true
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *GetUserResponse) ApplyFieldMask(src *GetUserResponse, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *GetUserResponse) MergeFieldMask(src *GetUserResponse, mask *PuregenFieldMask) error {
+	masked := &GetUserResponse{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *GetUserResponse) Redacted() *GetUserResponse {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.User = r.User.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *GetUserResponse) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *GetUserResponse) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("user", r.User),
+		slog.Any("found", r.Found),
+	)
 }
 
 func (m *GetUserResponse) Validate() error {
@@ -154,6 +1380,270 @@ func (m *GetUserResponse) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
+// Descriptors
+
+// UserDescriptor describes User and its fields
+var UserDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.examples.user.v1.User",
+	Name:     "User",
+	GoName:   "User",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "id",
+			GoName:   "Id",
+			JSONName: "id",
+			Number:   1,
+			Kind:     "int32",
+		},
+		{
+			Name:     "name",
+			GoName:   "Name",
+			JSONName: "name",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "email",
+			GoName:   "Email",
+			JSONName: "email",
+			Number:   3,
+			Kind:     "string",
+		},
+		{
+			Name:     "is_active",
+			GoName:   "IsActive",
+			JSONName: "isActive",
+			Number:   4,
+			Kind:     "bool",
+		},
+		{
+			Name:     "tags",
+			GoName:   "Tags",
+			JSONName: "tags",
+			Number:   5,
+			Kind:     "string",
+			Repeated: true,
+		},
+		{
+			Name:     "profile",
+			GoName:   "Profile",
+			JSONName: "profile",
+			Number:   6,
+			Kind:     "message",
+			TypeName: "puregen.examples.user.v1.UserProfile",
+		},
+	},
+}
+
+// Descriptor returns UserDescriptor
+func (*User) Descriptor() *PuregenMessageDescriptor {
+	return UserDescriptor
+}
+
+// UserProfileDescriptor describes UserProfile and its fields
+var UserProfileDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.examples.user.v1.UserProfile",
+	Name:     "UserProfile",
+	GoName:   "UserProfile",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "bio",
+			GoName:   "Bio",
+			JSONName: "bio",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "avatar_url",
+			GoName:   "AvatarUrl",
+			JSONName: "avatarUrl",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "created_at",
+			GoName:   "CreatedAt",
+			JSONName: "createdAt",
+			Number:   3,
+			Kind:     "int64",
+		},
+	},
+}
+
+// Descriptor returns UserProfileDescriptor
+func (*UserProfile) Descriptor() *PuregenMessageDescriptor {
+	return UserProfileDescriptor
+}
+
+// CreateUserRequestDescriptor describes CreateUserRequest and its fields
+var CreateUserRequestDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.examples.user.v1.CreateUserRequest",
+	Name:     "CreateUserRequest",
+	GoName:   "CreateUserRequest",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "name",
+			GoName:   "Name",
+			JSONName: "name",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "email",
+			GoName:   "Email",
+			JSONName: "email",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "profile",
+			GoName:   "Profile",
+			JSONName: "profile",
+			Number:   3,
+			Kind:     "message",
+			TypeName: "puregen.examples.user.v1.UserProfile",
+		},
+	},
+}
+
+// Descriptor returns CreateUserRequestDescriptor
+func (*CreateUserRequest) Descriptor() *PuregenMessageDescriptor {
+	return CreateUserRequestDescriptor
+}
+
+// CreateUserResponseDescriptor describes CreateUserResponse and its fields
+var CreateUserResponseDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.examples.user.v1.CreateUserResponse",
+	Name:     "CreateUserResponse",
+	GoName:   "CreateUserResponse",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "user",
+			GoName:   "User",
+			JSONName: "user",
+			Number:   1,
+			Kind:     "message",
+			TypeName: "puregen.examples.user.v1.User",
+		},
+		{
+			Name:     "success",
+			GoName:   "Success",
+			JSONName: "success",
+			Number:   2,
+			Kind:     "bool",
+		},
+		{
+			Name:     "message",
+			GoName:   "Message",
+			JSONName: "message",
+			Number:   3,
+			Kind:     "string",
+		},
+	},
+}
+
+// Descriptor returns CreateUserResponseDescriptor
+func (*CreateUserResponse) Descriptor() *PuregenMessageDescriptor {
+	return CreateUserResponseDescriptor
+}
+
+// GetUserRequestDescriptor describes GetUserRequest and its fields
+var GetUserRequestDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.examples.user.v1.GetUserRequest",
+	Name:     "GetUserRequest",
+	GoName:   "GetUserRequest",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "id",
+			GoName:   "Id",
+			JSONName: "id",
+			Number:   1,
+			Kind:     "int32",
+		},
+	},
+}
+
+// Descriptor returns GetUserRequestDescriptor
+func (*GetUserRequest) Descriptor() *PuregenMessageDescriptor {
+	return GetUserRequestDescriptor
+}
+
+// GetUserResponseDescriptor describes GetUserResponse and its fields
+var GetUserResponseDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.examples.user.v1.GetUserResponse",
+	Name:     "GetUserResponse",
+	GoName:   "GetUserResponse",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "user",
+			GoName:   "User",
+			JSONName: "user",
+			Number:   1,
+			Kind:     "message",
+			TypeName: "puregen.examples.user.v1.User",
+		},
+		{
+			Name:     "found",
+			GoName:   "Found",
+			JSONName: "found",
+			Number:   2,
+			Kind:     "bool",
+		},
+	},
+}
+
+// Descriptor returns GetUserResponseDescriptor
+func (*GetUserResponse) Descriptor() *PuregenMessageDescriptor {
+	return GetUserResponseDescriptor
+}
+
+func init() {
+	registerMessageDescriptors(UserDescriptor, UserProfileDescriptor, CreateUserRequestDescriptor, CreateUserResponseDescriptor, GetUserRequestDescriptor, GetUserResponseDescriptor)
+}
+
+// Message types
+
+// ProtoName returns the full proto name of User
+func (*User) ProtoName() string {
+	return "puregen.examples.user.v1.User"
+}
+
+// ProtoName returns the full proto name of UserProfile
+func (*UserProfile) ProtoName() string {
+	return "puregen.examples.user.v1.UserProfile"
+}
+
+// ProtoName returns the full proto name of CreateUserRequest
+func (*CreateUserRequest) ProtoName() string {
+	return "puregen.examples.user.v1.CreateUserRequest"
+}
+
+// ProtoName returns the full proto name of CreateUserResponse
+func (*CreateUserResponse) ProtoName() string {
+	return "puregen.examples.user.v1.CreateUserResponse"
+}
+
+// ProtoName returns the full proto name of GetUserRequest
+func (*GetUserRequest) ProtoName() string {
+	return "puregen.examples.user.v1.GetUserRequest"
+}
+
+// ProtoName returns the full proto name of GetUserResponse
+func (*GetUserResponse) ProtoName() string {
+	return "puregen.examples.user.v1.GetUserResponse"
+}
+
+func init() {
+	registerMessageTypes(map[string]func() PuregenMessage{
+		"puregen.examples.user.v1.User":               func() PuregenMessage { return NewUser() },
+		"puregen.examples.user.v1.UserProfile":        func() PuregenMessage { return NewUserProfile() },
+		"puregen.examples.user.v1.CreateUserRequest":  func() PuregenMessage { return NewCreateUserRequest() },
+		"puregen.examples.user.v1.CreateUserResponse": func() PuregenMessage { return NewCreateUserResponse() },
+		"puregen.examples.user.v1.GetUserRequest":     func() PuregenMessage { return NewGetUserRequest() },
+		"puregen.examples.user.v1.GetUserResponse":    func() PuregenMessage { return NewGetUserResponse() },
+	})
+}
+
 // Services
 
 // UserService provides operations for managing users
@@ -189,7 +1679,7 @@ const (
 	UserService_GetUser    = "UserService_GetUser"
 )
 
-var UserServiceMethodMetadata = map[string]map[string]string{
+var UserServiceMethodMetadata = map[string]map[string]any{
 	UserService_CreateUser: {
 		"method": "POST",
 		"path":   "/users",
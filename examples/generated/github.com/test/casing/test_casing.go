@@ -3,7 +3,10 @@
 package casing
 
 import (
-	"encoding/json"
+	json "encoding/json"
+	fmt "fmt"
+	slog "log/slog"
+	strings "strings"
 )
 
 // Messages
@@ -22,8 +25,390 @@ type TestMessage struct {
 	HTMLContent  string `json:"HTMLContent"`
 }
 
-func NewTestMessage() *TestMessage {
-	return &TestMessage{}
+// TestMessageOption sets a field of a new TestMessage
+type TestMessageOption func(*TestMessage)
+
+// WithTestMessageAPIHost sets the APIHost field
+func WithTestMessageAPIHost(value string) TestMessageOption {
+	return func(m *TestMessage) {
+		m.APIHost = value
+	}
+}
+
+// WithTestMessageTPMData sets the TPMData field
+func WithTestMessageTPMData(value string) TestMessageOption {
+	return func(m *TestMessage) {
+		m.TPMData = value
+	}
+}
+
+// WithTestMessageXMLContent sets the XMLContent field
+func WithTestMessageXMLContent(value string) TestMessageOption {
+	return func(m *TestMessage) {
+		m.XMLContent = value
+	}
+}
+
+// WithTestMessageURLPath sets the URLPath field
+func WithTestMessageURLPath(value string) TestMessageOption {
+	return func(m *TestMessage) {
+		m.URLPath = value
+	}
+}
+
+// WithTestMessageHTTPSEnabled sets the HTTPSEnabled field
+func WithTestMessageHTTPSEnabled(value string) TestMessageOption {
+	return func(m *TestMessage) {
+		m.HTTPSEnabled = value
+	}
+}
+
+// WithTestMessageUUIDValue sets the UUIDValue field
+func WithTestMessageUUIDValue(value string) TestMessageOption {
+	return func(m *TestMessage) {
+		m.UUIDValue = value
+	}
+}
+
+// WithTestMessageJSONData sets the JSONData field
+func WithTestMessageJSONData(value string) TestMessageOption {
+	return func(m *TestMessage) {
+		m.JSONData = value
+	}
+}
+
+// WithTestMessageAPIKey sets the APIKey field
+func WithTestMessageAPIKey(value string) TestMessageOption {
+	return func(m *TestMessage) {
+		m.APIKey = value
+	}
+}
+
+// WithTestMessageSQLQuery sets the SQLQuery field
+func WithTestMessageSQLQuery(value string) TestMessageOption {
+	return func(m *TestMessage) {
+		m.SQLQuery = value
+	}
+}
+
+// WithTestMessageHTMLContent sets the HTMLContent field
+func WithTestMessageHTMLContent(value string) TestMessageOption {
+	return func(m *TestMessage) {
+		m.HTMLContent = value
+	}
+}
+
+func NewTestMessage(opts ...TestMessageOption) *TestMessage {
+	m := &TestMessage{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetAPIHost returns the APIHost field, or its zero value if m is nil
+func (m *TestMessage) GetAPIHost() string {
+	if m != nil {
+		return m.APIHost
+	}
+	return ""
+}
+
+// GetTPMData returns the TPMData field, or its zero value if m is nil
+func (m *TestMessage) GetTPMData() string {
+	if m != nil {
+		return m.TPMData
+	}
+	return ""
+}
+
+// GetXMLContent returns the XMLContent field, or its zero value if m is nil
+func (m *TestMessage) GetXMLContent() string {
+	if m != nil {
+		return m.XMLContent
+	}
+	return ""
+}
+
+// GetURLPath returns the URLPath field, or its zero value if m is nil
+func (m *TestMessage) GetURLPath() string {
+	if m != nil {
+		return m.URLPath
+	}
+	return ""
+}
+
+// GetHTTPSEnabled returns the HTTPSEnabled field, or its zero value if m is nil
+func (m *TestMessage) GetHTTPSEnabled() string {
+	if m != nil {
+		return m.HTTPSEnabled
+	}
+	return ""
+}
+
+// GetUUIDValue returns the UUIDValue field, or its zero value if m is nil
+func (m *TestMessage) GetUUIDValue() string {
+	if m != nil {
+		return m.UUIDValue
+	}
+	return ""
+}
+
+// GetJSONData returns the JSONData field, or its zero value if m is nil
+func (m *TestMessage) GetJSONData() string {
+	if m != nil {
+		return m.JSONData
+	}
+	return ""
+}
+
+// GetAPIKey returns the APIKey field, or its zero value if m is nil
+func (m *TestMessage) GetAPIKey() string {
+	if m != nil {
+		return m.APIKey
+	}
+	return ""
+}
+
+// GetSQLQuery returns the SQLQuery field, or its zero value if m is nil
+func (m *TestMessage) GetSQLQuery() string {
+	if m != nil {
+		return m.SQLQuery
+	}
+	return ""
+}
+
+// GetHTMLContent returns the HTMLContent field, or its zero value if m is nil
+func (m *TestMessage) GetHTMLContent() string {
+	if m != nil {
+		return m.HTMLContent
+	}
+	return ""
+}
+
+// Clone returns a deep copy of m
+func (m *TestMessage) Clone() *TestMessage {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *TestMessage) Equal(other *TestMessage) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.APIHost != other.APIHost {
+		return false
+	}
+	if m.TPMData != other.TPMData {
+		return false
+	}
+	if m.XMLContent != other.XMLContent {
+		return false
+	}
+	if m.URLPath != other.URLPath {
+		return false
+	}
+	if m.HTTPSEnabled != other.HTTPSEnabled {
+		return false
+	}
+	if m.UUIDValue != other.UUIDValue {
+		return false
+	}
+	if m.JSONData != other.JSONData {
+		return false
+	}
+	if m.APIKey != other.APIKey {
+		return false
+	}
+	if m.SQLQuery != other.SQLQuery {
+		return false
+	}
+	if m.HTMLContent != other.HTMLContent {
+		return false
+	}
+	return true
+}
+
+// Field paths of TestMessage, for ApplyMask and field masks
+const (
+	TestMessage_APIHost_PATH      = "APIHost"
+	TestMessage_TPMData_PATH      = "TPMData"
+	TestMessage_XMLContent_PATH   = "XMLContent"
+	TestMessage_URLPath_PATH      = "URLPath"
+	TestMessage_HTTPSEnabled_PATH = "HTTPSEnabled"
+	TestMessage_UUIDValue_PATH    = "UUIDValue"
+	TestMessage_JSONData_PATH     = "JSONData"
+	TestMessage_APIKey_PATH       = "APIKey"
+	TestMessage_SQLQuery_PATH     = "SQLQuery"
+	TestMessage_HTMLContent_PATH  = "HTMLContent"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewTestMessage().
+func (m *TestMessage) ApplyMask(src *TestMessage, paths []string) error {
+	if src == nil {
+		src = NewTestMessage()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case TestMessage_APIHost_PATH:
+			if nested {
+				return fmt.Errorf("TestMessage: field %q has no subfields", name)
+			}
+			m.APIHost = src.APIHost
+		case TestMessage_TPMData_PATH:
+			if nested {
+				return fmt.Errorf("TestMessage: field %q has no subfields", name)
+			}
+			m.TPMData = src.TPMData
+		case TestMessage_XMLContent_PATH:
+			if nested {
+				return fmt.Errorf("TestMessage: field %q has no subfields", name)
+			}
+			m.XMLContent = src.XMLContent
+		case TestMessage_URLPath_PATH:
+			if nested {
+				return fmt.Errorf("TestMessage: field %q has no subfields", name)
+			}
+			m.URLPath = src.URLPath
+		case TestMessage_HTTPSEnabled_PATH:
+			if nested {
+				return fmt.Errorf("TestMessage: field %q has no subfields", name)
+			}
+			m.HTTPSEnabled = src.HTTPSEnabled
+		case TestMessage_UUIDValue_PATH:
+			if nested {
+				return fmt.Errorf("TestMessage: field %q has no subfields", name)
+			}
+			m.UUIDValue = src.UUIDValue
+		case TestMessage_JSONData_PATH:
+			if nested {
+				return fmt.Errorf("TestMessage: field %q has no subfields", name)
+			}
+			m.JSONData = src.JSONData
+		case TestMessage_APIKey_PATH:
+			if nested {
+				return fmt.Errorf("TestMessage: field %q has no subfields", name)
+			}
+			m.APIKey = src.APIKey
+		case TestMessage_SQLQuery_PATH:
+			if nested {
+				return fmt.Errorf("TestMessage: field %q has no subfields", name)
+			}
+			m.SQLQuery = src.SQLQuery
+		case TestMessage_HTMLContent_PATH:
+			if nested {
+				return fmt.Errorf("TestMessage: field %q has no subfields", name)
+			}
+			m.HTMLContent = src.HTMLContent
+		default:
+			return fmt.Errorf("TestMessage: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *TestMessage) Merge(src *TestMessage) {
+	if src == nil {
+		return
+	}
+	if src.APIHost != "" {
+		m.APIHost = src.APIHost
+	}
+	if src.TPMData != "" {
+		m.TPMData = src.TPMData
+	}
+	if src.XMLContent != "" {
+		m.XMLContent = src.XMLContent
+	}
+	if src.URLPath != "" {
+		m.URLPath = src.URLPath
+	}
+	if src.HTTPSEnabled != "" {
+		m.HTTPSEnabled = src.HTTPSEnabled
+	}
+	if src.UUIDValue != "" {
+		m.UUIDValue = src.UUIDValue
+	}
+	if src.JSONData != "" {
+		m.JSONData = src.JSONData
+	}
+	if src.APIKey != "" {
+		m.APIKey = src.APIKey
+	}
+	if src.SQLQuery != "" {
+		m.SQLQuery = src.SQLQuery
+	}
+	if src.HTMLContent != "" {
+		m.HTMLContent = src.HTMLContent
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *TestMessage) ApplyFieldMask(src *TestMessage, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *TestMessage) MergeFieldMask(src *TestMessage, mask *PuregenFieldMask) error {
+	masked := &TestMessage{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *TestMessage) Redacted() *TestMessage {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *TestMessage) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *TestMessage) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("APIHost", r.APIHost),
+		slog.Any("TPMData", r.TPMData),
+		slog.Any("XMLContent", r.XMLContent),
+		slog.Any("URLPath", r.URLPath),
+		slog.Any("HTTPSEnabled", r.HTTPSEnabled),
+		slog.Any("UUIDValue", r.UUIDValue),
+		slog.Any("JSONData", r.JSONData),
+		slog.Any("APIKey", r.APIKey),
+		slog.Any("SQLQuery", r.SQLQuery),
+		slog.Any("HTMLContent", r.HTMLContent),
+	)
 }
 
 func (m *TestMessage) Validate() error {
@@ -45,8 +430,112 @@ const (
 )
 
 // MessageField metadata for TestMessage
-var TestMessageFieldMetadata = map[string]map[string]string{
+var TestMessageFieldMetadata = map[string]map[string]any{
 	TestMessage_APIHost_FIELD: {
 		"urls": "http://example.com/api/test",
 	},
 }
+
+// Descriptors
+
+// TestMessageDescriptor describes TestMessage and its fields
+var TestMessageDescriptor = &PuregenMessageDescriptor{
+	FullName: "test.casing.TestMessage",
+	Name:     "TestMessage",
+	GoName:   "TestMessage",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "APIHost",
+			GoName:   "APIHost",
+			JSONName: "APIHost",
+			Number:   1,
+			Kind:     "string",
+			Metadata: TestMessageFieldMetadata[TestMessage_APIHost_FIELD],
+		},
+		{
+			Name:     "TPMData",
+			GoName:   "TPMData",
+			JSONName: "TPMData",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "XMLContent",
+			GoName:   "XMLContent",
+			JSONName: "XMLContent",
+			Number:   3,
+			Kind:     "string",
+		},
+		{
+			Name:     "URLPath",
+			GoName:   "URLPath",
+			JSONName: "URLPath",
+			Number:   4,
+			Kind:     "string",
+		},
+		{
+			Name:     "HTTPSEnabled",
+			GoName:   "HTTPSEnabled",
+			JSONName: "HTTPSEnabled",
+			Number:   5,
+			Kind:     "string",
+		},
+		{
+			Name:     "UUIDValue",
+			GoName:   "UUIDValue",
+			JSONName: "UUIDValue",
+			Number:   6,
+			Kind:     "string",
+		},
+		{
+			Name:     "JSONData",
+			GoName:   "JSONData",
+			JSONName: "JSONData",
+			Number:   7,
+			Kind:     "string",
+		},
+		{
+			Name:     "APIKey",
+			GoName:   "APIKey",
+			JSONName: "APIKey",
+			Number:   8,
+			Kind:     "string",
+		},
+		{
+			Name:     "SQLQuery",
+			GoName:   "SQLQuery",
+			JSONName: "SQLQuery",
+			Number:   9,
+			Kind:     "string",
+		},
+		{
+			Name:     "HTMLContent",
+			GoName:   "HTMLContent",
+			JSONName: "HTMLContent",
+			Number:   10,
+			Kind:     "string",
+		},
+	},
+}
+
+// Descriptor returns TestMessageDescriptor
+func (*TestMessage) Descriptor() *PuregenMessageDescriptor {
+	return TestMessageDescriptor
+}
+
+func init() {
+	registerMessageDescriptors(TestMessageDescriptor)
+}
+
+// Message types
+
+// ProtoName returns the full proto name of TestMessage
+func (*TestMessage) ProtoName() string {
+	return "test.casing.TestMessage"
+}
+
+func init() {
+	registerMessageTypes(map[string]func() PuregenMessage{
+		"test.casing.TestMessage": func() PuregenMessage { return NewTestMessage() },
+	})
+}
@@ -9,6 +9,19 @@ import com.fasterxml.jackson.databind.*;
 
     // CreateGroupRequest is the request for creating a group
 public class CreateGroupRequest {
+    // Field paths, for applyMask and field masks
+    public static final String CreateGroupRequest_Name_PATH = "name";
+    public static final String CreateGroupRequest_Description_PATH = "description";
+    public static final String CreateGroupRequest_Owner_PATH = "owner";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.examples.groups.CreateGroupRequest", "CreateGroupRequest", "CreateGroupRequest",
+        Arrays.asList(
+            new PuregenFieldDescriptor("name", "name", "name", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("description", "description", "description", 2, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("owner", "owner", "owner", 3, "message", "puregen.examples.groups.Principal", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     @JsonProperty("name")
     private String name;
 
@@ -69,19 +82,142 @@ public class CreateGroupRequest {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        CreateGroupRequest other = (CreateGroupRequest) o;
+        return Objects.equals(name, other.name)
+            && Objects.equals(description, other.description)
+            && Objects.equals(owner, other.owner);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(name, description, owner);
+    }
+
+    @Override
+    public String toString() {
+        return "CreateGroupRequest{"
+            + "name=" + (name != null ? "'" + name + "'" : null)
+            + ", description=" + (description != null ? "'" + description + "'" : null)
+            + ", owner=" + owner
+            + "}";
+    }
+
+    /** Returns a CreateGroupRequest with every field at its zero value, unlike new CreateGroupRequest(), which applies the field defaults. */
+    public static CreateGroupRequest zeroValue() {
+        CreateGroupRequest zero = new CreateGroupRequest();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new CreateGroupRequest.
+     */
+    public void applyMask(CreateGroupRequest src, Collection<String> paths) {
+        CreateGroupRequest source = src != null ? src : new CreateGroupRequest();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case CreateGroupRequest_Name_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("CreateGroupRequest: field " + name + " has no subfields");
+                    }
+                    this.name = source.name;
+                    break;
+                case CreateGroupRequest_Description_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("CreateGroupRequest: field " + name + " has no subfields");
+                    }
+                    this.description = source.description;
+                    break;
+                case CreateGroupRequest_Owner_PATH:
+                    if (rest == null) {
+                        this.owner = source.owner;
+                    } else {
+                        if (this.owner == null) {
+                            this.owner = Principal.zeroValue();
+                        }
+                        this.owner.applyMask(source.owner, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("CreateGroupRequest: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(CreateGroupRequest src) {
+        if (src == null) {
+            return;
+        }
+        if (src.name != null && !src.name.isEmpty()) {
+            this.name = src.name;
+        }
+        if (src.description != null && !src.description.isEmpty()) {
+            this.description = src.description;
+        }
+        if (src.owner != null) {
+            if (this.owner == null) {
+                this.owner = Principal.zeroValue();
+            }
+            this.owner.merge(src.owner);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(CreateGroupRequest src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(CreateGroupRequest src, PuregenFieldMask mask) {
+        CreateGroupRequest masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public CreateGroupRequest redacted() {
+        CreateGroupRequest copy = new CreateGroupRequest();
+        copy.name = name;
+        copy.description = description;
+        copy.owner = owner != null ? owner.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static CreateGroupRequest fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, CreateGroupRequest.class);
+        return PuregenJson.fromJson(json, CreateGroupRequest.class);
     }
 
 }
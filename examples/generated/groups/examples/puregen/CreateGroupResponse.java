@@ -9,6 +9,17 @@ import com.fasterxml.jackson.databind.*;
 
     // CreateGroupResponse is the response for creating a group
 public class CreateGroupResponse {
+    // Field paths, for applyMask and field masks
+    public static final String CreateGroupResponse_Group_PATH = "group";
+    public static final String CreateGroupResponse_Error_PATH = "error";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.examples.groups.CreateGroupResponse", "CreateGroupResponse", "CreateGroupResponse",
+        Arrays.asList(
+            new PuregenFieldDescriptor("group", "group", "group", 1, "message", "puregen.examples.groups.Group", null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("error", "error", "error", 2, "message", "company.examples.proto.error.v1.Error", null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     @JsonProperty("group")
     private Group group;
 
@@ -53,19 +64,137 @@ public class CreateGroupResponse {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        CreateGroupResponse other = (CreateGroupResponse) o;
+        return Objects.equals(group, other.group)
+            && Objects.equals(error, other.error);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(group, error);
+    }
+
+    @Override
+    public String toString() {
+        return "CreateGroupResponse{"
+            + "group=" + group
+            + ", error=" + error
+            + "}";
+    }
+
+    /** Returns a CreateGroupResponse with every field at its zero value, unlike new CreateGroupResponse(), which applies the field defaults. */
+    public static CreateGroupResponse zeroValue() {
+        CreateGroupResponse zero = new CreateGroupResponse();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new CreateGroupResponse.
+     */
+    public void applyMask(CreateGroupResponse src, Collection<String> paths) {
+        CreateGroupResponse source = src != null ? src : new CreateGroupResponse();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case CreateGroupResponse_Group_PATH:
+                    if (rest == null) {
+                        this.group = source.group;
+                    } else {
+                        if (this.group == null) {
+                            this.group = Group.zeroValue();
+                        }
+                        this.group.applyMask(source.group, List.of(rest));
+                    }
+                    break;
+                case CreateGroupResponse_Error_PATH:
+                    if (rest == null) {
+                        this.error = source.error;
+                    } else {
+                        if (this.error == null) {
+                            this.error = Error.zeroValue();
+                        }
+                        this.error.applyMask(source.error, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("CreateGroupResponse: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(CreateGroupResponse src) {
+        if (src == null) {
+            return;
+        }
+        if (src.group != null) {
+            if (this.group == null) {
+                this.group = Group.zeroValue();
+            }
+            this.group.merge(src.group);
+        }
+        if (src.error != null) {
+            if (this.error == null) {
+                this.error = Error.zeroValue();
+            }
+            this.error.merge(src.error);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(CreateGroupResponse src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(CreateGroupResponse src, PuregenFieldMask mask) {
+        CreateGroupResponse masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public CreateGroupResponse redacted() {
+        CreateGroupResponse copy = new CreateGroupResponse();
+        copy.group = group != null ? group.redacted() : null;
+        copy.error = error != null ? error.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static CreateGroupResponse fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, CreateGroupResponse.class);
+        return PuregenJson.fromJson(json, CreateGroupResponse.class);
     }
 
 }
@@ -2,6 +2,8 @@
 
 package groups.examples.puregen;
 
+import java.util.*;
+
 public class DefaultGroupServiceService implements GroupServiceService {
     // CreateGroup creates a new group
     @Override
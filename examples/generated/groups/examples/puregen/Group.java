@@ -9,6 +9,21 @@ import com.fasterxml.jackson.databind.*;
 
     // Group represents a group entity
 public class Group {
+    // Field paths, for applyMask and field masks
+    public static final String Group_Id_PATH = "id";
+    public static final String Group_Name_PATH = "name";
+    public static final String Group_Description_PATH = "description";
+    public static final String Group_CreatedAt_PATH = "created_at";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.examples.groups.Group", "Group", "Group",
+        Arrays.asList(
+            new PuregenFieldDescriptor("id", "id", "id", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("name", "name", "name", 2, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("description", "description", "description", 3, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("created_at", "createdAt", "createdAt", 4, "int64", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     @JsonProperty("id")
     private String id;
 
@@ -19,6 +34,7 @@ public class Group {
     private String description;
 
     @JsonProperty("createdAt")
+    @JsonAlias({"created_at"})
     private long createdAt;
 
     public Group() {
@@ -84,19 +100,148 @@ public class Group {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        Group other = (Group) o;
+        return Objects.equals(id, other.id)
+            && Objects.equals(name, other.name)
+            && Objects.equals(description, other.description)
+            && createdAt == other.createdAt;
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(id, name, description, createdAt);
+    }
+
+    @Override
+    public String toString() {
+        return "Group{"
+            + "id=" + (id != null ? "'" + id + "'" : null)
+            + ", name=" + (name != null ? "'" + name + "'" : null)
+            + ", description=" + (description != null ? "'" + description + "'" : null)
+            + ", createdAt=" + createdAt
+            + "}";
+    }
+
+    /** Returns a Group with every field at its zero value, unlike new Group(), which applies the field defaults. */
+    public static Group zeroValue() {
+        Group zero = new Group();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new Group.
+     */
+    public void applyMask(Group src, Collection<String> paths) {
+        Group source = src != null ? src : new Group();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case Group_Id_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Group: field " + name + " has no subfields");
+                    }
+                    this.id = source.id;
+                    break;
+                case Group_Name_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Group: field " + name + " has no subfields");
+                    }
+                    this.name = source.name;
+                    break;
+                case Group_Description_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Group: field " + name + " has no subfields");
+                    }
+                    this.description = source.description;
+                    break;
+                case Group_CreatedAt_PATH:
+                case "createdAt":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Group: field " + name + " has no subfields");
+                    }
+                    this.createdAt = source.createdAt;
+                    break;
+                default:
+                    throw new IllegalArgumentException("Group: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(Group src) {
+        if (src == null) {
+            return;
+        }
+        if (src.id != null && !src.id.isEmpty()) {
+            this.id = src.id;
+        }
+        if (src.name != null && !src.name.isEmpty()) {
+            this.name = src.name;
+        }
+        if (src.description != null && !src.description.isEmpty()) {
+            this.description = src.description;
+        }
+        if (src.createdAt != 0) {
+            this.createdAt = src.createdAt;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(Group src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(Group src, PuregenFieldMask mask) {
+        Group masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public Group redacted() {
+        Group copy = new Group();
+        copy.id = id;
+        copy.name = name;
+        copy.description = description;
+        copy.createdAt = createdAt;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static Group fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, Group.class);
+        return PuregenJson.fromJson(json, Group.class);
     }
 
 }
@@ -14,7 +14,7 @@ public class GroupServiceClient {
     // CreateGroup creates a new group
     public CreateGroupResponse createGroup(Map<String, Object> ctx, CreateGroupRequest request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = GroupServiceMethods.METHOD_METADATA.get(GroupServiceMethods.GroupService_CreateGroup);
+        Map<String, Object> methodMetadata = GroupServiceMethods.METHOD_METADATA.get(GroupServiceMethods.GroupService_CreateGroup);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -24,7 +24,7 @@ public class GroupServiceClient {
     // ListGroups lists all groups with pagination
     public ListGroupsResponse listGroups(Map<String, Object> ctx, ListGroupsRequest request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = GroupServiceMethods.METHOD_METADATA.get(GroupServiceMethods.GroupService_ListGroups);
+        Map<String, Object> methodMetadata = GroupServiceMethods.METHOD_METADATA.get(GroupServiceMethods.GroupService_ListGroups);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -10,7 +10,7 @@ public final class GroupServiceMethods {
     public static final String GroupService_CreateGroup = "GroupService_CreateGroup";
     public static final String GroupService_ListGroups = "GroupService_ListGroups";
 
-    public static final Map<String, Map<String, String>> METHOD_METADATA = new HashMap<>();
+    public static final Map<String, Map<String, Object>> METHOD_METADATA = new HashMap<>();
     static {
     }
 }
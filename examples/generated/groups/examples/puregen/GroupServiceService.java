@@ -2,6 +2,8 @@
 
 package groups.examples.puregen;
 
+import java.util.*;
+
     // GroupService provides operations on groups
 public interface GroupServiceService {
     // CreateGroup creates a new group
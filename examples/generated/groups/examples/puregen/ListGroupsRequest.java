@@ -9,10 +9,23 @@ import com.fasterxml.jackson.databind.*;
 
     // ListGroupsRequest is the request for listing groups
 public class ListGroupsRequest {
+    // Field paths, for applyMask and field masks
+    public static final String ListGroupsRequest_PageSize_PATH = "page_size";
+    public static final String ListGroupsRequest_PageToken_PATH = "page_token";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.examples.groups.ListGroupsRequest", "ListGroupsRequest", "ListGroupsRequest",
+        Arrays.asList(
+            new PuregenFieldDescriptor("page_size", "pageSize", "pageSize", 1, "int32", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("page_token", "pageToken", "pageToken", 2, "string", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     @JsonProperty("pageSize")
+    @JsonAlias({"page_size"})
     private int pageSize;
 
     @JsonProperty("pageToken")
+    @JsonAlias({"page_token"})
     private String pageToken;
 
     public ListGroupsRequest() {
@@ -52,19 +65,125 @@ public class ListGroupsRequest {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        ListGroupsRequest other = (ListGroupsRequest) o;
+        return pageSize == other.pageSize
+            && Objects.equals(pageToken, other.pageToken);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(pageSize, pageToken);
+    }
+
+    @Override
+    public String toString() {
+        return "ListGroupsRequest{"
+            + "pageSize=" + pageSize
+            + ", pageToken=" + (pageToken != null ? "'" + pageToken + "'" : null)
+            + "}";
+    }
+
+    /** Returns a ListGroupsRequest with every field at its zero value, unlike new ListGroupsRequest(), which applies the field defaults. */
+    public static ListGroupsRequest zeroValue() {
+        ListGroupsRequest zero = new ListGroupsRequest();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new ListGroupsRequest.
+     */
+    public void applyMask(ListGroupsRequest src, Collection<String> paths) {
+        ListGroupsRequest source = src != null ? src : new ListGroupsRequest();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case ListGroupsRequest_PageSize_PATH:
+                case "pageSize":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("ListGroupsRequest: field " + name + " has no subfields");
+                    }
+                    this.pageSize = source.pageSize;
+                    break;
+                case ListGroupsRequest_PageToken_PATH:
+                case "pageToken":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("ListGroupsRequest: field " + name + " has no subfields");
+                    }
+                    this.pageToken = source.pageToken;
+                    break;
+                default:
+                    throw new IllegalArgumentException("ListGroupsRequest: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(ListGroupsRequest src) {
+        if (src == null) {
+            return;
+        }
+        if (src.pageSize != 0) {
+            this.pageSize = src.pageSize;
+        }
+        if (src.pageToken != null && !src.pageToken.isEmpty()) {
+            this.pageToken = src.pageToken;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(ListGroupsRequest src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(ListGroupsRequest src, PuregenFieldMask mask) {
+        ListGroupsRequest masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public ListGroupsRequest redacted() {
+        ListGroupsRequest copy = new ListGroupsRequest();
+        copy.pageSize = pageSize;
+        copy.pageToken = pageToken;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static ListGroupsRequest fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, ListGroupsRequest.class);
+        return PuregenJson.fromJson(json, ListGroupsRequest.class);
     }
 
 }
@@ -9,10 +9,22 @@ import com.fasterxml.jackson.databind.*;
 
     // ListGroupsResponse is the response for listing groups
 public class ListGroupsResponse {
+    // Field paths, for applyMask and field masks
+    public static final String ListGroupsResponse_Groups_PATH = "groups";
+    public static final String ListGroupsResponse_NextPageToken_PATH = "next_page_token";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.examples.groups.ListGroupsResponse", "ListGroupsResponse", "ListGroupsResponse",
+        Arrays.asList(
+            new PuregenFieldDescriptor("groups", "groups", "groups", 1, "message", "puregen.examples.groups.Group", null, true, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("next_page_token", "nextPageToken", "nextPageToken", 2, "string", null, null, false, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     @JsonProperty("groups")
     private List<Group> groups = new ArrayList<>();
 
     @JsonProperty("nextPageToken")
+    @JsonAlias({"next_page_token"})
     private String nextPageToken;
 
     public ListGroupsResponse() {
@@ -59,19 +71,132 @@ public class ListGroupsResponse {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        ListGroupsResponse other = (ListGroupsResponse) o;
+        return Objects.equals(groups, other.groups)
+            && Objects.equals(nextPageToken, other.nextPageToken);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(groups, nextPageToken);
+    }
+
+    @Override
+    public String toString() {
+        return "ListGroupsResponse{"
+            + "groups=" + groups
+            + ", nextPageToken=" + (nextPageToken != null ? "'" + nextPageToken + "'" : null)
+            + "}";
+    }
+
+    /** Returns a ListGroupsResponse with every field at its zero value, unlike new ListGroupsResponse(), which applies the field defaults. */
+    public static ListGroupsResponse zeroValue() {
+        ListGroupsResponse zero = new ListGroupsResponse();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new ListGroupsResponse.
+     */
+    public void applyMask(ListGroupsResponse src, Collection<String> paths) {
+        ListGroupsResponse source = src != null ? src : new ListGroupsResponse();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case ListGroupsResponse_Groups_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("ListGroupsResponse: field " + name + " has no subfields");
+                    }
+                    this.groups = source.groups != null ? new ArrayList<>(source.groups) : new ArrayList<>();
+                    break;
+                case ListGroupsResponse_NextPageToken_PATH:
+                case "nextPageToken":
+                    if (rest != null) {
+                        throw new IllegalArgumentException("ListGroupsResponse: field " + name + " has no subfields");
+                    }
+                    this.nextPageToken = source.nextPageToken;
+                    break;
+                default:
+                    throw new IllegalArgumentException("ListGroupsResponse: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(ListGroupsResponse src) {
+        if (src == null) {
+            return;
+        }
+        if (src.groups != null) {
+            if (this.groups == null) {
+                this.groups = new ArrayList<>();
+            }
+            this.groups.addAll(src.groups);
+        }
+        if (src.nextPageToken != null && !src.nextPageToken.isEmpty()) {
+            this.nextPageToken = src.nextPageToken;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(ListGroupsResponse src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(ListGroupsResponse src, PuregenFieldMask mask) {
+        ListGroupsResponse masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public ListGroupsResponse redacted() {
+        ListGroupsResponse copy = new ListGroupsResponse();
+        if (groups != null) {
+            copy.groups = new ArrayList<>(groups);
+            copy.groups.replaceAll(item -> item != null ? item.redacted() : null);
+        } else {
+            copy.groups = null;
+        }
+        copy.nextPageToken = nextPageToken;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static ListGroupsResponse fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, ListGroupsResponse.class);
+        return PuregenJson.fromJson(json, ListGroupsResponse.class);
     }
 
 }
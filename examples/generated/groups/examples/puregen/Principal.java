@@ -8,6 +8,21 @@ import com.fasterxml.jackson.annotation.*;
 import com.fasterxml.jackson.databind.*;
 
 public class Principal {
+    // Field paths, for applyMask and field masks
+    public static final String Principal_Id_PATH = "id";
+    public static final String Principal_Name_PATH = "name";
+    public static final String Principal_Type_PATH = "type";
+    public static final String Principal_Roles_PATH = "roles";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "puregen.examples.groups.Principal", "Principal", "Principal",
+        Arrays.asList(
+            new PuregenFieldDescriptor("id", "id", "id", 1, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("name", "name", "name", 2, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("type", "type", "type", 3, "string", null, null, false, false, false, Collections.emptyMap()),
+            new PuregenFieldDescriptor("roles", "roles", "roles", 4, "string", null, null, true, false, false, Collections.emptyMap())),
+        Collections.emptyMap());
+
     // Unique identifier for the principal
     @JsonProperty("id")
     private String id;
@@ -94,19 +109,150 @@ public class Principal {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        Principal other = (Principal) o;
+        return Objects.equals(id, other.id)
+            && Objects.equals(name, other.name)
+            && Objects.equals(type, other.type)
+            && Objects.equals(roles, other.roles);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(id, name, type, roles);
+    }
+
+    @Override
+    public String toString() {
+        return "Principal{"
+            + "id=" + (id != null ? "'" + id + "'" : null)
+            + ", name=" + (name != null ? "'" + name + "'" : null)
+            + ", type=" + (type != null ? "'" + type + "'" : null)
+            + ", roles=" + roles
+            + "}";
+    }
+
+    /** Returns a Principal with every field at its zero value, unlike new Principal(), which applies the field defaults. */
+    public static Principal zeroValue() {
+        Principal zero = new Principal();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new Principal.
+     */
+    public void applyMask(Principal src, Collection<String> paths) {
+        Principal source = src != null ? src : new Principal();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case Principal_Id_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Principal: field " + name + " has no subfields");
+                    }
+                    this.id = source.id;
+                    break;
+                case Principal_Name_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Principal: field " + name + " has no subfields");
+                    }
+                    this.name = source.name;
+                    break;
+                case Principal_Type_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Principal: field " + name + " has no subfields");
+                    }
+                    this.type = source.type;
+                    break;
+                case Principal_Roles_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("Principal: field " + name + " has no subfields");
+                    }
+                    this.roles = source.roles != null ? new ArrayList<>(source.roles) : new ArrayList<>();
+                    break;
+                default:
+                    throw new IllegalArgumentException("Principal: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(Principal src) {
+        if (src == null) {
+            return;
+        }
+        if (src.id != null && !src.id.isEmpty()) {
+            this.id = src.id;
+        }
+        if (src.name != null && !src.name.isEmpty()) {
+            this.name = src.name;
+        }
+        if (src.type != null && !src.type.isEmpty()) {
+            this.type = src.type;
+        }
+        if (src.roles != null) {
+            if (this.roles == null) {
+                this.roles = new ArrayList<>();
+            }
+            this.roles.addAll(src.roles);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(Principal src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(Principal src, PuregenFieldMask mask) {
+        Principal masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public Principal redacted() {
+        Principal copy = new Principal();
+        copy.id = id;
+        copy.name = name;
+        copy.type = type;
+        copy.roles = roles != null ? new ArrayList<>(roles) : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static Principal fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, Principal.class);
+        return PuregenJson.fromJson(json, Principal.class);
     }
 
 }
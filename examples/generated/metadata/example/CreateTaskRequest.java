@@ -8,6 +8,17 @@ import com.fasterxml.jackson.annotation.*;
 import com.fasterxml.jackson.databind.*;
 
 public class CreateTaskRequest {
+    // Field paths, for applyMask and field masks
+    public static final String CreateTaskRequest_Title_PATH = "title";
+    public static final String CreateTaskRequest_Description_PATH = "description";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "example.metadata.CreateTaskRequest", "CreateTaskRequest", "CreateTaskRequest",
+        Arrays.asList(
+            new PuregenFieldDescriptor("title", "title", "title", 1, "string", null, null, false, false, false, CreateTaskRequestFieldMetadata.FIELD_METADATA.get(CreateTaskRequestFieldMetadata.CreateTaskRequest_Title_FIELD)),
+            new PuregenFieldDescriptor("description", "description", "description", 2, "string", null, null, false, false, false, Collections.emptyMap())),
+        CreateTaskRequestMetadata.METADATA);
+
     // Required fields for task creation
     @JsonProperty("title")
     private String title;
@@ -52,19 +63,123 @@ public class CreateTaskRequest {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        CreateTaskRequest other = (CreateTaskRequest) o;
+        return Objects.equals(title, other.title)
+            && Objects.equals(description, other.description);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(title, description);
+    }
+
+    @Override
+    public String toString() {
+        return "CreateTaskRequest{"
+            + "title=" + (title != null ? "'" + title + "'" : null)
+            + ", description=" + (description != null ? "'" + description + "'" : null)
+            + "}";
+    }
+
+    /** Returns a CreateTaskRequest with every field at its zero value, unlike new CreateTaskRequest(), which applies the field defaults. */
+    public static CreateTaskRequest zeroValue() {
+        CreateTaskRequest zero = new CreateTaskRequest();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new CreateTaskRequest.
+     */
+    public void applyMask(CreateTaskRequest src, Collection<String> paths) {
+        CreateTaskRequest source = src != null ? src : new CreateTaskRequest();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case CreateTaskRequest_Title_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("CreateTaskRequest: field " + name + " has no subfields");
+                    }
+                    this.title = source.title;
+                    break;
+                case CreateTaskRequest_Description_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("CreateTaskRequest: field " + name + " has no subfields");
+                    }
+                    this.description = source.description;
+                    break;
+                default:
+                    throw new IllegalArgumentException("CreateTaskRequest: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(CreateTaskRequest src) {
+        if (src == null) {
+            return;
+        }
+        if (src.title != null && !src.title.isEmpty()) {
+            this.title = src.title;
+        }
+        if (src.description != null && !src.description.isEmpty()) {
+            this.description = src.description;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(CreateTaskRequest src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(CreateTaskRequest src, PuregenFieldMask mask) {
+        CreateTaskRequest masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public CreateTaskRequest redacted() {
+        CreateTaskRequest copy = new CreateTaskRequest();
+        copy.title = title;
+        copy.description = description;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static CreateTaskRequest fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, CreateTaskRequest.class);
+        return PuregenJson.fromJson(json, CreateTaskRequest.class);
     }
 
 }
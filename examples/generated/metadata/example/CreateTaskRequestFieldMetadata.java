@@ -10,9 +10,9 @@ public final class CreateTaskRequestFieldMetadata {
     // Field name constants
     public static final String CreateTaskRequest_Title_FIELD = "CreateTaskRequest_Title";
 
-    public static final Map<String, Map<String, String>> FIELD_METADATA = new HashMap<>();
+    public static final Map<String, Map<String, Object>> FIELD_METADATA = new HashMap<>();
     static {
-        Map<String, String> titleMeta = new HashMap<>();
+        Map<String, Object> titleMeta = new HashMap<>();
         titleMeta.put("trim_whitespace", "true");
         titleMeta.put("validation", "required");
         FIELD_METADATA.put(CreateTaskRequest_Title_FIELD, titleMeta);
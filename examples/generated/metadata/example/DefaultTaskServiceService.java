@@ -2,6 +2,8 @@
 
 package metadata.example;
 
+import java.util.*;
+
 public class DefaultTaskServiceService implements TaskServiceService {
     // Create task endpoint with HTTP mapping
     @Override
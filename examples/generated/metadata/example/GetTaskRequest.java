@@ -8,6 +8,15 @@ import com.fasterxml.jackson.annotation.*;
 import com.fasterxml.jackson.databind.*;
 
 public class GetTaskRequest {
+    // Field paths, for applyMask and field masks
+    public static final String GetTaskRequest_Id_PATH = "id";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "example.metadata.GetTaskRequest", "GetTaskRequest", "GetTaskRequest",
+        Arrays.asList(
+            new PuregenFieldDescriptor("id", "id", "id", 1, "string", null, null, false, false, false, GetTaskRequestFieldMetadata.FIELD_METADATA.get(GetTaskRequestFieldMetadata.GetTaskRequest_Id_FIELD))),
+        GetTaskRequestMetadata.METADATA);
+
     // 
     @JsonProperty("id")
     private String id;
@@ -36,19 +45,111 @@ public class GetTaskRequest {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        GetTaskRequest other = (GetTaskRequest) o;
+        return Objects.equals(id, other.id);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(id);
+    }
+
+    @Override
+    public String toString() {
+        return "GetTaskRequest{"
+            + "id=" + (id != null ? "'" + id + "'" : null)
+            + "}";
+    }
+
+    /** Returns a GetTaskRequest with every field at its zero value, unlike new GetTaskRequest(), which applies the field defaults. */
+    public static GetTaskRequest zeroValue() {
+        GetTaskRequest zero = new GetTaskRequest();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new GetTaskRequest.
+     */
+    public void applyMask(GetTaskRequest src, Collection<String> paths) {
+        GetTaskRequest source = src != null ? src : new GetTaskRequest();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case GetTaskRequest_Id_PATH:
+                    if (rest != null) {
+                        throw new IllegalArgumentException("GetTaskRequest: field " + name + " has no subfields");
+                    }
+                    this.id = source.id;
+                    break;
+                default:
+                    throw new IllegalArgumentException("GetTaskRequest: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(GetTaskRequest src) {
+        if (src == null) {
+            return;
+        }
+        if (src.id != null && !src.id.isEmpty()) {
+            this.id = src.id;
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(GetTaskRequest src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(GetTaskRequest src, PuregenFieldMask mask) {
+        GetTaskRequest masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public GetTaskRequest redacted() {
+        GetTaskRequest copy = new GetTaskRequest();
+        copy.id = id;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static GetTaskRequest fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, GetTaskRequest.class);
+        return PuregenJson.fromJson(json, GetTaskRequest.class);
     }
 
 }
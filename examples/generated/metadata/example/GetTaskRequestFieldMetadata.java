@@ -10,9 +10,9 @@ public final class GetTaskRequestFieldMetadata {
     // Field name constants
     public static final String GetTaskRequest_Id_FIELD = "GetTaskRequest_Id";
 
-    public static final Map<String, Map<String, String>> FIELD_METADATA = new HashMap<>();
+    public static final Map<String, Map<String, Object>> FIELD_METADATA = new HashMap<>();
     static {
-        Map<String, String> idMeta = new HashMap<>();
+        Map<String, Object> idMeta = new HashMap<>();
         idMeta.put("validation", "uuid");
         FIELD_METADATA.put(GetTaskRequest_Id_FIELD, idMeta);
     }
@@ -8,6 +8,15 @@ import com.fasterxml.jackson.annotation.*;
 import com.fasterxml.jackson.databind.*;
 
 public class GetTaskResponse {
+    // Field paths, for applyMask and field masks
+    public static final String GetTaskResponse_Task_PATH = "task";
+
+    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(
+        "example.metadata.GetTaskResponse", "GetTaskResponse", "GetTaskResponse",
+        Arrays.asList(
+            new PuregenFieldDescriptor("task", "task", "task", 1, "message", "example.metadata.Task", null, false, false, false, Collections.emptyMap())),
+        GetTaskResponseMetadata.METADATA);
+
     @JsonProperty("task")
     private Task task;
 
@@ -35,19 +44,118 @@ public class GetTaskResponse {
         }
     }
 
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (o == null || getClass() != o.getClass()) {
+            return false;
+        }
+        GetTaskResponse other = (GetTaskResponse) o;
+        return Objects.equals(task, other.task);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(task);
+    }
+
+    @Override
+    public String toString() {
+        return "GetTaskResponse{"
+            + "task=" + task
+            + "}";
+    }
+
+    /** Returns a GetTaskResponse with every field at its zero value, unlike new GetTaskResponse(), which applies the field defaults. */
+    public static GetTaskResponse zeroValue() {
+        GetTaskResponse zero = new GetTaskResponse();
+        return zero;
+    }
+
+    /**
+     * Copies the fields named by paths from src. Paths are proto (or JSON) field
+     * names and may select nested fields, e.g. "profile.bio". A null src counts
+     * as a new GetTaskResponse.
+     */
+    public void applyMask(GetTaskResponse src, Collection<String> paths) {
+        GetTaskResponse source = src != null ? src : new GetTaskResponse();
+        for (String path : paths) {
+            int dot = path.indexOf('.');
+            String name = dot < 0 ? path : path.substring(0, dot);
+            String rest = dot < 0 ? null : path.substring(dot + 1);
+            switch (name) {
+                case GetTaskResponse_Task_PATH:
+                    if (rest == null) {
+                        this.task = source.task;
+                    } else {
+                        if (this.task == null) {
+                            this.task = Task.zeroValue();
+                        }
+                        this.task.applyMask(source.task, List.of(rest));
+                    }
+                    break;
+                default:
+                    throw new IllegalArgumentException("GetTaskResponse: unknown field path " + path);
+            }
+        }
+    }
+
+    /**
+     * Merges src as proto merge does: non-zero scalars and bytes overwrite,
+     * lists are appended, map entries are replaced and messages are merged
+     * recursively.
+     */
+    public void merge(GetTaskResponse src) {
+        if (src == null) {
+            return;
+        }
+        if (src.task != null) {
+            if (this.task == null) {
+                this.task = Task.zeroValue();
+            }
+            this.task.merge(src.task);
+        }
+    }
+
+    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */
+    public void applyMask(GetTaskResponse src, PuregenFieldMask mask) {
+        applyMask(src, mask != null ? mask.getPaths() : List.of());
+    }
+
+    /**
+     * Merges the fields named by a field mask from src: the fields of src
+     * outside the mask are left out, the others are merged as merge does.
+     */
+    public void merge(GetTaskResponse src, PuregenFieldMask mask) {
+        GetTaskResponse masked = zeroValue();
+        masked.applyMask(src, mask);
+        merge(masked);
+    }
+
+    /**
+     * Returns a deep copy that is safe to log: sensitive strings that are set
+     * become "[REDACTED]" and other sensitive fields are cleared,
+     * in this message and in every nested message.
+     */
+    public GetTaskResponse redacted() {
+        GetTaskResponse copy = new GetTaskResponse();
+        copy.task = task != null ? task.redacted() : null;
+        return copy;
+    }
+
     public boolean validate() {
         // Add custom validation logic here
         return true;
     }
 
     public String toJson() throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.writeValueAsString(this);
+        return PuregenJson.toJson(this);
     }
 
     public static GetTaskResponse fromJson(String json) throws Exception {
-        ObjectMapper mapper = new ObjectMapper();
-        return mapper.readValue(json, GetTaskResponse.class);
+        return PuregenJson.fromJson(json, GetTaskResponse.class);
     }
 
 }
@@ -14,30 +14,31 @@ public final class TaskFieldMetadata {
     public static final String Task_Status_FIELD = "Task_Status";
     public static final String Task_CreatedAt_FIELD = "Task_CreatedAt";
 
-    public static final Map<String, Map<String, String>> FIELD_METADATA = new HashMap<>();
+    public static final Map<String, Map<String, Object>> FIELD_METADATA = new HashMap<>();
     static {
-        Map<String, String> idMeta = new HashMap<>();
+        Map<String, Object> idMeta = new HashMap<>();
         idMeta.put("db_column", "task_id");
         idMeta.put("index", "primary");
         idMeta.put("validation", "uuid");
         FIELD_METADATA.put(Task_Id_FIELD, idMeta);
-        Map<String, String> titleMeta = new HashMap<>();
-        titleMeta.put("max_length", "200");
-        titleMeta.put("min_length", "1");
+        Map<String, Object> titleMeta = new HashMap<>();
+        titleMeta.put("max_length", 200);
+        titleMeta.put("min_length", 1);
         titleMeta.put("validation", "required");
         FIELD_METADATA.put(Task_Title_FIELD, titleMeta);
-        Map<String, String> descriptionMeta = new HashMap<>();
+        Map<String, Object> descriptionMeta = new HashMap<>();
         descriptionMeta.put("placeholder", "Enter task description...");
         descriptionMeta.put("ui_widget", "textarea");
         FIELD_METADATA.put(Task_Description_FIELD, descriptionMeta);
-        Map<String, String> statusMeta = new HashMap<>();
+        Map<String, Object> statusMeta = new HashMap<>();
         statusMeta.put("default", "PENDING");
         statusMeta.put("required", "true");
         statusMeta.put("validation", "enum");
         FIELD_METADATA.put(Task_Status_FIELD, statusMeta);
-        Map<String, String> createdatMeta = new HashMap<>();
+        Map<String, Object> createdatMeta = new HashMap<>();
         createdatMeta.put("format", "unix_timestamp");
         createdatMeta.put("index", "secondary");
+        createdatMeta.put("sortable", "true");
         FIELD_METADATA.put(Task_CreatedAt_FIELD, createdatMeta);
     }
 }
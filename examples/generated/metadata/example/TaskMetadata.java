@@ -7,10 +7,11 @@ import java.util.*;
 public final class TaskMetadata {
     private TaskMetadata() {} // Prevent instantiation
 
-    public static final Map<String, String> METADATA = new HashMap<>();
+    public static final Map<String, Object> METADATA = new HashMap<>();
     static {
-        METADATA.put("cache", "true");
+        METADATA.put("cache", true);
         METADATA.put("partition_key", "user_id");
+        METADATA.put("schema", "tasks");
         METADATA.put("table", "tasks");
     }
 }
@@ -14,7 +14,7 @@ public class TaskServiceClient {
     // Create task endpoint with HTTP mapping
     public CreateTaskResponse createTask(Map<String, Object> ctx, CreateTaskRequest request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = TaskServiceMethods.METHOD_METADATA.get(TaskServiceMethods.TaskService_CreateTask);
+        Map<String, Object> methodMetadata = TaskServiceMethods.METHOD_METADATA.get(TaskServiceMethods.TaskService_CreateTask);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -24,7 +24,7 @@ public class TaskServiceClient {
     // Get task endpoint with caching
     public GetTaskResponse getTask(Map<String, Object> ctx, GetTaskRequest request) throws Exception {
         Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());
-        Map<String, String> methodMetadata = TaskServiceMethods.METHOD_METADATA.get(TaskServiceMethods.TaskService_GetTask);
+        Map<String, Object> methodMetadata = TaskServiceMethods.METHOD_METADATA.get(TaskServiceMethods.TaskService_GetTask);
         if (methodMetadata != null) {
             enhancedCtx.put("method_metadata", methodMetadata);
         }
@@ -7,21 +7,32 @@ import java.util.*;
 public final class TaskServiceMethods {
     private TaskServiceMethods() {} // Prevent instantiation
 
+    private static Map<String, Object> metadataMap(Object... entries) {
+        Map<String, Object> map = new LinkedHashMap<>();
+        for (int i = 0; i < entries.length; i += 2) {
+            map.put((String) entries[i], entries[i + 1]);
+        }
+        return map;
+    }
+
     public static final String TaskService_CreateTask = "TaskService_CreateTask";
     public static final String TaskService_GetTask = "TaskService_GetTask";
 
-    public static final Map<String, Map<String, String>> METHOD_METADATA = new HashMap<>();
+    public static final Map<String, Map<String, Object>> METHOD_METADATA = new HashMap<>();
     static {
-        Map<String, String> createtaskMetadata = new HashMap<>();
+        Map<String, Object> createtaskMetadata = new HashMap<>();
         createtaskMetadata.put("auth", "required");
         createtaskMetadata.put("method", "POST");
         createtaskMetadata.put("path", "/api/v1/tasks");
+        createtaskMetadata.put("roles", Arrays.<Object>asList("admin", "ops"));
         METHOD_METADATA.put(TaskService_CreateTask, createtaskMetadata);
-        Map<String, String> gettaskMetadata = new HashMap<>();
-        gettaskMetadata.put("cache", "true");
-        gettaskMetadata.put("cache_ttl", "300");
+        Map<String, Object> gettaskMetadata = new HashMap<>();
+        gettaskMetadata.put("auth", "required");
+        gettaskMetadata.put("cache", true);
+        gettaskMetadata.put("cache_ttl", 300);
         gettaskMetadata.put("method", "GET");
         gettaskMetadata.put("path", "/api/v1/tasks/{id}");
+        gettaskMetadata.put("rate_limit", metadataMap("requests", 100, "window", "1m"));
         METHOD_METADATA.put(TaskService_GetTask, gettaskMetadata);
     }
 }
@@ -2,6 +2,8 @@
 
 package metadata.example;
 
+import java.util.*;
+
     // Example service with method metadata
 public interface TaskServiceService {
     // Create task endpoint with HTTP mapping
@@ -28,4 +28,21 @@ public final class TaskStatus {
         }
         return false;
     }
+
+    public static String getDisplayName(String value) {
+        switch (value) {
+            case UNKNOWN:
+                return "UNKNOWN";
+            case PENDING:
+                return "Pending";
+            case IN_PROGRESS:
+                return "In progress";
+            case COMPLETED:
+                return "Completed";
+            case CANCELLED:
+                return "Cancelled";
+            default:
+                return value;
+        }
+    }
 }
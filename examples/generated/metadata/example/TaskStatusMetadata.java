@@ -7,10 +7,32 @@ import java.util.*;
 public final class TaskStatusMetadata {
     private TaskStatusMetadata() {} // Prevent instantiation
 
-    public static final Map<String, String> METADATA = new HashMap<>();
+    public static final Map<String, Object> METADATA = new HashMap<>();
     static {
         METADATA.put("category", "status");
         METADATA.put("ui_type", "dropdown");
         METADATA.put("validation", "required");
     }
+
+    public static final Map<String, Map<String, Object>> VALUE_METADATA = new HashMap<>();
+    static {
+        Map<String, Object> pendingMeta = new HashMap<>();
+        pendingMeta.put("display_name", "Pending");
+        pendingMeta.put("order", 1);
+        VALUE_METADATA.put("PENDING", pendingMeta);
+        Map<String, Object> in_progressMeta = new HashMap<>();
+        in_progressMeta.put("display_name", "In progress");
+        in_progressMeta.put("order", 2);
+        VALUE_METADATA.put("IN_PROGRESS", in_progressMeta);
+        Map<String, Object> completedMeta = new HashMap<>();
+        completedMeta.put("display_name", "Completed");
+        completedMeta.put("order", 3);
+        completedMeta.put("terminal", true);
+        VALUE_METADATA.put("COMPLETED", completedMeta);
+        Map<String, Object> cancelledMeta = new HashMap<>();
+        cancelledMeta.put("display_name", "Cancelled");
+        cancelledMeta.put("order", 4);
+        cancelledMeta.put("terminal", true);
+        VALUE_METADATA.put("CANCELLED", cancelledMeta);
+    }
 }
@@ -1,2 +1,74 @@
+# Code generated by protoc-gen-puregen. DO NOT EDIT.
 # Package initialization file
-# Generated by protoc-gen-puregen
+
+from .puregen_transport import PuregenTransport, AsyncPuregenTransport
+from .puregen_descriptors import PuregenFieldDescriptor, PuregenMessageDescriptor, find_message_descriptor, message_descriptors
+from .puregen_registry import PuregenEnvelope, find_message_type, pack, unpack, unpack_as
+from .puregen_fieldmask import PuregenFieldMask
+from .booking import (
+    OperationType,
+    BookingStatus,
+    HotelReservationRequest_RoomType,
+    PaymentInfo,
+    Error,
+    BookingHeader,
+    BookingOperationRequest,
+    BookingOperationResponse,
+    ListBookingsRequest,
+    ListBookingsResponse,
+    BookingConfirmationRequest,
+    BookingStatsResponse,
+    HotelReservationRequest,
+    HotelReservationResponse,
+    HotelReservationResponse_Hotel,
+    HotelReservationResponse_AvailableRoom,
+    HotelReservationResponse_SingleHotelReservationResponse,
+    FlightBookingRequest,
+    FlightBookingResponse,
+    FlightBookingResponse_SingleFlightBooking,
+    TravelPackageBookingRequest,
+    TravelPackageBookingResponse,
+    TravelPackageBookingResponse_SingleTravelPackageResponse,
+    BookingServiceClient,
+    AsyncBookingServiceClient,
+)
+
+__all__ = [
+    "PuregenTransport",
+    "AsyncPuregenTransport",
+    "PuregenFieldDescriptor",
+    "PuregenMessageDescriptor",
+    "find_message_descriptor",
+    "message_descriptors",
+    "PuregenEnvelope",
+    "find_message_type",
+    "pack",
+    "unpack",
+    "unpack_as",
+    "PuregenFieldMask",
+    "OperationType",
+    "BookingStatus",
+    "HotelReservationRequest_RoomType",
+    "PaymentInfo",
+    "Error",
+    "BookingHeader",
+    "BookingOperationRequest",
+    "BookingOperationResponse",
+    "ListBookingsRequest",
+    "ListBookingsResponse",
+    "BookingConfirmationRequest",
+    "BookingStatsResponse",
+    "HotelReservationRequest",
+    "HotelReservationResponse",
+    "HotelReservationResponse_Hotel",
+    "HotelReservationResponse_AvailableRoom",
+    "HotelReservationResponse_SingleHotelReservationResponse",
+    "FlightBookingRequest",
+    "FlightBookingResponse",
+    "FlightBookingResponse_SingleFlightBooking",
+    "TravelPackageBookingRequest",
+    "TravelPackageBookingResponse",
+    "TravelPackageBookingResponse_SingleTravelPackageResponse",
+    "BookingServiceClient",
+    "AsyncBookingServiceClient",
+]
@@ -1,11 +1,23 @@
 # Code generated by protoc-gen-puregen. DO NOT EDIT.
 
 from dataclasses import dataclass, field
-from typing import Optional, List, Dict, Any
+from typing import Optional, List, Dict, Any, ClassVar, Final, Literal, Union
 from abc import ABC, abstractmethod
+import base64
+import copy
 import json
+import sys
+import warnings
 from enum import IntEnum
-from .puregen_transport import PuregenTransport
+from .puregen_transport import PuregenTransport, AsyncPuregenTransport
+from .puregen_descriptors import PuregenFieldDescriptor, PuregenMessageDescriptor, register_message_descriptors
+from .puregen_registry import PuregenEnvelope, register_message_types
+from .puregen_fieldmask import PuregenFieldMask
+
+if sys.version_info >= (3, 11):
+    from typing import Self
+else:
+    from typing_extensions import Self
 
 # Enums
 
@@ -17,6 +29,18 @@ class OperationType(IntEnum):
     OPERATIONTYPE_FLIGHT_BOOKING = 2
     OPERATIONTYPE_TRAVEL_PACKAGE = 3
 
+    @classmethod
+    def _missing_(cls, value: object) -> Optional['OperationType']:
+        """Look up names, and keep numbers without a value as UNRECOGNIZED members"""
+        if isinstance(value, str):
+            return cls.__members__.get(value)
+        if isinstance(value, int):
+            member = int.__new__(cls, value)
+            member._name_ = "UNRECOGNIZED"
+            member._value_ = value
+            return member
+        return None
+
     @classmethod
     def is_valid(cls, value: int) -> bool:
         """Check if value is a valid OperationType"""
@@ -25,14 +49,14 @@ class OperationType(IntEnum):
 # Status of the booking request
 class BookingStatus:
     """BookingStatus enum values as string constants"""
-    BOOKINGSTATUS_UNKNOWN = "BookingStatus_UNKNOWN"
-    BOOKINGSTATUS_CONFIRMED = "BookingStatus_CONFIRMED"
-    BOOKINGSTATUS_FAILED = "BookingStatus_FAILED"
-    BOOKINGSTATUS_PENDING = "BookingStatus_PENDING"
-    BOOKINGSTATUS_PARTIAL_CONFIRMATION = "BookingStatus_PARTIAL_CONFIRMATION"
-    BOOKINGSTATUS_CANCELLED = "BookingStatus_CANCELLED"
-
-    VALUES = [
+    BOOKINGSTATUS_UNKNOWN: Final = "BookingStatus_UNKNOWN"
+    BOOKINGSTATUS_CONFIRMED: Final = "BookingStatus_CONFIRMED"
+    BOOKINGSTATUS_FAILED: Final = "BookingStatus_FAILED"
+    BOOKINGSTATUS_PENDING: Final = "BookingStatus_PENDING"
+    BOOKINGSTATUS_PARTIAL_CONFIRMATION: Final = "BookingStatus_PARTIAL_CONFIRMATION"
+    BOOKINGSTATUS_CANCELLED: Final = "BookingStatus_CANCELLED"
+
+    VALUES: Final[List[str]] = [
         BOOKINGSTATUS_UNKNOWN,
         BOOKINGSTATUS_CONFIRMED,
         BOOKINGSTATUS_FAILED,
@@ -49,13 +73,13 @@ class BookingStatus:
 # Enum for room types
 class HotelReservationRequest_RoomType:
     """HotelReservationRequest_RoomType enum values as string constants"""
-    ROOMTYPE_UNKNOWN = "RoomType_UNKNOWN"
-    ROOMTYPE_STANDARD = "RoomType_STANDARD"
-    ROOMTYPE_DELUXE = "RoomType_DELUXE"
-    ROOMTYPE_SUITE = "RoomType_SUITE"
-    ROOMTYPE_EXECUTIVE = "RoomType_EXECUTIVE"
+    ROOMTYPE_UNKNOWN: Final = "RoomType_UNKNOWN"
+    ROOMTYPE_STANDARD: Final = "RoomType_STANDARD"
+    ROOMTYPE_DELUXE: Final = "RoomType_DELUXE"
+    ROOMTYPE_SUITE: Final = "RoomType_SUITE"
+    ROOMTYPE_EXECUTIVE: Final = "RoomType_EXECUTIVE"
 
-    VALUES = [
+    VALUES: Final[List[str]] = [
         ROOMTYPE_UNKNOWN,
         ROOMTYPE_STANDARD,
         ROOMTYPE_DELUXE,
@@ -74,6 +98,13 @@ class HotelReservationRequest_RoomType:
 @dataclass
 class PaymentInfo:
     """Generated message class for PaymentInfo"""
+    class Paths:
+        """Field paths of PaymentInfo, for apply_mask and field masks"""
+        PAYMENT_METHOD = "paymentMethod"
+        PAYMENT_TOKEN = "paymentToken"
+        OPERATION_TYPE = "operationType"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Payment method (e.g., credit card, PayPal)
     payment_method: str = ""
     # Card token or payment reference
@@ -85,13 +116,65 @@ class PaymentInfo:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a PaymentInfo with every field at its zero value, unlike
+        PaymentInfo(), which applies the field defaults."""
+        return cls(payment_method="", payment_token="", operation_type=0)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new PaymentInfo."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == PaymentInfo.Paths.PAYMENT_METHOD:
+                if dot:
+                    raise ValueError(f"PaymentInfo: field {name!r} has no subfields")
+                self.payment_method = source.payment_method
+            elif name == PaymentInfo.Paths.PAYMENT_TOKEN:
+                if dot:
+                    raise ValueError(f"PaymentInfo: field {name!r} has no subfields")
+                self.payment_token = source.payment_token
+            elif name == PaymentInfo.Paths.OPERATION_TYPE:
+                if dot:
+                    raise ValueError(f"PaymentInfo: field {name!r} has no subfields")
+                self.operation_type = source.operation_type
+            else:
+                raise ValueError(f"PaymentInfo: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.payment_method:
+            self.payment_method = src.payment_method
+        if src.payment_token:
+            self.payment_token = src.payment_token
+        if src.operation_type:
+            self.operation_type = src.operation_type
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.payment_method is not None:
             result['paymentMethod'] = self.payment_method
         if self.payment_token is not None:
@@ -101,27 +184,33 @@ class PaymentInfo:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'PaymentInfo':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'PaymentInfo':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'paymentMethod' in data:
             kwargs['payment_method'] = data['paymentMethod']
         if 'paymentToken' in data:
             kwargs['payment_token'] = data['paymentToken']
-        if 'operationType' in data:
-            kwargs['operation_type'] = data['operationType']
+        if data.get('operationType') is not None:
+            kwargs['operation_type'] = OperationType(data['operationType'])
         return cls(**kwargs)
 
 # Error Response
 @dataclass
 class Error:
     """Generated message class for Error"""
+    class Paths:
+        """Field paths of Error, for apply_mask and field masks"""
+        MESSAGE = "message"
+        CODE = "code"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Error message
     message: str = ""
     # Error code
@@ -132,13 +221,59 @@ class Error:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a Error with every field at its zero value, unlike
+        Error(), which applies the field defaults."""
+        return cls(message="", code="")
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new Error."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == Error.Paths.MESSAGE:
+                if dot:
+                    raise ValueError(f"Error: field {name!r} has no subfields")
+                self.message = source.message
+            elif name == Error.Paths.CODE:
+                if dot:
+                    raise ValueError(f"Error: field {name!r} has no subfields")
+                self.code = source.code
+            else:
+                raise ValueError(f"Error: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.message:
+            self.message = src.message
+        if src.code:
+            self.code = src.code
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.message is not None:
             result['message'] = self.message
         if self.code is not None:
@@ -146,15 +281,15 @@ class Error:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'Error':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'Error':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'message' in data:
             kwargs['message'] = data['message']
         if 'code' in data:
@@ -165,6 +300,14 @@ class Error:
 @dataclass
 class BookingHeader:
     """Generated message class for BookingHeader"""
+    class Paths:
+        """Field paths of BookingHeader, for apply_mask and field masks"""
+        USER_ID = "userId"
+        APPLICATION_NAME = "applicationName"
+        REQUEST_ID = "requestId"
+        REQUEST_TIMESTAMP = "requestTimestamp"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # User who initiated the booking request
     user_id: str = ""
     # Application from which the request originated
@@ -179,13 +322,71 @@ class BookingHeader:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a BookingHeader with every field at its zero value, unlike
+        BookingHeader(), which applies the field defaults."""
+        return cls(user_id="", application_name="", request_id="", request_timestamp=0)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new BookingHeader."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == BookingHeader.Paths.USER_ID:
+                if dot:
+                    raise ValueError(f"BookingHeader: field {name!r} has no subfields")
+                self.user_id = source.user_id
+            elif name == BookingHeader.Paths.APPLICATION_NAME:
+                if dot:
+                    raise ValueError(f"BookingHeader: field {name!r} has no subfields")
+                self.application_name = source.application_name
+            elif name == BookingHeader.Paths.REQUEST_ID:
+                if dot:
+                    raise ValueError(f"BookingHeader: field {name!r} has no subfields")
+                self.request_id = source.request_id
+            elif name == BookingHeader.Paths.REQUEST_TIMESTAMP:
+                if dot:
+                    raise ValueError(f"BookingHeader: field {name!r} has no subfields")
+                self.request_timestamp = source.request_timestamp
+            else:
+                raise ValueError(f"BookingHeader: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.user_id:
+            self.user_id = src.user_id
+        if src.application_name:
+            self.application_name = src.application_name
+        if src.request_id:
+            self.request_id = src.request_id
+        if src.request_timestamp:
+            self.request_timestamp = src.request_timestamp
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.user_id is not None:
             result['userId'] = self.user_id
         if self.application_name is not None:
@@ -197,15 +398,15 @@ class BookingHeader:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'BookingHeader':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'BookingHeader':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'userId' in data:
             kwargs['user_id'] = data['userId']
         if 'applicationName' in data:
@@ -219,6 +420,13 @@ class BookingHeader:
 @dataclass
 class BookingOperationRequest:
     """Generated message class for BookingOperationRequest"""
+    class Paths:
+        """Field paths of BookingOperationRequest, for apply_mask and field masks"""
+        OPERATION_ID = "operationId"
+        PAYMENT_INFO = "paymentInfo"
+        CONFIRM = "confirm"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Operation ID
     operation_id: str = ""
     # Payment info used during original request
@@ -231,13 +439,72 @@ class BookingOperationRequest:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a BookingOperationRequest with every field at its zero value, unlike
+        BookingOperationRequest(), which applies the field defaults."""
+        return cls(operation_id="", payment_info=None, confirm=False)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new BookingOperationRequest."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == BookingOperationRequest.Paths.OPERATION_ID:
+                if dot:
+                    raise ValueError(f"BookingOperationRequest: field {name!r} has no subfields")
+                self.operation_id = source.operation_id
+            elif name == BookingOperationRequest.Paths.PAYMENT_INFO:
+                if not dot:
+                    self.payment_info = copy.deepcopy(source.payment_info)
+                else:
+                    if self.payment_info is None:
+                        self.payment_info = PaymentInfo.zero_value()
+                    self.payment_info.apply_mask(source.payment_info, [rest])
+            elif name == BookingOperationRequest.Paths.CONFIRM:
+                if dot:
+                    raise ValueError(f"BookingOperationRequest: field {name!r} has no subfields")
+                self.confirm = source.confirm
+            else:
+                raise ValueError(f"BookingOperationRequest: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.operation_id:
+            self.operation_id = src.operation_id
+        if src.payment_info is not None:
+            if self.payment_info is None:
+                self.payment_info = PaymentInfo.zero_value()
+            self.payment_info.merge(src.payment_info)
+        if src.confirm:
+            self.confirm = src.confirm
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.payment_info is not None:
+            result.payment_info = result.payment_info.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.operation_id is not None:
             result['operationId'] = self.operation_id
         if self.payment_info is not None:
@@ -247,15 +514,15 @@ class BookingOperationRequest:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'BookingOperationRequest':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'BookingOperationRequest':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'operationId' in data:
             kwargs['operation_id'] = data['operationId']
         if 'paymentInfo' in data:
@@ -268,10 +535,17 @@ class BookingOperationRequest:
 @dataclass
 class BookingOperationResponse:
     """Generated message class for BookingOperationResponse"""
+    class Paths:
+        """Field paths of BookingOperationResponse, for apply_mask and field masks"""
+        OPERATION_ID = "operationId"
+        STATUS = "status"
+        ERROR = "error"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Operation ID
     operation_id: str = ""
     # Status of the booking
-    status: str = 0
+    status: Literal["BookingStatus_UNKNOWN", "BookingStatus_CONFIRMED", "BookingStatus_FAILED", "BookingStatus_PENDING", "BookingStatus_PARTIAL_CONFIRMATION", "BookingStatus_CANCELLED"] = "BookingStatus_UNKNOWN"
     # Error message
     error: Optional['Error'] = None
 
@@ -280,13 +554,72 @@ class BookingOperationResponse:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a BookingOperationResponse with every field at its zero value, unlike
+        BookingOperationResponse(), which applies the field defaults."""
+        return cls(operation_id="", status="BookingStatus_UNKNOWN", error=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new BookingOperationResponse."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == BookingOperationResponse.Paths.OPERATION_ID:
+                if dot:
+                    raise ValueError(f"BookingOperationResponse: field {name!r} has no subfields")
+                self.operation_id = source.operation_id
+            elif name == BookingOperationResponse.Paths.STATUS:
+                if dot:
+                    raise ValueError(f"BookingOperationResponse: field {name!r} has no subfields")
+                self.status = source.status
+            elif name == BookingOperationResponse.Paths.ERROR:
+                if not dot:
+                    self.error = copy.deepcopy(source.error)
+                else:
+                    if self.error is None:
+                        self.error = Error.zero_value()
+                    self.error.apply_mask(source.error, [rest])
+            else:
+                raise ValueError(f"BookingOperationResponse: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.operation_id:
+            self.operation_id = src.operation_id
+        if src.status != "BookingStatus_UNKNOWN":
+            self.status = src.status
+        if src.error is not None:
+            if self.error is None:
+                self.error = Error.zero_value()
+            self.error.merge(src.error)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.error is not None:
+            result.error = result.error.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.operation_id is not None:
             result['operationId'] = self.operation_id
         if self.status is not None:
@@ -296,15 +629,15 @@ class BookingOperationResponse:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'BookingOperationResponse':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'BookingOperationResponse':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'operationId' in data:
             kwargs['operation_id'] = data['operationId']
         if 'status' in data:
@@ -316,6 +649,11 @@ class BookingOperationResponse:
 @dataclass
 class ListBookingsRequest:
     """Generated message class for ListBookingsRequest"""
+    class Paths:
+        """Field paths of ListBookingsRequest, for apply_mask and field masks"""
+        PAYMENT_INFO = "paymentInfo"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Payment info used during original request
     payment_info: Optional['PaymentInfo'] = None
 
@@ -324,27 +662,74 @@ class ListBookingsRequest:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a ListBookingsRequest with every field at its zero value, unlike
+        ListBookingsRequest(), which applies the field defaults."""
+        return cls(payment_info=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new ListBookingsRequest."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == ListBookingsRequest.Paths.PAYMENT_INFO:
+                if not dot:
+                    self.payment_info = copy.deepcopy(source.payment_info)
+                else:
+                    if self.payment_info is None:
+                        self.payment_info = PaymentInfo.zero_value()
+                    self.payment_info.apply_mask(source.payment_info, [rest])
+            else:
+                raise ValueError(f"ListBookingsRequest: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.payment_info is not None:
+            if self.payment_info is None:
+                self.payment_info = PaymentInfo.zero_value()
+            self.payment_info.merge(src.payment_info)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.payment_info is not None:
+            result.payment_info = result.payment_info.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.payment_info is not None:
             result['paymentInfo'] = self.payment_info.to_dict() if hasattr(self.payment_info, 'to_dict') else self.payment_info
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'ListBookingsRequest':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'ListBookingsRequest':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'paymentInfo' in data:
             kwargs['payment_info'] = PaymentInfo.from_dict(data['paymentInfo']) if isinstance(data['paymentInfo'], dict) else data['paymentInfo']
         return cls(**kwargs)
@@ -353,6 +738,13 @@ class ListBookingsRequest:
 @dataclass
 class ListBookingsResponse:
     """Generated message class for ListBookingsResponse"""
+    class Paths:
+        """Field paths of ListBookingsResponse, for apply_mask and field masks"""
+        CONFIRMED_BOOKING_IDS = "confirmedBookingIds"
+        PENDING_BOOKING_IDS = "pendingBookingIds"
+        ERROR = "error"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # List of confirmed booking IDs
     confirmed_booking_ids: List[str] = field(default_factory=list)
     # Pending booking IDs
@@ -365,13 +757,70 @@ class ListBookingsResponse:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a ListBookingsResponse with every field at its zero value, unlike
+        ListBookingsResponse(), which applies the field defaults."""
+        return cls(confirmed_booking_ids=[], pending_booking_ids=[], error=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new ListBookingsResponse."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == ListBookingsResponse.Paths.CONFIRMED_BOOKING_IDS:
+                if dot:
+                    raise ValueError(f"ListBookingsResponse: field {name!r} has no subfields")
+                self.confirmed_booking_ids = list(source.confirmed_booking_ids)
+            elif name == ListBookingsResponse.Paths.PENDING_BOOKING_IDS:
+                if dot:
+                    raise ValueError(f"ListBookingsResponse: field {name!r} has no subfields")
+                self.pending_booking_ids = list(source.pending_booking_ids)
+            elif name == ListBookingsResponse.Paths.ERROR:
+                if not dot:
+                    self.error = copy.deepcopy(source.error)
+                else:
+                    if self.error is None:
+                        self.error = Error.zero_value()
+                    self.error.apply_mask(source.error, [rest])
+            else:
+                raise ValueError(f"ListBookingsResponse: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        self.confirmed_booking_ids.extend(src.confirmed_booking_ids)
+        self.pending_booking_ids.extend(src.pending_booking_ids)
+        if src.error is not None:
+            if self.error is None:
+                self.error = Error.zero_value()
+            self.error.merge(src.error)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.error is not None:
+            result.error = result.error.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.confirmed_booking_ids is not None:
             result['confirmedBookingIds'] = self.confirmed_booking_ids
         if self.pending_booking_ids is not None:
@@ -381,15 +830,15 @@ class ListBookingsResponse:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'ListBookingsResponse':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'ListBookingsResponse':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'confirmedBookingIds' in data:
             kwargs['confirmed_booking_ids'] = data['confirmedBookingIds']
         if 'pendingBookingIds' in data:
@@ -401,6 +850,12 @@ class ListBookingsResponse:
 @dataclass
 class BookingConfirmationRequest:
     """Generated message class for BookingConfirmationRequest"""
+    class Paths:
+        """Field paths of BookingConfirmationRequest, for apply_mask and field masks"""
+        BOOKING_IDS = "bookingIds"
+        PAYMENT_INFO = "paymentInfo"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Booking ID
     booking_ids: List[str] = field(default_factory=list)
     # Payment info used during original request
@@ -411,13 +866,65 @@ class BookingConfirmationRequest:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a BookingConfirmationRequest with every field at its zero value, unlike
+        BookingConfirmationRequest(), which applies the field defaults."""
+        return cls(booking_ids=[], payment_info=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new BookingConfirmationRequest."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == BookingConfirmationRequest.Paths.BOOKING_IDS:
+                if dot:
+                    raise ValueError(f"BookingConfirmationRequest: field {name!r} has no subfields")
+                self.booking_ids = list(source.booking_ids)
+            elif name == BookingConfirmationRequest.Paths.PAYMENT_INFO:
+                if not dot:
+                    self.payment_info = copy.deepcopy(source.payment_info)
+                else:
+                    if self.payment_info is None:
+                        self.payment_info = PaymentInfo.zero_value()
+                    self.payment_info.apply_mask(source.payment_info, [rest])
+            else:
+                raise ValueError(f"BookingConfirmationRequest: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        self.booking_ids.extend(src.booking_ids)
+        if src.payment_info is not None:
+            if self.payment_info is None:
+                self.payment_info = PaymentInfo.zero_value()
+            self.payment_info.merge(src.payment_info)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.payment_info is not None:
+            result.payment_info = result.payment_info.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.booking_ids is not None:
             result['bookingIds'] = self.booking_ids
         if self.payment_info is not None:
@@ -425,15 +932,15 @@ class BookingConfirmationRequest:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'BookingConfirmationRequest':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'BookingConfirmationRequest':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'bookingIds' in data:
             kwargs['booking_ids'] = data['bookingIds']
         if 'paymentInfo' in data:
@@ -443,6 +950,13 @@ class BookingConfirmationRequest:
 @dataclass
 class BookingStatsResponse:
     """Generated message class for BookingStatsResponse"""
+    class Paths:
+        """Field paths of BookingStatsResponse, for apply_mask and field masks"""
+        TOTAL_AMOUNT_CHARGED = "totalAmountCharged"
+        TOTAL_GUESTS = "totalGuests"
+        TOTAL_BOOKINGS = "totalBookings"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Total amount charged
     total_amount_charged: float = 0.0
     # Total number of guests
@@ -455,13 +969,65 @@ class BookingStatsResponse:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a BookingStatsResponse with every field at its zero value, unlike
+        BookingStatsResponse(), which applies the field defaults."""
+        return cls(total_amount_charged=0.0, total_guests=0, total_bookings=0)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new BookingStatsResponse."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == BookingStatsResponse.Paths.TOTAL_AMOUNT_CHARGED:
+                if dot:
+                    raise ValueError(f"BookingStatsResponse: field {name!r} has no subfields")
+                self.total_amount_charged = source.total_amount_charged
+            elif name == BookingStatsResponse.Paths.TOTAL_GUESTS:
+                if dot:
+                    raise ValueError(f"BookingStatsResponse: field {name!r} has no subfields")
+                self.total_guests = source.total_guests
+            elif name == BookingStatsResponse.Paths.TOTAL_BOOKINGS:
+                if dot:
+                    raise ValueError(f"BookingStatsResponse: field {name!r} has no subfields")
+                self.total_bookings = source.total_bookings
+            else:
+                raise ValueError(f"BookingStatsResponse: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.total_amount_charged:
+            self.total_amount_charged = src.total_amount_charged
+        if src.total_guests:
+            self.total_guests = src.total_guests
+        if src.total_bookings:
+            self.total_bookings = src.total_bookings
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.total_amount_charged is not None:
             result['totalAmountCharged'] = self.total_amount_charged
         if self.total_guests is not None:
@@ -471,15 +1037,15 @@ class BookingStatsResponse:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'BookingStatsResponse':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'BookingStatsResponse':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'totalAmountCharged' in data:
             kwargs['total_amount_charged'] = data['totalAmountCharged']
         if 'totalGuests' in data:
@@ -492,10 +1058,21 @@ class BookingStatsResponse:
 @dataclass
 class HotelReservationRequest:
     """Generated message class for HotelReservationRequest"""
+    class Paths:
+        """Field paths of HotelReservationRequest, for apply_mask and field masks"""
+        HOTEL_LOCATIONS = "hotelLocations"
+        ROOM_TYPES = "roomTypes"
+        MAX_PRICE_PER_NIGHT = "maxPricePerNight"
+        PAYMENT_INFO = "paymentInfo"
+        CHECK_IN_DATE = "checkInDate"
+        CHECK_OUT_DATE = "checkOutDate"
+        NUMBER_OF_GUESTS = "numberOfGuests"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Hotel search criteria
     hotel_locations: List[str] = field(default_factory=list)
     # List of preferred room types
-    room_types: List[str] = field(default_factory=list)
+    room_types: List[Literal["RoomType_UNKNOWN", "RoomType_STANDARD", "RoomType_DELUXE", "RoomType_SUITE", "RoomType_EXECUTIVE"]] = field(default_factory=list)
     # Maximum price per night
     max_price_per_night: float = 0.0
     # Required payment information
@@ -511,13 +1088,94 @@ class HotelReservationRequest:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a HotelReservationRequest with every field at its zero value, unlike
+        HotelReservationRequest(), which applies the field defaults."""
+        return cls(hotel_locations=[], room_types=[], max_price_per_night=0.0, payment_info=None, check_in_date=0, check_out_date=0, number_of_guests=0)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new HotelReservationRequest."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == HotelReservationRequest.Paths.HOTEL_LOCATIONS:
+                if dot:
+                    raise ValueError(f"HotelReservationRequest: field {name!r} has no subfields")
+                self.hotel_locations = list(source.hotel_locations)
+            elif name == HotelReservationRequest.Paths.ROOM_TYPES:
+                if dot:
+                    raise ValueError(f"HotelReservationRequest: field {name!r} has no subfields")
+                self.room_types = list(source.room_types)
+            elif name == HotelReservationRequest.Paths.MAX_PRICE_PER_NIGHT:
+                if dot:
+                    raise ValueError(f"HotelReservationRequest: field {name!r} has no subfields")
+                self.max_price_per_night = source.max_price_per_night
+            elif name == HotelReservationRequest.Paths.PAYMENT_INFO:
+                if not dot:
+                    self.payment_info = copy.deepcopy(source.payment_info)
+                else:
+                    if self.payment_info is None:
+                        self.payment_info = PaymentInfo.zero_value()
+                    self.payment_info.apply_mask(source.payment_info, [rest])
+            elif name == HotelReservationRequest.Paths.CHECK_IN_DATE:
+                if dot:
+                    raise ValueError(f"HotelReservationRequest: field {name!r} has no subfields")
+                self.check_in_date = source.check_in_date
+            elif name == HotelReservationRequest.Paths.CHECK_OUT_DATE:
+                if dot:
+                    raise ValueError(f"HotelReservationRequest: field {name!r} has no subfields")
+                self.check_out_date = source.check_out_date
+            elif name == HotelReservationRequest.Paths.NUMBER_OF_GUESTS:
+                if dot:
+                    raise ValueError(f"HotelReservationRequest: field {name!r} has no subfields")
+                self.number_of_guests = source.number_of_guests
+            else:
+                raise ValueError(f"HotelReservationRequest: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        self.hotel_locations.extend(src.hotel_locations)
+        self.room_types.extend(src.room_types)
+        if src.max_price_per_night:
+            self.max_price_per_night = src.max_price_per_night
+        if src.payment_info is not None:
+            if self.payment_info is None:
+                self.payment_info = PaymentInfo.zero_value()
+            self.payment_info.merge(src.payment_info)
+        if src.check_in_date:
+            self.check_in_date = src.check_in_date
+        if src.check_out_date:
+            self.check_out_date = src.check_out_date
+        if src.number_of_guests:
+            self.number_of_guests = src.number_of_guests
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.payment_info is not None:
+            result.payment_info = result.payment_info.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.hotel_locations is not None:
             result['hotelLocations'] = self.hotel_locations
         if self.room_types is not None:
@@ -535,15 +1193,15 @@ class HotelReservationRequest:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'HotelReservationRequest':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'HotelReservationRequest':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'hotelLocations' in data:
             kwargs['hotel_locations'] = data['hotelLocations']
         if 'roomTypes' in data:
@@ -564,10 +1222,18 @@ class HotelReservationRequest:
 @dataclass
 class HotelReservationResponse:
     """Generated message class for HotelReservationResponse"""
+    class Paths:
+        """Field paths of HotelReservationResponse, for apply_mask and field masks"""
+        RESULT = "result"
+        STATUS = "status"
+        ERROR = "error"
+        BOOKING_STATS = "bookingStats"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # List of results for each search location
     result: List['HotelReservationResponse_SingleHotelReservationResponse'] = field(default_factory=list)
     # Status of the request
-    status: str = 0
+    status: Literal["BookingStatus_UNKNOWN", "BookingStatus_CONFIRMED", "BookingStatus_FAILED", "BookingStatus_PENDING", "BookingStatus_PARTIAL_CONFIRMATION", "BookingStatus_CANCELLED"] = "BookingStatus_UNKNOWN"
     # Error message
     error: Optional['Error'] = None
     # Booking stats
@@ -578,13 +1244,85 @@ class HotelReservationResponse:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a HotelReservationResponse with every field at its zero value, unlike
+        HotelReservationResponse(), which applies the field defaults."""
+        return cls(result=[], status="BookingStatus_UNKNOWN", error=None, booking_stats=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new HotelReservationResponse."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == HotelReservationResponse.Paths.RESULT:
+                if dot:
+                    raise ValueError(f"HotelReservationResponse: field {name!r} has no subfields")
+                self.result = copy.deepcopy(source.result)
+            elif name == HotelReservationResponse.Paths.STATUS:
+                if dot:
+                    raise ValueError(f"HotelReservationResponse: field {name!r} has no subfields")
+                self.status = source.status
+            elif name == HotelReservationResponse.Paths.ERROR:
+                if not dot:
+                    self.error = copy.deepcopy(source.error)
+                else:
+                    if self.error is None:
+                        self.error = Error.zero_value()
+                    self.error.apply_mask(source.error, [rest])
+            elif name == HotelReservationResponse.Paths.BOOKING_STATS:
+                if not dot:
+                    self.booking_stats = copy.deepcopy(source.booking_stats)
+                else:
+                    if self.booking_stats is None:
+                        self.booking_stats = BookingStatsResponse.zero_value()
+                    self.booking_stats.apply_mask(source.booking_stats, [rest])
+            else:
+                raise ValueError(f"HotelReservationResponse: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        self.result.extend(copy.deepcopy(src.result))
+        if src.status != "BookingStatus_UNKNOWN":
+            self.status = src.status
+        if src.error is not None:
+            if self.error is None:
+                self.error = Error.zero_value()
+            self.error.merge(src.error)
+        if src.booking_stats is not None:
+            if self.booking_stats is None:
+                self.booking_stats = BookingStatsResponse.zero_value()
+            self.booking_stats.merge(src.booking_stats)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        result.result = [item.redacted() for item in result.result]
+        if result.error is not None:
+            result.error = result.error.redacted()
+        if result.booking_stats is not None:
+            result.booking_stats = result.booking_stats.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.result is not None:
             result['result'] = [item.to_dict() if hasattr(item, 'to_dict') else item for item in self.result]
         if self.status is not None:
@@ -596,15 +1334,15 @@ class HotelReservationResponse:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'HotelReservationResponse':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'HotelReservationResponse':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'result' in data:
             kwargs['result'] = [HotelReservationResponse_SingleHotelReservationResponse.from_dict(item) if isinstance(item, dict) else item for item in data['result']]
         if 'status' in data:
@@ -619,6 +1357,14 @@ class HotelReservationResponse:
 @dataclass
 class HotelReservationResponse_Hotel:
     """Generated message class for HotelReservationResponse_Hotel"""
+    class Paths:
+        """Field paths of HotelReservationResponse_Hotel, for apply_mask and field masks"""
+        NAME = "name"
+        RATING = "rating"
+        PRICE_PER_NIGHT = "pricePerNight"
+        ADDRESS = "address"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Name of the hotel
     name: str = ""
     # Hotel rating (1-5 stars)
@@ -633,13 +1379,71 @@ class HotelReservationResponse_Hotel:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a HotelReservationResponse_Hotel with every field at its zero value, unlike
+        HotelReservationResponse_Hotel(), which applies the field defaults."""
+        return cls(name="", rating=0.0, price_per_night=0.0, address="")
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new HotelReservationResponse_Hotel."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == HotelReservationResponse_Hotel.Paths.NAME:
+                if dot:
+                    raise ValueError(f"HotelReservationResponse_Hotel: field {name!r} has no subfields")
+                self.name = source.name
+            elif name == HotelReservationResponse_Hotel.Paths.RATING:
+                if dot:
+                    raise ValueError(f"HotelReservationResponse_Hotel: field {name!r} has no subfields")
+                self.rating = source.rating
+            elif name == HotelReservationResponse_Hotel.Paths.PRICE_PER_NIGHT:
+                if dot:
+                    raise ValueError(f"HotelReservationResponse_Hotel: field {name!r} has no subfields")
+                self.price_per_night = source.price_per_night
+            elif name == HotelReservationResponse_Hotel.Paths.ADDRESS:
+                if dot:
+                    raise ValueError(f"HotelReservationResponse_Hotel: field {name!r} has no subfields")
+                self.address = source.address
+            else:
+                raise ValueError(f"HotelReservationResponse_Hotel: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.name:
+            self.name = src.name
+        if src.rating:
+            self.rating = src.rating
+        if src.price_per_night:
+            self.price_per_night = src.price_per_night
+        if src.address:
+            self.address = src.address
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.name is not None:
             result['name'] = self.name
         if self.rating is not None:
@@ -651,15 +1455,15 @@ class HotelReservationResponse_Hotel:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'HotelReservationResponse_Hotel':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'HotelReservationResponse_Hotel':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'name' in data:
             kwargs['name'] = data['name']
         if 'rating' in data:
@@ -674,10 +1478,17 @@ class HotelReservationResponse_Hotel:
 @dataclass
 class HotelReservationResponse_AvailableRoom:
     """Generated message class for HotelReservationResponse_AvailableRoom"""
+    class Paths:
+        """Field paths of HotelReservationResponse_AvailableRoom, for apply_mask and field masks"""
+        HOTEL = "hotel"
+        ROOM_TYPE = "roomType"
+        AVAILABLE_ROOMS = "availableRooms"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Hotel information
     hotel: Optional['HotelReservationResponse_Hotel'] = None
     # Room type
-    room_type: str = 0
+    room_type: Literal["RoomType_UNKNOWN", "RoomType_STANDARD", "RoomType_DELUXE", "RoomType_SUITE", "RoomType_EXECUTIVE"] = "RoomType_UNKNOWN"
     # Available rooms count
     available_rooms: int = 0
 
@@ -686,13 +1497,72 @@ class HotelReservationResponse_AvailableRoom:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a HotelReservationResponse_AvailableRoom with every field at its zero value, unlike
+        HotelReservationResponse_AvailableRoom(), which applies the field defaults."""
+        return cls(hotel=None, room_type="RoomType_UNKNOWN", available_rooms=0)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new HotelReservationResponse_AvailableRoom."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == HotelReservationResponse_AvailableRoom.Paths.HOTEL:
+                if not dot:
+                    self.hotel = copy.deepcopy(source.hotel)
+                else:
+                    if self.hotel is None:
+                        self.hotel = HotelReservationResponse_Hotel.zero_value()
+                    self.hotel.apply_mask(source.hotel, [rest])
+            elif name == HotelReservationResponse_AvailableRoom.Paths.ROOM_TYPE:
+                if dot:
+                    raise ValueError(f"HotelReservationResponse_AvailableRoom: field {name!r} has no subfields")
+                self.room_type = source.room_type
+            elif name == HotelReservationResponse_AvailableRoom.Paths.AVAILABLE_ROOMS:
+                if dot:
+                    raise ValueError(f"HotelReservationResponse_AvailableRoom: field {name!r} has no subfields")
+                self.available_rooms = source.available_rooms
+            else:
+                raise ValueError(f"HotelReservationResponse_AvailableRoom: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.hotel is not None:
+            if self.hotel is None:
+                self.hotel = HotelReservationResponse_Hotel.zero_value()
+            self.hotel.merge(src.hotel)
+        if src.room_type != "RoomType_UNKNOWN":
+            self.room_type = src.room_type
+        if src.available_rooms:
+            self.available_rooms = src.available_rooms
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.hotel is not None:
+            result.hotel = result.hotel.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.hotel is not None:
             result['hotel'] = self.hotel.to_dict() if hasattr(self.hotel, 'to_dict') else self.hotel
         if self.room_type is not None:
@@ -702,15 +1572,15 @@ class HotelReservationResponse_AvailableRoom:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'HotelReservationResponse_AvailableRoom':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'HotelReservationResponse_AvailableRoom':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'hotel' in data:
             kwargs['hotel'] = HotelReservationResponse_Hotel.from_dict(data['hotel']) if isinstance(data['hotel'], dict) else data['hotel']
         if 'roomType' in data:
@@ -723,6 +1593,12 @@ class HotelReservationResponse_AvailableRoom:
 @dataclass
 class HotelReservationResponse_SingleHotelReservationResponse:
     """Generated message class for HotelReservationResponse_SingleHotelReservationResponse"""
+    class Paths:
+        """Field paths of HotelReservationResponse_SingleHotelReservationResponse, for apply_mask and field masks"""
+        AVAILABLE_ROOMS = "availableRooms"
+        ERROR = "error"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # List of available rooms
     available_rooms: List['HotelReservationResponse_AvailableRoom'] = field(default_factory=list)
     # Error message
@@ -733,13 +1609,66 @@ class HotelReservationResponse_SingleHotelReservationResponse:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a HotelReservationResponse_SingleHotelReservationResponse with every field at its zero value, unlike
+        HotelReservationResponse_SingleHotelReservationResponse(), which applies the field defaults."""
+        return cls(available_rooms=[], error=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new HotelReservationResponse_SingleHotelReservationResponse."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == HotelReservationResponse_SingleHotelReservationResponse.Paths.AVAILABLE_ROOMS:
+                if dot:
+                    raise ValueError(f"HotelReservationResponse_SingleHotelReservationResponse: field {name!r} has no subfields")
+                self.available_rooms = copy.deepcopy(source.available_rooms)
+            elif name == HotelReservationResponse_SingleHotelReservationResponse.Paths.ERROR:
+                if not dot:
+                    self.error = copy.deepcopy(source.error)
+                else:
+                    if self.error is None:
+                        self.error = Error.zero_value()
+                    self.error.apply_mask(source.error, [rest])
+            else:
+                raise ValueError(f"HotelReservationResponse_SingleHotelReservationResponse: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        self.available_rooms.extend(copy.deepcopy(src.available_rooms))
+        if src.error is not None:
+            if self.error is None:
+                self.error = Error.zero_value()
+            self.error.merge(src.error)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        result.available_rooms = [item.redacted() for item in result.available_rooms]
+        if result.error is not None:
+            result.error = result.error.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.available_rooms is not None:
             result['availableRooms'] = [item.to_dict() if hasattr(item, 'to_dict') else item for item in self.available_rooms]
         if self.error is not None:
@@ -747,15 +1676,15 @@ class HotelReservationResponse_SingleHotelReservationResponse:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'HotelReservationResponse_SingleHotelReservationResponse':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'HotelReservationResponse_SingleHotelReservationResponse':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'availableRooms' in data:
             kwargs['available_rooms'] = [HotelReservationResponse_AvailableRoom.from_dict(item) if isinstance(item, dict) else item for item in data['availableRooms']]
         if 'error' in data:
@@ -766,6 +1695,16 @@ class HotelReservationResponse_SingleHotelReservationResponse:
 @dataclass
 class FlightBookingRequest:
     """Generated message class for FlightBookingRequest"""
+    class Paths:
+        """Field paths of FlightBookingRequest, for apply_mask and field masks"""
+        FLIGHT_ROUTES = "flightRoutes"
+        PAYMENT_INFO = "paymentInfo"
+        INCLUDE_HOTEL_RECOMMENDATIONS = "includeHotelRecommendations"
+        DEPARTURE_DATE = "departureDate"
+        RETURN_DATE = "returnDate"
+        NUMBER_OF_PASSENGERS = "numberOfPassengers"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Flight search criteria
     flight_routes: List[str] = field(default_factory=list)
     # Required payment information
@@ -783,13 +1722,89 @@ class FlightBookingRequest:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a FlightBookingRequest with every field at its zero value, unlike
+        FlightBookingRequest(), which applies the field defaults."""
+        return cls(flight_routes=[], payment_info=None, include_hotel_recommendations=False, departure_date=0, return_date=0, number_of_passengers=0)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new FlightBookingRequest."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == FlightBookingRequest.Paths.FLIGHT_ROUTES:
+                if dot:
+                    raise ValueError(f"FlightBookingRequest: field {name!r} has no subfields")
+                self.flight_routes = list(source.flight_routes)
+            elif name == FlightBookingRequest.Paths.PAYMENT_INFO:
+                if not dot:
+                    self.payment_info = copy.deepcopy(source.payment_info)
+                else:
+                    if self.payment_info is None:
+                        self.payment_info = PaymentInfo.zero_value()
+                    self.payment_info.apply_mask(source.payment_info, [rest])
+            elif name == FlightBookingRequest.Paths.INCLUDE_HOTEL_RECOMMENDATIONS:
+                if dot:
+                    raise ValueError(f"FlightBookingRequest: field {name!r} has no subfields")
+                self.include_hotel_recommendations = source.include_hotel_recommendations
+            elif name == FlightBookingRequest.Paths.DEPARTURE_DATE:
+                if dot:
+                    raise ValueError(f"FlightBookingRequest: field {name!r} has no subfields")
+                self.departure_date = source.departure_date
+            elif name == FlightBookingRequest.Paths.RETURN_DATE:
+                if dot:
+                    raise ValueError(f"FlightBookingRequest: field {name!r} has no subfields")
+                self.return_date = source.return_date
+            elif name == FlightBookingRequest.Paths.NUMBER_OF_PASSENGERS:
+                if dot:
+                    raise ValueError(f"FlightBookingRequest: field {name!r} has no subfields")
+                self.number_of_passengers = source.number_of_passengers
+            else:
+                raise ValueError(f"FlightBookingRequest: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        self.flight_routes.extend(src.flight_routes)
+        if src.payment_info is not None:
+            if self.payment_info is None:
+                self.payment_info = PaymentInfo.zero_value()
+            self.payment_info.merge(src.payment_info)
+        if src.include_hotel_recommendations:
+            self.include_hotel_recommendations = src.include_hotel_recommendations
+        if src.departure_date:
+            self.departure_date = src.departure_date
+        if src.return_date:
+            self.return_date = src.return_date
+        if src.number_of_passengers:
+            self.number_of_passengers = src.number_of_passengers
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.payment_info is not None:
+            result.payment_info = result.payment_info.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.flight_routes is not None:
             result['flightRoutes'] = self.flight_routes
         if self.payment_info is not None:
@@ -805,15 +1820,15 @@ class FlightBookingRequest:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'FlightBookingRequest':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'FlightBookingRequest':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'flightRoutes' in data:
             kwargs['flight_routes'] = data['flightRoutes']
         if 'paymentInfo' in data:
@@ -832,12 +1847,20 @@ class FlightBookingRequest:
 @dataclass
 class FlightBookingResponse:
     """Generated message class for FlightBookingResponse"""
+    class Paths:
+        """Field paths of FlightBookingResponse, for apply_mask and field masks"""
+        FLIGHT_BOOKING = "FlightBooking"
+        ERROR = "error"
+        STATUS = "status"
+        BOOKING_STATS = "bookingStats"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # List of flight bookings for each route
     flight_booking: List['FlightBookingResponse_SingleFlightBooking'] = field(default_factory=list)
     # Error message
     error: Optional['Error'] = None
     # Status of the request
-    status: str = 0
+    status: Literal["BookingStatus_UNKNOWN", "BookingStatus_CONFIRMED", "BookingStatus_FAILED", "BookingStatus_PENDING", "BookingStatus_PARTIAL_CONFIRMATION", "BookingStatus_CANCELLED"] = "BookingStatus_UNKNOWN"
     # Booking stats
     booking_stats: Optional['BookingStatsResponse'] = None
 
@@ -846,13 +1869,85 @@ class FlightBookingResponse:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a FlightBookingResponse with every field at its zero value, unlike
+        FlightBookingResponse(), which applies the field defaults."""
+        return cls(flight_booking=[], error=None, status="BookingStatus_UNKNOWN", booking_stats=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new FlightBookingResponse."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == FlightBookingResponse.Paths.FLIGHT_BOOKING:
+                if dot:
+                    raise ValueError(f"FlightBookingResponse: field {name!r} has no subfields")
+                self.flight_booking = copy.deepcopy(source.flight_booking)
+            elif name == FlightBookingResponse.Paths.ERROR:
+                if not dot:
+                    self.error = copy.deepcopy(source.error)
+                else:
+                    if self.error is None:
+                        self.error = Error.zero_value()
+                    self.error.apply_mask(source.error, [rest])
+            elif name == FlightBookingResponse.Paths.STATUS:
+                if dot:
+                    raise ValueError(f"FlightBookingResponse: field {name!r} has no subfields")
+                self.status = source.status
+            elif name == FlightBookingResponse.Paths.BOOKING_STATS:
+                if not dot:
+                    self.booking_stats = copy.deepcopy(source.booking_stats)
+                else:
+                    if self.booking_stats is None:
+                        self.booking_stats = BookingStatsResponse.zero_value()
+                    self.booking_stats.apply_mask(source.booking_stats, [rest])
+            else:
+                raise ValueError(f"FlightBookingResponse: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        self.flight_booking.extend(copy.deepcopy(src.flight_booking))
+        if src.error is not None:
+            if self.error is None:
+                self.error = Error.zero_value()
+            self.error.merge(src.error)
+        if src.status != "BookingStatus_UNKNOWN":
+            self.status = src.status
+        if src.booking_stats is not None:
+            if self.booking_stats is None:
+                self.booking_stats = BookingStatsResponse.zero_value()
+            self.booking_stats.merge(src.booking_stats)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        result.flight_booking = [item.redacted() for item in result.flight_booking]
+        if result.error is not None:
+            result.error = result.error.redacted()
+        if result.booking_stats is not None:
+            result.booking_stats = result.booking_stats.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.flight_booking is not None:
             result['FlightBooking'] = [item.to_dict() if hasattr(item, 'to_dict') else item for item in self.flight_booking]
         if self.error is not None:
@@ -864,15 +1959,15 @@ class FlightBookingResponse:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'FlightBookingResponse':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'FlightBookingResponse':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'FlightBooking' in data:
             kwargs['flight_booking'] = [FlightBookingResponse_SingleFlightBooking.from_dict(item) if isinstance(item, dict) else item for item in data['FlightBooking']]
         if 'error' in data:
@@ -887,6 +1982,17 @@ class FlightBookingResponse:
 @dataclass
 class FlightBookingResponse_SingleFlightBooking:
     """Generated message class for FlightBookingResponse_SingleFlightBooking"""
+    class Paths:
+        """Field paths of FlightBookingResponse_SingleFlightBooking, for apply_mask and field masks"""
+        FLIGHT_NUMBER = "flightNumber"
+        AIRLINE = "airline"
+        PRICE = "price"
+        DEPARTURE_TIME = "departureTime"
+        ARRIVAL_TIME = "arrivalTime"
+        ERROR = "error"
+        HOTEL_RECOMMENDATIONS = "hotelRecommendations"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Flight details
     flight_number: str = ""
     # Airline name
@@ -907,13 +2013,103 @@ class FlightBookingResponse_SingleFlightBooking:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a FlightBookingResponse_SingleFlightBooking with every field at its zero value, unlike
+        FlightBookingResponse_SingleFlightBooking(), which applies the field defaults."""
+        return cls(flight_number="", airline="", price=0.0, departure_time=0, arrival_time=0, error=None, hotel_recommendations=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new FlightBookingResponse_SingleFlightBooking."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == FlightBookingResponse_SingleFlightBooking.Paths.FLIGHT_NUMBER:
+                if dot:
+                    raise ValueError(f"FlightBookingResponse_SingleFlightBooking: field {name!r} has no subfields")
+                self.flight_number = source.flight_number
+            elif name == FlightBookingResponse_SingleFlightBooking.Paths.AIRLINE:
+                if dot:
+                    raise ValueError(f"FlightBookingResponse_SingleFlightBooking: field {name!r} has no subfields")
+                self.airline = source.airline
+            elif name == FlightBookingResponse_SingleFlightBooking.Paths.PRICE:
+                if dot:
+                    raise ValueError(f"FlightBookingResponse_SingleFlightBooking: field {name!r} has no subfields")
+                self.price = source.price
+            elif name == FlightBookingResponse_SingleFlightBooking.Paths.DEPARTURE_TIME:
+                if dot:
+                    raise ValueError(f"FlightBookingResponse_SingleFlightBooking: field {name!r} has no subfields")
+                self.departure_time = source.departure_time
+            elif name == FlightBookingResponse_SingleFlightBooking.Paths.ARRIVAL_TIME:
+                if dot:
+                    raise ValueError(f"FlightBookingResponse_SingleFlightBooking: field {name!r} has no subfields")
+                self.arrival_time = source.arrival_time
+            elif name == FlightBookingResponse_SingleFlightBooking.Paths.ERROR:
+                if not dot:
+                    self.error = copy.deepcopy(source.error)
+                else:
+                    if self.error is None:
+                        self.error = Error.zero_value()
+                    self.error.apply_mask(source.error, [rest])
+            elif name == FlightBookingResponse_SingleFlightBooking.Paths.HOTEL_RECOMMENDATIONS:
+                if not dot:
+                    self.hotel_recommendations = copy.deepcopy(source.hotel_recommendations)
+                else:
+                    if self.hotel_recommendations is None:
+                        self.hotel_recommendations = HotelReservationResponse_SingleHotelReservationResponse.zero_value()
+                    self.hotel_recommendations.apply_mask(source.hotel_recommendations, [rest])
+            else:
+                raise ValueError(f"FlightBookingResponse_SingleFlightBooking: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.flight_number:
+            self.flight_number = src.flight_number
+        if src.airline:
+            self.airline = src.airline
+        if src.price:
+            self.price = src.price
+        if src.departure_time:
+            self.departure_time = src.departure_time
+        if src.arrival_time:
+            self.arrival_time = src.arrival_time
+        if src.error is not None:
+            if self.error is None:
+                self.error = Error.zero_value()
+            self.error.merge(src.error)
+        if src.hotel_recommendations is not None:
+            if self.hotel_recommendations is None:
+                self.hotel_recommendations = HotelReservationResponse_SingleHotelReservationResponse.zero_value()
+            self.hotel_recommendations.merge(src.hotel_recommendations)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.error is not None:
+            result.error = result.error.redacted()
+        if result.hotel_recommendations is not None:
+            result.hotel_recommendations = result.hotel_recommendations.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.flight_number is not None:
             result['flightNumber'] = self.flight_number
         if self.airline is not None:
@@ -931,15 +2127,15 @@ class FlightBookingResponse_SingleFlightBooking:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'FlightBookingResponse_SingleFlightBooking':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'FlightBookingResponse_SingleFlightBooking':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'flightNumber' in data:
             kwargs['flight_number'] = data['flightNumber']
         if 'airline' in data:
@@ -960,6 +2156,12 @@ class FlightBookingResponse_SingleFlightBooking:
 @dataclass
 class TravelPackageBookingRequest:
     """Generated message class for TravelPackageBookingRequest"""
+    class Paths:
+        """Field paths of TravelPackageBookingRequest, for apply_mask and field masks"""
+        DESTINATIONS = "destinations"
+        PAYMENT_INFO = "paymentInfo"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Travel destinations
     destinations: List[str] = field(default_factory=list)
     # Required payment information
@@ -970,13 +2172,65 @@ class TravelPackageBookingRequest:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a TravelPackageBookingRequest with every field at its zero value, unlike
+        TravelPackageBookingRequest(), which applies the field defaults."""
+        return cls(destinations=[], payment_info=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new TravelPackageBookingRequest."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == TravelPackageBookingRequest.Paths.DESTINATIONS:
+                if dot:
+                    raise ValueError(f"TravelPackageBookingRequest: field {name!r} has no subfields")
+                self.destinations = list(source.destinations)
+            elif name == TravelPackageBookingRequest.Paths.PAYMENT_INFO:
+                if not dot:
+                    self.payment_info = copy.deepcopy(source.payment_info)
+                else:
+                    if self.payment_info is None:
+                        self.payment_info = PaymentInfo.zero_value()
+                    self.payment_info.apply_mask(source.payment_info, [rest])
+            else:
+                raise ValueError(f"TravelPackageBookingRequest: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        self.destinations.extend(src.destinations)
+        if src.payment_info is not None:
+            if self.payment_info is None:
+                self.payment_info = PaymentInfo.zero_value()
+            self.payment_info.merge(src.payment_info)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.payment_info is not None:
+            result.payment_info = result.payment_info.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.destinations is not None:
             result['destinations'] = self.destinations
         if self.payment_info is not None:
@@ -984,15 +2238,15 @@ class TravelPackageBookingRequest:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'TravelPackageBookingRequest':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'TravelPackageBookingRequest':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'destinations' in data:
             kwargs['destinations'] = data['destinations']
         if 'paymentInfo' in data:
@@ -1003,12 +2257,20 @@ class TravelPackageBookingRequest:
 @dataclass
 class TravelPackageBookingResponse:
     """Generated message class for TravelPackageBookingResponse"""
+    class Paths:
+        """Field paths of TravelPackageBookingResponse, for apply_mask and field masks"""
+        TRAVEL_PACKAGES = "travelPackages"
+        ERROR = "error"
+        STATUS = "status"
+        BOOKING_STATS = "bookingStats"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # List of travel packages for each destination
     travel_packages: List['TravelPackageBookingResponse_SingleTravelPackageResponse'] = field(default_factory=list)
     # Error message
     error: Optional['Error'] = None
     # Status of the request
-    status: str = 0
+    status: Literal["BookingStatus_UNKNOWN", "BookingStatus_CONFIRMED", "BookingStatus_FAILED", "BookingStatus_PENDING", "BookingStatus_PARTIAL_CONFIRMATION", "BookingStatus_CANCELLED"] = "BookingStatus_UNKNOWN"
     # Booking stats
     booking_stats: Optional['BookingStatsResponse'] = None
 
@@ -1017,13 +2279,85 @@ class TravelPackageBookingResponse:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a TravelPackageBookingResponse with every field at its zero value, unlike
+        TravelPackageBookingResponse(), which applies the field defaults."""
+        return cls(travel_packages=[], error=None, status="BookingStatus_UNKNOWN", booking_stats=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new TravelPackageBookingResponse."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == TravelPackageBookingResponse.Paths.TRAVEL_PACKAGES:
+                if dot:
+                    raise ValueError(f"TravelPackageBookingResponse: field {name!r} has no subfields")
+                self.travel_packages = copy.deepcopy(source.travel_packages)
+            elif name == TravelPackageBookingResponse.Paths.ERROR:
+                if not dot:
+                    self.error = copy.deepcopy(source.error)
+                else:
+                    if self.error is None:
+                        self.error = Error.zero_value()
+                    self.error.apply_mask(source.error, [rest])
+            elif name == TravelPackageBookingResponse.Paths.STATUS:
+                if dot:
+                    raise ValueError(f"TravelPackageBookingResponse: field {name!r} has no subfields")
+                self.status = source.status
+            elif name == TravelPackageBookingResponse.Paths.BOOKING_STATS:
+                if not dot:
+                    self.booking_stats = copy.deepcopy(source.booking_stats)
+                else:
+                    if self.booking_stats is None:
+                        self.booking_stats = BookingStatsResponse.zero_value()
+                    self.booking_stats.apply_mask(source.booking_stats, [rest])
+            else:
+                raise ValueError(f"TravelPackageBookingResponse: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        self.travel_packages.extend(copy.deepcopy(src.travel_packages))
+        if src.error is not None:
+            if self.error is None:
+                self.error = Error.zero_value()
+            self.error.merge(src.error)
+        if src.status != "BookingStatus_UNKNOWN":
+            self.status = src.status
+        if src.booking_stats is not None:
+            if self.booking_stats is None:
+                self.booking_stats = BookingStatsResponse.zero_value()
+            self.booking_stats.merge(src.booking_stats)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        result.travel_packages = [item.redacted() for item in result.travel_packages]
+        if result.error is not None:
+            result.error = result.error.redacted()
+        if result.booking_stats is not None:
+            result.booking_stats = result.booking_stats.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.travel_packages is not None:
             result['travelPackages'] = [item.to_dict() if hasattr(item, 'to_dict') else item for item in self.travel_packages]
         if self.error is not None:
@@ -1035,15 +2369,15 @@ class TravelPackageBookingResponse:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'TravelPackageBookingResponse':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'TravelPackageBookingResponse':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'travelPackages' in data:
             kwargs['travel_packages'] = [TravelPackageBookingResponse_SingleTravelPackageResponse.from_dict(item) if isinstance(item, dict) else item for item in data['travelPackages']]
         if 'error' in data:
@@ -1058,6 +2392,15 @@ class TravelPackageBookingResponse:
 @dataclass
 class TravelPackageBookingResponse_SingleTravelPackageResponse:
     """Generated message class for TravelPackageBookingResponse_SingleTravelPackageResponse"""
+    class Paths:
+        """Field paths of TravelPackageBookingResponse_SingleTravelPackageResponse, for apply_mask and field masks"""
+        PACKAGE_NAME = "packageName"
+        DESCRIPTION = "description"
+        TOTAL_PRICE = "totalPrice"
+        DURATION_DAYS = "durationDays"
+        ERROR = "error"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Package name
     package_name: str = ""
     # Package description
@@ -1074,13 +2417,84 @@ class TravelPackageBookingResponse_SingleTravelPackageResponse:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a TravelPackageBookingResponse_SingleTravelPackageResponse with every field at its zero value, unlike
+        TravelPackageBookingResponse_SingleTravelPackageResponse(), which applies the field defaults."""
+        return cls(package_name="", description="", total_price=0.0, duration_days=0, error=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new TravelPackageBookingResponse_SingleTravelPackageResponse."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == TravelPackageBookingResponse_SingleTravelPackageResponse.Paths.PACKAGE_NAME:
+                if dot:
+                    raise ValueError(f"TravelPackageBookingResponse_SingleTravelPackageResponse: field {name!r} has no subfields")
+                self.package_name = source.package_name
+            elif name == TravelPackageBookingResponse_SingleTravelPackageResponse.Paths.DESCRIPTION:
+                if dot:
+                    raise ValueError(f"TravelPackageBookingResponse_SingleTravelPackageResponse: field {name!r} has no subfields")
+                self.description = source.description
+            elif name == TravelPackageBookingResponse_SingleTravelPackageResponse.Paths.TOTAL_PRICE:
+                if dot:
+                    raise ValueError(f"TravelPackageBookingResponse_SingleTravelPackageResponse: field {name!r} has no subfields")
+                self.total_price = source.total_price
+            elif name == TravelPackageBookingResponse_SingleTravelPackageResponse.Paths.DURATION_DAYS:
+                if dot:
+                    raise ValueError(f"TravelPackageBookingResponse_SingleTravelPackageResponse: field {name!r} has no subfields")
+                self.duration_days = source.duration_days
+            elif name == TravelPackageBookingResponse_SingleTravelPackageResponse.Paths.ERROR:
+                if not dot:
+                    self.error = copy.deepcopy(source.error)
+                else:
+                    if self.error is None:
+                        self.error = Error.zero_value()
+                    self.error.apply_mask(source.error, [rest])
+            else:
+                raise ValueError(f"TravelPackageBookingResponse_SingleTravelPackageResponse: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.package_name:
+            self.package_name = src.package_name
+        if src.description:
+            self.description = src.description
+        if src.total_price:
+            self.total_price = src.total_price
+        if src.duration_days:
+            self.duration_days = src.duration_days
+        if src.error is not None:
+            if self.error is None:
+                self.error = Error.zero_value()
+            self.error.merge(src.error)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.error is not None:
+            result.error = result.error.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.package_name is not None:
             result['packageName'] = self.package_name
         if self.description is not None:
@@ -1094,15 +2508,15 @@ class TravelPackageBookingResponse_SingleTravelPackageResponse:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'TravelPackageBookingResponse_SingleTravelPackageResponse':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'TravelPackageBookingResponse_SingleTravelPackageResponse':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'packageName' in data:
             kwargs['package_name'] = data['packageName']
         if 'description' in data:
@@ -1115,6 +2529,288 @@ class TravelPackageBookingResponse_SingleTravelPackageResponse:
             kwargs['error'] = Error.from_dict(data['error']) if isinstance(data['error'], dict) else data['error']
         return cls(**kwargs)
 
+# Descriptors
+
+PaymentInfo.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.PaymentInfo",
+    name="PaymentInfo",
+    python_name="PaymentInfo",
+    fields=[
+        PuregenFieldDescriptor(name="paymentMethod", python_name="payment_method", json_name="paymentMethod", number=1, kind="string"),
+        PuregenFieldDescriptor(name="paymentToken", python_name="payment_token", json_name="paymentToken", number=2, kind="string"),
+        PuregenFieldDescriptor(name="operationType", python_name="operation_type", json_name="operationType", number=3, kind="enum", type_name="puregen.booking.reservations.OperationType"),
+    ],
+)
+
+Error.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.Error",
+    name="Error",
+    python_name="Error",
+    fields=[
+        PuregenFieldDescriptor(name="message", python_name="message", json_name="message", number=1, kind="string"),
+        PuregenFieldDescriptor(name="code", python_name="code", json_name="code", number=2, kind="string"),
+    ],
+)
+
+BookingHeader.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.BookingHeader",
+    name="BookingHeader",
+    python_name="BookingHeader",
+    fields=[
+        PuregenFieldDescriptor(name="userId", python_name="user_id", json_name="userId", number=1, kind="string"),
+        PuregenFieldDescriptor(name="applicationName", python_name="application_name", json_name="applicationName", number=2, kind="string"),
+        PuregenFieldDescriptor(name="requestId", python_name="request_id", json_name="requestId", number=3, kind="string"),
+        PuregenFieldDescriptor(name="requestTimestamp", python_name="request_timestamp", json_name="requestTimestamp", number=4, kind="int64"),
+    ],
+)
+
+BookingOperationRequest.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.BookingOperationRequest",
+    name="BookingOperationRequest",
+    python_name="BookingOperationRequest",
+    fields=[
+        PuregenFieldDescriptor(name="operationId", python_name="operation_id", json_name="operationId", number=1, kind="string"),
+        PuregenFieldDescriptor(name="paymentInfo", python_name="payment_info", json_name="paymentInfo", number=2, kind="message", type_name="puregen.booking.reservations.PaymentInfo"),
+        PuregenFieldDescriptor(name="confirm", python_name="confirm", json_name="confirm", number=3, kind="bool"),
+    ],
+)
+
+BookingOperationResponse.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.BookingOperationResponse",
+    name="BookingOperationResponse",
+    python_name="BookingOperationResponse",
+    fields=[
+        PuregenFieldDescriptor(name="operationId", python_name="operation_id", json_name="operationId", number=1, kind="string"),
+        PuregenFieldDescriptor(name="status", python_name="status", json_name="status", number=2, kind="enum", type_name="puregen.booking.reservations.BookingStatus"),
+        PuregenFieldDescriptor(name="error", python_name="error", json_name="error", number=3, kind="message", type_name="puregen.booking.reservations.Error"),
+    ],
+)
+
+ListBookingsRequest.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.ListBookingsRequest",
+    name="ListBookingsRequest",
+    python_name="ListBookingsRequest",
+    fields=[
+        PuregenFieldDescriptor(name="paymentInfo", python_name="payment_info", json_name="paymentInfo", number=1, kind="message", type_name="puregen.booking.reservations.PaymentInfo"),
+    ],
+)
+
+ListBookingsResponse.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.ListBookingsResponse",
+    name="ListBookingsResponse",
+    python_name="ListBookingsResponse",
+    fields=[
+        PuregenFieldDescriptor(name="confirmedBookingIds", python_name="confirmed_booking_ids", json_name="confirmedBookingIds", number=1, kind="string", repeated=True),
+        PuregenFieldDescriptor(name="pendingBookingIds", python_name="pending_booking_ids", json_name="pendingBookingIds", number=2, kind="string", repeated=True),
+        PuregenFieldDescriptor(name="error", python_name="error", json_name="error", number=3, kind="message", type_name="puregen.booking.reservations.Error"),
+    ],
+)
+
+BookingConfirmationRequest.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.BookingConfirmationRequest",
+    name="BookingConfirmationRequest",
+    python_name="BookingConfirmationRequest",
+    fields=[
+        PuregenFieldDescriptor(name="bookingIds", python_name="booking_ids", json_name="bookingIds", number=1, kind="string", repeated=True),
+        PuregenFieldDescriptor(name="paymentInfo", python_name="payment_info", json_name="paymentInfo", number=2, kind="message", type_name="puregen.booking.reservations.PaymentInfo"),
+    ],
+)
+
+BookingStatsResponse.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.BookingStatsResponse",
+    name="BookingStatsResponse",
+    python_name="BookingStatsResponse",
+    fields=[
+        PuregenFieldDescriptor(name="totalAmountCharged", python_name="total_amount_charged", json_name="totalAmountCharged", number=1, kind="double"),
+        PuregenFieldDescriptor(name="totalGuests", python_name="total_guests", json_name="totalGuests", number=2, kind="int32"),
+        PuregenFieldDescriptor(name="totalBookings", python_name="total_bookings", json_name="totalBookings", number=3, kind="int32"),
+    ],
+)
+
+HotelReservationRequest.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.HotelReservationRequest",
+    name="HotelReservationRequest",
+    python_name="HotelReservationRequest",
+    fields=[
+        PuregenFieldDescriptor(name="hotelLocations", python_name="hotel_locations", json_name="hotelLocations", number=1, kind="string", repeated=True),
+        PuregenFieldDescriptor(name="roomTypes", python_name="room_types", json_name="roomTypes", number=2, kind="enum", type_name="puregen.booking.reservations.HotelReservationRequest.RoomType", repeated=True),
+        PuregenFieldDescriptor(name="maxPricePerNight", python_name="max_price_per_night", json_name="maxPricePerNight", number=3, kind="double"),
+        PuregenFieldDescriptor(name="paymentInfo", python_name="payment_info", json_name="paymentInfo", number=4, kind="message", type_name="puregen.booking.reservations.PaymentInfo"),
+        PuregenFieldDescriptor(name="checkInDate", python_name="check_in_date", json_name="checkInDate", number=5, kind="int64"),
+        PuregenFieldDescriptor(name="checkOutDate", python_name="check_out_date", json_name="checkOutDate", number=6, kind="int64"),
+        PuregenFieldDescriptor(name="numberOfGuests", python_name="number_of_guests", json_name="numberOfGuests", number=7, kind="int32"),
+    ],
+)
+
+HotelReservationResponse.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.HotelReservationResponse",
+    name="HotelReservationResponse",
+    python_name="HotelReservationResponse",
+    fields=[
+        PuregenFieldDescriptor(name="result", python_name="result", json_name="result", number=2, kind="message", type_name="puregen.booking.reservations.HotelReservationResponse.SingleHotelReservationResponse", repeated=True),
+        PuregenFieldDescriptor(name="status", python_name="status", json_name="status", number=3, kind="enum", type_name="puregen.booking.reservations.BookingStatus"),
+        PuregenFieldDescriptor(name="error", python_name="error", json_name="error", number=4, kind="message", type_name="puregen.booking.reservations.Error"),
+        PuregenFieldDescriptor(name="bookingStats", python_name="booking_stats", json_name="bookingStats", number=5, kind="message", type_name="puregen.booking.reservations.BookingStatsResponse"),
+    ],
+)
+
+HotelReservationResponse_Hotel.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.HotelReservationResponse.Hotel",
+    name="Hotel",
+    python_name="HotelReservationResponse_Hotel",
+    fields=[
+        PuregenFieldDescriptor(name="name", python_name="name", json_name="name", number=1, kind="string"),
+        PuregenFieldDescriptor(name="rating", python_name="rating", json_name="rating", number=2, kind="double"),
+        PuregenFieldDescriptor(name="pricePerNight", python_name="price_per_night", json_name="pricePerNight", number=3, kind="double"),
+        PuregenFieldDescriptor(name="address", python_name="address", json_name="address", number=4, kind="string"),
+    ],
+)
+
+HotelReservationResponse_AvailableRoom.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.HotelReservationResponse.AvailableRoom",
+    name="AvailableRoom",
+    python_name="HotelReservationResponse_AvailableRoom",
+    fields=[
+        PuregenFieldDescriptor(name="hotel", python_name="hotel", json_name="hotel", number=1, kind="message", type_name="puregen.booking.reservations.HotelReservationResponse.Hotel"),
+        PuregenFieldDescriptor(name="roomType", python_name="room_type", json_name="roomType", number=2, kind="enum", type_name="puregen.booking.reservations.HotelReservationRequest.RoomType"),
+        PuregenFieldDescriptor(name="availableRooms", python_name="available_rooms", json_name="availableRooms", number=3, kind="int32"),
+    ],
+)
+
+HotelReservationResponse_SingleHotelReservationResponse.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.HotelReservationResponse.SingleHotelReservationResponse",
+    name="SingleHotelReservationResponse",
+    python_name="HotelReservationResponse_SingleHotelReservationResponse",
+    fields=[
+        PuregenFieldDescriptor(name="availableRooms", python_name="available_rooms", json_name="availableRooms", number=1, kind="message", type_name="puregen.booking.reservations.HotelReservationResponse.AvailableRoom", repeated=True),
+        PuregenFieldDescriptor(name="error", python_name="error", json_name="error", number=2, kind="message", type_name="puregen.booking.reservations.Error"),
+    ],
+)
+
+FlightBookingRequest.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.FlightBookingRequest",
+    name="FlightBookingRequest",
+    python_name="FlightBookingRequest",
+    fields=[
+        PuregenFieldDescriptor(name="flightRoutes", python_name="flight_routes", json_name="flightRoutes", number=1, kind="string", repeated=True),
+        PuregenFieldDescriptor(name="paymentInfo", python_name="payment_info", json_name="paymentInfo", number=2, kind="message", type_name="puregen.booking.reservations.PaymentInfo"),
+        PuregenFieldDescriptor(name="includeHotelRecommendations", python_name="include_hotel_recommendations", json_name="includeHotelRecommendations", number=3, kind="bool"),
+        PuregenFieldDescriptor(name="departureDate", python_name="departure_date", json_name="departureDate", number=4, kind="int64"),
+        PuregenFieldDescriptor(name="returnDate", python_name="return_date", json_name="returnDate", number=5, kind="int64"),
+        PuregenFieldDescriptor(name="numberOfPassengers", python_name="number_of_passengers", json_name="numberOfPassengers", number=6, kind="int32"),
+    ],
+)
+
+FlightBookingResponse.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.FlightBookingResponse",
+    name="FlightBookingResponse",
+    python_name="FlightBookingResponse",
+    fields=[
+        PuregenFieldDescriptor(name="FlightBooking", python_name="flight_booking", json_name="FlightBooking", number=1, kind="message", type_name="puregen.booking.reservations.FlightBookingResponse.SingleFlightBooking", repeated=True),
+        PuregenFieldDescriptor(name="error", python_name="error", json_name="error", number=3, kind="message", type_name="puregen.booking.reservations.Error"),
+        PuregenFieldDescriptor(name="status", python_name="status", json_name="status", number=4, kind="enum", type_name="puregen.booking.reservations.BookingStatus"),
+        PuregenFieldDescriptor(name="bookingStats", python_name="booking_stats", json_name="bookingStats", number=5, kind="message", type_name="puregen.booking.reservations.BookingStatsResponse"),
+    ],
+)
+
+FlightBookingResponse_SingleFlightBooking.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.FlightBookingResponse.SingleFlightBooking",
+    name="SingleFlightBooking",
+    python_name="FlightBookingResponse_SingleFlightBooking",
+    fields=[
+        PuregenFieldDescriptor(name="flightNumber", python_name="flight_number", json_name="flightNumber", number=1, kind="string"),
+        PuregenFieldDescriptor(name="airline", python_name="airline", json_name="airline", number=2, kind="string"),
+        PuregenFieldDescriptor(name="price", python_name="price", json_name="price", number=3, kind="double"),
+        PuregenFieldDescriptor(name="departureTime", python_name="departure_time", json_name="departureTime", number=4, kind="int64"),
+        PuregenFieldDescriptor(name="arrivalTime", python_name="arrival_time", json_name="arrivalTime", number=5, kind="int64"),
+        PuregenFieldDescriptor(name="error", python_name="error", json_name="error", number=6, kind="message", type_name="puregen.booking.reservations.Error"),
+        PuregenFieldDescriptor(name="hotelRecommendations", python_name="hotel_recommendations", json_name="hotelRecommendations", number=7, kind="message", type_name="puregen.booking.reservations.HotelReservationResponse.SingleHotelReservationResponse"),
+    ],
+)
+
+TravelPackageBookingRequest.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.TravelPackageBookingRequest",
+    name="TravelPackageBookingRequest",
+    python_name="TravelPackageBookingRequest",
+    fields=[
+        PuregenFieldDescriptor(name="destinations", python_name="destinations", json_name="destinations", number=1, kind="string", repeated=True),
+        PuregenFieldDescriptor(name="paymentInfo", python_name="payment_info", json_name="paymentInfo", number=2, kind="message", type_name="puregen.booking.reservations.PaymentInfo"),
+    ],
+)
+
+TravelPackageBookingResponse.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.TravelPackageBookingResponse",
+    name="TravelPackageBookingResponse",
+    python_name="TravelPackageBookingResponse",
+    fields=[
+        PuregenFieldDescriptor(name="travelPackages", python_name="travel_packages", json_name="travelPackages", number=1, kind="message", type_name="puregen.booking.reservations.TravelPackageBookingResponse.SingleTravelPackageResponse", repeated=True),
+        PuregenFieldDescriptor(name="error", python_name="error", json_name="error", number=3, kind="message", type_name="puregen.booking.reservations.Error"),
+        PuregenFieldDescriptor(name="status", python_name="status", json_name="status", number=4, kind="enum", type_name="puregen.booking.reservations.BookingStatus"),
+        PuregenFieldDescriptor(name="bookingStats", python_name="booking_stats", json_name="bookingStats", number=5, kind="message", type_name="puregen.booking.reservations.BookingStatsResponse"),
+    ],
+)
+
+TravelPackageBookingResponse_SingleTravelPackageResponse.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.booking.reservations.TravelPackageBookingResponse.SingleTravelPackageResponse",
+    name="SingleTravelPackageResponse",
+    python_name="TravelPackageBookingResponse_SingleTravelPackageResponse",
+    fields=[
+        PuregenFieldDescriptor(name="packageName", python_name="package_name", json_name="packageName", number=1, kind="string"),
+        PuregenFieldDescriptor(name="description", python_name="description", json_name="description", number=2, kind="string"),
+        PuregenFieldDescriptor(name="totalPrice", python_name="total_price", json_name="totalPrice", number=3, kind="double"),
+        PuregenFieldDescriptor(name="durationDays", python_name="duration_days", json_name="durationDays", number=4, kind="int32"),
+        PuregenFieldDescriptor(name="error", python_name="error", json_name="error", number=5, kind="message", type_name="puregen.booking.reservations.Error"),
+    ],
+)
+
+register_message_descriptors(
+    PaymentInfo.DESCRIPTOR,
+    Error.DESCRIPTOR,
+    BookingHeader.DESCRIPTOR,
+    BookingOperationRequest.DESCRIPTOR,
+    BookingOperationResponse.DESCRIPTOR,
+    ListBookingsRequest.DESCRIPTOR,
+    ListBookingsResponse.DESCRIPTOR,
+    BookingConfirmationRequest.DESCRIPTOR,
+    BookingStatsResponse.DESCRIPTOR,
+    HotelReservationRequest.DESCRIPTOR,
+    HotelReservationResponse.DESCRIPTOR,
+    HotelReservationResponse_Hotel.DESCRIPTOR,
+    HotelReservationResponse_AvailableRoom.DESCRIPTOR,
+    HotelReservationResponse_SingleHotelReservationResponse.DESCRIPTOR,
+    FlightBookingRequest.DESCRIPTOR,
+    FlightBookingResponse.DESCRIPTOR,
+    FlightBookingResponse_SingleFlightBooking.DESCRIPTOR,
+    TravelPackageBookingRequest.DESCRIPTOR,
+    TravelPackageBookingResponse.DESCRIPTOR,
+    TravelPackageBookingResponse_SingleTravelPackageResponse.DESCRIPTOR,
+)
+
+# Message types
+
+register_message_types({
+    "puregen.booking.reservations.PaymentInfo": PaymentInfo,
+    "puregen.booking.reservations.Error": Error,
+    "puregen.booking.reservations.BookingHeader": BookingHeader,
+    "puregen.booking.reservations.BookingOperationRequest": BookingOperationRequest,
+    "puregen.booking.reservations.BookingOperationResponse": BookingOperationResponse,
+    "puregen.booking.reservations.ListBookingsRequest": ListBookingsRequest,
+    "puregen.booking.reservations.ListBookingsResponse": ListBookingsResponse,
+    "puregen.booking.reservations.BookingConfirmationRequest": BookingConfirmationRequest,
+    "puregen.booking.reservations.BookingStatsResponse": BookingStatsResponse,
+    "puregen.booking.reservations.HotelReservationRequest": HotelReservationRequest,
+    "puregen.booking.reservations.HotelReservationResponse": HotelReservationResponse,
+    "puregen.booking.reservations.HotelReservationResponse.Hotel": HotelReservationResponse_Hotel,
+    "puregen.booking.reservations.HotelReservationResponse.AvailableRoom": HotelReservationResponse_AvailableRoom,
+    "puregen.booking.reservations.HotelReservationResponse.SingleHotelReservationResponse": HotelReservationResponse_SingleHotelReservationResponse,
+    "puregen.booking.reservations.FlightBookingRequest": FlightBookingRequest,
+    "puregen.booking.reservations.FlightBookingResponse": FlightBookingResponse,
+    "puregen.booking.reservations.FlightBookingResponse.SingleFlightBooking": FlightBookingResponse_SingleFlightBooking,
+    "puregen.booking.reservations.TravelPackageBookingRequest": TravelPackageBookingRequest,
+    "puregen.booking.reservations.TravelPackageBookingResponse": TravelPackageBookingResponse,
+    "puregen.booking.reservations.TravelPackageBookingResponse.SingleTravelPackageResponse": TravelPackageBookingResponse_SingleTravelPackageResponse,
+})
+
 # Services
 
 """
@@ -1249,7 +2945,7 @@ class BookingServiceMethods:
     BookingService_DescribeTravelPackageBooking = "BookingService_DescribeTravelPackageBooking"
     BookingService_GetTravelPackageBookingResult = "BookingService_GetTravelPackageBookingResult"
 
-    METHOD_METADATA = {
+    METHOD_METADATA: Dict[str, Dict[str, Any]] = {
     }
 
 # Client
@@ -1257,7 +2953,7 @@ class BookingServiceMethods:
 class BookingServiceClient:
     """Client for BookingService service"""
 
-    def __init__(self, transport: PuregenTransport):
+    def __init__(self, transport: PuregenTransport) -> None:
         self.transport = transport
 
     def start_hotel_reservation(self, ctx: Dict[str, Any], request: HotelReservationRequest) -> HotelReservationResponse:
@@ -1368,3 +3064,117 @@ class BookingServiceClient:
             return TravelPackageBookingResponse.from_dict(result)
         raise ValueError(f"Invalid response type for get_travel_package_booking_result: {type(result)}")
 
+class AsyncBookingServiceClient:
+    """Asyncio client for BookingService service"""
+
+    def __init__(self, transport: AsyncPuregenTransport) -> None:
+        self.transport = transport
+
+    async def start_hotel_reservation(self, ctx: Dict[str, Any], request: HotelReservationRequest) -> HotelReservationResponse:
+        """StartHotelReservation client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_StartHotelReservation, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, BookingServiceMethods.BookingService_StartHotelReservation, request, HotelReservationResponse)
+        if isinstance(result, HotelReservationResponse):
+            return result
+        if isinstance(result, dict):
+            return HotelReservationResponse.from_dict(result)
+        raise ValueError(f"Invalid response type for start_hotel_reservation: {type(result)}")
+
+    async def describe_hotel_reservation(self, ctx: Dict[str, Any], request: HotelReservationRequest) -> HotelReservationResponse:
+        """DescribeHotelReservation client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_DescribeHotelReservation, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, BookingServiceMethods.BookingService_DescribeHotelReservation, request, HotelReservationResponse)
+        if isinstance(result, HotelReservationResponse):
+            return result
+        if isinstance(result, dict):
+            return HotelReservationResponse.from_dict(result)
+        raise ValueError(f"Invalid response type for describe_hotel_reservation: {type(result)}")
+
+    async def get_hotel_reservation_result(self, ctx: Dict[str, Any], request: HotelReservationRequest) -> HotelReservationResponse:
+        """GetHotelReservationResult client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_GetHotelReservationResult, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, BookingServiceMethods.BookingService_GetHotelReservationResult, request, HotelReservationResponse)
+        if isinstance(result, HotelReservationResponse):
+            return result
+        if isinstance(result, dict):
+            return HotelReservationResponse.from_dict(result)
+        raise ValueError(f"Invalid response type for get_hotel_reservation_result: {type(result)}")
+
+    async def start_flight_booking(self, ctx: Dict[str, Any], request: FlightBookingRequest) -> FlightBookingResponse:
+        """StartFlightBooking client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_StartFlightBooking, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, BookingServiceMethods.BookingService_StartFlightBooking, request, FlightBookingResponse)
+        if isinstance(result, FlightBookingResponse):
+            return result
+        if isinstance(result, dict):
+            return FlightBookingResponse.from_dict(result)
+        raise ValueError(f"Invalid response type for start_flight_booking: {type(result)}")
+
+    async def describe_flight_booking(self, ctx: Dict[str, Any], request: FlightBookingRequest) -> FlightBookingResponse:
+        """DescribeFlightBooking client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_DescribeFlightBooking, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, BookingServiceMethods.BookingService_DescribeFlightBooking, request, FlightBookingResponse)
+        if isinstance(result, FlightBookingResponse):
+            return result
+        if isinstance(result, dict):
+            return FlightBookingResponse.from_dict(result)
+        raise ValueError(f"Invalid response type for describe_flight_booking: {type(result)}")
+
+    async def get_flight_booking_result(self, ctx: Dict[str, Any], request: FlightBookingRequest) -> FlightBookingResponse:
+        """GetFlightBookingResult client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_GetFlightBookingResult, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, BookingServiceMethods.BookingService_GetFlightBookingResult, request, FlightBookingResponse)
+        if isinstance(result, FlightBookingResponse):
+            return result
+        if isinstance(result, dict):
+            return FlightBookingResponse.from_dict(result)
+        raise ValueError(f"Invalid response type for get_flight_booking_result: {type(result)}")
+
+    async def start_travel_package_booking(self, ctx: Dict[str, Any], request: TravelPackageBookingRequest) -> TravelPackageBookingResponse:
+        """StartTravelPackageBooking client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_StartTravelPackageBooking, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, BookingServiceMethods.BookingService_StartTravelPackageBooking, request, TravelPackageBookingResponse)
+        if isinstance(result, TravelPackageBookingResponse):
+            return result
+        if isinstance(result, dict):
+            return TravelPackageBookingResponse.from_dict(result)
+        raise ValueError(f"Invalid response type for start_travel_package_booking: {type(result)}")
+
+    async def describe_travel_package_booking(self, ctx: Dict[str, Any], request: TravelPackageBookingRequest) -> TravelPackageBookingResponse:
+        """DescribeTravelPackageBooking client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_DescribeTravelPackageBooking, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, BookingServiceMethods.BookingService_DescribeTravelPackageBooking, request, TravelPackageBookingResponse)
+        if isinstance(result, TravelPackageBookingResponse):
+            return result
+        if isinstance(result, dict):
+            return TravelPackageBookingResponse.from_dict(result)
+        raise ValueError(f"Invalid response type for describe_travel_package_booking: {type(result)}")
+
+    async def get_travel_package_booking_result(self, ctx: Dict[str, Any], request: TravelPackageBookingRequest) -> TravelPackageBookingResponse:
+        """GetTravelPackageBookingResult client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = BookingServiceMethods.METHOD_METADATA.get(BookingServiceMethods.BookingService_GetTravelPackageBookingResult, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, BookingServiceMethods.BookingService_GetTravelPackageBookingResult, request, TravelPackageBookingResponse)
+        if isinstance(result, TravelPackageBookingResponse):
+            return result
+        if isinstance(result, dict):
+            return TravelPackageBookingResponse.from_dict(result)
+        raise ValueError(f"Invalid response type for get_travel_package_booking_result: {type(result)}")
+
@@ -2,12 +2,20 @@
 # Package Transport interface
 
 from abc import ABC, abstractmethod
-from typing import Dict, Any
+from typing import Dict, Any, Type
 
 class PuregenTransport(ABC):
     """Abstract transport interface for client communication"""
 
     @abstractmethod
-    def send(self, ctx: Dict[str, Any], method_name: str, input_data: Any, output_type: type) -> Any:
+    def send(self, ctx: Dict[str, Any], method_name: str, input_data: Any, output_type: Type[Any]) -> Any:
+        """Send request and return response"""
+        pass
+
+class AsyncPuregenTransport(ABC):
+    """Abstract asyncio transport interface for client communication"""
+
+    @abstractmethod
+    async def send(self, ctx: Dict[str, Any], method_name: str, input_data: Any, output_type: Type[Any]) -> Any:
         """Send request and return response"""
         pass
@@ -3,9 +3,11 @@
 package groups
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
+	context "context"
+	json "encoding/json"
+	fmt "fmt"
+	slog "log/slog"
+	strings "strings"
 )
 
 // Imported Messages (redefined locally)
@@ -19,8 +21,194 @@ type Error struct {
 	Details string `json:"details"`
 }
 
-func NewError() *Error {
-	return &Error{}
+// ErrorOption sets a field of a new Error
+type ErrorOption func(*Error)
+
+// WithErrorCode sets the Code field
+func WithErrorCode(value int32) ErrorOption {
+	return func(m *Error) {
+		m.Code = value
+	}
+}
+
+// WithErrorMessage sets the Message field
+func WithErrorMessage(value string) ErrorOption {
+	return func(m *Error) {
+		m.Message = value
+	}
+}
+
+// WithErrorDetails sets the Details field
+func WithErrorDetails(value string) ErrorOption {
+	return func(m *Error) {
+		m.Details = value
+	}
+}
+
+func NewError(opts ...ErrorOption) *Error {
+	m := &Error{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetCode returns the Code field, or its zero value if m is nil
+func (m *Error) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+// GetMessage returns the Message field, or its zero value if m is nil
+func (m *Error) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// GetDetails returns the Details field, or its zero value if m is nil
+func (m *Error) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+// Clone returns a deep copy of m
+func (m *Error) Clone() *Error {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *Error) Equal(other *Error) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Code != other.Code {
+		return false
+	}
+	if m.Message != other.Message {
+		return false
+	}
+	if m.Details != other.Details {
+		return false
+	}
+	return true
+}
+
+// Field paths of Error, for ApplyMask and field masks
+const (
+	Error_Code_PATH    = "code"
+	Error_Message_PATH = "message"
+	Error_Details_PATH = "details"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewError().
+func (m *Error) ApplyMask(src *Error, paths []string) error {
+	if src == nil {
+		src = NewError()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case Error_Code_PATH:
+			if nested {
+				return fmt.Errorf("Error: field %q has no subfields", name)
+			}
+			m.Code = src.Code
+		case Error_Message_PATH:
+			if nested {
+				return fmt.Errorf("Error: field %q has no subfields", name)
+			}
+			m.Message = src.Message
+		case Error_Details_PATH:
+			if nested {
+				return fmt.Errorf("Error: field %q has no subfields", name)
+			}
+			m.Details = src.Details
+		default:
+			return fmt.Errorf("Error: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *Error) Merge(src *Error) {
+	if src == nil {
+		return
+	}
+	if src.Code != 0 {
+		m.Code = src.Code
+	}
+	if src.Message != "" {
+		m.Message = src.Message
+	}
+	if src.Details != "" {
+		m.Details = src.Details
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *Error) ApplyFieldMask(src *Error, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *Error) MergeFieldMask(src *Error, mask *PuregenFieldMask) error {
+	masked := &Error{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *Error) Redacted() *Error {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *Error) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *Error) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("code", r.Code),
+		slog.Any("message", r.Message),
+		slog.Any("details", r.Details),
+	)
 }
 
 func (m *Error) Validate() error {
@@ -46,8 +234,222 @@ type Group struct {
 	CreatedAt   int64  `json:"createdAt"`
 }
 
-func NewGroup() *Group {
-	return &Group{}
+// GroupOption sets a field of a new Group
+type GroupOption func(*Group)
+
+// WithGroupId sets the Id field
+func WithGroupId(value string) GroupOption {
+	return func(m *Group) {
+		m.Id = value
+	}
+}
+
+// WithGroupName sets the Name field
+func WithGroupName(value string) GroupOption {
+	return func(m *Group) {
+		m.Name = value
+	}
+}
+
+// WithGroupDescription sets the Description field
+func WithGroupDescription(value string) GroupOption {
+	return func(m *Group) {
+		m.Description = value
+	}
+}
+
+// WithGroupCreatedAt sets the CreatedAt field
+func WithGroupCreatedAt(value int64) GroupOption {
+	return func(m *Group) {
+		m.CreatedAt = value
+	}
+}
+
+func NewGroup(opts ...GroupOption) *Group {
+	m := &Group{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetId returns the Id field, or its zero value if m is nil
+func (m *Group) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// GetName returns the Name field, or its zero value if m is nil
+func (m *Group) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// GetDescription returns the Description field, or its zero value if m is nil
+func (m *Group) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// GetCreatedAt returns the CreatedAt field, or its zero value if m is nil
+func (m *Group) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// Clone returns a deep copy of m
+func (m *Group) Clone() *Group {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *Group) Equal(other *Group) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.CreatedAt != other.CreatedAt {
+		return false
+	}
+	return true
+}
+
+// Field paths of Group, for ApplyMask and field masks
+const (
+	Group_Id_PATH          = "id"
+	Group_Name_PATH        = "name"
+	Group_Description_PATH = "description"
+	Group_CreatedAt_PATH   = "created_at"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewGroup().
+func (m *Group) ApplyMask(src *Group, paths []string) error {
+	if src == nil {
+		src = NewGroup()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case Group_Id_PATH:
+			if nested {
+				return fmt.Errorf("Group: field %q has no subfields", name)
+			}
+			m.Id = src.Id
+		case Group_Name_PATH:
+			if nested {
+				return fmt.Errorf("Group: field %q has no subfields", name)
+			}
+			m.Name = src.Name
+		case Group_Description_PATH:
+			if nested {
+				return fmt.Errorf("Group: field %q has no subfields", name)
+			}
+			m.Description = src.Description
+		case Group_CreatedAt_PATH, "createdAt":
+			if nested {
+				return fmt.Errorf("Group: field %q has no subfields", name)
+			}
+			m.CreatedAt = src.CreatedAt
+		default:
+			return fmt.Errorf("Group: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *Group) Merge(src *Group) {
+	if src == nil {
+		return
+	}
+	if src.Id != "" {
+		m.Id = src.Id
+	}
+	if src.Name != "" {
+		m.Name = src.Name
+	}
+	if src.Description != "" {
+		m.Description = src.Description
+	}
+	if src.CreatedAt != 0 {
+		m.CreatedAt = src.CreatedAt
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *Group) ApplyFieldMask(src *Group, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *Group) MergeFieldMask(src *Group, mask *PuregenFieldMask) error {
+	masked := &Group{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *Group) Redacted() *Group {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *Group) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *Group) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("id", r.Id),
+		slog.Any("name", r.Name),
+		slog.Any("description", r.Description),
+		slog.Any("createdAt", r.CreatedAt),
+	)
 }
 
 func (m *Group) Validate() error {
@@ -63,6 +465,31 @@ func (m *Group) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
+// UnmarshalJSON accepts both the proto field names and the JSON names of the fields
+func (m *Group) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for alias, name := range map[string]string{
+		"created_at": "createdAt",
+	} {
+		if value, ok := fields[alias]; ok {
+			delete(fields, alias)
+			if _, ok := fields[name]; !ok {
+				fields[name] = value
+			}
+		}
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	// plain has the fields of Group but not this method
+	type plain Group
+	return json.Unmarshal(data, (*plain)(m))
+}
+
 // CreateGroupRequest is the request for creating a group
 type CreateGroupRequest struct {
 	Name        string `json:"name"`
@@ -71,8 +498,205 @@ type CreateGroupRequest struct {
 	Owner *Principal `json:"owner"`
 }
 
-func NewCreateGroupRequest() *CreateGroupRequest {
-	return &CreateGroupRequest{}
+// CreateGroupRequestOption sets a field of a new CreateGroupRequest
+type CreateGroupRequestOption func(*CreateGroupRequest)
+
+// WithCreateGroupRequestName sets the Name field
+func WithCreateGroupRequestName(value string) CreateGroupRequestOption {
+	return func(m *CreateGroupRequest) {
+		m.Name = value
+	}
+}
+
+// WithCreateGroupRequestDescription sets the Description field
+func WithCreateGroupRequestDescription(value string) CreateGroupRequestOption {
+	return func(m *CreateGroupRequest) {
+		m.Description = value
+	}
+}
+
+// WithCreateGroupRequestOwner sets the Owner field
+func WithCreateGroupRequestOwner(value *Principal) CreateGroupRequestOption {
+	return func(m *CreateGroupRequest) {
+		m.Owner = value
+	}
+}
+
+func NewCreateGroupRequest(opts ...CreateGroupRequestOption) *CreateGroupRequest {
+	m := &CreateGroupRequest{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetName returns the Name field, or its zero value if m is nil
+func (m *CreateGroupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// GetDescription returns the Description field, or its zero value if m is nil
+func (m *CreateGroupRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// GetOwner returns the Owner field, or its zero value if m is nil
+func (m *CreateGroupRequest) GetOwner() *Principal {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *CreateGroupRequest) Clone() *CreateGroupRequest {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	c.Owner = m.Owner.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *CreateGroupRequest) Equal(other *CreateGroupRequest) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.Owner.Equal(other.Owner) {
+		return false
+	}
+	return true
+}
+
+// Field paths of CreateGroupRequest, for ApplyMask and field masks
+const (
+	CreateGroupRequest_Name_PATH        = "name"
+	CreateGroupRequest_Description_PATH = "description"
+	CreateGroupRequest_Owner_PATH       = "owner"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewCreateGroupRequest().
+func (m *CreateGroupRequest) ApplyMask(src *CreateGroupRequest, paths []string) error {
+	if src == nil {
+		src = NewCreateGroupRequest()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case CreateGroupRequest_Name_PATH:
+			if nested {
+				return fmt.Errorf("CreateGroupRequest: field %q has no subfields", name)
+			}
+			m.Name = src.Name
+		case CreateGroupRequest_Description_PATH:
+			if nested {
+				return fmt.Errorf("CreateGroupRequest: field %q has no subfields", name)
+			}
+			m.Description = src.Description
+		case CreateGroupRequest_Owner_PATH:
+			if !nested {
+				m.Owner = src.Owner.Clone()
+				continue
+			}
+			if m.Owner == nil {
+				m.Owner = &Principal{}
+			}
+			if err := m.Owner.ApplyMask(src.Owner, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("CreateGroupRequest: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *CreateGroupRequest) Merge(src *CreateGroupRequest) {
+	if src == nil {
+		return
+	}
+	if src.Name != "" {
+		m.Name = src.Name
+	}
+	if src.Description != "" {
+		m.Description = src.Description
+	}
+	if src.Owner != nil {
+		if m.Owner == nil {
+			m.Owner = &Principal{}
+		}
+		m.Owner.Merge(src.Owner)
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *CreateGroupRequest) ApplyFieldMask(src *CreateGroupRequest, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *CreateGroupRequest) MergeFieldMask(src *CreateGroupRequest, mask *PuregenFieldMask) error {
+	masked := &CreateGroupRequest{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *CreateGroupRequest) Redacted() *CreateGroupRequest {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.Owner = r.Owner.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *CreateGroupRequest) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *CreateGroupRequest) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("name", r.Name),
+		slog.Any("description", r.Description),
+		slog.Any("owner", r.Owner),
+	)
 }
 
 func (m *CreateGroupRequest) Validate() error {
@@ -95,8 +719,188 @@ type CreateGroupResponse struct {
 	Error *Error `json:"error"`
 }
 
-func NewCreateGroupResponse() *CreateGroupResponse {
-	return &CreateGroupResponse{}
+// CreateGroupResponseOption sets a field of a new CreateGroupResponse
+type CreateGroupResponseOption func(*CreateGroupResponse)
+
+// WithCreateGroupResponseGroup sets the Group field
+func WithCreateGroupResponseGroup(value *Group) CreateGroupResponseOption {
+	return func(m *CreateGroupResponse) {
+		m.Group = value
+	}
+}
+
+// WithCreateGroupResponseError sets the Error field
+func WithCreateGroupResponseError(value *Error) CreateGroupResponseOption {
+	return func(m *CreateGroupResponse) {
+		m.Error = value
+	}
+}
+
+func NewCreateGroupResponse(opts ...CreateGroupResponseOption) *CreateGroupResponse {
+	m := &CreateGroupResponse{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetGroup returns the Group field, or its zero value if m is nil
+func (m *CreateGroupResponse) GetGroup() *Group {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+// GetError returns the Error field, or its zero value if m is nil
+func (m *CreateGroupResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *CreateGroupResponse) Clone() *CreateGroupResponse {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	c.Group = m.Group.Clone()
+	c.Error = m.Error.Clone()
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *CreateGroupResponse) Equal(other *CreateGroupResponse) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.Group.Equal(other.Group) {
+		return false
+	}
+	if !m.Error.Equal(other.Error) {
+		return false
+	}
+	return true
+}
+
+// Field paths of CreateGroupResponse, for ApplyMask and field masks
+const (
+	CreateGroupResponse_Group_PATH = "group"
+	CreateGroupResponse_Error_PATH = "error"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewCreateGroupResponse().
+func (m *CreateGroupResponse) ApplyMask(src *CreateGroupResponse, paths []string) error {
+	if src == nil {
+		src = NewCreateGroupResponse()
+	}
+	for _, path := range paths {
+		name, rest, nested := strings.Cut(path, ".")
+		switch name {
+		case CreateGroupResponse_Group_PATH:
+			if !nested {
+				m.Group = src.Group.Clone()
+				continue
+			}
+			if m.Group == nil {
+				m.Group = &Group{}
+			}
+			if err := m.Group.ApplyMask(src.Group, []string{rest}); err != nil {
+				return err
+			}
+		case CreateGroupResponse_Error_PATH:
+			if !nested {
+				m.Error = src.Error.Clone()
+				continue
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.ApplyMask(src.Error, []string{rest}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("CreateGroupResponse: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *CreateGroupResponse) Merge(src *CreateGroupResponse) {
+	if src == nil {
+		return
+	}
+	if src.Group != nil {
+		if m.Group == nil {
+			m.Group = &Group{}
+		}
+		m.Group.Merge(src.Group)
+	}
+	if src.Error != nil {
+		if m.Error == nil {
+			m.Error = &Error{}
+		}
+		m.Error.Merge(src.Error)
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *CreateGroupResponse) ApplyFieldMask(src *CreateGroupResponse, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *CreateGroupResponse) MergeFieldMask(src *CreateGroupResponse, mask *PuregenFieldMask) error {
+	masked := &CreateGroupResponse{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *CreateGroupResponse) Redacted() *CreateGroupResponse {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	r.Group = r.Group.Redacted()
+	r.Error = r.Error.Redacted()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *CreateGroupResponse) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *CreateGroupResponse) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("group", r.Group),
+		slog.Any("error", r.Error),
+	)
 }
 
 func (m *CreateGroupResponse) Validate() error {
@@ -118,8 +922,166 @@ type ListGroupsRequest struct {
 	PageToken string `json:"pageToken"`
 }
 
-func NewListGroupsRequest() *ListGroupsRequest {
-	return &ListGroupsRequest{}
+// ListGroupsRequestOption sets a field of a new ListGroupsRequest
+type ListGroupsRequestOption func(*ListGroupsRequest)
+
+// WithListGroupsRequestPageSize sets the PageSize field
+func WithListGroupsRequestPageSize(value int32) ListGroupsRequestOption {
+	return func(m *ListGroupsRequest) {
+		m.PageSize = value
+	}
+}
+
+// WithListGroupsRequestPageToken sets the PageToken field
+func WithListGroupsRequestPageToken(value string) ListGroupsRequestOption {
+	return func(m *ListGroupsRequest) {
+		m.PageToken = value
+	}
+}
+
+func NewListGroupsRequest(opts ...ListGroupsRequestOption) *ListGroupsRequest {
+	m := &ListGroupsRequest{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetPageSize returns the PageSize field, or its zero value if m is nil
+func (m *ListGroupsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+// GetPageToken returns the PageToken field, or its zero value if m is nil
+func (m *ListGroupsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// Clone returns a deep copy of m
+func (m *ListGroupsRequest) Clone() *ListGroupsRequest {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *ListGroupsRequest) Equal(other *ListGroupsRequest) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.PageSize != other.PageSize {
+		return false
+	}
+	if m.PageToken != other.PageToken {
+		return false
+	}
+	return true
+}
+
+// Field paths of ListGroupsRequest, for ApplyMask and field masks
+const (
+	ListGroupsRequest_PageSize_PATH  = "page_size"
+	ListGroupsRequest_PageToken_PATH = "page_token"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewListGroupsRequest().
+func (m *ListGroupsRequest) ApplyMask(src *ListGroupsRequest, paths []string) error {
+	if src == nil {
+		src = NewListGroupsRequest()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case ListGroupsRequest_PageSize_PATH, "pageSize":
+			if nested {
+				return fmt.Errorf("ListGroupsRequest: field %q has no subfields", name)
+			}
+			m.PageSize = src.PageSize
+		case ListGroupsRequest_PageToken_PATH, "pageToken":
+			if nested {
+				return fmt.Errorf("ListGroupsRequest: field %q has no subfields", name)
+			}
+			m.PageToken = src.PageToken
+		default:
+			return fmt.Errorf("ListGroupsRequest: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *ListGroupsRequest) Merge(src *ListGroupsRequest) {
+	if src == nil {
+		return
+	}
+	if src.PageSize != 0 {
+		m.PageSize = src.PageSize
+	}
+	if src.PageToken != "" {
+		m.PageToken = src.PageToken
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *ListGroupsRequest) ApplyFieldMask(src *ListGroupsRequest, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *ListGroupsRequest) MergeFieldMask(src *ListGroupsRequest, mask *PuregenFieldMask) error {
+	masked := &ListGroupsRequest{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *ListGroupsRequest) Redacted() *ListGroupsRequest {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *ListGroupsRequest) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *ListGroupsRequest) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("pageSize", r.PageSize),
+		slog.Any("pageToken", r.PageToken),
+	)
 }
 
 func (m *ListGroupsRequest) Validate() error {
@@ -135,14 +1097,215 @@ func (m *ListGroupsRequest) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
+// UnmarshalJSON accepts both the proto field names and the JSON names of the fields
+func (m *ListGroupsRequest) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for alias, name := range map[string]string{
+		"page_size":  "pageSize",
+		"page_token": "pageToken",
+	} {
+		if value, ok := fields[alias]; ok {
+			delete(fields, alias)
+			if _, ok := fields[name]; !ok {
+				fields[name] = value
+			}
+		}
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	// plain has the fields of ListGroupsRequest but not this method
+	type plain ListGroupsRequest
+	return json.Unmarshal(data, (*plain)(m))
+}
+
 // ListGroupsResponse is the response for listing groups
 type ListGroupsResponse struct {
 	Groups        []*Group `json:"groups"`
 	NextPageToken string   `json:"nextPageToken"`
 }
 
-func NewListGroupsResponse() *ListGroupsResponse {
-	return &ListGroupsResponse{}
+// ListGroupsResponseOption sets a field of a new ListGroupsResponse
+type ListGroupsResponseOption func(*ListGroupsResponse)
+
+// WithListGroupsResponseGroups sets the Groups field
+func WithListGroupsResponseGroups(value []*Group) ListGroupsResponseOption {
+	return func(m *ListGroupsResponse) {
+		m.Groups = value
+	}
+}
+
+// WithListGroupsResponseNextPageToken sets the NextPageToken field
+func WithListGroupsResponseNextPageToken(value string) ListGroupsResponseOption {
+	return func(m *ListGroupsResponse) {
+		m.NextPageToken = value
+	}
+}
+
+func NewListGroupsResponse(opts ...ListGroupsResponseOption) *ListGroupsResponse {
+	m := &ListGroupsResponse{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetGroups returns the Groups field, or its zero value if m is nil
+func (m *ListGroupsResponse) GetGroups() []*Group {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+// GetNextPageToken returns the NextPageToken field, or its zero value if m is nil
+func (m *ListGroupsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// Clone returns a deep copy of m
+func (m *ListGroupsResponse) Clone() *ListGroupsResponse {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	if m.Groups != nil {
+		c.Groups = make([]*Group, len(m.Groups))
+		for i, v := range m.Groups {
+			c.Groups[i] = v.Clone()
+		}
+	}
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *ListGroupsResponse) Equal(other *ListGroupsResponse) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Groups) != len(other.Groups) {
+		return false
+	}
+	for i := range m.Groups {
+		if !m.Groups[i].Equal(other.Groups[i]) {
+			return false
+		}
+	}
+	if m.NextPageToken != other.NextPageToken {
+		return false
+	}
+	return true
+}
+
+// Field paths of ListGroupsResponse, for ApplyMask and field masks
+const (
+	ListGroupsResponse_Groups_PATH        = "groups"
+	ListGroupsResponse_NextPageToken_PATH = "next_page_token"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewListGroupsResponse().
+func (m *ListGroupsResponse) ApplyMask(src *ListGroupsResponse, paths []string) error {
+	if src == nil {
+		src = NewListGroupsResponse()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case ListGroupsResponse_Groups_PATH:
+			if nested {
+				return fmt.Errorf("ListGroupsResponse: field %q has no subfields", name)
+			}
+			m.Groups = make([]*Group, len(src.Groups))
+			for i, v := range src.Groups {
+				m.Groups[i] = v.Clone()
+			}
+		case ListGroupsResponse_NextPageToken_PATH, "nextPageToken":
+			if nested {
+				return fmt.Errorf("ListGroupsResponse: field %q has no subfields", name)
+			}
+			m.NextPageToken = src.NextPageToken
+		default:
+			return fmt.Errorf("ListGroupsResponse: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *ListGroupsResponse) Merge(src *ListGroupsResponse) {
+	if src == nil {
+		return
+	}
+	for _, v := range src.Groups {
+		m.Groups = append(m.Groups, v.Clone())
+	}
+	if src.NextPageToken != "" {
+		m.NextPageToken = src.NextPageToken
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *ListGroupsResponse) ApplyFieldMask(src *ListGroupsResponse, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *ListGroupsResponse) MergeFieldMask(src *ListGroupsResponse, mask *PuregenFieldMask) error {
+	masked := &ListGroupsResponse{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *ListGroupsResponse) Redacted() *ListGroupsResponse {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	for i, v := range r.Groups {
+		r.Groups[i] = v.Redacted()
+	}
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *ListGroupsResponse) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *ListGroupsResponse) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("groups", r.Groups),
+		slog.Any("nextPageToken", r.NextPageToken),
+	)
 }
 
 func (m *ListGroupsResponse) Validate() error {
@@ -158,6 +1321,281 @@ func (m *ListGroupsResponse) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
 
+// UnmarshalJSON accepts both the proto field names and the JSON names of the fields
+func (m *ListGroupsResponse) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for alias, name := range map[string]string{
+		"next_page_token": "nextPageToken",
+	} {
+		if value, ok := fields[alias]; ok {
+			delete(fields, alias)
+			if _, ok := fields[name]; !ok {
+				fields[name] = value
+			}
+		}
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	// plain has the fields of ListGroupsResponse but not this method
+	type plain ListGroupsResponse
+	return json.Unmarshal(data, (*plain)(m))
+}
+
+// Descriptors
+
+// ErrorDescriptor describes Error and its fields
+var ErrorDescriptor = &PuregenMessageDescriptor{
+	FullName: "company.examples.proto.error.v1.Error",
+	Name:     "Error",
+	GoName:   "Error",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "code",
+			GoName:   "Code",
+			JSONName: "code",
+			Number:   1,
+			Kind:     "int32",
+		},
+		{
+			Name:     "message",
+			GoName:   "Message",
+			JSONName: "message",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "details",
+			GoName:   "Details",
+			JSONName: "details",
+			Number:   3,
+			Kind:     "string",
+		},
+	},
+}
+
+// Descriptor returns ErrorDescriptor
+func (*Error) Descriptor() *PuregenMessageDescriptor {
+	return ErrorDescriptor
+}
+
+// GroupDescriptor describes Group and its fields
+var GroupDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.examples.groups.Group",
+	Name:     "Group",
+	GoName:   "Group",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "id",
+			GoName:   "Id",
+			JSONName: "id",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "name",
+			GoName:   "Name",
+			JSONName: "name",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "description",
+			GoName:   "Description",
+			JSONName: "description",
+			Number:   3,
+			Kind:     "string",
+		},
+		{
+			Name:     "created_at",
+			GoName:   "CreatedAt",
+			JSONName: "createdAt",
+			Number:   4,
+			Kind:     "int64",
+		},
+	},
+}
+
+// Descriptor returns GroupDescriptor
+func (*Group) Descriptor() *PuregenMessageDescriptor {
+	return GroupDescriptor
+}
+
+// CreateGroupRequestDescriptor describes CreateGroupRequest and its fields
+var CreateGroupRequestDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.examples.groups.CreateGroupRequest",
+	Name:     "CreateGroupRequest",
+	GoName:   "CreateGroupRequest",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "name",
+			GoName:   "Name",
+			JSONName: "name",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "description",
+			GoName:   "Description",
+			JSONName: "description",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "owner",
+			GoName:   "Owner",
+			JSONName: "owner",
+			Number:   3,
+			Kind:     "message",
+			TypeName: "puregen.examples.groups.Principal",
+		},
+	},
+}
+
+// Descriptor returns CreateGroupRequestDescriptor
+func (*CreateGroupRequest) Descriptor() *PuregenMessageDescriptor {
+	return CreateGroupRequestDescriptor
+}
+
+// CreateGroupResponseDescriptor describes CreateGroupResponse and its fields
+var CreateGroupResponseDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.examples.groups.CreateGroupResponse",
+	Name:     "CreateGroupResponse",
+	GoName:   "CreateGroupResponse",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "group",
+			GoName:   "Group",
+			JSONName: "group",
+			Number:   1,
+			Kind:     "message",
+			TypeName: "puregen.examples.groups.Group",
+		},
+		{
+			Name:     "error",
+			GoName:   "Error",
+			JSONName: "error",
+			Number:   2,
+			Kind:     "message",
+			TypeName: "company.examples.proto.error.v1.Error",
+		},
+	},
+}
+
+// Descriptor returns CreateGroupResponseDescriptor
+func (*CreateGroupResponse) Descriptor() *PuregenMessageDescriptor {
+	return CreateGroupResponseDescriptor
+}
+
+// ListGroupsRequestDescriptor describes ListGroupsRequest and its fields
+var ListGroupsRequestDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.examples.groups.ListGroupsRequest",
+	Name:     "ListGroupsRequest",
+	GoName:   "ListGroupsRequest",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "page_size",
+			GoName:   "PageSize",
+			JSONName: "pageSize",
+			Number:   1,
+			Kind:     "int32",
+		},
+		{
+			Name:     "page_token",
+			GoName:   "PageToken",
+			JSONName: "pageToken",
+			Number:   2,
+			Kind:     "string",
+		},
+	},
+}
+
+// Descriptor returns ListGroupsRequestDescriptor
+func (*ListGroupsRequest) Descriptor() *PuregenMessageDescriptor {
+	return ListGroupsRequestDescriptor
+}
+
+// ListGroupsResponseDescriptor describes ListGroupsResponse and its fields
+var ListGroupsResponseDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.examples.groups.ListGroupsResponse",
+	Name:     "ListGroupsResponse",
+	GoName:   "ListGroupsResponse",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "groups",
+			GoName:   "Groups",
+			JSONName: "groups",
+			Number:   1,
+			Kind:     "message",
+			TypeName: "puregen.examples.groups.Group",
+			Repeated: true,
+		},
+		{
+			Name:     "next_page_token",
+			GoName:   "NextPageToken",
+			JSONName: "nextPageToken",
+			Number:   2,
+			Kind:     "string",
+		},
+	},
+}
+
+// Descriptor returns ListGroupsResponseDescriptor
+func (*ListGroupsResponse) Descriptor() *PuregenMessageDescriptor {
+	return ListGroupsResponseDescriptor
+}
+
+func init() {
+	registerMessageDescriptors(ErrorDescriptor, GroupDescriptor, CreateGroupRequestDescriptor, CreateGroupResponseDescriptor, ListGroupsRequestDescriptor, ListGroupsResponseDescriptor)
+}
+
+// Message types
+
+// ProtoName returns the full proto name of Error
+func (*Error) ProtoName() string {
+	return "company.examples.proto.error.v1.Error"
+}
+
+// ProtoName returns the full proto name of Group
+func (*Group) ProtoName() string {
+	return "puregen.examples.groups.Group"
+}
+
+// ProtoName returns the full proto name of CreateGroupRequest
+func (*CreateGroupRequest) ProtoName() string {
+	return "puregen.examples.groups.CreateGroupRequest"
+}
+
+// ProtoName returns the full proto name of CreateGroupResponse
+func (*CreateGroupResponse) ProtoName() string {
+	return "puregen.examples.groups.CreateGroupResponse"
+}
+
+// ProtoName returns the full proto name of ListGroupsRequest
+func (*ListGroupsRequest) ProtoName() string {
+	return "puregen.examples.groups.ListGroupsRequest"
+}
+
+// ProtoName returns the full proto name of ListGroupsResponse
+func (*ListGroupsResponse) ProtoName() string {
+	return "puregen.examples.groups.ListGroupsResponse"
+}
+
+func init() {
+	registerMessageTypes(map[string]func() PuregenMessage{
+		"company.examples.proto.error.v1.Error":       func() PuregenMessage { return NewError() },
+		"puregen.examples.groups.Group":               func() PuregenMessage { return NewGroup() },
+		"puregen.examples.groups.CreateGroupRequest":  func() PuregenMessage { return NewCreateGroupRequest() },
+		"puregen.examples.groups.CreateGroupResponse": func() PuregenMessage { return NewCreateGroupResponse() },
+		"puregen.examples.groups.ListGroupsRequest":   func() PuregenMessage { return NewListGroupsRequest() },
+		"puregen.examples.groups.ListGroupsResponse":  func() PuregenMessage { return NewListGroupsResponse() },
+	})
+}
+
 // Services
 
 // GroupService provides operations on groups
@@ -189,7 +1627,7 @@ const (
 	GroupService_ListGroups  = "GroupService_ListGroups"
 )
 
-var GroupServiceMethodMetadata = map[string]map[string]string{}
+var GroupServiceMethodMetadata = map[string]map[string]any{}
 
 // Client
 
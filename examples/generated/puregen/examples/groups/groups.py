@@ -1,17 +1,36 @@
 # Code generated by protoc-gen-puregen. DO NOT EDIT.
 
 from dataclasses import dataclass, field
-from typing import Optional, List, Dict, Any
+from typing import Optional, List, Dict, Any, ClassVar, Final, Literal, Union
 from abc import ABC, abstractmethod
+import base64
+import copy
 import json
-from .puregen_transport import PuregenTransport
+import sys
+import warnings
+from .puregen_transport import PuregenTransport, AsyncPuregenTransport
+from .puregen_descriptors import PuregenFieldDescriptor, PuregenMessageDescriptor, register_message_descriptors
+from .puregen_registry import PuregenEnvelope, register_message_types
+from .puregen_fieldmask import PuregenFieldMask
 from puregen.examples.groups.principal import Principal
 
+if sys.version_info >= (3, 11):
+    from typing import Self
+else:
+    from typing_extensions import Self
+
 # Imported Messages (redefined locally)
 
 @dataclass
 class Error:
     """Generated message class for Error"""
+    class Paths:
+        """Field paths of Error, for apply_mask and field masks"""
+        CODE = "code"
+        MESSAGE = "message"
+        DETAILS = "details"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Error code
     code: int = 0
     # Human-readable error message
@@ -24,13 +43,65 @@ class Error:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a Error with every field at its zero value, unlike
+        Error(), which applies the field defaults."""
+        return cls(code=0, message="", details="")
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new Error."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == Error.Paths.CODE:
+                if dot:
+                    raise ValueError(f"Error: field {name!r} has no subfields")
+                self.code = source.code
+            elif name == Error.Paths.MESSAGE:
+                if dot:
+                    raise ValueError(f"Error: field {name!r} has no subfields")
+                self.message = source.message
+            elif name == Error.Paths.DETAILS:
+                if dot:
+                    raise ValueError(f"Error: field {name!r} has no subfields")
+                self.details = source.details
+            else:
+                raise ValueError(f"Error: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.code:
+            self.code = src.code
+        if src.message:
+            self.message = src.message
+        if src.details:
+            self.details = src.details
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.code is not None:
             result['code'] = self.code
         if self.message is not None:
@@ -40,15 +111,15 @@ class Error:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'Error':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'Error':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'code' in data:
             kwargs['code'] = data['code']
         if 'message' in data:
@@ -63,6 +134,14 @@ class Error:
 @dataclass
 class Group:
     """Generated message class for Group"""
+    class Paths:
+        """Field paths of Group, for apply_mask and field masks"""
+        ID = "id"
+        NAME = "name"
+        DESCRIPTION = "description"
+        CREATED_AT = "created_at"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     id: str = ""
     name: str = ""
     description: str = ""
@@ -73,13 +152,71 @@ class Group:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a Group with every field at its zero value, unlike
+        Group(), which applies the field defaults."""
+        return cls(id="", name="", description="", created_at=0)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new Group."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == Group.Paths.ID:
+                if dot:
+                    raise ValueError(f"Group: field {name!r} has no subfields")
+                self.id = source.id
+            elif name == Group.Paths.NAME:
+                if dot:
+                    raise ValueError(f"Group: field {name!r} has no subfields")
+                self.name = source.name
+            elif name == Group.Paths.DESCRIPTION:
+                if dot:
+                    raise ValueError(f"Group: field {name!r} has no subfields")
+                self.description = source.description
+            elif name in (Group.Paths.CREATED_AT, "createdAt"):
+                if dot:
+                    raise ValueError(f"Group: field {name!r} has no subfields")
+                self.created_at = source.created_at
+            else:
+                raise ValueError(f"Group: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.id:
+            self.id = src.id
+        if src.name:
+            self.name = src.name
+        if src.description:
+            self.description = src.description
+        if src.created_at:
+            self.created_at = src.created_at
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.id is not None:
             result['id'] = self.id
         if self.name is not None:
@@ -91,15 +228,19 @@ class Group:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'Group':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'Group':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
+        data = dict(data)
+        for alias, name in [("created_at", "createdAt")]:
+            if alias in data:
+                data.setdefault(name, data.pop(alias))
         if 'id' in data:
             kwargs['id'] = data['id']
         if 'name' in data:
@@ -114,6 +255,13 @@ class Group:
 @dataclass
 class CreateGroupRequest:
     """Generated message class for CreateGroupRequest"""
+    class Paths:
+        """Field paths of CreateGroupRequest, for apply_mask and field masks"""
+        NAME = "name"
+        DESCRIPTION = "description"
+        OWNER = "owner"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     name: str = ""
     description: str = ""
     # Principal who owns the group
@@ -124,13 +272,72 @@ class CreateGroupRequest:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a CreateGroupRequest with every field at its zero value, unlike
+        CreateGroupRequest(), which applies the field defaults."""
+        return cls(name="", description="", owner=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new CreateGroupRequest."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == CreateGroupRequest.Paths.NAME:
+                if dot:
+                    raise ValueError(f"CreateGroupRequest: field {name!r} has no subfields")
+                self.name = source.name
+            elif name == CreateGroupRequest.Paths.DESCRIPTION:
+                if dot:
+                    raise ValueError(f"CreateGroupRequest: field {name!r} has no subfields")
+                self.description = source.description
+            elif name == CreateGroupRequest.Paths.OWNER:
+                if not dot:
+                    self.owner = copy.deepcopy(source.owner)
+                else:
+                    if self.owner is None:
+                        self.owner = Principal.zero_value()
+                    self.owner.apply_mask(source.owner, [rest])
+            else:
+                raise ValueError(f"CreateGroupRequest: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.name:
+            self.name = src.name
+        if src.description:
+            self.description = src.description
+        if src.owner is not None:
+            if self.owner is None:
+                self.owner = Principal.zero_value()
+            self.owner.merge(src.owner)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.owner is not None:
+            result.owner = result.owner.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.name is not None:
             result['name'] = self.name
         if self.description is not None:
@@ -140,15 +347,15 @@ class CreateGroupRequest:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'CreateGroupRequest':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'CreateGroupRequest':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'name' in data:
             kwargs['name'] = data['name']
         if 'description' in data:
@@ -161,6 +368,12 @@ class CreateGroupRequest:
 @dataclass
 class CreateGroupResponse:
     """Generated message class for CreateGroupResponse"""
+    class Paths:
+        """Field paths of CreateGroupResponse, for apply_mask and field masks"""
+        GROUP = "group"
+        ERROR = "error"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     group: Optional['Group'] = None
     # Error details if creation fails
     error: Optional['Error'] = None
@@ -170,13 +383,73 @@ class CreateGroupResponse:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a CreateGroupResponse with every field at its zero value, unlike
+        CreateGroupResponse(), which applies the field defaults."""
+        return cls(group=None, error=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new CreateGroupResponse."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == CreateGroupResponse.Paths.GROUP:
+                if not dot:
+                    self.group = copy.deepcopy(source.group)
+                else:
+                    if self.group is None:
+                        self.group = Group.zero_value()
+                    self.group.apply_mask(source.group, [rest])
+            elif name == CreateGroupResponse.Paths.ERROR:
+                if not dot:
+                    self.error = copy.deepcopy(source.error)
+                else:
+                    if self.error is None:
+                        self.error = Error.zero_value()
+                    self.error.apply_mask(source.error, [rest])
+            else:
+                raise ValueError(f"CreateGroupResponse: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.group is not None:
+            if self.group is None:
+                self.group = Group.zero_value()
+            self.group.merge(src.group)
+        if src.error is not None:
+            if self.error is None:
+                self.error = Error.zero_value()
+            self.error.merge(src.error)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.group is not None:
+            result.group = result.group.redacted()
+        if result.error is not None:
+            result.error = result.error.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.group is not None:
             result['group'] = self.group.to_dict() if hasattr(self.group, 'to_dict') else self.group
         if self.error is not None:
@@ -184,15 +457,15 @@ class CreateGroupResponse:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'CreateGroupResponse':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'CreateGroupResponse':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'group' in data:
             kwargs['group'] = Group.from_dict(data['group']) if isinstance(data['group'], dict) else data['group']
         if 'error' in data:
@@ -203,6 +476,12 @@ class CreateGroupResponse:
 @dataclass
 class ListGroupsRequest:
     """Generated message class for ListGroupsRequest"""
+    class Paths:
+        """Field paths of ListGroupsRequest, for apply_mask and field masks"""
+        PAGE_SIZE = "page_size"
+        PAGE_TOKEN = "page_token"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     page_size: int = 0
     page_token: str = ""
 
@@ -211,13 +490,59 @@ class ListGroupsRequest:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a ListGroupsRequest with every field at its zero value, unlike
+        ListGroupsRequest(), which applies the field defaults."""
+        return cls(page_size=0, page_token="")
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new ListGroupsRequest."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name in (ListGroupsRequest.Paths.PAGE_SIZE, "pageSize"):
+                if dot:
+                    raise ValueError(f"ListGroupsRequest: field {name!r} has no subfields")
+                self.page_size = source.page_size
+            elif name in (ListGroupsRequest.Paths.PAGE_TOKEN, "pageToken"):
+                if dot:
+                    raise ValueError(f"ListGroupsRequest: field {name!r} has no subfields")
+                self.page_token = source.page_token
+            else:
+                raise ValueError(f"ListGroupsRequest: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.page_size:
+            self.page_size = src.page_size
+        if src.page_token:
+            self.page_token = src.page_token
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.page_size is not None:
             result['pageSize'] = self.page_size
         if self.page_token is not None:
@@ -225,15 +550,19 @@ class ListGroupsRequest:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'ListGroupsRequest':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'ListGroupsRequest':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
+        data = dict(data)
+        for alias, name in [("page_size", "pageSize"), ("page_token", "pageToken")]:
+            if alias in data:
+                data.setdefault(name, data.pop(alias))
         if 'pageSize' in data:
             kwargs['page_size'] = data['pageSize']
         if 'pageToken' in data:
@@ -244,6 +573,12 @@ class ListGroupsRequest:
 @dataclass
 class ListGroupsResponse:
     """Generated message class for ListGroupsResponse"""
+    class Paths:
+        """Field paths of ListGroupsResponse, for apply_mask and field masks"""
+        GROUPS = "groups"
+        NEXT_PAGE_TOKEN = "next_page_token"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     groups: List['Group'] = field(default_factory=list)
     next_page_token: str = ""
 
@@ -252,13 +587,59 @@ class ListGroupsResponse:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a ListGroupsResponse with every field at its zero value, unlike
+        ListGroupsResponse(), which applies the field defaults."""
+        return cls(groups=[], next_page_token="")
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new ListGroupsResponse."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == ListGroupsResponse.Paths.GROUPS:
+                if dot:
+                    raise ValueError(f"ListGroupsResponse: field {name!r} has no subfields")
+                self.groups = copy.deepcopy(source.groups)
+            elif name in (ListGroupsResponse.Paths.NEXT_PAGE_TOKEN, "nextPageToken"):
+                if dot:
+                    raise ValueError(f"ListGroupsResponse: field {name!r} has no subfields")
+                self.next_page_token = source.next_page_token
+            else:
+                raise ValueError(f"ListGroupsResponse: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        self.groups.extend(copy.deepcopy(src.groups))
+        if src.next_page_token:
+            self.next_page_token = src.next_page_token
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        result.groups = [item.redacted() for item in result.groups]
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.groups is not None:
             result['groups'] = [item.to_dict() if hasattr(item, 'to_dict') else item for item in self.groups]
         if self.next_page_token is not None:
@@ -266,21 +647,111 @@ class ListGroupsResponse:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'ListGroupsResponse':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'ListGroupsResponse':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
+        data = dict(data)
+        for alias, name in [("next_page_token", "nextPageToken")]:
+            if alias in data:
+                data.setdefault(name, data.pop(alias))
         if 'groups' in data:
             kwargs['groups'] = [Group.from_dict(item) if isinstance(item, dict) else item for item in data['groups']]
         if 'nextPageToken' in data:
             kwargs['next_page_token'] = data['nextPageToken']
         return cls(**kwargs)
 
+# Descriptors
+
+Error.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="company.examples.proto.error.v1.Error",
+    name="Error",
+    python_name="Error",
+    fields=[
+        PuregenFieldDescriptor(name="code", python_name="code", json_name="code", number=1, kind="int32"),
+        PuregenFieldDescriptor(name="message", python_name="message", json_name="message", number=2, kind="string"),
+        PuregenFieldDescriptor(name="details", python_name="details", json_name="details", number=3, kind="string"),
+    ],
+)
+
+Group.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.examples.groups.Group",
+    name="Group",
+    python_name="Group",
+    fields=[
+        PuregenFieldDescriptor(name="id", python_name="id", json_name="id", number=1, kind="string"),
+        PuregenFieldDescriptor(name="name", python_name="name", json_name="name", number=2, kind="string"),
+        PuregenFieldDescriptor(name="description", python_name="description", json_name="description", number=3, kind="string"),
+        PuregenFieldDescriptor(name="created_at", python_name="created_at", json_name="createdAt", number=4, kind="int64"),
+    ],
+)
+
+CreateGroupRequest.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.examples.groups.CreateGroupRequest",
+    name="CreateGroupRequest",
+    python_name="CreateGroupRequest",
+    fields=[
+        PuregenFieldDescriptor(name="name", python_name="name", json_name="name", number=1, kind="string"),
+        PuregenFieldDescriptor(name="description", python_name="description", json_name="description", number=2, kind="string"),
+        PuregenFieldDescriptor(name="owner", python_name="owner", json_name="owner", number=3, kind="message", type_name="puregen.examples.groups.Principal"),
+    ],
+)
+
+CreateGroupResponse.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.examples.groups.CreateGroupResponse",
+    name="CreateGroupResponse",
+    python_name="CreateGroupResponse",
+    fields=[
+        PuregenFieldDescriptor(name="group", python_name="group", json_name="group", number=1, kind="message", type_name="puregen.examples.groups.Group"),
+        PuregenFieldDescriptor(name="error", python_name="error", json_name="error", number=2, kind="message", type_name="company.examples.proto.error.v1.Error"),
+    ],
+)
+
+ListGroupsRequest.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.examples.groups.ListGroupsRequest",
+    name="ListGroupsRequest",
+    python_name="ListGroupsRequest",
+    fields=[
+        PuregenFieldDescriptor(name="page_size", python_name="page_size", json_name="pageSize", number=1, kind="int32"),
+        PuregenFieldDescriptor(name="page_token", python_name="page_token", json_name="pageToken", number=2, kind="string"),
+    ],
+)
+
+ListGroupsResponse.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.examples.groups.ListGroupsResponse",
+    name="ListGroupsResponse",
+    python_name="ListGroupsResponse",
+    fields=[
+        PuregenFieldDescriptor(name="groups", python_name="groups", json_name="groups", number=1, kind="message", type_name="puregen.examples.groups.Group", repeated=True),
+        PuregenFieldDescriptor(name="next_page_token", python_name="next_page_token", json_name="nextPageToken", number=2, kind="string"),
+    ],
+)
+
+register_message_descriptors(
+    Error.DESCRIPTOR,
+    Group.DESCRIPTOR,
+    CreateGroupRequest.DESCRIPTOR,
+    CreateGroupResponse.DESCRIPTOR,
+    ListGroupsRequest.DESCRIPTOR,
+    ListGroupsResponse.DESCRIPTOR,
+)
+
+# Message types
+
+register_message_types({
+    "company.examples.proto.error.v1.Error": Error,
+    "puregen.examples.groups.Group": Group,
+    "puregen.examples.groups.CreateGroupRequest": CreateGroupRequest,
+    "puregen.examples.groups.CreateGroupResponse": CreateGroupResponse,
+    "puregen.examples.groups.ListGroupsRequest": ListGroupsRequest,
+    "puregen.examples.groups.ListGroupsResponse": ListGroupsResponse,
+})
+
 # Services
 
 # GroupService provides operations on groups
@@ -321,7 +792,7 @@ class GroupServiceMethods:
     GroupService_CreateGroup = "GroupService_CreateGroup"
     GroupService_ListGroups = "GroupService_ListGroups"
 
-    METHOD_METADATA = {
+    METHOD_METADATA: Dict[str, Dict[str, Any]] = {
     }
 
 # Client
@@ -329,7 +800,7 @@ class GroupServiceMethods:
 class GroupServiceClient:
     """Client for GroupService service"""
 
-    def __init__(self, transport: PuregenTransport):
+    def __init__(self, transport: PuregenTransport) -> None:
         self.transport = transport
 
     def create_group(self, ctx: Dict[str, Any], request: CreateGroupRequest) -> CreateGroupResponse:
@@ -356,3 +827,33 @@ class GroupServiceClient:
             return ListGroupsResponse.from_dict(result)
         raise ValueError(f"Invalid response type for list_groups: {type(result)}")
 
+class AsyncGroupServiceClient:
+    """Asyncio client for GroupService service"""
+
+    def __init__(self, transport: AsyncPuregenTransport) -> None:
+        self.transport = transport
+
+    async def create_group(self, ctx: Dict[str, Any], request: CreateGroupRequest) -> CreateGroupResponse:
+        """CreateGroup client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = GroupServiceMethods.METHOD_METADATA.get(GroupServiceMethods.GroupService_CreateGroup, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, GroupServiceMethods.GroupService_CreateGroup, request, CreateGroupResponse)
+        if isinstance(result, CreateGroupResponse):
+            return result
+        if isinstance(result, dict):
+            return CreateGroupResponse.from_dict(result)
+        raise ValueError(f"Invalid response type for create_group: {type(result)}")
+
+    async def list_groups(self, ctx: Dict[str, Any], request: ListGroupsRequest) -> ListGroupsResponse:
+        """ListGroups client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = GroupServiceMethods.METHOD_METADATA.get(GroupServiceMethods.GroupService_ListGroups, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, GroupServiceMethods.GroupService_ListGroups, request, ListGroupsResponse)
+        if isinstance(result, ListGroupsResponse):
+            return result
+        if isinstance(result, dict):
+            return ListGroupsResponse.from_dict(result)
+        raise ValueError(f"Invalid response type for list_groups: {type(result)}")
+
@@ -3,7 +3,10 @@
 package groups
 
 import (
-	"encoding/json"
+	json "encoding/json"
+	fmt "fmt"
+	slog "log/slog"
+	strings "strings"
 )
 
 // Messages
@@ -19,8 +22,229 @@ type Principal struct {
 	Roles []string `json:"roles"`
 }
 
-func NewPrincipal() *Principal {
-	return &Principal{}
+// PrincipalOption sets a field of a new Principal
+type PrincipalOption func(*Principal)
+
+// WithPrincipalId sets the Id field
+func WithPrincipalId(value string) PrincipalOption {
+	return func(m *Principal) {
+		m.Id = value
+	}
+}
+
+// WithPrincipalName sets the Name field
+func WithPrincipalName(value string) PrincipalOption {
+	return func(m *Principal) {
+		m.Name = value
+	}
+}
+
+// WithPrincipalType sets the Type field
+func WithPrincipalType(value string) PrincipalOption {
+	return func(m *Principal) {
+		m.Type = value
+	}
+}
+
+// WithPrincipalRoles sets the Roles field
+func WithPrincipalRoles(value []string) PrincipalOption {
+	return func(m *Principal) {
+		m.Roles = value
+	}
+}
+
+func NewPrincipal(opts ...PrincipalOption) *Principal {
+	m := &Principal{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetId returns the Id field, or its zero value if m is nil
+func (m *Principal) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// GetName returns the Name field, or its zero value if m is nil
+func (m *Principal) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// GetType returns the Type field, or its zero value if m is nil
+func (m *Principal) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+// GetRoles returns the Roles field, or its zero value if m is nil
+func (m *Principal) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m
+func (m *Principal) Clone() *Principal {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	if m.Roles != nil {
+		c.Roles = make([]string, len(m.Roles))
+		copy(c.Roles, m.Roles)
+	}
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *Principal) Equal(other *Principal) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if len(m.Roles) != len(other.Roles) {
+		return false
+	}
+	for i := range m.Roles {
+		if m.Roles[i] != other.Roles[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Field paths of Principal, for ApplyMask and field masks
+const (
+	Principal_Id_PATH    = "id"
+	Principal_Name_PATH  = "name"
+	Principal_Type_PATH  = "type"
+	Principal_Roles_PATH = "roles"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewPrincipal().
+func (m *Principal) ApplyMask(src *Principal, paths []string) error {
+	if src == nil {
+		src = NewPrincipal()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case Principal_Id_PATH:
+			if nested {
+				return fmt.Errorf("Principal: field %q has no subfields", name)
+			}
+			m.Id = src.Id
+		case Principal_Name_PATH:
+			if nested {
+				return fmt.Errorf("Principal: field %q has no subfields", name)
+			}
+			m.Name = src.Name
+		case Principal_Type_PATH:
+			if nested {
+				return fmt.Errorf("Principal: field %q has no subfields", name)
+			}
+			m.Type = src.Type
+		case Principal_Roles_PATH:
+			if nested {
+				return fmt.Errorf("Principal: field %q has no subfields", name)
+			}
+			m.Roles = append([]string(nil), src.Roles...)
+		default:
+			return fmt.Errorf("Principal: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *Principal) Merge(src *Principal) {
+	if src == nil {
+		return
+	}
+	if src.Id != "" {
+		m.Id = src.Id
+	}
+	if src.Name != "" {
+		m.Name = src.Name
+	}
+	if src.Type != "" {
+		m.Type = src.Type
+	}
+	m.Roles = append(m.Roles, src.Roles...)
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *Principal) ApplyFieldMask(src *Principal, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *Principal) MergeFieldMask(src *Principal, mask *PuregenFieldMask) error {
+	masked := &Principal{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *Principal) Redacted() *Principal {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *Principal) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *Principal) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("id", r.Id),
+		slog.Any("name", r.Name),
+		slog.Any("type", r.Type),
+		slog.Any("roles", r.Roles),
+	)
 }
 
 func (m *Principal) Validate() error {
@@ -35,3 +259,65 @@ func (m *Principal) ToJSON() ([]byte, error) {
 func (m *Principal) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
+
+// Descriptors
+
+// PrincipalDescriptor describes Principal and its fields
+var PrincipalDescriptor = &PuregenMessageDescriptor{
+	FullName: "puregen.examples.groups.Principal",
+	Name:     "Principal",
+	GoName:   "Principal",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "id",
+			GoName:   "Id",
+			JSONName: "id",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "name",
+			GoName:   "Name",
+			JSONName: "name",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "type",
+			GoName:   "Type",
+			JSONName: "type",
+			Number:   3,
+			Kind:     "string",
+		},
+		{
+			Name:     "roles",
+			GoName:   "Roles",
+			JSONName: "roles",
+			Number:   4,
+			Kind:     "string",
+			Repeated: true,
+		},
+	},
+}
+
+// Descriptor returns PrincipalDescriptor
+func (*Principal) Descriptor() *PuregenMessageDescriptor {
+	return PrincipalDescriptor
+}
+
+func init() {
+	registerMessageDescriptors(PrincipalDescriptor)
+}
+
+// Message types
+
+// ProtoName returns the full proto name of Principal
+func (*Principal) ProtoName() string {
+	return "puregen.examples.groups.Principal"
+}
+
+func init() {
+	registerMessageTypes(map[string]func() PuregenMessage{
+		"puregen.examples.groups.Principal": func() PuregenMessage { return NewPrincipal() },
+	})
+}
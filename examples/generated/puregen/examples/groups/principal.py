@@ -1,15 +1,35 @@
 # Code generated by protoc-gen-puregen. DO NOT EDIT.
 
 from dataclasses import dataclass, field
-from typing import Optional, List, Dict, Any
+from typing import Optional, List, Dict, Any, ClassVar, Final, Literal, Union
 from abc import ABC, abstractmethod
+import base64
+import copy
 import json
+import sys
+import warnings
+from .puregen_descriptors import PuregenFieldDescriptor, PuregenMessageDescriptor, register_message_descriptors
+from .puregen_registry import PuregenEnvelope, register_message_types
+from .puregen_fieldmask import PuregenFieldMask
+
+if sys.version_info >= (3, 11):
+    from typing import Self
+else:
+    from typing_extensions import Self
 
 # Messages
 
 @dataclass
 class Principal:
     """Generated message class for Principal"""
+    class Paths:
+        """Field paths of Principal, for apply_mask and field masks"""
+        ID = "id"
+        NAME = "name"
+        TYPE = "type"
+        ROLES = "roles"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # Unique identifier for the principal
     id: str = ""
     # Name of the principal
@@ -24,13 +44,70 @@ class Principal:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a Principal with every field at its zero value, unlike
+        Principal(), which applies the field defaults."""
+        return cls(id="", name="", type="", roles=[])
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new Principal."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == Principal.Paths.ID:
+                if dot:
+                    raise ValueError(f"Principal: field {name!r} has no subfields")
+                self.id = source.id
+            elif name == Principal.Paths.NAME:
+                if dot:
+                    raise ValueError(f"Principal: field {name!r} has no subfields")
+                self.name = source.name
+            elif name == Principal.Paths.TYPE:
+                if dot:
+                    raise ValueError(f"Principal: field {name!r} has no subfields")
+                self.type = source.type
+            elif name == Principal.Paths.ROLES:
+                if dot:
+                    raise ValueError(f"Principal: field {name!r} has no subfields")
+                self.roles = list(source.roles)
+            else:
+                raise ValueError(f"Principal: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.id:
+            self.id = src.id
+        if src.name:
+            self.name = src.name
+        if src.type:
+            self.type = src.type
+        self.roles.extend(src.roles)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.id is not None:
             result['id'] = self.id
         if self.name is not None:
@@ -42,15 +119,15 @@ class Principal:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'Principal':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'Principal':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'id' in data:
             kwargs['id'] = data['id']
         if 'name' in data:
@@ -61,3 +138,27 @@ class Principal:
             kwargs['roles'] = data['roles']
         return cls(**kwargs)
 
+# Descriptors
+
+Principal.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.examples.groups.Principal",
+    name="Principal",
+    python_name="Principal",
+    fields=[
+        PuregenFieldDescriptor(name="id", python_name="id", json_name="id", number=1, kind="string"),
+        PuregenFieldDescriptor(name="name", python_name="name", json_name="name", number=2, kind="string"),
+        PuregenFieldDescriptor(name="type", python_name="type", json_name="type", number=3, kind="string"),
+        PuregenFieldDescriptor(name="roles", python_name="roles", json_name="roles", number=4, kind="string", repeated=True),
+    ],
+)
+
+register_message_descriptors(
+    Principal.DESCRIPTOR,
+)
+
+# Message types
+
+register_message_types({
+    "puregen.examples.groups.Principal": Principal,
+})
+
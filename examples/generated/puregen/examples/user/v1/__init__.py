@@ -1,2 +1,40 @@
+# Code generated by protoc-gen-puregen. DO NOT EDIT.
 # Package initialization file
-# Generated by protoc-gen-puregen
+
+from .puregen_transport import PuregenTransport, AsyncPuregenTransport
+from .puregen_descriptors import PuregenFieldDescriptor, PuregenMessageDescriptor, find_message_descriptor, message_descriptors
+from .puregen_registry import PuregenEnvelope, find_message_type, pack, unpack, unpack_as
+from .puregen_fieldmask import PuregenFieldMask
+from .user import (
+    User,
+    UserProfile,
+    CreateUserRequest,
+    CreateUserResponse,
+    GetUserRequest,
+    GetUserResponse,
+    UserServiceClient,
+    AsyncUserServiceClient,
+)
+
+__all__ = [
+    "PuregenTransport",
+    "AsyncPuregenTransport",
+    "PuregenFieldDescriptor",
+    "PuregenMessageDescriptor",
+    "find_message_descriptor",
+    "message_descriptors",
+    "PuregenEnvelope",
+    "find_message_type",
+    "pack",
+    "unpack",
+    "unpack_as",
+    "PuregenFieldMask",
+    "User",
+    "UserProfile",
+    "CreateUserRequest",
+    "CreateUserResponse",
+    "GetUserRequest",
+    "GetUserResponse",
+    "UserServiceClient",
+    "AsyncUserServiceClient",
+]
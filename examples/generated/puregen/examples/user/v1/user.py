@@ -1,10 +1,22 @@
 # Code generated by protoc-gen-puregen. DO NOT EDIT.
 
 from dataclasses import dataclass, field
-from typing import Optional, List, Dict, Any
+from typing import Optional, List, Dict, Any, ClassVar, Final, Literal, Union
 from abc import ABC, abstractmethod
+import base64
+import copy
 import json
-from .puregen_transport import PuregenTransport
+import sys
+import warnings
+from .puregen_transport import PuregenTransport, AsyncPuregenTransport
+from .puregen_descriptors import PuregenFieldDescriptor, PuregenMessageDescriptor, register_message_descriptors
+from .puregen_registry import PuregenEnvelope, register_message_types
+from .puregen_fieldmask import PuregenFieldMask
+
+if sys.version_info >= (3, 11):
+    from typing import Self
+else:
+    from typing_extensions import Self
 
 # Messages
 
@@ -12,8 +24,19 @@ from .puregen_transport import PuregenTransport
 @dataclass
 class User:
     """Generated message class for User"""
+    class Paths:
+        """Field paths of User, for apply_mask and field masks"""
+        ID = "id"
+        NAME = "name"
+        EMAIL = "email"
+        IS_ACTIVE = "is_active"
+        TAGS = "tags"
+        PROFILE = "profile"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     id: int = 0
     name: str = ""
+    # 
     email: str = ""
     is_active: bool = False
     tags: List[str] = field(default_factory=list)
@@ -24,13 +47,96 @@ class User:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a User with every field at its zero value, unlike
+        User(), which applies the field defaults."""
+        return cls(id=0, name="", email="", is_active=False, tags=[], profile=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new User."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == User.Paths.ID:
+                if dot:
+                    raise ValueError(f"User: field {name!r} has no subfields")
+                self.id = source.id
+            elif name == User.Paths.NAME:
+                if dot:
+                    raise ValueError(f"User: field {name!r} has no subfields")
+                self.name = source.name
+            elif name == User.Paths.EMAIL:
+                if dot:
+                    raise ValueError(f"User: field {name!r} has no subfields")
+                self.email = source.email
+            elif name in (User.Paths.IS_ACTIVE, "isActive"):
+                if dot:
+                    raise ValueError(f"User: field {name!r} has no subfields")
+                self.is_active = source.is_active
+            elif name == User.Paths.TAGS:
+                if dot:
+                    raise ValueError(f"User: field {name!r} has no subfields")
+                self.tags = list(source.tags)
+            elif name == User.Paths.PROFILE:
+                if not dot:
+                    self.profile = copy.deepcopy(source.profile)
+                else:
+                    if self.profile is None:
+                        self.profile = UserProfile.zero_value()
+                    self.profile.apply_mask(source.profile, [rest])
+            else:
+                raise ValueError(f"User: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.id:
+            self.id = src.id
+        if src.name:
+            self.name = src.name
+        if src.email:
+            self.email = src.email
+        if src.is_active:
+            self.is_active = src.is_active
+        self.tags.extend(src.tags)
+        if src.profile is not None:
+            if self.profile is None:
+                self.profile = UserProfile.zero_value()
+            self.profile.merge(src.profile)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.email:
+            result.email = "[REDACTED]"
+        if result.profile is not None:
+            result.profile = result.profile.redacted()
+        return result
+
+    def __repr__(self) -> str:
+        """Render the message with sensitive fields redacted"""
+        redacted = self.redacted()
+        return f"User(id={redacted.id!r}, name={redacted.name!r}, email={redacted.email!r}, is_active={redacted.is_active!r}, tags={redacted.tags!r}, profile={redacted.profile!r})"
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.id is not None:
             result['id'] = self.id
         if self.name is not None:
@@ -46,15 +152,19 @@ class User:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'User':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'User':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
+        data = dict(data)
+        for alias, name in [("is_active", "isActive")]:
+            if alias in data:
+                data.setdefault(name, data.pop(alias))
         if 'id' in data:
             kwargs['id'] = data['id']
         if 'name' in data:
@@ -73,6 +183,13 @@ class User:
 @dataclass
 class UserProfile:
     """Generated message class for UserProfile"""
+    class Paths:
+        """Field paths of UserProfile, for apply_mask and field masks"""
+        BIO = "bio"
+        AVATAR_URL = "avatar_url"
+        CREATED_AT = "created_at"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     bio: str = ""
     avatar_url: str = ""
     created_at: int = 0
@@ -82,13 +199,65 @@ class UserProfile:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a UserProfile with every field at its zero value, unlike
+        UserProfile(), which applies the field defaults."""
+        return cls(bio="", avatar_url="", created_at=0)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new UserProfile."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == UserProfile.Paths.BIO:
+                if dot:
+                    raise ValueError(f"UserProfile: field {name!r} has no subfields")
+                self.bio = source.bio
+            elif name in (UserProfile.Paths.AVATAR_URL, "avatarUrl"):
+                if dot:
+                    raise ValueError(f"UserProfile: field {name!r} has no subfields")
+                self.avatar_url = source.avatar_url
+            elif name in (UserProfile.Paths.CREATED_AT, "createdAt"):
+                if dot:
+                    raise ValueError(f"UserProfile: field {name!r} has no subfields")
+                self.created_at = source.created_at
+            else:
+                raise ValueError(f"UserProfile: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.bio:
+            self.bio = src.bio
+        if src.avatar_url:
+            self.avatar_url = src.avatar_url
+        if src.created_at:
+            self.created_at = src.created_at
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.bio is not None:
             result['bio'] = self.bio
         if self.avatar_url is not None:
@@ -98,15 +267,19 @@ class UserProfile:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'UserProfile':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'UserProfile':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
+        data = dict(data)
+        for alias, name in [("avatar_url", "avatarUrl"), ("created_at", "createdAt")]:
+            if alias in data:
+                data.setdefault(name, data.pop(alias))
         if 'bio' in data:
             kwargs['bio'] = data['bio']
         if 'avatarUrl' in data:
@@ -119,6 +292,13 @@ class UserProfile:
 @dataclass
 class CreateUserRequest:
     """Generated message class for CreateUserRequest"""
+    class Paths:
+        """Field paths of CreateUserRequest, for apply_mask and field masks"""
+        NAME = "name"
+        EMAIL = "email"
+        PROFILE = "profile"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     name: str = ""
     email: str = ""
     profile: Optional['UserProfile'] = None
@@ -128,13 +308,72 @@ class CreateUserRequest:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a CreateUserRequest with every field at its zero value, unlike
+        CreateUserRequest(), which applies the field defaults."""
+        return cls(name="", email="", profile=None)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new CreateUserRequest."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == CreateUserRequest.Paths.NAME:
+                if dot:
+                    raise ValueError(f"CreateUserRequest: field {name!r} has no subfields")
+                self.name = source.name
+            elif name == CreateUserRequest.Paths.EMAIL:
+                if dot:
+                    raise ValueError(f"CreateUserRequest: field {name!r} has no subfields")
+                self.email = source.email
+            elif name == CreateUserRequest.Paths.PROFILE:
+                if not dot:
+                    self.profile = copy.deepcopy(source.profile)
+                else:
+                    if self.profile is None:
+                        self.profile = UserProfile.zero_value()
+                    self.profile.apply_mask(source.profile, [rest])
+            else:
+                raise ValueError(f"CreateUserRequest: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.name:
+            self.name = src.name
+        if src.email:
+            self.email = src.email
+        if src.profile is not None:
+            if self.profile is None:
+                self.profile = UserProfile.zero_value()
+            self.profile.merge(src.profile)
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.profile is not None:
+            result.profile = result.profile.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.name is not None:
             result['name'] = self.name
         if self.email is not None:
@@ -144,15 +383,15 @@ class CreateUserRequest:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'CreateUserRequest':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'CreateUserRequest':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'name' in data:
             kwargs['name'] = data['name']
         if 'email' in data:
@@ -165,6 +404,13 @@ class CreateUserRequest:
 @dataclass
 class CreateUserResponse:
     """Generated message class for CreateUserResponse"""
+    class Paths:
+        """Field paths of CreateUserResponse, for apply_mask and field masks"""
+        USER = "user"
+        SUCCESS = "success"
+        MESSAGE = "message"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     user: Optional['User'] = None
     success: bool = False
     message: str = ""
@@ -174,13 +420,72 @@ class CreateUserResponse:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a CreateUserResponse with every field at its zero value, unlike
+        CreateUserResponse(), which applies the field defaults."""
+        return cls(user=None, success=False, message="")
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new CreateUserResponse."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == CreateUserResponse.Paths.USER:
+                if not dot:
+                    self.user = copy.deepcopy(source.user)
+                else:
+                    if self.user is None:
+                        self.user = User.zero_value()
+                    self.user.apply_mask(source.user, [rest])
+            elif name == CreateUserResponse.Paths.SUCCESS:
+                if dot:
+                    raise ValueError(f"CreateUserResponse: field {name!r} has no subfields")
+                self.success = source.success
+            elif name == CreateUserResponse.Paths.MESSAGE:
+                if dot:
+                    raise ValueError(f"CreateUserResponse: field {name!r} has no subfields")
+                self.message = source.message
+            else:
+                raise ValueError(f"CreateUserResponse: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.user is not None:
+            if self.user is None:
+                self.user = User.zero_value()
+            self.user.merge(src.user)
+        if src.success:
+            self.success = src.success
+        if src.message:
+            self.message = src.message
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.user is not None:
+            result.user = result.user.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.user is not None:
             result['user'] = self.user.to_dict() if hasattr(self.user, 'to_dict') else self.user
         if self.success is not None:
@@ -190,15 +495,15 @@ class CreateUserResponse:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'CreateUserResponse':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'CreateUserResponse':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'user' in data:
             kwargs['user'] = User.from_dict(data['user']) if isinstance(data['user'], dict) else data['user']
         if 'success' in data:
@@ -211,6 +516,11 @@ class CreateUserResponse:
 @dataclass
 class GetUserRequest:
     """Generated message class for GetUserRequest"""
+    class Paths:
+        """Field paths of GetUserRequest, for apply_mask and field masks"""
+        ID = "id"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     id: int = 0
 
     def validate(self) -> bool:
@@ -218,27 +528,67 @@ class GetUserRequest:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a GetUserRequest with every field at its zero value, unlike
+        GetUserRequest(), which applies the field defaults."""
+        return cls(id=0)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new GetUserRequest."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == GetUserRequest.Paths.ID:
+                if dot:
+                    raise ValueError(f"GetUserRequest: field {name!r} has no subfields")
+                self.id = source.id
+            else:
+                raise ValueError(f"GetUserRequest: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.id:
+            self.id = src.id
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.id is not None:
             result['id'] = self.id
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'GetUserRequest':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'GetUserRequest':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'id' in data:
             kwargs['id'] = data['id']
         return cls(**kwargs)
@@ -247,6 +597,12 @@ class GetUserRequest:
 @dataclass
 class GetUserResponse:
     """Generated message class for GetUserResponse"""
+    class Paths:
+        """Field paths of GetUserResponse, for apply_mask and field masks"""
+        USER = "user"
+        FOUND = "found"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     user: Optional['User'] = None
     found: bool = False
 
@@ -255,13 +611,66 @@ class GetUserResponse:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a GetUserResponse with every field at its zero value, unlike
+        GetUserResponse(), which applies the field defaults."""
+        return cls(user=None, found=False)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new GetUserResponse."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == GetUserResponse.Paths.USER:
+                if not dot:
+                    self.user = copy.deepcopy(source.user)
+                else:
+                    if self.user is None:
+                        self.user = User.zero_value()
+                    self.user.apply_mask(source.user, [rest])
+            elif name == GetUserResponse.Paths.FOUND:
+                if dot:
+                    raise ValueError(f"GetUserResponse: field {name!r} has no subfields")
+                self.found = source.found
+            else:
+                raise ValueError(f"GetUserResponse: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.user is not None:
+            if self.user is None:
+                self.user = User.zero_value()
+            self.user.merge(src.user)
+        if src.found:
+            self.found = src.found
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        if result.user is not None:
+            result.user = result.user.redacted()
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.user is not None:
             result['user'] = self.user.to_dict() if hasattr(self.user, 'to_dict') else self.user
         if self.found is not None:
@@ -269,21 +678,109 @@ class GetUserResponse:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'GetUserResponse':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'GetUserResponse':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'user' in data:
             kwargs['user'] = User.from_dict(data['user']) if isinstance(data['user'], dict) else data['user']
         if 'found' in data:
             kwargs['found'] = data['found']
         return cls(**kwargs)
 
+# Descriptors
+
+User.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.examples.user.v1.User",
+    name="User",
+    python_name="User",
+    fields=[
+        PuregenFieldDescriptor(name="id", python_name="id", json_name="id", number=1, kind="int32"),
+        PuregenFieldDescriptor(name="name", python_name="name", json_name="name", number=2, kind="string"),
+        PuregenFieldDescriptor(name="email", python_name="email", json_name="email", number=3, kind="string"),
+        PuregenFieldDescriptor(name="is_active", python_name="is_active", json_name="isActive", number=4, kind="bool"),
+        PuregenFieldDescriptor(name="tags", python_name="tags", json_name="tags", number=5, kind="string", repeated=True),
+        PuregenFieldDescriptor(name="profile", python_name="profile", json_name="profile", number=6, kind="message", type_name="puregen.examples.user.v1.UserProfile"),
+    ],
+)
+
+UserProfile.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.examples.user.v1.UserProfile",
+    name="UserProfile",
+    python_name="UserProfile",
+    fields=[
+        PuregenFieldDescriptor(name="bio", python_name="bio", json_name="bio", number=1, kind="string"),
+        PuregenFieldDescriptor(name="avatar_url", python_name="avatar_url", json_name="avatarUrl", number=2, kind="string"),
+        PuregenFieldDescriptor(name="created_at", python_name="created_at", json_name="createdAt", number=3, kind="int64"),
+    ],
+)
+
+CreateUserRequest.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.examples.user.v1.CreateUserRequest",
+    name="CreateUserRequest",
+    python_name="CreateUserRequest",
+    fields=[
+        PuregenFieldDescriptor(name="name", python_name="name", json_name="name", number=1, kind="string"),
+        PuregenFieldDescriptor(name="email", python_name="email", json_name="email", number=2, kind="string"),
+        PuregenFieldDescriptor(name="profile", python_name="profile", json_name="profile", number=3, kind="message", type_name="puregen.examples.user.v1.UserProfile"),
+    ],
+)
+
+CreateUserResponse.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.examples.user.v1.CreateUserResponse",
+    name="CreateUserResponse",
+    python_name="CreateUserResponse",
+    fields=[
+        PuregenFieldDescriptor(name="user", python_name="user", json_name="user", number=1, kind="message", type_name="puregen.examples.user.v1.User"),
+        PuregenFieldDescriptor(name="success", python_name="success", json_name="success", number=2, kind="bool"),
+        PuregenFieldDescriptor(name="message", python_name="message", json_name="message", number=3, kind="string"),
+    ],
+)
+
+GetUserRequest.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.examples.user.v1.GetUserRequest",
+    name="GetUserRequest",
+    python_name="GetUserRequest",
+    fields=[
+        PuregenFieldDescriptor(name="id", python_name="id", json_name="id", number=1, kind="int32"),
+    ],
+)
+
+GetUserResponse.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="puregen.examples.user.v1.GetUserResponse",
+    name="GetUserResponse",
+    python_name="GetUserResponse",
+    fields=[
+        PuregenFieldDescriptor(name="user", python_name="user", json_name="user", number=1, kind="message", type_name="puregen.examples.user.v1.User"),
+        PuregenFieldDescriptor(name="found", python_name="found", json_name="found", number=2, kind="bool"),
+    ],
+)
+
+register_message_descriptors(
+    User.DESCRIPTOR,
+    UserProfile.DESCRIPTOR,
+    CreateUserRequest.DESCRIPTOR,
+    CreateUserResponse.DESCRIPTOR,
+    GetUserRequest.DESCRIPTOR,
+    GetUserResponse.DESCRIPTOR,
+)
+
+# Message types
+
+register_message_types({
+    "puregen.examples.user.v1.User": User,
+    "puregen.examples.user.v1.UserProfile": UserProfile,
+    "puregen.examples.user.v1.CreateUserRequest": CreateUserRequest,
+    "puregen.examples.user.v1.CreateUserResponse": CreateUserResponse,
+    "puregen.examples.user.v1.GetUserRequest": GetUserRequest,
+    "puregen.examples.user.v1.GetUserResponse": GetUserResponse,
+})
+
 # Services
 
 # UserService provides operations for managing users
@@ -332,7 +829,7 @@ class UserServiceMethods:
     UserService_CreateUser = "UserService_CreateUser"
     UserService_GetUser = "UserService_GetUser"
 
-    METHOD_METADATA = {
+    METHOD_METADATA: Dict[str, Dict[str, Any]] = {
         UserService_CreateUser: {
             "method": "POST",
             "path": "/users",
@@ -348,7 +845,7 @@ class UserServiceMethods:
 class UserServiceClient:
     """Client for UserService service"""
 
-    def __init__(self, transport: PuregenTransport):
+    def __init__(self, transport: PuregenTransport) -> None:
         self.transport = transport
 
     def create_user(self, ctx: Dict[str, Any], request: CreateUserRequest) -> CreateUserResponse:
@@ -375,3 +872,33 @@ class UserServiceClient:
             return GetUserResponse.from_dict(result)
         raise ValueError(f"Invalid response type for get_user: {type(result)}")
 
+class AsyncUserServiceClient:
+    """Asyncio client for UserService service"""
+
+    def __init__(self, transport: AsyncPuregenTransport) -> None:
+        self.transport = transport
+
+    async def create_user(self, ctx: Dict[str, Any], request: CreateUserRequest) -> CreateUserResponse:
+        """CreateUser client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = UserServiceMethods.METHOD_METADATA.get(UserServiceMethods.UserService_CreateUser, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, UserServiceMethods.UserService_CreateUser, request, CreateUserResponse)
+        if isinstance(result, CreateUserResponse):
+            return result
+        if isinstance(result, dict):
+            return CreateUserResponse.from_dict(result)
+        raise ValueError(f"Invalid response type for create_user: {type(result)}")
+
+    async def get_user(self, ctx: Dict[str, Any], request: GetUserRequest) -> GetUserResponse:
+        """GetUser client method"""
+        enhanced_ctx = ctx.copy() if ctx else {}
+        method_metadata = UserServiceMethods.METHOD_METADATA.get(UserServiceMethods.UserService_GetUser, {})
+        enhanced_ctx['method_metadata'] = method_metadata
+        result = await self.transport.send(enhanced_ctx, UserServiceMethods.UserService_GetUser, request, GetUserResponse)
+        if isinstance(result, GetUserResponse):
+            return result
+        if isinstance(result, dict):
+            return GetUserResponse.from_dict(result)
+        raise ValueError(f"Invalid response type for get_user: {type(result)}")
+
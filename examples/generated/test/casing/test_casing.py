@@ -1,15 +1,41 @@
 # Code generated by protoc-gen-puregen. DO NOT EDIT.
 
 from dataclasses import dataclass, field
-from typing import Optional, List, Dict, Any
+from typing import Optional, List, Dict, Any, ClassVar, Final, Literal, Union
 from abc import ABC, abstractmethod
+import base64
+import copy
 import json
+import sys
+import warnings
+from .puregen_descriptors import PuregenFieldDescriptor, PuregenMessageDescriptor, register_message_descriptors
+from .puregen_registry import PuregenEnvelope, register_message_types
+from .puregen_fieldmask import PuregenFieldMask
+
+if sys.version_info >= (3, 11):
+    from typing import Self
+else:
+    from typing_extensions import Self
 
 # Messages
 
 @dataclass
 class TestMessage:
     """Generated message class for TestMessage"""
+    class Paths:
+        """Field paths of TestMessage, for apply_mask and field masks"""
+        API_HOST = "APIHost"
+        TPM_DATA = "TPMData"
+        XML_CONTENT = "XMLContent"
+        URL_PATH = "URLPath"
+        HTTPS_ENABLED = "HTTPSEnabled"
+        UUID_VALUE = "UUIDValue"
+        JSON_DATA = "JSONData"
+        API_KEY = "APIKey"
+        SQL_QUERY = "SQLQuery"
+        HTML_CONTENT = "HTMLContent"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # 
     api_host: str = ""
     tpm_data: str = ""
@@ -27,13 +53,107 @@ class TestMessage:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a TestMessage with every field at its zero value, unlike
+        TestMessage(), which applies the field defaults."""
+        return cls(api_host="", tpm_data="", xml_content="", url_path="", https_enabled="", uuid_value="", json_data="", api_key="", sql_query="", html_content="")
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new TestMessage."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == TestMessage.Paths.API_HOST:
+                if dot:
+                    raise ValueError(f"TestMessage: field {name!r} has no subfields")
+                self.api_host = source.api_host
+            elif name == TestMessage.Paths.TPM_DATA:
+                if dot:
+                    raise ValueError(f"TestMessage: field {name!r} has no subfields")
+                self.tpm_data = source.tpm_data
+            elif name == TestMessage.Paths.XML_CONTENT:
+                if dot:
+                    raise ValueError(f"TestMessage: field {name!r} has no subfields")
+                self.xml_content = source.xml_content
+            elif name == TestMessage.Paths.URL_PATH:
+                if dot:
+                    raise ValueError(f"TestMessage: field {name!r} has no subfields")
+                self.url_path = source.url_path
+            elif name == TestMessage.Paths.HTTPS_ENABLED:
+                if dot:
+                    raise ValueError(f"TestMessage: field {name!r} has no subfields")
+                self.https_enabled = source.https_enabled
+            elif name == TestMessage.Paths.UUID_VALUE:
+                if dot:
+                    raise ValueError(f"TestMessage: field {name!r} has no subfields")
+                self.uuid_value = source.uuid_value
+            elif name == TestMessage.Paths.JSON_DATA:
+                if dot:
+                    raise ValueError(f"TestMessage: field {name!r} has no subfields")
+                self.json_data = source.json_data
+            elif name == TestMessage.Paths.API_KEY:
+                if dot:
+                    raise ValueError(f"TestMessage: field {name!r} has no subfields")
+                self.api_key = source.api_key
+            elif name == TestMessage.Paths.SQL_QUERY:
+                if dot:
+                    raise ValueError(f"TestMessage: field {name!r} has no subfields")
+                self.sql_query = source.sql_query
+            elif name == TestMessage.Paths.HTML_CONTENT:
+                if dot:
+                    raise ValueError(f"TestMessage: field {name!r} has no subfields")
+                self.html_content = source.html_content
+            else:
+                raise ValueError(f"TestMessage: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.api_host:
+            self.api_host = src.api_host
+        if src.tpm_data:
+            self.tpm_data = src.tpm_data
+        if src.xml_content:
+            self.xml_content = src.xml_content
+        if src.url_path:
+            self.url_path = src.url_path
+        if src.https_enabled:
+            self.https_enabled = src.https_enabled
+        if src.uuid_value:
+            self.uuid_value = src.uuid_value
+        if src.json_data:
+            self.json_data = src.json_data
+        if src.api_key:
+            self.api_key = src.api_key
+        if src.sql_query:
+            self.sql_query = src.sql_query
+        if src.html_content:
+            self.html_content = src.html_content
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.api_host is not None:
             result['APIHost'] = self.api_host
         if self.tpm_data is not None:
@@ -57,15 +177,15 @@ class TestMessage:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'TestMessage':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'TestMessage':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'APIHost' in data:
             kwargs['api_host'] = data['APIHost']
         if 'TPMData' in data:
@@ -92,9 +212,39 @@ class TestMessage:
 TestMessage_APIHost_FIELD = "TestMessage_APIHost"
 
 # MessageField metadata for TestMessage
-TestMessageFieldMetadata = {
+TestMessageFieldMetadata: Dict[str, Dict[str, Any]] = {
     TestMessage_APIHost_FIELD: {
         "urls": "http://example.com/api/test",
     },
 }
 
+# Descriptors
+
+TestMessage.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="test.casing.TestMessage",
+    name="TestMessage",
+    python_name="TestMessage",
+    fields=[
+        PuregenFieldDescriptor(name="APIHost", python_name="api_host", json_name="APIHost", number=1, kind="string", metadata=TestMessageFieldMetadata[TestMessage_APIHost_FIELD]),
+        PuregenFieldDescriptor(name="TPMData", python_name="tpm_data", json_name="TPMData", number=2, kind="string"),
+        PuregenFieldDescriptor(name="XMLContent", python_name="xml_content", json_name="XMLContent", number=3, kind="string"),
+        PuregenFieldDescriptor(name="URLPath", python_name="url_path", json_name="URLPath", number=4, kind="string"),
+        PuregenFieldDescriptor(name="HTTPSEnabled", python_name="https_enabled", json_name="HTTPSEnabled", number=5, kind="string"),
+        PuregenFieldDescriptor(name="UUIDValue", python_name="uuid_value", json_name="UUIDValue", number=6, kind="string"),
+        PuregenFieldDescriptor(name="JSONData", python_name="json_data", json_name="JSONData", number=7, kind="string"),
+        PuregenFieldDescriptor(name="APIKey", python_name="api_key", json_name="APIKey", number=8, kind="string"),
+        PuregenFieldDescriptor(name="SQLQuery", python_name="sql_query", json_name="SQLQuery", number=9, kind="string"),
+        PuregenFieldDescriptor(name="HTMLContent", python_name="html_content", json_name="HTMLContent", number=10, kind="string"),
+    ],
+)
+
+register_message_descriptors(
+    TestMessage.DESCRIPTOR,
+)
+
+# Message types
+
+register_message_types({
+    "test.casing.TestMessage": TestMessage,
+})
+
@@ -3,7 +3,10 @@
 package defaults
 
 import (
-	"encoding/json"
+	json "encoding/json"
+	fmt "fmt"
+	slog "log/slog"
+	strings "strings"
 )
 
 // Messages
@@ -23,13 +26,283 @@ type TestDefaults struct {
 	Age int32 `json:"age"`
 }
 
-func NewTestDefaults() *TestDefaults {
-	return &TestDefaults{
+// TestDefaultsOption sets a field of a new TestDefaults
+type TestDefaultsOption func(*TestDefaults)
+
+// WithTestDefaultsMessage sets the Message field
+func WithTestDefaultsMessage(value string) TestDefaultsOption {
+	return func(m *TestDefaults) {
+		m.Message = value
+	}
+}
+
+// WithTestDefaultsCount sets the Count field
+func WithTestDefaultsCount(value int32) TestDefaultsOption {
+	return func(m *TestDefaults) {
+		m.Count = value
+	}
+}
+
+// WithTestDefaultsEnabled sets the Enabled field
+func WithTestDefaultsEnabled(value bool) TestDefaultsOption {
+	return func(m *TestDefaults) {
+		m.Enabled = value
+	}
+}
+
+// WithTestDefaultsRatio sets the Ratio field
+func WithTestDefaultsRatio(value float32) TestDefaultsOption {
+	return func(m *TestDefaults) {
+		m.Ratio = value
+	}
+}
+
+// WithTestDefaultsDescription sets the Description field
+func WithTestDefaultsDescription(value string) TestDefaultsOption {
+	return func(m *TestDefaults) {
+		m.Description = value
+	}
+}
+
+// WithTestDefaultsAge sets the Age field
+func WithTestDefaultsAge(value int32) TestDefaultsOption {
+	return func(m *TestDefaults) {
+		m.Age = value
+	}
+}
+
+func NewTestDefaults(opts ...TestDefaultsOption) *TestDefaults {
+	m := &TestDefaults{
 		Message: "hello world",
 		Count:   42,
 		Enabled: true,
 		Ratio:   3.14,
 	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetMessage returns the Message field, or its zero value if m is nil
+func (m *TestDefaults) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// GetCount returns the Count field, or its zero value if m is nil
+func (m *TestDefaults) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// GetEnabled returns the Enabled field, or its zero value if m is nil
+func (m *TestDefaults) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// GetRatio returns the Ratio field, or its zero value if m is nil
+func (m *TestDefaults) GetRatio() float32 {
+	if m != nil {
+		return m.Ratio
+	}
+	return 0
+}
+
+// GetDescription returns the Description field, or its zero value if m is nil
+func (m *TestDefaults) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// GetAge returns the Age field, or its zero value if m is nil
+func (m *TestDefaults) GetAge() int32 {
+	if m != nil {
+		return m.Age
+	}
+	return 0
+}
+
+// Clone returns a deep copy of m
+func (m *TestDefaults) Clone() *TestDefaults {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *TestDefaults) Equal(other *TestDefaults) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Message != other.Message {
+		return false
+	}
+	if m.Count != other.Count {
+		return false
+	}
+	if m.Enabled != other.Enabled {
+		return false
+	}
+	if m.Ratio != other.Ratio {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.Age != other.Age {
+		return false
+	}
+	return true
+}
+
+// Field paths of TestDefaults, for ApplyMask and field masks
+const (
+	TestDefaults_Message_PATH     = "message"
+	TestDefaults_Count_PATH       = "count"
+	TestDefaults_Enabled_PATH     = "enabled"
+	TestDefaults_Ratio_PATH       = "ratio"
+	TestDefaults_Description_PATH = "description"
+	TestDefaults_Age_PATH         = "age"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewTestDefaults().
+func (m *TestDefaults) ApplyMask(src *TestDefaults, paths []string) error {
+	if src == nil {
+		src = NewTestDefaults()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case TestDefaults_Message_PATH:
+			if nested {
+				return fmt.Errorf("TestDefaults: field %q has no subfields", name)
+			}
+			m.Message = src.Message
+		case TestDefaults_Count_PATH:
+			if nested {
+				return fmt.Errorf("TestDefaults: field %q has no subfields", name)
+			}
+			m.Count = src.Count
+		case TestDefaults_Enabled_PATH:
+			if nested {
+				return fmt.Errorf("TestDefaults: field %q has no subfields", name)
+			}
+			m.Enabled = src.Enabled
+		case TestDefaults_Ratio_PATH:
+			if nested {
+				return fmt.Errorf("TestDefaults: field %q has no subfields", name)
+			}
+			m.Ratio = src.Ratio
+		case TestDefaults_Description_PATH:
+			if nested {
+				return fmt.Errorf("TestDefaults: field %q has no subfields", name)
+			}
+			m.Description = src.Description
+		case TestDefaults_Age_PATH:
+			if nested {
+				return fmt.Errorf("TestDefaults: field %q has no subfields", name)
+			}
+			m.Age = src.Age
+		default:
+			return fmt.Errorf("TestDefaults: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *TestDefaults) Merge(src *TestDefaults) {
+	if src == nil {
+		return
+	}
+	if src.Message != "" {
+		m.Message = src.Message
+	}
+	if src.Count != 0 {
+		m.Count = src.Count
+	}
+	if src.Enabled {
+		m.Enabled = true
+	}
+	if src.Ratio != 0 {
+		m.Ratio = src.Ratio
+	}
+	if src.Description != "" {
+		m.Description = src.Description
+	}
+	if src.Age != 0 {
+		m.Age = src.Age
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *TestDefaults) ApplyFieldMask(src *TestDefaults, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *TestDefaults) MergeFieldMask(src *TestDefaults, mask *PuregenFieldMask) error {
+	masked := &TestDefaults{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *TestDefaults) Redacted() *TestDefaults {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *TestDefaults) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *TestDefaults) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("message", r.Message),
+		slog.Any("count", r.Count),
+		slog.Any("enabled", r.Enabled),
+		slog.Any("ratio", r.Ratio),
+		slog.Any("description", r.Description),
+		slog.Any("age", r.Age),
+	)
 }
 
 func (m *TestDefaults) Validate() error {
@@ -52,8 +325,194 @@ type NoDefaults struct {
 	Flag  bool   `json:"flag"`
 }
 
-func NewNoDefaults() *NoDefaults {
-	return &NoDefaults{}
+// NoDefaultsOption sets a field of a new NoDefaults
+type NoDefaultsOption func(*NoDefaults)
+
+// WithNoDefaultsName sets the Name field
+func WithNoDefaultsName(value string) NoDefaultsOption {
+	return func(m *NoDefaults) {
+		m.Name = value
+	}
+}
+
+// WithNoDefaultsValue sets the Value field
+func WithNoDefaultsValue(value int32) NoDefaultsOption {
+	return func(m *NoDefaults) {
+		m.Value = value
+	}
+}
+
+// WithNoDefaultsFlag sets the Flag field
+func WithNoDefaultsFlag(value bool) NoDefaultsOption {
+	return func(m *NoDefaults) {
+		m.Flag = value
+	}
+}
+
+func NewNoDefaults(opts ...NoDefaultsOption) *NoDefaults {
+	m := &NoDefaults{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetName returns the Name field, or its zero value if m is nil
+func (m *NoDefaults) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// GetValue returns the Value field, or its zero value if m is nil
+func (m *NoDefaults) GetValue() int32 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+// GetFlag returns the Flag field, or its zero value if m is nil
+func (m *NoDefaults) GetFlag() bool {
+	if m != nil {
+		return m.Flag
+	}
+	return false
+}
+
+// Clone returns a deep copy of m
+func (m *NoDefaults) Clone() *NoDefaults {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *NoDefaults) Equal(other *NoDefaults) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Value != other.Value {
+		return false
+	}
+	if m.Flag != other.Flag {
+		return false
+	}
+	return true
+}
+
+// Field paths of NoDefaults, for ApplyMask and field masks
+const (
+	NoDefaults_Name_PATH  = "name"
+	NoDefaults_Value_PATH = "value"
+	NoDefaults_Flag_PATH  = "flag"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewNoDefaults().
+func (m *NoDefaults) ApplyMask(src *NoDefaults, paths []string) error {
+	if src == nil {
+		src = NewNoDefaults()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case NoDefaults_Name_PATH:
+			if nested {
+				return fmt.Errorf("NoDefaults: field %q has no subfields", name)
+			}
+			m.Name = src.Name
+		case NoDefaults_Value_PATH:
+			if nested {
+				return fmt.Errorf("NoDefaults: field %q has no subfields", name)
+			}
+			m.Value = src.Value
+		case NoDefaults_Flag_PATH:
+			if nested {
+				return fmt.Errorf("NoDefaults: field %q has no subfields", name)
+			}
+			m.Flag = src.Flag
+		default:
+			return fmt.Errorf("NoDefaults: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *NoDefaults) Merge(src *NoDefaults) {
+	if src == nil {
+		return
+	}
+	if src.Name != "" {
+		m.Name = src.Name
+	}
+	if src.Value != 0 {
+		m.Value = src.Value
+	}
+	if src.Flag {
+		m.Flag = true
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *NoDefaults) ApplyFieldMask(src *NoDefaults, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *NoDefaults) MergeFieldMask(src *NoDefaults, mask *PuregenFieldMask) error {
+	masked := &NoDefaults{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *NoDefaults) Redacted() *NoDefaults {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *NoDefaults) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *NoDefaults) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("name", r.Name),
+		slog.Any("value", r.Value),
+		slog.Any("flag", r.Flag),
+	)
 }
 
 func (m *NoDefaults) Validate() error {
@@ -95,8 +554,88 @@ type EdgeCases struct {
 	SignedValue int32 `json:"signedValue"`
 }
 
-func NewEdgeCases() *EdgeCases {
-	return &EdgeCases{
+// EdgeCasesOption sets a field of a new EdgeCases
+type EdgeCasesOption func(*EdgeCases)
+
+// WithEdgeCasesSimpleString sets the SimpleString field
+func WithEdgeCasesSimpleString(value string) EdgeCasesOption {
+	return func(m *EdgeCases) {
+		m.SimpleString = value
+	}
+}
+
+// WithEdgeCasesEmptyString sets the EmptyString field
+func WithEdgeCasesEmptyString(value string) EdgeCasesOption {
+	return func(m *EdgeCases) {
+		m.EmptyString = value
+	}
+}
+
+// WithEdgeCasesZeroInt sets the ZeroInt field
+func WithEdgeCasesZeroInt(value int32) EdgeCasesOption {
+	return func(m *EdgeCases) {
+		m.ZeroInt = value
+	}
+}
+
+// WithEdgeCasesZeroFloat sets the ZeroFloat field
+func WithEdgeCasesZeroFloat(value float32) EdgeCasesOption {
+	return func(m *EdgeCases) {
+		m.ZeroFloat = value
+	}
+}
+
+// WithEdgeCasesFalseBool sets the FalseBool field
+func WithEdgeCasesFalseBool(value bool) EdgeCasesOption {
+	return func(m *EdgeCases) {
+		m.FalseBool = value
+	}
+}
+
+// WithEdgeCasesLargeInt sets the LargeInt field
+func WithEdgeCasesLargeInt(value int64) EdgeCasesOption {
+	return func(m *EdgeCases) {
+		m.LargeInt = value
+	}
+}
+
+// WithEdgeCasesNegativeInt sets the NegativeInt field
+func WithEdgeCasesNegativeInt(value int32) EdgeCasesOption {
+	return func(m *EdgeCases) {
+		m.NegativeInt = value
+	}
+}
+
+// WithEdgeCasesScientific sets the Scientific field
+func WithEdgeCasesScientific(value float64) EdgeCasesOption {
+	return func(m *EdgeCases) {
+		m.Scientific = value
+	}
+}
+
+// WithEdgeCasesNoDirective sets the NoDirective field
+func WithEdgeCasesNoDirective(value string) EdgeCasesOption {
+	return func(m *EdgeCases) {
+		m.NoDirective = value
+	}
+}
+
+// WithEdgeCasesUnsignedValue sets the UnsignedValue field
+func WithEdgeCasesUnsignedValue(value uint32) EdgeCasesOption {
+	return func(m *EdgeCases) {
+		m.UnsignedValue = value
+	}
+}
+
+// WithEdgeCasesSignedValue sets the SignedValue field
+func WithEdgeCasesSignedValue(value int32) EdgeCasesOption {
+	return func(m *EdgeCases) {
+		m.SignedValue = value
+	}
+}
+
+func NewEdgeCases(opts ...EdgeCasesOption) *EdgeCases {
+	m := &EdgeCases{
 		SimpleString:  "Hello World",
 		EmptyString:   "",
 		ZeroInt:       0,
@@ -108,6 +647,336 @@ func NewEdgeCases() *EdgeCases {
 		UnsignedValue: 255,
 		SignedValue:   2147483647,
 	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetSimpleString returns the SimpleString field, or its zero value if m is nil
+func (m *EdgeCases) GetSimpleString() string {
+	if m != nil {
+		return m.SimpleString
+	}
+	return ""
+}
+
+// GetEmptyString returns the EmptyString field, or its zero value if m is nil
+func (m *EdgeCases) GetEmptyString() string {
+	if m != nil {
+		return m.EmptyString
+	}
+	return ""
+}
+
+// GetZeroInt returns the ZeroInt field, or its zero value if m is nil
+func (m *EdgeCases) GetZeroInt() int32 {
+	if m != nil {
+		return m.ZeroInt
+	}
+	return 0
+}
+
+// GetZeroFloat returns the ZeroFloat field, or its zero value if m is nil
+func (m *EdgeCases) GetZeroFloat() float32 {
+	if m != nil {
+		return m.ZeroFloat
+	}
+	return 0
+}
+
+// GetFalseBool returns the FalseBool field, or its zero value if m is nil
+func (m *EdgeCases) GetFalseBool() bool {
+	if m != nil {
+		return m.FalseBool
+	}
+	return false
+}
+
+// GetLargeInt returns the LargeInt field, or its zero value if m is nil
+func (m *EdgeCases) GetLargeInt() int64 {
+	if m != nil {
+		return m.LargeInt
+	}
+	return 0
+}
+
+// GetNegativeInt returns the NegativeInt field, or its zero value if m is nil
+func (m *EdgeCases) GetNegativeInt() int32 {
+	if m != nil {
+		return m.NegativeInt
+	}
+	return 0
+}
+
+// GetScientific returns the Scientific field, or its zero value if m is nil
+func (m *EdgeCases) GetScientific() float64 {
+	if m != nil {
+		return m.Scientific
+	}
+	return 0
+}
+
+// GetNoDirective returns the NoDirective field, or its zero value if m is nil
+func (m *EdgeCases) GetNoDirective() string {
+	if m != nil {
+		return m.NoDirective
+	}
+	return ""
+}
+
+// GetUnsignedValue returns the UnsignedValue field, or its zero value if m is nil
+func (m *EdgeCases) GetUnsignedValue() uint32 {
+	if m != nil {
+		return m.UnsignedValue
+	}
+	return 0
+}
+
+// GetSignedValue returns the SignedValue field, or its zero value if m is nil
+func (m *EdgeCases) GetSignedValue() int32 {
+	if m != nil {
+		return m.SignedValue
+	}
+	return 0
+}
+
+// Clone returns a deep copy of m
+func (m *EdgeCases) Clone() *EdgeCases {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *EdgeCases) Equal(other *EdgeCases) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.SimpleString != other.SimpleString {
+		return false
+	}
+	if m.EmptyString != other.EmptyString {
+		return false
+	}
+	if m.ZeroInt != other.ZeroInt {
+		return false
+	}
+	if m.ZeroFloat != other.ZeroFloat {
+		return false
+	}
+	if m.FalseBool != other.FalseBool {
+		return false
+	}
+	if m.LargeInt != other.LargeInt {
+		return false
+	}
+	if m.NegativeInt != other.NegativeInt {
+		return false
+	}
+	if m.Scientific != other.Scientific {
+		return false
+	}
+	if m.NoDirective != other.NoDirective {
+		return false
+	}
+	if m.UnsignedValue != other.UnsignedValue {
+		return false
+	}
+	if m.SignedValue != other.SignedValue {
+		return false
+	}
+	return true
+}
+
+// Field paths of EdgeCases, for ApplyMask and field masks
+const (
+	EdgeCases_SimpleString_PATH  = "simple_string"
+	EdgeCases_EmptyString_PATH   = "empty_string"
+	EdgeCases_ZeroInt_PATH       = "zero_int"
+	EdgeCases_ZeroFloat_PATH     = "zero_float"
+	EdgeCases_FalseBool_PATH     = "false_bool"
+	EdgeCases_LargeInt_PATH      = "large_int"
+	EdgeCases_NegativeInt_PATH   = "negative_int"
+	EdgeCases_Scientific_PATH    = "scientific"
+	EdgeCases_NoDirective_PATH   = "no_directive"
+	EdgeCases_UnsignedValue_PATH = "unsigned_value"
+	EdgeCases_SignedValue_PATH   = "signed_value"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewEdgeCases().
+func (m *EdgeCases) ApplyMask(src *EdgeCases, paths []string) error {
+	if src == nil {
+		src = NewEdgeCases()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case EdgeCases_SimpleString_PATH, "simpleString":
+			if nested {
+				return fmt.Errorf("EdgeCases: field %q has no subfields", name)
+			}
+			m.SimpleString = src.SimpleString
+		case EdgeCases_EmptyString_PATH, "emptyString":
+			if nested {
+				return fmt.Errorf("EdgeCases: field %q has no subfields", name)
+			}
+			m.EmptyString = src.EmptyString
+		case EdgeCases_ZeroInt_PATH, "zeroInt":
+			if nested {
+				return fmt.Errorf("EdgeCases: field %q has no subfields", name)
+			}
+			m.ZeroInt = src.ZeroInt
+		case EdgeCases_ZeroFloat_PATH, "zeroFloat":
+			if nested {
+				return fmt.Errorf("EdgeCases: field %q has no subfields", name)
+			}
+			m.ZeroFloat = src.ZeroFloat
+		case EdgeCases_FalseBool_PATH, "falseBool":
+			if nested {
+				return fmt.Errorf("EdgeCases: field %q has no subfields", name)
+			}
+			m.FalseBool = src.FalseBool
+		case EdgeCases_LargeInt_PATH, "largeInt":
+			if nested {
+				return fmt.Errorf("EdgeCases: field %q has no subfields", name)
+			}
+			m.LargeInt = src.LargeInt
+		case EdgeCases_NegativeInt_PATH, "negativeInt":
+			if nested {
+				return fmt.Errorf("EdgeCases: field %q has no subfields", name)
+			}
+			m.NegativeInt = src.NegativeInt
+		case EdgeCases_Scientific_PATH:
+			if nested {
+				return fmt.Errorf("EdgeCases: field %q has no subfields", name)
+			}
+			m.Scientific = src.Scientific
+		case EdgeCases_NoDirective_PATH, "noDirective":
+			if nested {
+				return fmt.Errorf("EdgeCases: field %q has no subfields", name)
+			}
+			m.NoDirective = src.NoDirective
+		case EdgeCases_UnsignedValue_PATH, "unsignedValue":
+			if nested {
+				return fmt.Errorf("EdgeCases: field %q has no subfields", name)
+			}
+			m.UnsignedValue = src.UnsignedValue
+		case EdgeCases_SignedValue_PATH, "signedValue":
+			if nested {
+				return fmt.Errorf("EdgeCases: field %q has no subfields", name)
+			}
+			m.SignedValue = src.SignedValue
+		default:
+			return fmt.Errorf("EdgeCases: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *EdgeCases) Merge(src *EdgeCases) {
+	if src == nil {
+		return
+	}
+	if src.SimpleString != "" {
+		m.SimpleString = src.SimpleString
+	}
+	if src.EmptyString != "" {
+		m.EmptyString = src.EmptyString
+	}
+	if src.ZeroInt != 0 {
+		m.ZeroInt = src.ZeroInt
+	}
+	if src.ZeroFloat != 0 {
+		m.ZeroFloat = src.ZeroFloat
+	}
+	if src.FalseBool {
+		m.FalseBool = true
+	}
+	if src.LargeInt != 0 {
+		m.LargeInt = src.LargeInt
+	}
+	if src.NegativeInt != 0 {
+		m.NegativeInt = src.NegativeInt
+	}
+	if src.Scientific != 0 {
+		m.Scientific = src.Scientific
+	}
+	if src.NoDirective != "" {
+		m.NoDirective = src.NoDirective
+	}
+	if src.UnsignedValue != 0 {
+		m.UnsignedValue = src.UnsignedValue
+	}
+	if src.SignedValue != 0 {
+		m.SignedValue = src.SignedValue
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *EdgeCases) ApplyFieldMask(src *EdgeCases, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *EdgeCases) MergeFieldMask(src *EdgeCases, mask *PuregenFieldMask) error {
+	masked := &EdgeCases{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *EdgeCases) Redacted() *EdgeCases {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *EdgeCases) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *EdgeCases) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("simpleString", r.SimpleString),
+		slog.Any("emptyString", r.EmptyString),
+		slog.Any("zeroInt", r.ZeroInt),
+		slog.Any("zeroFloat", r.ZeroFloat),
+		slog.Any("falseBool", r.FalseBool),
+		slog.Any("largeInt", r.LargeInt),
+		slog.Any("negativeInt", r.NegativeInt),
+		slog.Any("scientific", r.Scientific),
+		slog.Any("noDirective", r.NoDirective),
+		slog.Any("unsignedValue", r.UnsignedValue),
+		slog.Any("signedValue", r.SignedValue),
+	)
 }
 
 func (m *EdgeCases) Validate() error {
@@ -122,3 +991,250 @@ func (m *EdgeCases) ToJSON() ([]byte, error) {
 func (m *EdgeCases) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
+
+// UnmarshalJSON accepts both the proto field names and the JSON names of the fields
+func (m *EdgeCases) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for alias, name := range map[string]string{
+		"simple_string":  "simpleString",
+		"empty_string":   "emptyString",
+		"zero_int":       "zeroInt",
+		"zero_float":     "zeroFloat",
+		"false_bool":     "falseBool",
+		"large_int":      "largeInt",
+		"negative_int":   "negativeInt",
+		"no_directive":   "noDirective",
+		"unsigned_value": "unsignedValue",
+		"signed_value":   "signedValue",
+	} {
+		if value, ok := fields[alias]; ok {
+			delete(fields, alias)
+			if _, ok := fields[name]; !ok {
+				fields[name] = value
+			}
+		}
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	// plain has the fields of EdgeCases but not this method
+	type plain EdgeCases
+	return json.Unmarshal(data, (*plain)(m))
+}
+
+// Descriptors
+
+// TestDefaultsDescriptor describes TestDefaults and its fields
+var TestDefaultsDescriptor = &PuregenMessageDescriptor{
+	FullName: "test.defaults.TestDefaults",
+	Name:     "TestDefaults",
+	GoName:   "TestDefaults",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "message",
+			GoName:   "Message",
+			JSONName: "message",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "count",
+			GoName:   "Count",
+			JSONName: "count",
+			Number:   2,
+			Kind:     "int32",
+		},
+		{
+			Name:     "enabled",
+			GoName:   "Enabled",
+			JSONName: "enabled",
+			Number:   3,
+			Kind:     "bool",
+		},
+		{
+			Name:     "ratio",
+			GoName:   "Ratio",
+			JSONName: "ratio",
+			Number:   4,
+			Kind:     "float",
+		},
+		{
+			Name:     "description",
+			GoName:   "Description",
+			JSONName: "description",
+			Number:   5,
+			Kind:     "string",
+		},
+		{
+			Name:     "age",
+			GoName:   "Age",
+			JSONName: "age",
+			Number:   6,
+			Kind:     "int32",
+		},
+	},
+}
+
+// Descriptor returns TestDefaultsDescriptor
+func (*TestDefaults) Descriptor() *PuregenMessageDescriptor {
+	return TestDefaultsDescriptor
+}
+
+// NoDefaultsDescriptor describes NoDefaults and its fields
+var NoDefaultsDescriptor = &PuregenMessageDescriptor{
+	FullName: "test.defaults.NoDefaults",
+	Name:     "NoDefaults",
+	GoName:   "NoDefaults",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "name",
+			GoName:   "Name",
+			JSONName: "name",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "value",
+			GoName:   "Value",
+			JSONName: "value",
+			Number:   2,
+			Kind:     "int32",
+		},
+		{
+			Name:     "flag",
+			GoName:   "Flag",
+			JSONName: "flag",
+			Number:   3,
+			Kind:     "bool",
+		},
+	},
+}
+
+// Descriptor returns NoDefaultsDescriptor
+func (*NoDefaults) Descriptor() *PuregenMessageDescriptor {
+	return NoDefaultsDescriptor
+}
+
+// EdgeCasesDescriptor describes EdgeCases and its fields
+var EdgeCasesDescriptor = &PuregenMessageDescriptor{
+	FullName: "test.defaults.EdgeCases",
+	Name:     "EdgeCases",
+	GoName:   "EdgeCases",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "simple_string",
+			GoName:   "SimpleString",
+			JSONName: "simpleString",
+			Number:   1,
+			Kind:     "string",
+		},
+		{
+			Name:     "empty_string",
+			GoName:   "EmptyString",
+			JSONName: "emptyString",
+			Number:   2,
+			Kind:     "string",
+		},
+		{
+			Name:     "zero_int",
+			GoName:   "ZeroInt",
+			JSONName: "zeroInt",
+			Number:   3,
+			Kind:     "int32",
+		},
+		{
+			Name:     "zero_float",
+			GoName:   "ZeroFloat",
+			JSONName: "zeroFloat",
+			Number:   4,
+			Kind:     "float",
+		},
+		{
+			Name:     "false_bool",
+			GoName:   "FalseBool",
+			JSONName: "falseBool",
+			Number:   5,
+			Kind:     "bool",
+		},
+		{
+			Name:     "large_int",
+			GoName:   "LargeInt",
+			JSONName: "largeInt",
+			Number:   6,
+			Kind:     "int64",
+		},
+		{
+			Name:     "negative_int",
+			GoName:   "NegativeInt",
+			JSONName: "negativeInt",
+			Number:   7,
+			Kind:     "int32",
+		},
+		{
+			Name:     "scientific",
+			GoName:   "Scientific",
+			JSONName: "scientific",
+			Number:   8,
+			Kind:     "double",
+		},
+		{
+			Name:     "no_directive",
+			GoName:   "NoDirective",
+			JSONName: "noDirective",
+			Number:   9,
+			Kind:     "string",
+		},
+		{
+			Name:     "unsigned_value",
+			GoName:   "UnsignedValue",
+			JSONName: "unsignedValue",
+			Number:   10,
+			Kind:     "uint32",
+		},
+		{
+			Name:     "signed_value",
+			GoName:   "SignedValue",
+			JSONName: "signedValue",
+			Number:   11,
+			Kind:     "sint32",
+		},
+	},
+}
+
+// Descriptor returns EdgeCasesDescriptor
+func (*EdgeCases) Descriptor() *PuregenMessageDescriptor {
+	return EdgeCasesDescriptor
+}
+
+func init() {
+	registerMessageDescriptors(TestDefaultsDescriptor, NoDefaultsDescriptor, EdgeCasesDescriptor)
+}
+
+// Message types
+
+// ProtoName returns the full proto name of TestDefaults
+func (*TestDefaults) ProtoName() string {
+	return "test.defaults.TestDefaults"
+}
+
+// ProtoName returns the full proto name of NoDefaults
+func (*NoDefaults) ProtoName() string {
+	return "test.defaults.NoDefaults"
+}
+
+// ProtoName returns the full proto name of EdgeCases
+func (*EdgeCases) ProtoName() string {
+	return "test.defaults.EdgeCases"
+}
+
+func init() {
+	registerMessageTypes(map[string]func() PuregenMessage{
+		"test.defaults.TestDefaults": func() PuregenMessage { return NewTestDefaults() },
+		"test.defaults.NoDefaults":   func() PuregenMessage { return NewNoDefaults() },
+		"test.defaults.EdgeCases":    func() PuregenMessage { return NewEdgeCases() },
+	})
+}
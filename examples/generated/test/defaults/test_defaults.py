@@ -1,9 +1,21 @@
 # Code generated by protoc-gen-puregen. DO NOT EDIT.
 
 from dataclasses import dataclass, field
-from typing import Optional, List, Dict, Any
+from typing import Optional, List, Dict, Any, ClassVar, Final, Literal, Union
 from abc import ABC, abstractmethod
+import base64
+import copy
 import json
+import sys
+import warnings
+from .puregen_descriptors import PuregenFieldDescriptor, PuregenMessageDescriptor, register_message_descriptors
+from .puregen_registry import PuregenEnvelope, register_message_types
+from .puregen_fieldmask import PuregenFieldMask
+
+if sys.version_info >= (3, 11):
+    from typing import Self
+else:
+    from typing_extensions import Self
 
 # Messages
 
@@ -11,6 +23,16 @@ import json
 @dataclass
 class TestDefaults:
     """Generated message class for TestDefaults"""
+    class Paths:
+        """Field paths of TestDefaults, for apply_mask and field masks"""
+        MESSAGE = "message"
+        COUNT = "count"
+        ENABLED = "enabled"
+        RATIO = "ratio"
+        DESCRIPTION = "description"
+        AGE = "age"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # String field with default value
     message: str = "hello world"
     # Integer field with default value
@@ -29,13 +51,83 @@ class TestDefaults:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a TestDefaults with every field at its zero value, unlike
+        TestDefaults(), which applies the field defaults."""
+        return cls(message="", count=0, enabled=False, ratio=0.0, description="", age=0)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new TestDefaults."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == TestDefaults.Paths.MESSAGE:
+                if dot:
+                    raise ValueError(f"TestDefaults: field {name!r} has no subfields")
+                self.message = source.message
+            elif name == TestDefaults.Paths.COUNT:
+                if dot:
+                    raise ValueError(f"TestDefaults: field {name!r} has no subfields")
+                self.count = source.count
+            elif name == TestDefaults.Paths.ENABLED:
+                if dot:
+                    raise ValueError(f"TestDefaults: field {name!r} has no subfields")
+                self.enabled = source.enabled
+            elif name == TestDefaults.Paths.RATIO:
+                if dot:
+                    raise ValueError(f"TestDefaults: field {name!r} has no subfields")
+                self.ratio = source.ratio
+            elif name == TestDefaults.Paths.DESCRIPTION:
+                if dot:
+                    raise ValueError(f"TestDefaults: field {name!r} has no subfields")
+                self.description = source.description
+            elif name == TestDefaults.Paths.AGE:
+                if dot:
+                    raise ValueError(f"TestDefaults: field {name!r} has no subfields")
+                self.age = source.age
+            else:
+                raise ValueError(f"TestDefaults: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.message:
+            self.message = src.message
+        if src.count:
+            self.count = src.count
+        if src.enabled:
+            self.enabled = src.enabled
+        if src.ratio:
+            self.ratio = src.ratio
+        if src.description:
+            self.description = src.description
+        if src.age:
+            self.age = src.age
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.message is not None:
             result['message'] = self.message
         if self.count is not None:
@@ -51,15 +143,15 @@ class TestDefaults:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'TestDefaults':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'TestDefaults':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'message' in data:
             kwargs['message'] = data['message']
         if 'count' in data:
@@ -78,6 +170,13 @@ class TestDefaults:
 @dataclass
 class NoDefaults:
     """Generated message class for NoDefaults"""
+    class Paths:
+        """Field paths of NoDefaults, for apply_mask and field masks"""
+        NAME = "name"
+        VALUE = "value"
+        FLAG = "flag"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     name: str = ""
     value: int = 0
     flag: bool = False
@@ -87,13 +186,65 @@ class NoDefaults:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a NoDefaults with every field at its zero value, unlike
+        NoDefaults(), which applies the field defaults."""
+        return cls(name="", value=0, flag=False)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new NoDefaults."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == NoDefaults.Paths.NAME:
+                if dot:
+                    raise ValueError(f"NoDefaults: field {name!r} has no subfields")
+                self.name = source.name
+            elif name == NoDefaults.Paths.VALUE:
+                if dot:
+                    raise ValueError(f"NoDefaults: field {name!r} has no subfields")
+                self.value = source.value
+            elif name == NoDefaults.Paths.FLAG:
+                if dot:
+                    raise ValueError(f"NoDefaults: field {name!r} has no subfields")
+                self.flag = source.flag
+            else:
+                raise ValueError(f"NoDefaults: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.name:
+            self.name = src.name
+        if src.value:
+            self.value = src.value
+        if src.flag:
+            self.flag = src.flag
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.name is not None:
             result['name'] = self.name
         if self.value is not None:
@@ -103,15 +254,15 @@ class NoDefaults:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'NoDefaults':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'NoDefaults':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
         if 'name' in data:
             kwargs['name'] = data['name']
         if 'value' in data:
@@ -124,6 +275,21 @@ class NoDefaults:
 @dataclass
 class EdgeCases:
     """Generated message class for EdgeCases"""
+    class Paths:
+        """Field paths of EdgeCases, for apply_mask and field masks"""
+        SIMPLE_STRING = "simple_string"
+        EMPTY_STRING = "empty_string"
+        ZERO_INT = "zero_int"
+        ZERO_FLOAT = "zero_float"
+        FALSE_BOOL = "false_bool"
+        LARGE_INT = "large_int"
+        NEGATIVE_INT = "negative_int"
+        SCIENTIFIC = "scientific"
+        NO_DIRECTIVE = "no_directive"
+        UNSIGNED_VALUE = "unsigned_value"
+        SIGNED_VALUE = "signed_value"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     # String with simple text
     simple_string: str = "Hello World"
     # Empty string default
@@ -152,13 +318,113 @@ class EdgeCases:
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a EdgeCases with every field at its zero value, unlike
+        EdgeCases(), which applies the field defaults."""
+        return cls(simple_string="", empty_string="", zero_int=0, zero_float=0.0, false_bool=False, large_int=0, negative_int=0, scientific=0.0, no_directive="", unsigned_value=0, signed_value=0)
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new EdgeCases."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name in (EdgeCases.Paths.SIMPLE_STRING, "simpleString"):
+                if dot:
+                    raise ValueError(f"EdgeCases: field {name!r} has no subfields")
+                self.simple_string = source.simple_string
+            elif name in (EdgeCases.Paths.EMPTY_STRING, "emptyString"):
+                if dot:
+                    raise ValueError(f"EdgeCases: field {name!r} has no subfields")
+                self.empty_string = source.empty_string
+            elif name in (EdgeCases.Paths.ZERO_INT, "zeroInt"):
+                if dot:
+                    raise ValueError(f"EdgeCases: field {name!r} has no subfields")
+                self.zero_int = source.zero_int
+            elif name in (EdgeCases.Paths.ZERO_FLOAT, "zeroFloat"):
+                if dot:
+                    raise ValueError(f"EdgeCases: field {name!r} has no subfields")
+                self.zero_float = source.zero_float
+            elif name in (EdgeCases.Paths.FALSE_BOOL, "falseBool"):
+                if dot:
+                    raise ValueError(f"EdgeCases: field {name!r} has no subfields")
+                self.false_bool = source.false_bool
+            elif name in (EdgeCases.Paths.LARGE_INT, "largeInt"):
+                if dot:
+                    raise ValueError(f"EdgeCases: field {name!r} has no subfields")
+                self.large_int = source.large_int
+            elif name in (EdgeCases.Paths.NEGATIVE_INT, "negativeInt"):
+                if dot:
+                    raise ValueError(f"EdgeCases: field {name!r} has no subfields")
+                self.negative_int = source.negative_int
+            elif name == EdgeCases.Paths.SCIENTIFIC:
+                if dot:
+                    raise ValueError(f"EdgeCases: field {name!r} has no subfields")
+                self.scientific = source.scientific
+            elif name in (EdgeCases.Paths.NO_DIRECTIVE, "noDirective"):
+                if dot:
+                    raise ValueError(f"EdgeCases: field {name!r} has no subfields")
+                self.no_directive = source.no_directive
+            elif name in (EdgeCases.Paths.UNSIGNED_VALUE, "unsignedValue"):
+                if dot:
+                    raise ValueError(f"EdgeCases: field {name!r} has no subfields")
+                self.unsigned_value = source.unsigned_value
+            elif name in (EdgeCases.Paths.SIGNED_VALUE, "signedValue"):
+                if dot:
+                    raise ValueError(f"EdgeCases: field {name!r} has no subfields")
+                self.signed_value = source.signed_value
+            else:
+                raise ValueError(f"EdgeCases: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.simple_string:
+            self.simple_string = src.simple_string
+        if src.empty_string:
+            self.empty_string = src.empty_string
+        if src.zero_int:
+            self.zero_int = src.zero_int
+        if src.zero_float:
+            self.zero_float = src.zero_float
+        if src.false_bool:
+            self.false_bool = src.false_bool
+        if src.large_int:
+            self.large_int = src.large_int
+        if src.negative_int:
+            self.negative_int = src.negative_int
+        if src.scientific:
+            self.scientific = src.scientific
+        if src.no_directive:
+            self.no_directive = src.no_directive
+        if src.unsigned_value:
+            self.unsigned_value = src.unsigned_value
+        if src.signed_value:
+            self.signed_value = src.signed_value
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.simple_string is not None:
             result['simpleString'] = self.simple_string
         if self.empty_string is not None:
@@ -184,15 +450,19 @@ class EdgeCases:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'EdgeCases':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'EdgeCases':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
+        kwargs: Dict[str, Any] = {}
+        data = dict(data)
+        for alias, name in [("simple_string", "simpleString"), ("empty_string", "emptyString"), ("zero_int", "zeroInt"), ("zero_float", "zeroFloat"), ("false_bool", "falseBool"), ("large_int", "largeInt"), ("negative_int", "negativeInt"), ("no_directive", "noDirective"), ("unsigned_value", "unsignedValue"), ("signed_value", "signedValue")]:
+            if alias in data:
+                data.setdefault(name, data.pop(alias))
         if 'simpleString' in data:
             kwargs['simple_string'] = data['simpleString']
         if 'emptyString' in data:
@@ -217,3 +487,63 @@ class EdgeCases:
             kwargs['signed_value'] = data['signedValue']
         return cls(**kwargs)
 
+# Descriptors
+
+TestDefaults.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="test.defaults.TestDefaults",
+    name="TestDefaults",
+    python_name="TestDefaults",
+    fields=[
+        PuregenFieldDescriptor(name="message", python_name="message", json_name="message", number=1, kind="string"),
+        PuregenFieldDescriptor(name="count", python_name="count", json_name="count", number=2, kind="int32"),
+        PuregenFieldDescriptor(name="enabled", python_name="enabled", json_name="enabled", number=3, kind="bool"),
+        PuregenFieldDescriptor(name="ratio", python_name="ratio", json_name="ratio", number=4, kind="float"),
+        PuregenFieldDescriptor(name="description", python_name="description", json_name="description", number=5, kind="string"),
+        PuregenFieldDescriptor(name="age", python_name="age", json_name="age", number=6, kind="int32"),
+    ],
+)
+
+NoDefaults.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="test.defaults.NoDefaults",
+    name="NoDefaults",
+    python_name="NoDefaults",
+    fields=[
+        PuregenFieldDescriptor(name="name", python_name="name", json_name="name", number=1, kind="string"),
+        PuregenFieldDescriptor(name="value", python_name="value", json_name="value", number=2, kind="int32"),
+        PuregenFieldDescriptor(name="flag", python_name="flag", json_name="flag", number=3, kind="bool"),
+    ],
+)
+
+EdgeCases.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="test.defaults.EdgeCases",
+    name="EdgeCases",
+    python_name="EdgeCases",
+    fields=[
+        PuregenFieldDescriptor(name="simple_string", python_name="simple_string", json_name="simpleString", number=1, kind="string"),
+        PuregenFieldDescriptor(name="empty_string", python_name="empty_string", json_name="emptyString", number=2, kind="string"),
+        PuregenFieldDescriptor(name="zero_int", python_name="zero_int", json_name="zeroInt", number=3, kind="int32"),
+        PuregenFieldDescriptor(name="zero_float", python_name="zero_float", json_name="zeroFloat", number=4, kind="float"),
+        PuregenFieldDescriptor(name="false_bool", python_name="false_bool", json_name="falseBool", number=5, kind="bool"),
+        PuregenFieldDescriptor(name="large_int", python_name="large_int", json_name="largeInt", number=6, kind="int64"),
+        PuregenFieldDescriptor(name="negative_int", python_name="negative_int", json_name="negativeInt", number=7, kind="int32"),
+        PuregenFieldDescriptor(name="scientific", python_name="scientific", json_name="scientific", number=8, kind="double"),
+        PuregenFieldDescriptor(name="no_directive", python_name="no_directive", json_name="noDirective", number=9, kind="string"),
+        PuregenFieldDescriptor(name="unsigned_value", python_name="unsigned_value", json_name="unsignedValue", number=10, kind="uint32"),
+        PuregenFieldDescriptor(name="signed_value", python_name="signed_value", json_name="signedValue", number=11, kind="sint32"),
+    ],
+)
+
+register_message_descriptors(
+    TestDefaults.DESCRIPTOR,
+    NoDefaults.DESCRIPTOR,
+    EdgeCases.DESCRIPTOR,
+)
+
+# Message types
+
+register_message_types({
+    "test.defaults.TestDefaults": TestDefaults,
+    "test.defaults.NoDefaults": NoDefaults,
+    "test.defaults.EdgeCases": EdgeCases,
+})
+
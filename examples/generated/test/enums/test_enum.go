@@ -3,8 +3,10 @@
 package enums
 
 import (
-	"encoding/json"
-	"fmt"
+	json "encoding/json"
+	fmt "fmt"
+	slog "log/slog"
+	strings "strings"
 )
 
 // Enums
@@ -13,8 +15,8 @@ type Status int32
 
 const (
 	Status_STATUS_UNKNOWN  Status = 0
-	Status_STATUS_ACTIVE          = 1
-	Status_STATUS_INACTIVE        = 2
+	Status_STATUS_ACTIVE   Status = 1
+	Status_STATUS_INACTIVE Status = 2
 )
 
 var Status_name = map[int32]string{
@@ -48,6 +50,29 @@ func (x Status) IsValid() bool {
 	return ok
 }
 
+// UnmarshalJSON accepts a Status as its number or its name. Unknown
+// numbers are kept as is; IsValid reports them.
+func (x *Status) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var number int32
+	if err := json.Unmarshal(data, &number); err == nil {
+		*x = Status(number)
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return fmt.Errorf("invalid Status value: %s", data)
+	}
+	value, err := ParseStatus(name)
+	if err != nil {
+		return err
+	}
+	*x = value
+	return nil
+}
+
 // Priority enum values as string constants
 const (
 	Priority_PRIORITY_LOW    = "PRIORITY_LOW"
@@ -77,8 +102,166 @@ type TestMessage struct {
 	Priority string `json:"priority"`
 }
 
-func NewTestMessage() *TestMessage {
-	return &TestMessage{}
+// TestMessageOption sets a field of a new TestMessage
+type TestMessageOption func(*TestMessage)
+
+// WithTestMessageStatus sets the Status field
+func WithTestMessageStatus(value Status) TestMessageOption {
+	return func(m *TestMessage) {
+		m.Status = value
+	}
+}
+
+// WithTestMessagePriority sets the Priority field
+func WithTestMessagePriority(value string) TestMessageOption {
+	return func(m *TestMessage) {
+		m.Priority = value
+	}
+}
+
+func NewTestMessage(opts ...TestMessageOption) *TestMessage {
+	m := &TestMessage{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// GetStatus returns the Status field, or its zero value if m is nil
+func (m *TestMessage) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+// GetPriority returns the Priority field, or its zero value if m is nil
+func (m *TestMessage) GetPriority() string {
+	if m != nil {
+		return m.Priority
+	}
+	return ""
+}
+
+// Clone returns a deep copy of m
+func (m *TestMessage) Clone() *TestMessage {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	return &c
+}
+
+// Equal reports whether m and other hold the same field values. Nil and
+// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.
+func (m *TestMessage) Equal(other *TestMessage) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Status != other.Status {
+		return false
+	}
+	if m.Priority != other.Priority {
+		return false
+	}
+	return true
+}
+
+// Field paths of TestMessage, for ApplyMask and field masks
+const (
+	TestMessage_Status_PATH   = "status"
+	TestMessage_Priority_PATH = "priority"
+)
+
+// ApplyMask copies the fields named by paths from src into m. Paths are proto
+// (or JSON) field names and may select nested fields, e.g. "profile.bio".
+// A nil src counts as NewTestMessage().
+func (m *TestMessage) ApplyMask(src *TestMessage, paths []string) error {
+	if src == nil {
+		src = NewTestMessage()
+	}
+	for _, path := range paths {
+		name, _, nested := strings.Cut(path, ".")
+		switch name {
+		case TestMessage_Status_PATH:
+			if nested {
+				return fmt.Errorf("TestMessage: field %q has no subfields", name)
+			}
+			m.Status = src.Status
+		case TestMessage_Priority_PATH:
+			if nested {
+				return fmt.Errorf("TestMessage: field %q has no subfields", name)
+			}
+			m.Priority = src.Priority
+		default:
+			return fmt.Errorf("TestMessage: unknown field path %q", path)
+		}
+	}
+	return nil
+}
+
+// Merge merges src into m as proto.Merge does: non-zero scalars and bytes
+// overwrite, lists are appended, map entries are replaced and messages are
+// merged recursively
+func (m *TestMessage) Merge(src *TestMessage) {
+	if src == nil {
+		return
+	}
+	if src.Status != 0 {
+		m.Status = src.Status
+	}
+	if src.Priority != "" {
+		m.Priority = src.Priority
+	}
+}
+
+// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask
+// does with its paths
+func (m *TestMessage) ApplyFieldMask(src *TestMessage, mask *PuregenFieldMask) error {
+	return m.ApplyMask(src, mask.GetPaths())
+}
+
+// MergeFieldMask merges the fields named by mask from src into m: the fields
+// of src outside the mask are left out, the others are merged as Merge does
+func (m *TestMessage) MergeFieldMask(src *TestMessage, mask *PuregenFieldMask) error {
+	masked := &TestMessage{}
+	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {
+		return err
+	}
+	m.Merge(masked)
+	return nil
+}
+
+// Redacted returns a deep copy of m that is safe to log: sensitive strings
+// that are set become "[REDACTED]" and other sensitive fields are
+// cleared, in m and in every nested message
+func (m *TestMessage) Redacted() *TestMessage {
+	if m == nil {
+		return nil
+	}
+	r := m.Clone()
+	return r
+}
+
+// String renders m with sensitive fields redacted
+func (m *TestMessage) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%+v", *m.Redacted())
+}
+
+// LogValue implements slog.LogValuer, logging m as a group keyed by JSON
+// field names with sensitive fields redacted
+func (m *TestMessage) LogValue() slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	r := m.Redacted()
+	return slog.GroupValue(
+		slog.Any("status", r.Status),
+		slog.Any("priority", r.Priority),
+	)
 }
 
 func (m *TestMessage) Validate() error {
@@ -93,3 +276,52 @@ func (m *TestMessage) ToJSON() ([]byte, error) {
 func (m *TestMessage) FromJSON(data []byte) error {
 	return json.Unmarshal(data, m)
 }
+
+// Descriptors
+
+// TestMessageDescriptor describes TestMessage and its fields
+var TestMessageDescriptor = &PuregenMessageDescriptor{
+	FullName: "test.enums.TestMessage",
+	Name:     "TestMessage",
+	GoName:   "TestMessage",
+	Fields: []*PuregenFieldDescriptor{
+		{
+			Name:     "status",
+			GoName:   "Status",
+			JSONName: "status",
+			Number:   1,
+			Kind:     "enum",
+			TypeName: "test.enums.Status",
+		},
+		{
+			Name:     "priority",
+			GoName:   "Priority",
+			JSONName: "priority",
+			Number:   2,
+			Kind:     "enum",
+			TypeName: "test.enums.Priority",
+		},
+	},
+}
+
+// Descriptor returns TestMessageDescriptor
+func (*TestMessage) Descriptor() *PuregenMessageDescriptor {
+	return TestMessageDescriptor
+}
+
+func init() {
+	registerMessageDescriptors(TestMessageDescriptor)
+}
+
+// Message types
+
+// ProtoName returns the full proto name of TestMessage
+func (*TestMessage) ProtoName() string {
+	return "test.enums.TestMessage"
+}
+
+func init() {
+	registerMessageTypes(map[string]func() PuregenMessage{
+		"test.enums.TestMessage": func() PuregenMessage { return NewTestMessage() },
+	})
+}
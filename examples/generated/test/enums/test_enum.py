@@ -1,10 +1,22 @@
 # Code generated by protoc-gen-puregen. DO NOT EDIT.
 
 from dataclasses import dataclass, field
-from typing import Optional, List, Dict, Any
+from typing import Optional, List, Dict, Any, ClassVar, Final, Literal, Union
 from abc import ABC, abstractmethod
+import base64
+import copy
 import json
+import sys
+import warnings
 from enum import IntEnum
+from .puregen_descriptors import PuregenFieldDescriptor, PuregenMessageDescriptor, register_message_descriptors
+from .puregen_registry import PuregenEnvelope, register_message_types
+from .puregen_fieldmask import PuregenFieldMask
+
+if sys.version_info >= (3, 11):
+    from typing import Self
+else:
+    from typing_extensions import Self
 
 # Enums
 
@@ -15,6 +27,18 @@ class Status(IntEnum):
     STATUS_ACTIVE = 1
     STATUS_INACTIVE = 2
 
+    @classmethod
+    def _missing_(cls, value: object) -> Optional['Status']:
+        """Look up names, and keep numbers without a value as UNRECOGNIZED members"""
+        if isinstance(value, str):
+            return cls.__members__.get(value)
+        if isinstance(value, int):
+            member = int.__new__(cls, value)
+            member._name_ = "UNRECOGNIZED"
+            member._value_ = value
+            return member
+        return None
+
     @classmethod
     def is_valid(cls, value: int) -> bool:
         """Check if value is a valid Status"""
@@ -23,11 +47,11 @@ class Status(IntEnum):
 # Default enum that should be generated as string constants
 class Priority:
     """Priority enum values as string constants"""
-    PRIORITY_LOW = "PRIORITY_LOW"
-    PRIORITY_MEDIUM = "PRIORITY_MEDIUM"
-    PRIORITY_HIGH = "PRIORITY_HIGH"
+    PRIORITY_LOW: Final = "PRIORITY_LOW"
+    PRIORITY_MEDIUM: Final = "PRIORITY_MEDIUM"
+    PRIORITY_HIGH: Final = "PRIORITY_HIGH"
 
-    VALUES = [
+    VALUES: Final[List[str]] = [
         PRIORITY_LOW,
         PRIORITY_MEDIUM,
         PRIORITY_HIGH,
@@ -43,21 +67,73 @@ class Priority:
 @dataclass
 class TestMessage:
     """Generated message class for TestMessage"""
+    class Paths:
+        """Field paths of TestMessage, for apply_mask and field masks"""
+        STATUS = "status"
+        PRIORITY = "priority"
+
+    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]
     status: int = 0
-    priority: str = 0
+    priority: Literal["PRIORITY_LOW", "PRIORITY_MEDIUM", "PRIORITY_HIGH"] = "PRIORITY_LOW"
 
     def validate(self) -> bool:
         """Validate the message fields"""
         # Add custom validation logic here
         return True
 
+    @classmethod
+    def zero_value(cls) -> Self:
+        """Return a TestMessage with every field at its zero value, unlike
+        TestMessage(), which applies the field defaults."""
+        return cls(status=0, priority="PRIORITY_LOW")
+
+    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:
+        """Copy the fields named by paths, or by a field mask, from src. Paths are
+        proto (or JSON) field names and may select nested fields, e.g.
+        "profile.bio". A None src counts as a new TestMessage."""
+        if isinstance(paths, PuregenFieldMask):
+            paths = paths.paths
+        source = src if src is not None else type(self)()
+        for path in paths:
+            name, dot, rest = path.partition('.')
+            if name == TestMessage.Paths.STATUS:
+                if dot:
+                    raise ValueError(f"TestMessage: field {name!r} has no subfields")
+                self.status = source.status
+            elif name == TestMessage.Paths.PRIORITY:
+                if dot:
+                    raise ValueError(f"TestMessage: field {name!r} has no subfields")
+                self.priority = source.priority
+            else:
+                raise ValueError(f"TestMessage: unknown field path {path!r}")
+
+    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:
+        """Merge src as proto merge does: fields set to a non-zero value
+        overwrite, lists are appended and messages are merged recursively. With
+        a mask, the fields of src outside the mask are left out."""
+        if mask is not None:
+            masked = type(self).zero_value()
+            masked.apply_mask(src, mask)
+            src = masked
+        if src.status:
+            self.status = src.status
+        if src.priority != "PRIORITY_LOW":
+            self.priority = src.priority
+
+    def redacted(self) -> Self:
+        """Return a deep copy that is safe to log: sensitive strings that are set
+        become "[REDACTED]" and other sensitive fields are cleared,
+        in this message and in every nested message"""
+        result = copy.deepcopy(self)
+        return result
+
     def to_json(self) -> str:
         """Convert message to JSON string"""
         return json.dumps(self.to_dict())
 
     def to_dict(self) -> Dict[str, Any]:
         """Convert message to dictionary"""
-        result = {}
+        result: Dict[str, Any] = {}
         if self.status is not None:
             result['status'] = self.status
         if self.priority is not None:
@@ -65,18 +141,40 @@ class TestMessage:
         return result
 
     @classmethod
-    def from_json(cls, json_str: str) -> 'TestMessage':
+    def from_json(cls, json_str: str) -> Self:
         """Create message from JSON string"""
         data = json.loads(json_str)
         return cls.from_dict(data)
 
     @classmethod
-    def from_dict(cls, data: Dict[str, Any]) -> 'TestMessage':
+    def from_dict(cls, data: Dict[str, Any]) -> Self:
         """Create message from dictionary"""
-        kwargs = {}
-        if 'status' in data:
-            kwargs['status'] = data['status']
+        kwargs: Dict[str, Any] = {}
+        if data.get('status') is not None:
+            kwargs['status'] = Status(data['status'])
         if 'priority' in data:
             kwargs['priority'] = data['priority']
         return cls(**kwargs)
 
+# Descriptors
+
+TestMessage.DESCRIPTOR = PuregenMessageDescriptor(
+    full_name="test.enums.TestMessage",
+    name="TestMessage",
+    python_name="TestMessage",
+    fields=[
+        PuregenFieldDescriptor(name="status", python_name="status", json_name="status", number=1, kind="enum", type_name="test.enums.Status"),
+        PuregenFieldDescriptor(name="priority", python_name="priority", json_name="priority", number=2, kind="enum", type_name="test.enums.Priority"),
+    ],
+)
+
+register_message_descriptors(
+    TestMessage.DESCRIPTOR,
+)
+
+# Message types
+
+register_message_types({
+    "test.enums.TestMessage": TestMessage,
+})
+
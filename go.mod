@@ -3,3 +3,10 @@ module github.com/nnanto/puregen
 go 1.23.2
 
 require google.golang.org/protobuf v1.35.2
+
+require (
+	github.com/bufbuild/protocompile v0.14.1
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require golang.org/x/sync v0.8.0 // indirect
@@ -1,141 +1,839 @@
 package generator
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
 	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
 )
 
-// filterPuregenDirectives removes lines containing puregen: directives from comment text
+const (
+	directivePrefix   = "puregen:"
+	generateDirective = "puregen:generate:"
+	metadataDirective = "puregen:metadata:"
+)
+
+// commentDirective is a single puregen directive found in a comment, with its
+// JSON payload joined across continuation lines
+type commentDirective struct {
+	Prefix  string
+	Payload string
+}
+
+// scanComment splits comment text into ordinary lines and puregen directives.
+// A directive whose JSON object is not closed on its own line continues on the
+// following lines until the brackets balance, so large objects can be wrapped.
+// Continuation stops at the next puregen: line or at a bracket that cannot
+// close the object; such an unclosed directive keeps only its first line, which
+// fails to decode and is ignored, and the lines after it stay comment text.
+func scanComment(comment string) ([]string, []commentDirective) {
+	var text []string
+	var directives []commentDirective
+
+	lines := strings.Split(comment, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmedLine := strings.TrimSpace(line)
+		if !strings.Contains(trimmedLine, directivePrefix) {
+			text = append(text, line)
+			continue
+		}
+
+		var prefix string
+		for _, p := range []string{generateDirective, metadataDirective} {
+			if strings.HasPrefix(trimmedLine, p) {
+				prefix = p
+				break
+			}
+		}
+		if prefix == "" {
+			// Unsupported directive names are dropped from the text and ignored
+			continue
+		}
+
+		payload := strings.TrimSpace(strings.TrimPrefix(trimmedLine, prefix))
+		if jsonObjectStateOf(payload) == jsonObjectOpen {
+			continued := payload
+			for j := i + 1; j < len(lines); j++ {
+				next := strings.TrimSpace(lines[j])
+				if strings.HasPrefix(next, directivePrefix) {
+					break
+				}
+				continued = strings.TrimSpace(continued + "\n" + next)
+				state := jsonObjectStateOf(continued)
+				if state == jsonObjectBroken {
+					break
+				}
+				if state == jsonObjectClosed {
+					payload = continued
+					i = j
+					break
+				}
+			}
+		}
+		directives = append(directives, commentDirective{Prefix: prefix, Payload: payload})
+	}
+
+	return text, directives
+}
+
+// jsonObjectState tells whether a directive payload is a complete JSON object
+type jsonObjectState int
+
+const (
+	// jsonObjectOpen is an object that may still be closed by following lines
+	jsonObjectOpen jsonObjectState = iota
+	// jsonObjectClosed is an object whose brackets balance
+	jsonObjectClosed
+	// jsonObjectBroken is not an object, or has a bracket that does not match
+	jsonObjectBroken
+)
+
+// jsonObjectStateOf checks the brackets of a payload, counting {} and [] each
+// on their own and skipping brackets inside strings. An empty payload is open
+// so the object may start on the next line.
+func jsonObjectStateOf(s string) jsonObjectState {
+	if s == "" {
+		return jsonObjectOpen
+	}
+	if !strings.HasPrefix(s, "{") {
+		// Not an object; leave it to the JSON decoder to reject
+		return jsonObjectBroken
+	}
+
+	var closers []rune
+	inString := false
+	escaped := false
+	for _, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case inString && r == '\\':
+			escaped = true
+		case r == '"':
+			inString = !inString
+		case inString:
+		case r == '{':
+			closers = append(closers, '}')
+		case r == '[':
+			closers = append(closers, ']')
+		case r == '}' || r == ']':
+			if len(closers) == 0 || closers[len(closers)-1] != r {
+				return jsonObjectBroken
+			}
+			closers = closers[:len(closers)-1]
+		}
+	}
+	if len(closers) == 0 {
+		return jsonObjectClosed
+	}
+	return jsonObjectOpen
+}
+
+// filterPuregenDirectives removes puregen directives, including the
+// continuation lines of multi-line directives, from comment text
 func filterPuregenDirectives(comment string) string {
 	if comment == "" {
 		return comment
 	}
 
-	lines := strings.Split(comment, "\n")
-	var filteredLines []string
+	filteredLines, _ := scanComment(comment)
+	return strings.Join(filteredLines, "\n")
+}
 
-	for _, line := range lines {
-		trimmedLine := strings.TrimSpace(line)
-		// Skip lines that contain puregen: directives
-		if !strings.Contains(trimmedLine, "puregen:") {
-			filteredLines = append(filteredLines, line)
+// collectDirectives returns the payloads of all directives with the given
+// prefix attached to an element, ordered from lowest to highest precedence:
+// detached comments first, then the leading comment, then the trailing
+// comment. Within a comment, later directives take precedence over earlier ones.
+func collectDirectives(comments protogen.CommentSet, prefix string) []string {
+	var blocks []string
+	for _, detached := range comments.LeadingDetached {
+		blocks = append(blocks, string(detached))
+	}
+	blocks = append(blocks, string(comments.Leading), string(comments.Trailing))
+
+	var payloads []string
+	for _, block := range blocks {
+		if block == "" {
+			continue
+		}
+		_, directives := scanComment(block)
+		for _, directive := range directives {
+			if directive.Prefix == prefix {
+				payloads = append(payloads, directive.Payload)
+			}
 		}
 	}
+	return payloads
+}
 
-	return strings.Join(filteredLines, "\n")
+// deprecatedKey is the metadata key set to true for methods and fields marked
+// [deprecated = true], so transports can log their use
+const deprecatedKey = "deprecated"
+
+// isDeprecated reports whether an element is marked deprecated in its proto options
+func isDeprecated(desc protoreflect.Descriptor) bool {
+	options, ok := desc.Options().(interface{ GetDeprecated() bool })
+	return ok && options.GetDeprecated()
+}
+
+// deprecationNotice describes a deprecated element in generated doc comments
+func deprecationNotice(desc protoreflect.Descriptor) string {
+	return "Deprecated: Marked as deprecated in " + desc.ParentFile().Path() + "."
+}
+
+// withDeprecation adds the deprecated key to the metadata of a deprecated element
+func withDeprecation(metadata map[string]any, desc protoreflect.Descriptor) map[string]any {
+	if !isDeprecated(desc) {
+		return metadata
+	}
+	return mergeMetadata(metadata, map[string]any{deprecatedKey: true})
 }
 
 // parseMethodMetadata extracts metadata from method comments using puregen:metadata: directive
-func parseMethodMetadata(comments protogen.CommentSet) map[string]string {
-	return parseMetadata(comments)
+// and the (puregen.method) option, which takes precedence. Metadata declared for
+// methods at package or file level is inherited (see fileScopeOf). Deprecated
+// methods get {"deprecated": true}.
+func parseMethodMetadata(method *protogen.Method, config *Config) map[string]any {
+	metadata := mergeMetadata(inheritedMetadata(method.Desc, methodsScope, config), parseMetadata(method.Comments))
+	if rules := puregenOption(method.Desc, "puregen.method", config); rules != nil {
+		metadata = mergeOptionMetadata(metadata, rules)
+		if http := optionMessage(rules, "http"); http != nil {
+			for _, key := range []protoreflect.Name{"method", "path"} {
+				if value := optionString(http, key); value != "" {
+					metadata = mergeMetadata(metadata, map[string]any{string(key): value})
+				}
+			}
+		}
+	}
+	return withDeprecation(metadata, method.Desc)
 }
 
 // parseMessageMetadata extracts metadata from message comments using puregen:metadata: directive
-func parseMessageMetadata(comments protogen.CommentSet) map[string]string {
-	return parseMetadata(comments)
+// and the (puregen.message) option, which takes precedence. Metadata declared for
+// messages at package or file level is inherited (see fileScopeOf).
+func parseMessageMetadata(msg *protogen.Message, config *Config) map[string]any {
+	metadata := mergeMetadata(inheritedMetadata(msg.Desc, messagesScope, config), parseMetadata(msg.Comments))
+	return mergeOptionMetadata(metadata, puregenOption(msg.Desc, "puregen.message", config))
 }
 
 // parseEnumMetadata extracts metadata from enum comments using puregen:metadata: directive
-func parseEnumMetadata(comments protogen.CommentSet) map[string]string {
-	return parseMetadata(comments)
+// and the (puregen.enum) option, which takes precedence. Metadata declared for
+// enums at package or file level is inherited (see fileScopeOf).
+func parseEnumMetadata(enum *protogen.Enum, config *Config) map[string]any {
+	metadata := mergeMetadata(inheritedMetadata(enum.Desc, enumsScope, config), parseMetadata(enum.Comments))
+	return mergeOptionMetadata(metadata, puregenOption(enum.Desc, "puregen.enum", config))
 }
 
-// parseFieldMetadata extracts metadata from field comments using puregen:metadata: directive
-func parseFieldMetadata(comments protogen.CommentSet) map[string]string {
-	return parseMetadata(comments)
+// displayNameKey is the enum value metadata key that produces display name accessors
+const displayNameKey = "display_name"
+
+// parseEnumValueMetadata extracts metadata from enum value comments using the
+// puregen:metadata: directive. Deprecated values get {"deprecated": true}.
+func parseEnumValueMetadata(value *protogen.EnumValue) map[string]any {
+	return withDeprecation(parseMetadata(value.Comments), value.Desc)
 }
 
-// parseMetadata is a generic function to extract metadata from comments using puregen:metadata: directive
-func parseMetadata(comments protogen.CommentSet) map[string]string {
-	// Use leading comments if available, otherwise trailing
-	comment := comments.Leading
-	if comment == "" && comments.Trailing != "" {
-		comment = comments.Trailing
+// hasEnumValueMetadata reports whether any value of an enum carries metadata
+func hasEnumValueMetadata(enum *protogen.Enum) bool {
+	for _, value := range enum.Values {
+		if parseEnumValueMetadata(value) != nil {
+			return true
+		}
 	}
+	return false
+}
 
-	if comment == "" {
-		return nil
+// hasEnumDisplayNames reports whether any value of an enum declares a display_name
+func hasEnumDisplayNames(enum *protogen.Enum) bool {
+	for _, value := range enum.Values {
+		if _, ok := parseEnumValueMetadata(value)[displayNameKey]; ok {
+			return true
+		}
 	}
+	return false
+}
 
-	lines := strings.Split(strings.TrimSpace(string(comment)), "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if strings.HasPrefix(line, "puregen:metadata:") {
-			jsonStr := strings.TrimPrefix(line, "puregen:metadata:")
-			jsonStr = strings.TrimSpace(jsonStr)
+// enumDisplayName returns the display_name of an enum value, falling back to
+// its proto name. An alias without its own display name uses the one of the
+// value it aliases.
+func enumDisplayName(enum *protogen.Enum, value *protogen.EnumValue) string {
+	if name, ok := parseEnumValueMetadata(value)[displayNameKey].(string); ok {
+		return name
+	}
+	if canonical := canonicalEnumValue(enum, value); canonical != value {
+		return enumDisplayName(enum, canonical)
+	}
+	return string(value.Desc.Name())
+}
 
-			var metadata map[string]string
-			if err := json.Unmarshal([]byte(jsonStr), &metadata); err == nil {
-				return metadata
-			}
+// parseFieldMetadata extracts metadata from field comments using puregen:metadata: directive
+// and the (puregen.field) option, which takes precedence. Metadata declared for
+// fields at package or file level is inherited (see fileScopeOf). Deprecated
+// fields get {"deprecated": true}.
+func parseFieldMetadata(field *protogen.Field, config *Config) map[string]any {
+	metadata := mergeMetadata(inheritedMetadata(field.Desc, fieldsScope, config), parseMetadata(field.Comments))
+	metadata = mergeOptionMetadata(metadata, puregenOption(field.Desc, "puregen.field", config))
+	return withDeprecation(metadata, field.Desc)
+}
+
+// mergeOptionMetadata merges the JSON metadata string of a puregen option message into metadata
+func mergeOptionMetadata(metadata map[string]any, rules protoreflect.Message) map[string]any {
+	if rules == nil {
+		return metadata
+	}
+	payload := optionString(rules, "metadata")
+	if payload == "" {
+		return metadata
+	}
+	values, err := decodeMetadata(payload)
+	if err != nil {
+		// Invalid JSON is ignored, as for comment directives
+		return metadata
+	}
+	return mergeMetadata(metadata, values)
+}
+
+// mergeMetadata returns metadata with values merged in, overriding existing keys.
+// Neither argument is modified.
+func mergeMetadata(metadata map[string]any, values map[string]any) map[string]any {
+	if len(values) == 0 {
+		return metadata
+	}
+	merged := make(map[string]any, len(metadata)+len(values))
+	for key, value := range metadata {
+		merged[key] = value
+	}
+	for key, value := range values {
+		merged[key] = value
+	}
+	return merged
+}
+
+// parseMetadata is a generic function to extract metadata from comments using puregen:metadata: directive.
+// Values keep their JSON types: strings, json.Number, bools, nil, []any and
+// map[string]any. All metadata directives on the element are merged; for
+// duplicate keys the directive with the highest precedence wins (see collectDirectives).
+func parseMetadata(comments protogen.CommentSet) map[string]any {
+	var metadata map[string]any
+	for _, payload := range collectDirectives(comments, metadataDirective) {
+		values, err := decodeMetadata(payload)
+		if err != nil {
+			// Invalid JSON is ignored
+			continue
+		}
+		if metadata == nil {
+			metadata = make(map[string]any)
+		}
+		for key, value := range values {
+			metadata[key] = value
 		}
 	}
-	return nil
+	return metadata
+}
+
+// decodeMetadata decodes a metadata JSON object, keeping numbers as json.Number
+// so integers and floats can be told apart when rendering literals
+func decodeMetadata(payload string) (map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(payload)))
+	decoder.UseNumber()
+
+	var values map[string]any
+	if err := decoder.Decode(&values); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after metadata object")
+	}
+	return values, nil
+}
+
+// sortedKeys returns the keys of a metadata map in sorted order for consistent output
+func sortedKeys(metadata map[string]any) []string {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 // PuregenDirective represents a parsed puregen directive from comments
 type PuregenDirective struct {
 	EnumType string `json:"enumType,omitempty"`
 	Value    string `json:"value,omitempty"`
+	// Sensitive fields are masked by Redacted and in string renderings
+	Sensitive bool `json:"sensitive,omitempty"`
+	// Strict typed_string enums reject unknown values when decoding JSON
+	Strict bool `json:"strict,omitempty"`
+	// JSONNaming overrides json.naming for the fields in scope
+	JSONNaming string `json:"jsonNaming,omitempty"`
+	// OmitEmpty overrides json.omit_empty for the fields in scope: unset and
+	// empty fields are left out of the JSON output, or written as null and
+	// zero values
+	OmitEmpty *bool `json:"omitEmpty,omitempty"`
 	// Add other directive fields as needed
 }
 
-// parsePuregenDirective extracts puregen directives from comments
+// parsePuregenDirective extracts puregen directives from comments.
+// All generate directives on the element are merged; a key set by a
+// directive with higher precedence overrides the same key set earlier.
 func parsePuregenDirective(comments protogen.CommentSet) *PuregenDirective {
-	// Use leading comments if available, otherwise trailing
-	comment := comments.Leading
-	if comment == "" && comments.Trailing != "" {
-		comment = comments.Trailing
+	return mergePuregenDirective(nil, comments)
+}
+
+// mergePuregenDirective overlays the generate directives found in comments on
+// a copy of base, which may be nil
+func mergePuregenDirective(base *PuregenDirective, comments protogen.CommentSet) *PuregenDirective {
+	directive := base
+	for _, payload := range collectDirectives(comments, generateDirective) {
+		// Decode into a scratch copy so an invalid payload leaves no partial state
+		merged := PuregenDirective{}
+		if directive != nil {
+			merged = *directive
+		}
+		if err := json.Unmarshal([]byte(payload), &merged); err != nil {
+			continue
+		}
+		directive = &merged
 	}
+	return directive
+}
 
-	if comment == "" {
-		return nil
+// parseEnumDirective extracts puregen directives from enum comments and the
+// (puregen.enum) option, which takes precedence. Settings such as enumType
+// declared at package or file level are inherited (see fileScopeOf).
+func parseEnumDirective(enum *protogen.Enum, config *Config) *PuregenDirective {
+	directive := mergePuregenDirective(fileScopeOf(enum.Desc.ParentFile(), config).directive, enum.Comments)
+
+	rules := puregenOption(enum.Desc, "puregen.enum", config)
+	if enumType := enumTypeOption(rules); enumType != "" {
+		merged := PuregenDirective{}
+		if directive != nil {
+			merged = *directive
+		}
+		merged.EnumType = enumType
+		directive = &merged
 	}
+	if rules != nil && rules.Has(rules.Descriptor().Fields().ByName("strict")) {
+		merged := PuregenDirective{}
+		if directive != nil {
+			merged = *directive
+		}
+		merged.Strict = optionBool(rules, "strict")
+		directive = &merged
+	}
+	return directive
+}
 
-	lines := strings.Split(strings.TrimSpace(string(comment)), "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if strings.HasPrefix(line, "puregen:generate:") {
-			jsonStr := strings.TrimPrefix(line, "puregen:generate:")
-			jsonStr = strings.TrimSpace(jsonStr)
+// isStringEnum reports whether an enum is generated as string constants, by
+// default or with {"enumType": "string"} or {"enumType": "typed_string"},
+// rather than as an integer enum with {"enumType": "int"}
+func isStringEnum(enum *protogen.Enum, config *Config) bool {
+	directive := parseEnumDirective(enum, config)
+	return directive == nil || directive.EnumType != "int"
+}
 
-			var directive PuregenDirective
-			if err := json.Unmarshal([]byte(jsonStr), &directive); err == nil {
-				return &directive
+// parseFileDirective returns the puregen directive that applies to the whole
+// file, combining package-level and file-level directives
+func parseFileDirective(file *protogen.File, config *Config) *PuregenDirective {
+	return fileScopeOf(file.Desc, config).directive
+}
+
+// parseFieldDirective extracts puregen directives from field comments and the
+// (puregen.field) option, which takes precedence
+func parseFieldDirective(field *protogen.Field, config *Config) *PuregenDirective {
+	directive := parsePuregenDirective(field.Comments)
+
+	rules := puregenOption(field.Desc, "puregen.field", config)
+	if rules != nil && rules.Has(rules.Descriptor().Fields().ByName("default")) {
+		if directive == nil {
+			directive = &PuregenDirective{}
+		}
+		directive.Value = optionString(rules, "default")
+	}
+	if rules != nil && rules.Has(rules.Descriptor().Fields().ByName("sensitive")) {
+		if directive == nil {
+			directive = &PuregenDirective{}
+		}
+		directive.Sensitive = optionBool(rules, "sensitive")
+	}
+	return directive
+}
+
+// isSensitiveField reports whether a field is marked sensitive, with
+// puregen:generate: {"sensitive": true} or the (puregen.field) option
+func isSensitiveField(field *protogen.Field, config *Config) bool {
+	directive := parseFieldDirective(field, config)
+	return directive != nil && directive.Sensitive
+}
+
+// redactedValue replaces sensitive strings in redacted copies
+const redactedValue = "[REDACTED]"
+
+// isRedactableString reports whether a sensitive field is a singular string,
+// which is masked with redactedValue rather than cleared
+func isRedactableString(field *protogen.Field) bool {
+	return field.Desc.Kind() == protoreflect.StringKind && !field.Desc.IsList() && !field.Desc.IsMap()
+}
+
+// hasSensitiveFields reports whether any field of a message is sensitive
+func hasSensitiveFields(msg *protogen.Message, config *Config) bool {
+	for _, field := range msg.Fields {
+		if isSensitiveField(field, config) {
+			return true
+		}
+	}
+	return false
+}
+
+// messageJSONConfig returns the JSON settings of a message: the json plugin
+// options, overridden by the jsonNaming and omitEmpty settings of the package,
+// file and message directives
+func messageJSONConfig(msg *protogen.Message, config *Config) JSONConfig {
+	directive := mergePuregenDirective(fileScopeOf(msg.Desc.ParentFile(), config).directive, msg.Comments)
+	return overlayJSONConfig(config.JSON, directive)
+}
+
+// fieldJSONConfig returns the JSON settings of a field: those of its message,
+// overridden by the field's own directives
+func fieldJSONConfig(field *protogen.Field, config *Config) JSONConfig {
+	if field.Parent == nil {
+		return overlayJSONConfig(config.JSON, parsePuregenDirective(field.Comments))
+	}
+	return overlayJSONConfig(messageJSONConfig(field.Parent, config), parsePuregenDirective(field.Comments))
+}
+
+// overlayJSONConfig returns settings with the JSON settings of a directive,
+// which may be nil, applied. Unsupported jsonNaming values are ignored.
+func overlayJSONConfig(settings JSONConfig, directive *PuregenDirective) JSONConfig {
+	if directive == nil {
+		return settings
+	}
+	switch directive.JSONNaming {
+	case camelJSONNaming, protoJSONNaming, customJSONNaming:
+		settings.Naming = directive.JSONNaming
+	}
+	if directive.OmitEmpty != nil {
+		settings.OmitEmpty = *directive.OmitEmpty
+	}
+	return settings
+}
+
+// jsonFieldName returns the name a field is written under in JSON, as
+// selected by json.naming or the jsonNaming directive setting in scope. The
+// camel names of protobuf's JSON mapping already honor an explicit
+// [json_name]; custom uses it where set and the proto field name elsewhere.
+func jsonFieldName(field *protogen.Field, config *Config) string {
+	switch fieldJSONConfig(field, config).Naming {
+	case protoJSONNaming:
+		return string(field.Desc.Name())
+	case customJSONNaming:
+		if !hasExplicitJSONName(field) {
+			return string(field.Desc.Name())
+		}
+	}
+	return field.Desc.JSONName()
+}
+
+// hasExplicitJSONName reports whether a field sets [json_name]. protoc fills
+// in json_name for every field it passes to plugins, so a name is explicit
+// when it differs from the one derived from the proto field name.
+func hasExplicitJSONName(field *protogen.Field) bool {
+	return field.Desc.JSONName() != defaultJSONName(string(field.Desc.Name()))
+}
+
+// defaultJSONName derives the JSON name of a proto field name as protoc
+// does: underscores are dropped and the letter after each is capitalized
+func defaultJSONName(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		switch {
+		case r == '_':
+			upper = true
+		case upper && 'a' <= r && r <= 'z':
+			b.WriteRune(r - 'a' + 'A')
+			upper = false
+		default:
+			b.WriteRune(r)
+			upper = false
+		}
+	}
+	return b.String()
+}
+
+// jsonFieldAliases returns the other names a field is accepted under when
+// parsing JSON: of its proto field name and its json_name, those that differ
+// from jsonFieldName
+func jsonFieldAliases(field *protogen.Field, config *Config) []string {
+	name := jsonFieldName(field, config)
+	var aliases []string
+	for _, alias := range []string{string(field.Desc.Name()), field.Desc.JSONName()} {
+		if alias != name && !slices.Contains(aliases, alias) {
+			aliases = append(aliases, alias)
+		}
+	}
+	return aliases
+}
+
+// hasJSONFieldAliases reports whether any field of a message has aliases
+func hasJSONFieldAliases(msg *protogen.Message, config *Config) bool {
+	for _, field := range msg.Fields {
+		if len(jsonFieldAliases(field, config)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Element kinds used to scope metadata declared at package or file level
+const (
+	messagesScope = "messages"
+	fieldsScope   = "fields"
+	enumsScope    = "enums"
+	methodsScope  = "methods"
+)
+
+// Source paths of the syntax and package statements in a file descriptor
+var (
+	syntaxStatementPath  = protoreflect.SourcePath{12}
+	packageStatementPath = protoreflect.SourcePath{2}
+)
+
+// fileScope holds the directives that apply to every element of a file
+type fileScope struct {
+	directive *PuregenDirective
+	// metadata holds inherited metadata by element kind (messagesScope, ...)
+	metadata map[string]map[string]any
+}
+
+// fileScopeOf resolves the directives inherited by elements of file, from
+// lowest to highest precedence:
+//
+//  1. directives in comments on the package statement of any file in the package
+//  2. directives in comments on the syntax statement of the file
+//  3. the (puregen.file) option of the file
+//
+// Element directives and options override inherited values. File-level
+// metadata is scoped by element kind, for example
+// {"messages": {"schema": "commerce"}, "methods": {"auth": "required"}}.
+func fileScopeOf(file protoreflect.FileDescriptor, config *Config) *fileScope {
+	if scope, ok := config.state.fileScopes[file.Path()]; ok {
+		return scope
+	}
+	scope := &fileScope{metadata: make(map[string]map[string]any)}
+
+	// Package-level directives, in file path order for stable output
+	siblings := append([]protoreflect.FileDescriptor(nil), config.state.packageFiles[file.Package()]...)
+	if !containsFile(siblings, file) {
+		siblings = append(siblings, file)
+	}
+	sort.Slice(siblings, func(i, j int) bool { return siblings[i].Path() < siblings[j].Path() })
+	for _, sibling := range siblings {
+		scope.apply(statementComments(sibling, packageStatementPath))
+	}
+
+	// File-level directives
+	scope.apply(statementComments(file, syntaxStatementPath))
+
+	if rules := puregenOption(file, "puregen.file", config); rules != nil {
+		if enumType := enumTypeOption(rules); enumType != "" {
+			merged := PuregenDirective{}
+			if scope.directive != nil {
+				merged = *scope.directive
 			}
+			merged.EnumType = enumType
+			scope.directive = &merged
+		}
+		if payload := optionString(rules, "metadata"); payload != "" {
+			scope.applyMetadata(payload)
 		}
 	}
-	return nil
+
+	config.state.fileScopes[file.Path()] = scope
+	return scope
 }
 
-// parseFieldDirective extracts puregen directives from field comments
-func parseFieldDirective(comments protogen.CommentSet) *PuregenDirective {
-	// Use leading comments if available, otherwise trailing
-	comment := comments.Leading
-	if comment == "" && comments.Trailing != "" {
-		comment = comments.Trailing
+// apply merges the directives found in comments into the scope
+func (s *fileScope) apply(comments protogen.CommentSet) {
+	s.directive = mergePuregenDirective(s.directive, comments)
+	for _, payload := range collectDirectives(comments, metadataDirective) {
+		s.applyMetadata(payload)
 	}
+}
 
-	if comment == "" {
+// applyMetadata merges a scoped metadata JSON object into the scope.
+// Keys other than the element kinds are ignored.
+func (s *fileScope) applyMetadata(payload string) {
+	values, err := decodeMetadata(payload)
+	if err != nil {
+		// Invalid JSON is ignored
+		return
+	}
+	for _, kind := range []string{messagesScope, fieldsScope, enumsScope, methodsScope} {
+		if scoped, ok := values[kind].(map[string]any); ok {
+			s.metadata[kind] = mergeMetadata(s.metadata[kind], scoped)
+		}
+	}
+}
+
+// inheritedMetadata returns the package and file level metadata for an element kind
+func inheritedMetadata(desc protoreflect.Descriptor, kind string, config *Config) map[string]any {
+	return fileScopeOf(desc.ParentFile(), config).metadata[kind]
+}
+
+// statementComments returns the comments attached to a statement of the file
+func statementComments(file protoreflect.FileDescriptor, path protoreflect.SourcePath) protogen.CommentSet {
+	loc := file.SourceLocations().ByPath(path)
+	comments := protogen.CommentSet{
+		Leading:  protogen.Comments(loc.LeadingComments),
+		Trailing: protogen.Comments(loc.TrailingComments),
+	}
+	for _, detached := range loc.LeadingDetachedComments {
+		comments.LeadingDetached = append(comments.LeadingDetached, protogen.Comments(detached))
+	}
+	return comments
+}
+
+// containsFile reports whether files contains file
+func containsFile(files []protoreflect.FileDescriptor, file protoreflect.FileDescriptor) bool {
+	for _, f := range files {
+		if f.Path() == file.Path() {
+			return true
+		}
+	}
+	return false
+}
+
+// enumTypeOption converts the puregen.EnumType of an option message to its directive value
+func enumTypeOption(rules protoreflect.Message) string {
+	if rules == nil {
+		return ""
+	}
+	fd := rules.Descriptor().Fields().ByName("enum_type")
+	if fd == nil || fd.Enum() == nil {
+		return ""
+	}
+	value := fd.Enum().Values().ByNumber(rules.Get(fd).Enum())
+	if value == nil {
+		return ""
+	}
+	switch value.Name() {
+	case "ENUM_TYPE_STRING":
+		return "string"
+	case "ENUM_TYPE_INT":
+		return "int"
+	case "ENUM_TYPE_TYPED_STRING":
+		return "typed_string"
+	}
+	return ""
+}
+
+// puregenOption returns the value of a puregen custom option (for example
+// "puregen.field") set on desc, or nil if it is not set. The extension is
+// resolved from puregen/options.proto among the imports of desc's file, so the
+// plugin does not need generated code for the options.
+func puregenOption(desc protoreflect.Descriptor, name protoreflect.FullName, config *Config) protoreflect.Message {
+	xd := findPuregenExtension(desc.ParentFile(), name, config)
+	if xd == nil {
+		return nil
+	}
+
+	// Options arrive with the extension as unknown fields; decode them again
+	// with a resolver that knows the extension type
+	options := desc.Options()
+	raw, err := proto.Marshal(options)
+	if err != nil || len(raw) == 0 {
+		return nil
+	}
+	xt := dynamicpb.NewExtensionType(xd)
+	resolver := new(protoregistry.Types)
+	if err := resolver.RegisterExtension(xt); err != nil {
+		return nil
+	}
+	decoded := options.ProtoReflect().New().Interface()
+	if err := (proto.UnmarshalOptions{Resolver: resolver}).Unmarshal(raw, decoded); err != nil {
+		return nil
+	}
+
+	message := decoded.ProtoReflect()
+	if !message.Has(xt.TypeDescriptor()) {
 		return nil
 	}
+	return message.Get(xt.TypeDescriptor()).Message()
+}
 
-	lines := strings.Split(strings.TrimSpace(string(comment)), "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if strings.HasPrefix(line, "puregen:generate:") {
-			jsonStr := strings.TrimPrefix(line, "puregen:generate:")
-			jsonStr = strings.TrimSpace(jsonStr)
+// findPuregenExtension looks up an extension by full name in file and its transitive imports
+func findPuregenExtension(file protoreflect.FileDescriptor, name protoreflect.FullName, config *Config) protoreflect.ExtensionDescriptor {
+	if file == nil {
+		return nil
+	}
+	cacheKey := file.Path() + ":" + string(name)
+	if xd, ok := config.state.puregenExtensions[cacheKey]; ok {
+		return xd
+	}
 
-			var directive PuregenDirective
-			if err := json.Unmarshal([]byte(jsonStr), &directive); err == nil {
-				return &directive
+	visited := make(map[string]bool)
+	var find func(f protoreflect.FileDescriptor) protoreflect.ExtensionDescriptor
+	find = func(f protoreflect.FileDescriptor) protoreflect.ExtensionDescriptor {
+		if f == nil || visited[f.Path()] {
+			return nil
+		}
+		visited[f.Path()] = true
+		if f.Package() == name.Parent() {
+			if xd := f.Extensions().ByName(name.Name()); xd != nil {
+				return xd
 			}
 		}
+		imports := f.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			if xd := find(imports.Get(i).FileDescriptor); xd != nil {
+				return xd
+			}
+		}
+		return nil
+	}
+
+	xd := find(file)
+	config.state.puregenExtensions[cacheKey] = xd
+	return xd
+}
+
+// optionString returns a string field of an option message by name
+func optionString(rules protoreflect.Message, name protoreflect.Name) string {
+	fd := rules.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return ""
+	}
+	return rules.Get(fd).String()
+}
+
+// optionBool returns a bool field of an option message by name
+func optionBool(rules protoreflect.Message, name protoreflect.Name) bool {
+	fd := rules.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.BoolKind {
+		return false
 	}
-	return nil
+	return rules.Get(fd).Bool()
+}
+
+// optionMessage returns a message field of an option message by name, or nil if unset
+func optionMessage(rules protoreflect.Message, name protoreflect.Name) protoreflect.Message {
+	fd := rules.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Message() == nil || !rules.Has(fd) {
+		return nil
+	}
+	return rules.Get(fd).Message()
 }
 
 // fileExists checks if a file already exists in the plugin's file list
@@ -180,6 +878,12 @@ func collectImportedFromMessage(msg *protogen.Message, currentFile *protogen.Fil
 		return
 	}
 
+	// google.protobuf.Any and FieldMask fields are shared puregen types, not
+	// messages of their own
+	if isSharedMessage(msg) {
+		return
+	}
+
 	messageKey := string(msg.Desc.FullName())
 
 	// If this message is from an imported file and we haven't seen it before
@@ -294,6 +998,17 @@ func collectAllEnums(file *protogen.File) []*protogen.Enum {
 	return allEnums
 }
 
+// canonicalEnumValue returns the first value of an enum with the number of
+// value: value itself, or the value it aliases under allow_alias
+func canonicalEnumValue(enum *protogen.Enum, value *protogen.EnumValue) *protogen.EnumValue {
+	for _, other := range enum.Values {
+		if other.Desc.Number() == value.Desc.Number() {
+			return other
+		}
+	}
+	return value
+}
+
 // collectEnumsFromMessage recursively collects all enums from a message and its nested messages
 func collectEnumsFromMessage(msg *protogen.Message) []*protogen.Enum {
 	var enums []*protogen.Enum
@@ -308,3 +1023,121 @@ func collectEnumsFromMessage(msg *protogen.Message) []*protogen.Enum {
 
 	return enums
 }
+
+// collectDescribedMessages returns the messages a generated file describes:
+// the imported messages redefined locally and the file's own messages, each
+// followed by its nested messages. Map entries are described by their field.
+func collectDescribedMessages(file *protogen.File) []*protogen.Message {
+	visited := make(map[protoreflect.FullName]bool)
+	var messages []*protogen.Message
+	var add func(msg *protogen.Message)
+	add = func(msg *protogen.Message) {
+		if msg.Desc.IsMapEntry() || visited[msg.Desc.FullName()] {
+			return
+		}
+		visited[msg.Desc.FullName()] = true
+		messages = append(messages, msg)
+		for _, nested := range msg.Messages {
+			add(nested)
+		}
+	}
+	for _, msg := range collectImportedMessages(file) {
+		add(msg)
+	}
+	for _, msg := range file.Messages {
+		add(msg)
+	}
+	return messages
+}
+
+// descriptorValue returns the field a descriptor describes the type of: the
+// value of a map field, else the field itself
+func descriptorValue(field *protogen.Field) *protogen.Field {
+	if field.Desc.IsMap() {
+		return field.Message.Fields[1]
+	}
+	return field
+}
+
+// descriptorKind returns the proto kind of a field ("string", "message", ...);
+// for map fields, the kind of the values
+func descriptorKind(field *protogen.Field) string {
+	return descriptorValue(field).Desc.Kind().String()
+}
+
+// descriptorTypeName returns the full proto name of the message or enum type
+// of a field, or of the values of a map field, or "" for scalar types
+func descriptorTypeName(field *protogen.Field) string {
+	value := descriptorValue(field)
+	switch {
+	case value.Message != nil:
+		return string(value.Message.Desc.FullName())
+	case value.Enum != nil:
+		return string(value.Enum.Desc.FullName())
+	}
+	return ""
+}
+
+// descriptorMapKey returns the proto kind of the keys of a map field, or "" for other fields
+func descriptorMapKey(field *protogen.Field) string {
+	if !field.Desc.IsMap() {
+		return ""
+	}
+	return field.Message.Fields[0].Desc.Kind().String()
+}
+
+// anyMessageName is the full name of google.protobuf.Any
+const anyMessageName = "google.protobuf.Any"
+
+// isAnyMessage reports whether msg is google.protobuf.Any, whose fields are
+// generated as PuregenEnvelope
+func isAnyMessage(msg *protogen.Message) bool {
+	return msg != nil && msg.Desc.FullName() == anyMessageName
+}
+
+// fieldMaskMessageName is the full name of google.protobuf.FieldMask
+const fieldMaskMessageName = "google.protobuf.FieldMask"
+
+// isFieldMaskMessage reports whether msg is google.protobuf.FieldMask, whose
+// fields are generated as PuregenFieldMask
+func isFieldMaskMessage(msg *protogen.Message) bool {
+	return msg != nil && msg.Desc.FullName() == fieldMaskMessageName
+}
+
+// isSharedMessage reports whether msg is generated as a shared puregen type,
+// PuregenEnvelope or PuregenFieldMask, rather than as a message of its own
+func isSharedMessage(msg *protogen.Message) bool {
+	return isAnyMessage(msg) || isFieldMaskMessage(msg)
+}
+
+// messageTypeName returns the name of the generated type of a message
+func messageTypeName(msg *protogen.Message) string {
+	switch {
+	case isAnyMessage(msg):
+		return "PuregenEnvelope"
+	case isFieldMaskMessage(msg):
+		return "PuregenFieldMask"
+	}
+	return msg.GoIdent.GoName
+}
+
+// usesAnyMessage reports whether any message of a generated file has
+// google.protobuf.Any fields, or map fields with Any values
+func usesAnyMessage(file *protogen.File) bool {
+	for _, msg := range collectDescribedMessages(file) {
+		for _, field := range msg.Fields {
+			if isAnyMessage(descriptorValue(field).Message) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasTypeRegistry reports whether the envelope type and the message type
+// registry are generated for a file: with the registry feature, or when the
+// file needs envelopes for its Any fields
+func hasTypeRegistry(file *protogen.File, config *Config) bool {
+	messages := collectDescribedMessages(file)
+	return (config.Features.Registry && len(messages) > 0) || usesAnyMessage(file)
+}
@@ -0,0 +1,225 @@
+package generator
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func TestScanComment(t *testing.T) {
+	tests := []struct {
+		name       string
+		comment    string
+		text       []string
+		directives []commentDirective
+	}{
+		{
+			name:    "plain text",
+			comment: " A task\n to do",
+			text:    []string{" A task", " to do"},
+		},
+		{
+			name:    "single line directive",
+			comment: " A task\n puregen:metadata: {\"table\": \"tasks\"}",
+			text:    []string{" A task"},
+			directives: []commentDirective{
+				{Prefix: metadataDirective, Payload: `{"table": "tasks"}`},
+			},
+		},
+		{
+			name:    "generate and metadata directives",
+			comment: " puregen:generate: {\"value\": \"1\"}\n puregen:metadata: {\"a\": 1}",
+			directives: []commentDirective{
+				{Prefix: generateDirective, Payload: `{"value": "1"}`},
+				{Prefix: metadataDirective, Payload: `{"a": 1}`},
+			},
+		},
+		{
+			name:    "multi-line object",
+			comment: " puregen:metadata: {\n   \"cache\": \"true\",\n   \"tags\": [\"a\",\n \"b\"]\n }\n Doc",
+			text:    []string{" Doc"},
+			directives: []commentDirective{
+				{Prefix: metadataDirective, Payload: "{\n\"cache\": \"true\",\n\"tags\": [\"a\",\n\"b\"]\n}"},
+			},
+		},
+		{
+			name:    "object starting on the next line",
+			comment: " puregen:metadata:\n {\"a\": 1}",
+			directives: []commentDirective{
+				{Prefix: metadataDirective, Payload: `{"a": 1}`},
+			},
+		},
+		{
+			name:    "brackets inside strings",
+			comment: " puregen:metadata: {\"pattern\": \"^[a-z]{2,\", \"b\": \"}\"}\n Doc",
+			text:    []string{" Doc"},
+			directives: []commentDirective{
+				{Prefix: metadataDirective, Payload: `{"pattern": "^[a-z]{2,", "b": "}"}`},
+			},
+		},
+		{
+			name:    "mismatched bracket does not continue",
+			comment: " puregen:metadata: {\"a\": 1, \"b\": [1, 2}\n puregen:metadata: {\"c\": 3}\n Doc",
+			text:    []string{" Doc"},
+			directives: []commentDirective{
+				{Prefix: metadataDirective, Payload: `{"a": 1, "b": [1, 2}`},
+				{Prefix: metadataDirective, Payload: `{"c": 3}`},
+			},
+		},
+		{
+			name:    "unclosed object stops at the next directive",
+			comment: " puregen:metadata: {\"a\": 1,\n More doc\n puregen:metadata: {\"c\": 3}\n Doc",
+			text:    []string{" More doc", " Doc"},
+			directives: []commentDirective{
+				{Prefix: metadataDirective, Payload: `{"a": 1,`},
+				{Prefix: metadataDirective, Payload: `{"c": 3}`},
+			},
+		},
+		{
+			name:    "unclosed object at the end keeps the text",
+			comment: " puregen:metadata: {\"a\": [1,\n Doc one\n Doc two",
+			text:    []string{" Doc one", " Doc two"},
+			directives: []commentDirective{
+				{Prefix: metadataDirective, Payload: `{"a": [1,`},
+			},
+		},
+		{
+			name:    "empty payload followed by text",
+			comment: " puregen:metadata:\n Doc",
+			text:    []string{" Doc"},
+			directives: []commentDirective{
+				{Prefix: metadataDirective, Payload: ""},
+			},
+		},
+		{
+			name:    "unsupported directive is dropped",
+			comment: " puregen:unknown: {}\n Doc",
+			text:    []string{" Doc"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, directives := scanComment(tt.comment)
+			if !reflect.DeepEqual(text, tt.text) {
+				t.Errorf("text = %q, want %q", text, tt.text)
+			}
+			if !reflect.DeepEqual(directives, tt.directives) {
+				t.Errorf("directives = %q, want %q", directives, tt.directives)
+			}
+		})
+	}
+}
+
+func TestParseMetadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		comments protogen.CommentSet
+		want     map[string]any
+	}{
+		{
+			name:     "no directives",
+			comments: protogen.CommentSet{Leading: " Doc\n"},
+			want:     nil,
+		},
+		{
+			name: "directives in one comment are merged, later ones win",
+			comments: protogen.CommentSet{
+				Leading: " puregen:metadata: {\"a\": \"1\", \"b\": \"1\"}\n puregen:metadata: {\"b\": \"2\"}\n",
+			},
+			want: map[string]any{"a": "1", "b": "2"},
+		},
+		{
+			name: "leading wins over detached, trailing wins over leading",
+			comments: protogen.CommentSet{
+				LeadingDetached: []protogen.Comments{" puregen:metadata: {\"a\": \"detached\", \"b\": \"detached\", \"c\": \"detached\"}\n"},
+				Leading:         " puregen:metadata: {\"b\": \"leading\", \"c\": \"leading\"}\n",
+				Trailing:        " puregen:metadata: {\"c\": \"trailing\"}\n",
+			},
+			want: map[string]any{"a": "detached", "b": "leading", "c": "trailing"},
+		},
+		{
+			name: "invalid payloads are skipped",
+			comments: protogen.CommentSet{
+				Leading: " puregen:metadata: {\"a\": 1, \"b\": [1, 2}\n puregen:metadata: {\"c\": 3}\n",
+			},
+			want: map[string]any{"c": json.Number("3")},
+		},
+		{
+			name: "typed values",
+			comments: protogen.CommentSet{
+				Leading: " puregen:metadata: {\"n\": 1.5, \"ok\": true, \"tags\": [\"x\"], \"none\": null}\n",
+			},
+			want: map[string]any{"n": json.Number("1.5"), "ok": true, "tags": []any{"x"}, "none": nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseMetadata(tt.comments); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseMetadata() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePuregenDirective(t *testing.T) {
+	tests := []struct {
+		name     string
+		comments protogen.CommentSet
+		want     *PuregenDirective
+	}{
+		{
+			name:     "no directives",
+			comments: protogen.CommentSet{Leading: " Doc\n"},
+			want:     nil,
+		},
+		{
+			name: "keys are merged across comments",
+			comments: protogen.CommentSet{
+				LeadingDetached: []protogen.Comments{" puregen:generate: {\"enumType\": \"int\", \"value\": \"a\"}\n"},
+				Trailing:        " puregen:generate: {\"value\": \"b\"}\n",
+			},
+			want: &PuregenDirective{EnumType: "int", Value: "b"},
+		},
+		{
+			name: "invalid payload leaves earlier settings",
+			comments: protogen.CommentSet{
+				Leading: " puregen:generate: {\"value\": \"a\"}\n puregen:generate: {\"value\": 1}\n",
+			},
+			want: &PuregenDirective{Value: "a"},
+		},
+		{
+			name: "omitEmpty false overrides true",
+			comments: protogen.CommentSet{
+				Leading: " puregen:generate: {\"jsonNaming\": \"proto\", \"omitEmpty\": true}\n puregen:generate: {\"omitEmpty\": false}\n",
+			},
+			want: &PuregenDirective{JSONNaming: "proto", OmitEmpty: new(bool)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parsePuregenDirective(tt.comments); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parsePuregenDirective() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeMetadata(t *testing.T) {
+	base := map[string]any{"a": "1", "b": "1"}
+	got := mergeMetadata(base, map[string]any{"b": "2", "c": "2"})
+	want := map[string]any{"a": "1", "b": "2", "c": "2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeMetadata() = %#v, want %#v", got, want)
+	}
+	if !reflect.DeepEqual(base, map[string]any{"a": "1", "b": "1"}) {
+		t.Errorf("mergeMetadata() modified its input: %#v", base)
+	}
+	if got := mergeMetadata(base, nil); !reflect.DeepEqual(got, base) {
+		t.Errorf("mergeMetadata(base, nil) = %#v, want %#v", got, base)
+	}
+}
@@ -0,0 +1,325 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds the plugin settings. It is built from defaults, then the
+// optional config file (config=puregen.yaml), then explicit --puregen_opt flags.
+type Config struct {
+	// Language selects the target language: go, java, python, or all
+	Language string `yaml:"language"`
+	// CommonNamespace is the namespace for common classes/interfaces (e.g., 'common' or 'shared.transport')
+	CommonNamespace string `yaml:"common_namespace"`
+	// CommonGoImportPath is the Go import path of the common_namespace package
+	// (e.g., 'github.com/acme/api/shared/transport')
+	CommonGoImportPath string `yaml:"common_go_import_path"`
+	// GoModule is the Go module path of the generated code. When set, the
+	// common_namespace package is placed under it.
+	GoModule string `yaml:"go_module"`
+	// Paths selects the output layout: "import" places files by Go import path,
+	// Java package and proto package; "source_relative" mirrors the .proto file
+	// paths, with Java package directories below the .proto file's directory
+	Paths string `yaml:"paths"`
+
+	PackageConfig `yaml:",inline"`
+
+	// Packages holds per proto package overrides of the PackageConfig settings,
+	// keyed by proto package name
+	Packages map[string]yaml.Node `yaml:"packages"`
+
+	// state is shared by the configs of every package of a request (see StartRequest)
+	state *generatorState
+}
+
+// PackageConfig holds the settings that can be overridden per proto package
+type PackageConfig struct {
+	Output   OutputConfig  `yaml:"output"`
+	Naming   NamingConfig  `yaml:"naming"`
+	Features FeatureConfig `yaml:"features"`
+	JSON     JSONConfig    `yaml:"json"`
+	Go       GoConfig      `yaml:"go"`
+	Python   PythonConfig  `yaml:"python"`
+	Java     JavaConfig    `yaml:"java"`
+	SQL      SQLConfig     `yaml:"sql"`
+}
+
+// OutputConfig sets the output root of each language, relative to --puregen_out
+type OutputConfig struct {
+	Go     string `yaml:"go"`
+	Java   string `yaml:"java"`
+	Python string `yaml:"python"`
+	SQL    string `yaml:"sql"`
+}
+
+// NamingConfig controls how generated identifiers are named
+type NamingConfig struct {
+	// Fields selects Java and Python field names: "default" derives camelCase
+	// (Java) and snake_case (Python) names, "proto" uses the proto field name as is
+	Fields string `yaml:"fields"`
+}
+
+// FeatureConfig enables or disables parts of the generated code
+type FeatureConfig struct {
+	Clients     bool `yaml:"clients"`
+	Services    bool `yaml:"services"`
+	Metadata    bool `yaml:"metadata"`
+	Validation  bool `yaml:"validation"`
+	Descriptors bool `yaml:"descriptors"`
+	Registry    bool `yaml:"registry"`
+}
+
+// JSONConfig controls JSON serialization of generated messages
+type JSONConfig struct {
+	// OmitEmpty leaves fields with zero or empty values out of the JSON output
+	OmitEmpty bool `yaml:"omit_empty"`
+	// Naming selects the JSON field names: "camel" (lowerCamelCase, or the
+	// explicit json_name), "proto" (the proto field name) or "custom" (the
+	// explicit json_name where set, else the proto field name). Parsers
+	// accept the proto field name and the json_name of every field.
+	Naming string `yaml:"naming"`
+}
+
+// GoConfig holds Go specific settings
+type GoConfig struct {
+	// IntEnums selects how MarshalJSON writes integer enums: "number" or "name".
+	// Both forms are accepted when reading.
+	IntEnums string `yaml:"int_enums"`
+}
+
+// JavaConfig holds Java specific settings
+type JavaConfig struct {
+	// Style selects the message classes: "class" (mutable, with setters),
+	// "records" (Java 17+ records) or "immutable" (final classes)
+	Style string `yaml:"style"`
+	// IntEnums selects how PuregenJson writes integer enums: "number" or "name".
+	// Both forms are accepted when reading.
+	IntEnums string `yaml:"int_enums"`
+}
+
+// PythonConfig holds Python specific settings
+type PythonConfig struct {
+	// Stubs writes a .pyi stub next to each generated module
+	Stubs bool `yaml:"stubs"`
+	// Style selects the message classes: "dataclass" or "pydantic" (BaseModel)
+	Style string `yaml:"style"`
+	// AsyncServices generates service interfaces with async methods
+	AsyncServices bool `yaml:"async_services"`
+}
+
+// SQLConfig controls the SQL generator, which maps messages declaring a
+// "table" in their metadata to database tables
+type SQLConfig struct {
+	// Dialect selects the dialect of the CREATE TABLE scripts and of the Go
+	// queries: "postgres" or "sqlite". Empty, the default, generates no SQL.
+	Dialect string `yaml:"dialect"`
+}
+
+// Output layouts for Config.Paths
+const (
+	importPaths         = "import"
+	sourceRelativePaths = "source_relative"
+)
+
+// Naming modes for NamingConfig.Fields
+const (
+	defaultFieldNaming = "default"
+	protoFieldNaming   = "proto"
+)
+
+// JSON field names for JSONConfig.Naming
+const (
+	camelJSONNaming  = "camel"
+	protoJSONNaming  = "proto"
+	customJSONNaming = "custom"
+)
+
+// Java message styles for JavaConfig.Style
+const (
+	classJavaStyle     = "class"
+	recordsJavaStyle   = "records"
+	immutableJavaStyle = "immutable"
+)
+
+// JSON forms of integer enums for GoConfig.IntEnums and JavaConfig.IntEnums
+const (
+	numberIntEnums = "number"
+	nameIntEnums   = "name"
+)
+
+// Python message styles for PythonConfig.Style
+const (
+	dataclassPythonStyle = "dataclass"
+	pydanticPythonStyle  = "pydantic"
+)
+
+// SQL dialects for SQLConfig.Dialect
+const (
+	postgresSQLDialect = "postgres"
+	sqliteSQLDialect   = "sqlite"
+)
+
+// DefaultConfig returns the configuration used when no config file or flags are given
+func DefaultConfig() *Config {
+	return &Config{
+		Language: "all",
+		Paths:    importPaths,
+		PackageConfig: PackageConfig{
+			Naming: NamingConfig{Fields: defaultFieldNaming},
+			JSON:   JSONConfig{Naming: camelJSONNaming},
+			Go:     GoConfig{IntEnums: numberIntEnums},
+			Python: PythonConfig{Style: dataclassPythonStyle},
+			Java:   JavaConfig{Style: classJavaStyle, IntEnums: numberIntEnums},
+			Features: FeatureConfig{
+				Clients:     true,
+				Services:    true,
+				Metadata:    true,
+				Validation:  true,
+				Descriptors: true,
+				Registry:    true,
+			},
+		},
+		state: newGeneratorState(),
+	}
+}
+
+// LoadConfigFile reads a YAML or JSON config file on top of the current settings.
+// Keys missing from the file keep their current values; unknown keys are errors.
+func (c *Config) LoadConfigFile(filename string) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+	if err := decodeStrict(data, c); err != nil {
+		return fmt.Errorf("config file %s: %w", filename, err)
+	}
+
+	// Check every package override up front so errors surface even for
+	// packages that are not generated in this run
+	for _, pkg := range c.packageNames() {
+		if _, err := c.ForPackage(pkg); err != nil {
+			return fmt.Errorf("config file %s: %w", filename, err)
+		}
+	}
+	return c.Validate()
+}
+
+// ForPackage returns the configuration for a proto package, with the
+// package's overrides applied on top of the base settings. Explicit
+// --puregen_opt flags take precedence over package overrides, so the caller
+// applies them again to the result.
+func (c *Config) ForPackage(pkg string) (*Config, error) {
+	override, ok := c.Packages[pkg]
+	if !ok {
+		return c, nil
+	}
+
+	data, err := yaml.Marshal(&override)
+	if err != nil {
+		return nil, fmt.Errorf("package %s: %w", pkg, err)
+	}
+	resolved := *c
+	if err := decodeStrict(data, &resolved.PackageConfig); err != nil {
+		return nil, fmt.Errorf("package %s: %w", pkg, err)
+	}
+	if err := resolved.Validate(); err != nil {
+		return nil, fmt.Errorf("package %s: %w", pkg, err)
+	}
+	return &resolved, nil
+}
+
+// Validate checks that enumerated settings hold supported values
+func (c *Config) Validate() error {
+	switch c.Language {
+	case "go", "java", "python", "all":
+	default:
+		return fmt.Errorf("unsupported language: %s", c.Language)
+	}
+	switch c.Paths {
+	case importPaths, sourceRelativePaths:
+	default:
+		return fmt.Errorf("unsupported paths: %s (want %s or %s)", c.Paths, importPaths, sourceRelativePaths)
+	}
+	switch c.Naming.Fields {
+	case defaultFieldNaming, protoFieldNaming:
+	default:
+		return fmt.Errorf("unsupported naming.fields: %s (want %s or %s)", c.Naming.Fields, defaultFieldNaming, protoFieldNaming)
+	}
+	switch c.JSON.Naming {
+	case camelJSONNaming, protoJSONNaming, customJSONNaming:
+	default:
+		return fmt.Errorf("unsupported json.naming: %s (want %s, %s or %s)", c.JSON.Naming, camelJSONNaming, protoJSONNaming, customJSONNaming)
+	}
+	switch c.Go.IntEnums {
+	case numberIntEnums, nameIntEnums:
+	default:
+		return fmt.Errorf("unsupported go.int_enums: %s (want %s or %s)", c.Go.IntEnums, numberIntEnums, nameIntEnums)
+	}
+	switch c.Java.Style {
+	case classJavaStyle, recordsJavaStyle, immutableJavaStyle:
+	default:
+		return fmt.Errorf("unsupported java.style: %s (want %s, %s or %s)", c.Java.Style, classJavaStyle, recordsJavaStyle, immutableJavaStyle)
+	}
+	switch c.Java.IntEnums {
+	case numberIntEnums, nameIntEnums:
+	default:
+		return fmt.Errorf("unsupported java.int_enums: %s (want %s or %s)", c.Java.IntEnums, numberIntEnums, nameIntEnums)
+	}
+	switch c.Python.Style {
+	case dataclassPythonStyle, pydanticPythonStyle:
+	default:
+		return fmt.Errorf("unsupported python.style: %s (want %s or %s)", c.Python.Style, dataclassPythonStyle, pydanticPythonStyle)
+	}
+	switch c.SQL.Dialect {
+	case "", postgresSQLDialect, sqliteSQLDialect:
+	default:
+		return fmt.Errorf("unsupported sql.dialect: %s (want %s or %s)", c.SQL.Dialect, postgresSQLDialect, sqliteSQLDialect)
+	}
+	return nil
+}
+
+// packageNames returns the names of the packages with overrides in sorted order
+func (c *Config) packageNames() []string {
+	names := make([]string, 0, len(c.Packages))
+	for name := range c.Packages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// decodeStrict decodes YAML (or JSON, which is valid YAML) onto out,
+// rejecting keys that out does not define
+func decodeStrict(data []byte, out any) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(out); err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+// sourceRelativeDir returns the directory of a .proto file for the
+// source_relative layout, or "" for files at the source root
+func sourceRelativeDir(protoPath string) string {
+	dir := path.Dir(protoPath)
+	if dir == "." {
+		return ""
+	}
+	return dir
+}
+
+// outputPath places a generated file under a language output root
+func outputPath(root, filename string) string {
+	if root == "" {
+		return filename
+	}
+	return path.Join(root, filename)
+}
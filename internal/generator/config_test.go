@@ -0,0 +1,198 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// writeConfigFile writes a config file into a temporary directory
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+	filename := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func TestDecodeStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "empty document", data: ""},
+		{name: "known keys", data: "language: go\njson:\n  omit_empty: true\n"},
+		{name: "json document", data: `{"language": "go", "naming": {"fields": "proto"}}`},
+		{name: "unknown top-level key", data: "langauge: go\n", wantErr: "field langauge not found"},
+		{name: "unknown nested key", data: "json:\n  omit_empty: true\n  omitempty: true\n", wantErr: "field omitempty not found"},
+		{name: "wrong type", data: "json:\n  omit_empty: sometimes\n", wantErr: "cannot unmarshal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			err := decodeStrict([]byte(tt.data), config)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("decodeStrict() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("decodeStrict() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	filename := writeConfigFile(t, "puregen.yaml", `
+language: python
+naming:
+  fields: proto
+`)
+	config := DefaultConfig()
+	if err := config.LoadConfigFile(filename); err != nil {
+		t.Fatalf("LoadConfigFile() error = %v", err)
+	}
+	if config.Language != "python" || config.Naming.Fields != protoFieldNaming {
+		t.Errorf("LoadConfigFile() language = %q, naming.fields = %q", config.Language, config.Naming.Fields)
+	}
+	// Keys left out keep their defaults
+	if config.JSON.OmitEmpty || !config.Features.Clients {
+		t.Errorf("LoadConfigFile() changed defaults: json.omit_empty = %v, features.clients = %v", config.JSON.OmitEmpty, config.Features.Clients)
+	}
+}
+
+func TestLoadConfigFileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "unknown key", content: "features:\n  client: false\n", wantErr: "field client not found"},
+		{name: "invalid value", content: "naming:\n  fields: camel\n", wantErr: "unsupported naming.fields: camel"},
+		{name: "unknown key in package override", content: "packages:\n  acme.v1:\n    feature:\n      clients: false\n", wantErr: "package acme.v1"},
+		{name: "invalid value in package override", content: "packages:\n  acme.v1:\n    naming:\n      fields: kebab\n", wantErr: "unsupported naming.fields: kebab"},
+		{name: "top-level key in package override", content: "packages:\n  acme.v1:\n    language: go\n", wantErr: "field language not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filename := writeConfigFile(t, "puregen.yaml", tt.content)
+			err := DefaultConfig().LoadConfigFile(filename)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("LoadConfigFile() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := DefaultConfig().LoadConfigFile(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("LoadConfigFile() of a missing file succeeded")
+	}
+}
+
+func TestForPackage(t *testing.T) {
+	filename := writeConfigFile(t, "puregen.yaml", `
+json:
+  omit_empty: true
+naming:
+  fields: proto
+packages:
+  acme.audit:
+    features:
+      clients: false
+    naming:
+      fields: default
+`)
+	config := DefaultConfig()
+	if err := config.LoadConfigFile(filename); err != nil {
+		t.Fatalf("LoadConfigFile() error = %v", err)
+	}
+
+	other, err := config.ForPackage("acme.billing")
+	if err != nil {
+		t.Fatalf("ForPackage() error = %v", err)
+	}
+	if other != config {
+		t.Error("ForPackage() of a package without overrides did not return the base config")
+	}
+
+	audit, err := config.ForPackage("acme.audit")
+	if err != nil {
+		t.Fatalf("ForPackage() error = %v", err)
+	}
+	if audit.Features.Clients || audit.Naming.Fields != defaultFieldNaming {
+		t.Errorf("ForPackage() features.clients = %v, naming.fields = %q; want overrides applied", audit.Features.Clients, audit.Naming.Fields)
+	}
+	// Settings the override leaves out are inherited from the base
+	if !audit.JSON.OmitEmpty || !audit.Features.Services {
+		t.Errorf("ForPackage() json.omit_empty = %v, features.services = %v; want base settings", audit.JSON.OmitEmpty, audit.Features.Services)
+	}
+	// Package configs share the generator state of the request
+	if audit.state != config.state {
+		t.Error("ForPackage() did not share the generator state")
+	}
+	// The base config is not modified
+	if !config.Features.Clients || config.Naming.Fields != protoFieldNaming {
+		t.Errorf("ForPackage() modified the base config: features.clients = %v, naming.fields = %q", config.Features.Clients, config.Naming.Fields)
+	}
+}
+
+func TestStartRequest(t *testing.T) {
+	gen, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := DefaultConfig()
+	config.StartRequest(gen)
+	if !config.state.createOnce("a/registry.go") || config.state.createOnce("a/registry.go") {
+		t.Error("createOnce() did not report the first creation only")
+	}
+	// Each request starts with nothing created
+	config.StartRequest(gen)
+	if !config.state.createOnce("a/registry.go") {
+		t.Error("StartRequest() kept the files created by the previous request")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Config)
+		wantErr string
+	}{
+		{name: "defaults", modify: func(*Config) {}},
+		{name: "language", modify: func(c *Config) { c.Language = "rust" }, wantErr: "unsupported language: rust"},
+		{name: "paths", modify: func(c *Config) { c.Paths = "flat" }, wantErr: "unsupported paths: flat"},
+		{name: "naming.fields", modify: func(c *Config) { c.Naming.Fields = "camel" }, wantErr: "unsupported naming.fields: camel"},
+		{name: "json.naming", modify: func(c *Config) { c.JSON.Naming = "kebab" }, wantErr: "unsupported json.naming: kebab"},
+		{name: "python.style", modify: func(c *Config) { c.Python.Style = "attrs" }, wantErr: "unsupported python.style: attrs"},
+		{name: "go.int_enums", modify: func(c *Config) { c.Go.IntEnums = "string" }, wantErr: "unsupported go.int_enums: string"},
+		{name: "java.style", modify: func(c *Config) { c.Java.Style = "beans" }, wantErr: "unsupported java.style: beans"},
+		{name: "sql.dialect", modify: func(c *Config) { c.SQL.Dialect = "mysql" }, wantErr: "unsupported sql.dialect: mysql"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			tt.modify(config)
+			err := config.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
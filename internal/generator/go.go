@@ -1,12 +1,14 @@
 package generator
 
 import (
+	"encoding/json"
+	"path"
 	"path/filepath"
-	"sort"
 	"strconv"
 	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // formatGoComment formats a comment for Go code
@@ -40,32 +42,91 @@ func formatGoComment(comments protogen.CommentSet) []string {
 	return result
 }
 
-// writeGoComment writes formatted comments to the generator
-func writeGoComment(g *protogen.GeneratedFile, comments protogen.CommentSet) {
-	commentLines := formatGoComment(comments)
-	for _, line := range commentLines {
-		g.P(line)
+// withGoDeprecation appends a Deprecated paragraph to the doc comment lines of
+// a deprecated element, which tools such as staticcheck and gopls report
+func withGoDeprecation(lines []string, desc protoreflect.Descriptor) []string {
+	if !isDeprecated(desc) {
+		return lines
+	}
+	if len(lines) > 0 {
+		lines = append(lines, "//")
 	}
+	return append(lines, "// "+deprecationNotice(desc))
 }
 
+// writeGoDocComment writes the doc comment of an element, marking it if deprecated
+func writeGoDocComment(g *protogen.GeneratedFile, indent string, comments protogen.CommentSet, desc protoreflect.Descriptor) {
+	for _, line := range withGoDeprecation(formatGoComment(comments), desc) {
+		g.P(indent, line)
+	}
+}
+
+// goMetadataLiteral renders a metadata value as a Go expression of type any
+func goMetadataLiteral(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return strconv.Quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return v.String()
+		}
+		// Keep large and fractional numbers from becoming untyped int constants
+		return "float64(" + v.String() + ")"
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, goMetadataLiteral(item))
+		}
+		return "[]any{" + strings.Join(items, ", ") + "}"
+	case map[string]any:
+		entries := make([]string, 0, len(v))
+		for _, key := range sortedKeys(v) {
+			entries = append(entries, strconv.Quote(key)+": "+goMetadataLiteral(v[key]))
+		}
+		return "map[string]any{" + strings.Join(entries, ", ") + "}"
+	default:
+		return "nil"
+	}
+}
+
+// Standard library packages referenced by generated Go code
+const (
+	contextPackage = protogen.GoImportPath("context")
+	jsonPackage    = protogen.GoImportPath("encoding/json")
+	fmtPackage     = protogen.GoImportPath("fmt")
+	bytesPackage   = protogen.GoImportPath("bytes")
+	stringsPackage = protogen.GoImportPath("strings")
+	slogPackage    = protogen.GoImportPath("log/slog")
+	sortPackage    = protogen.GoImportPath("sort")
+	sqlPackage     = protogen.GoImportPath("database/sql")
+	strconvPackage = protogen.GoImportPath("strconv")
+)
+
 // GenerateGoFile generates Go code for the given protobuf file
-func GenerateGoFile(gen *protogen.Plugin, file *protogen.File, commonNamespace string) {
+func GenerateGoFile(gen *protogen.Plugin, file *protogen.File, config *Config) {
 	if len(file.Messages) == 0 && len(file.Services) == 0 {
 		return
 	}
 
+	hasServices := len(file.Services) > 0 && config.Features.Services
+	hasClients := len(file.Services) > 0 && config.Features.Clients
+
 	// Generate transport interface based on namespace configuration
-	if len(file.Services) > 0 {
-		if commonNamespace != "" {
+	if hasClients {
+		if config.CommonNamespace != "" {
 			// Generate global transport if namespace is provided
-			generateGlobalTransportGo(gen, commonNamespace)
+			generateGlobalTransportGo(gen, config)
 		} else {
 			// Generate per-package transport if no common namespace is provided
-			generatePackageTransportGo(gen, file)
+			generatePackageTransportGo(gen, file, config)
 		}
 	}
 
-	filename := file.GeneratedFilenamePrefix + ".go"
+	filename := outputPath(config.Output.Go, goFilenamePrefix(file, config)+".go")
 	g := gen.NewGeneratedFile(filename, file.GoImportPath)
 
 	// Generate package declaration
@@ -74,41 +135,7 @@ func GenerateGoFile(gen *protogen.Plugin, file *protogen.File, commonNamespace s
 	g.P("package ", file.GoPackageName)
 	g.P()
 
-	// Generate imports
-	g.P("import (")
-	if len(file.Services) > 0 {
-		g.P(`"context"`)
-	}
-	g.P(`"encoding/json"`)
-	if len(file.Services) > 0 {
-		g.P(`"fmt"`)
-	}
-	
-	// Add fmt import if we have any int enums
-	hasIntEnums := false
-	enumsForImport := collectAllEnums(file)
-	for _, enum := range enumsForImport {
-		directive := parsePuregenDirective(enum.Comments)
-		if directive != nil && directive.EnumType == "int" {
-			hasIntEnums = true
-			break
-		}
-	}
-	if hasIntEnums && len(file.Services) == 0 {
-		g.P(`"fmt"`)
-	}
-	
-	// Import transport interface based on namespace configuration
-	if len(file.Services) > 0 {
-		if commonNamespace != "" {
-			// Import global transport if namespace is provided
-			importPath := strings.ReplaceAll(commonNamespace, ".", "/")
-			g.P(`"`, importPath, `"`)
-		}
-		// For per-package transport, no import needed as it's in the same package
-	}
-	g.P(")")
-	g.P()
+	// Imports are added by protogen for the package identifiers used below
 
 	// Collect and generate imported messages first
 	importedMessages := collectImportedMessages(file)
@@ -116,7 +143,9 @@ func GenerateGoFile(gen *protogen.Plugin, file *protogen.File, commonNamespace s
 		g.P("// Imported Messages (redefined locally)")
 		g.P()
 		for _, msg := range importedMessages {
-			generateGoMessage(g, msg)
+			if !msg.Desc.IsMapEntry() {
+				generateGoMessage(g, file, msg, config)
+			}
 		}
 	}
 
@@ -127,7 +156,7 @@ func GenerateGoFile(gen *protogen.Plugin, file *protogen.File, commonNamespace s
 		g.P()
 	}
 	for _, enum := range allEnums {
-		generateGoEnum(g, enum)
+		generateGoEnum(g, enum, config)
 	}
 
 	// Generate messages
@@ -136,37 +165,42 @@ func GenerateGoFile(gen *protogen.Plugin, file *protogen.File, commonNamespace s
 		g.P()
 	}
 	for _, message := range file.Messages {
-		generateGoMessage(g, message)
+		generateGoMessage(g, file, message, config)
 	}
 
+	generatePackageFieldMaskGo(gen, file, config)
+	generateGoDescriptors(gen, g, file, config)
+	generateGoTypeRegistry(gen, g, file, config)
+	generateGoSQL(g, file, config)
+
 	// Generate services
-	if len(file.Services) > 0 {
+	if hasServices {
 		g.P("// Services")
 		g.P()
-	}
-	for _, service := range file.Services {
-		generateGoService(g, service)
+		for _, service := range file.Services {
+			generateGoService(g, service)
+		}
 	}
 
 	// Generate method name constants
-	if len(file.Services) > 0 {
+	if hasServices || hasClients {
 		g.P("// Method name constants")
 		g.P()
 		for _, service := range file.Services {
-			generateGoMethodConstants(g, service)
+			generateGoMethodConstants(g, service, config)
 		}
 	}
 
-	if len(file.Services) > 0 {
+	if hasClients {
 		g.P("// Client")
 		g.P()
-	}
-	for _, service := range file.Services {
-		generateGoClient(g, service, commonNamespace, file)
+		for _, service := range file.Services {
+			generateGoClient(g, service, config)
+		}
 	}
 }
 
-func generateGoMethodConstants(g *protogen.GeneratedFile, service *protogen.Service) {
+func generateGoMethodConstants(g *protogen.GeneratedFile, service *protogen.Service, config *Config) {
 	serviceName := service.GoName
 
 	g.P("const (")
@@ -177,24 +211,20 @@ func generateGoMethodConstants(g *protogen.GeneratedFile, service *protogen.Serv
 	g.P(")")
 	g.P()
 
+	if !config.Features.Metadata {
+		return
+	}
+
 	// Generate method metadata map
-	g.P("var ", serviceName, "MethodMetadata = map[string]map[string]string{")
+	g.P("var ", serviceName, "MethodMetadata = map[string]map[string]any{")
 	for _, method := range service.Methods {
 		constName := serviceName + "_" + method.GoName
-		metadata := parseMethodMetadata(method.Comments)
+		metadata := parseMethodMetadata(method, config)
 		if metadata != nil {
 			g.P("	", constName, ": {")
 
-			// Sort keys for consistent output
-			var keys []string
-			for key := range metadata {
-				keys = append(keys, key)
-			}
-			sort.Strings(keys)
-
-			for _, key := range keys {
-				value := metadata[key]
-				g.P("		\"", key, "\": \"", value, "\",")
+			for _, key := range sortedKeys(metadata) {
+				g.P("		", strconv.Quote(key), ": ", goMetadataLiteral(metadata[key]), ",")
 			}
 			g.P("	},")
 		}
@@ -203,23 +233,28 @@ func generateGoMethodConstants(g *protogen.GeneratedFile, service *protogen.Serv
 	g.P()
 }
 
-func generateGoEnum(g *protogen.GeneratedFile, enum *protogen.Enum) {
+func generateGoEnum(g *protogen.GeneratedFile, enum *protogen.Enum, config *Config) {
 	enumName := enum.GoIdent.GoName
 
 	// Parse puregen directive to determine enum type
-	directive := parsePuregenDirective(enum.Comments)
+	directive := parseEnumDirective(enum, config)
 	useStringConstants := true // Default to string constants
 	
 	if directive != nil && directive.EnumType == "int" {
 		useStringConstants = false
 	}
 
-	if useStringConstants {
+	if isGoTypedStringEnum(enum, config) {
+		generateGoTypedStringEnum(g, enum, directive.Strict)
+	} else if useStringConstants {
 		// Generate string constants
-		g.P("// ", enumName, " enum values as string constants")
+		for _, line := range withGoDeprecation([]string{"// " + enumName + " enum values as string constants"}, enum.Desc) {
+			g.P(line)
+		}
 		g.P("const (")
 		for _, value := range enum.Values {
 			valueName := value.GoIdent.GoName
+			writeGoDocComment(g, "	", protogen.CommentSet{}, value.Desc)
 			g.P("	", valueName, " = \"", value.Desc.Name(), "\"")
 		}
 		g.P(")")
@@ -246,26 +281,33 @@ func generateGoEnum(g *protogen.GeneratedFile, enum *protogen.Enum) {
 		g.P()
 	} else {
 		// Generate traditional int32 enum
+		writeGoDocComment(g, "", protogen.CommentSet{}, enum.Desc)
 		g.P("type ", enumName, " int32")
 		g.P()
 
-		// Generate constants
+		// Generate constants; an alias shares the number of an earlier value
 		g.P("const (")
-		for i, value := range enum.Values {
+		for _, value := range enum.Values {
 			valueName := value.GoIdent.GoName
-			if i == 0 {
-				g.P("	", valueName, " ", enumName, " = ", value.Desc.Number())
-			} else {
-				g.P("	", valueName, " = ", value.Desc.Number())
+			var comment []string
+			if canonical := canonicalEnumValue(enum, value); canonical != value {
+				comment = append(comment, "// "+valueName+" is an alias of "+canonical.GoIdent.GoName)
 			}
+			for _, line := range withGoDeprecation(comment, value.Desc) {
+				g.P("	", line)
+			}
+			g.P("	", valueName, " ", enumName, " = ", value.Desc.Number())
 		}
 		g.P(")")
 		g.P()
 
-		// Generate value map for String() method
+		// Generate value map for String() method, which names aliased numbers
+		// after their first value
 		g.P("var ", enumName, "_name = map[int32]string{")
 		for _, value := range enum.Values {
-			g.P("	", value.Desc.Number(), ": \"", value.Desc.Name(), "\",")
+			if canonicalEnumValue(enum, value) == value {
+				g.P("	", value.Desc.Number(), ": \"", value.Desc.Name(), "\",")
+			}
 		}
 		g.P("}")
 		g.P()
@@ -283,7 +325,7 @@ func generateGoEnum(g *protogen.GeneratedFile, enum *protogen.Enum) {
 		g.P("	if name, ok := ", enumName, "_name[int32(x)]; ok {")
 		g.P("		return name")
 		g.P("	}")
-		g.P("	return fmt.Sprintf(\"", enumName, "(%d)\", x)")
+		g.P("	return ", fmtPackage.Ident("Sprintf"), "(\"", enumName, "(%d)\", x)")
 		g.P("}")
 		g.P()
 
@@ -292,7 +334,7 @@ func generateGoEnum(g *protogen.GeneratedFile, enum *protogen.Enum) {
 		g.P("	if value, ok := ", enumName, "_value[s]; ok {")
 		g.P("		return ", enumName, "(value), nil")
 		g.P("	}")
-		g.P("	return 0, fmt.Errorf(\"invalid ", enumName, " value: %s\", s)")
+		g.P("	return 0, ", fmtPackage.Ident("Errorf"), "(\"invalid ", enumName, " value: %s\", s)")
 		g.P("}")
 		g.P()
 
@@ -302,114 +344,214 @@ func generateGoEnum(g *protogen.GeneratedFile, enum *protogen.Enum) {
 		g.P("	return ok")
 		g.P("}")
 		g.P()
+
+		generateGoEnumJSON(g, enum, config)
 	}
 
 	// Generate enum metadata if available
-	enumMetadata := parseEnumMetadata(enum.Comments)
-	if enumMetadata != nil {
+	enumMetadata := parseEnumMetadata(enum, config)
+	if enumMetadata != nil && config.Features.Metadata {
 		g.P("// ", enumName, "Metadata contains metadata for ", enumName)
-		g.P("var ", enumName, "Metadata = map[string]string{")
-		// Sort keys for consistent output
-		var keys []string
-		for key := range enumMetadata {
-			keys = append(keys, key)
+		g.P("var ", enumName, "Metadata = map[string]any{")
+		for _, key := range sortedKeys(enumMetadata) {
+			g.P("	", strconv.Quote(key), ": ", goMetadataLiteral(enumMetadata[key]), ",")
 		}
-		sort.Strings(keys)
+		g.P("}")
+		g.P()
+	}
+
+	generateGoEnumValueMetadata(g, enum, useStringConstants && !isGoTypedStringEnum(enum, config), config)
+}
+
+// generateGoEnumValueMetadata writes the per-value metadata map and, when any
+// value declares a display_name, the display name accessor. Integer enums key
+// both by canonical value, so aliases share the entry of the value they alias.
+func generateGoEnumValueMetadata(g *protogen.GeneratedFile, enum *protogen.Enum, stringConstants bool, config *Config) {
+	enumName := enum.GoIdent.GoName
+	keyType := enumName
+	if stringConstants {
+		keyType = "string"
+	}
+	isAlias := func(value *protogen.EnumValue) bool {
+		return !stringConstants && canonicalEnumValue(enum, value) != value
+	}
 
-		for _, key := range keys {
-			value := enumMetadata[key]
-			g.P("	\"", key, "\": \"", value, "\",")
+	if config.Features.Metadata && hasEnumValueMetadata(enum) {
+		g.P("// ", enumName, "ValueMetadata contains metadata for each ", enumName, " value")
+		g.P("var ", enumName, "ValueMetadata = map[", keyType, "]map[string]any{")
+		for _, value := range enum.Values {
+			metadata := parseEnumValueMetadata(value)
+			if metadata == nil || isAlias(value) {
+				continue
+			}
+			g.P("	", value.GoIdent.GoName, ": {")
+			for _, key := range sortedKeys(metadata) {
+				g.P("		", strconv.Quote(key), ": ", goMetadataLiteral(metadata[key]), ",")
+			}
+			g.P("	},")
 		}
 		g.P("}")
 		g.P()
 	}
+
+	if !hasEnumDisplayNames(enum) {
+		return
+	}
+	if stringConstants {
+		g.P("// ", enumName, "DisplayName returns the display name of a ", enumName, " value, or the value itself")
+		g.P("func ", enumName, "DisplayName(value string) string {")
+		g.P("	switch value {")
+	} else {
+		g.P("// DisplayName returns the display name of x, or its name if it has none")
+		g.P("func (x ", enumName, ") DisplayName() string {")
+		g.P("	switch x {")
+	}
+	for _, value := range enum.Values {
+		if isAlias(value) {
+			continue
+		}
+		g.P("	case ", value.GoIdent.GoName, ":")
+		g.P("		return ", strconv.Quote(enumDisplayName(enum, value)))
+	}
+	g.P("	}")
+	if stringConstants {
+		g.P("	return value")
+	} else {
+		g.P("	return x.String()")
+	}
+	g.P("}")
+	g.P()
 }
 
-func generateGoMessage(g *protogen.GeneratedFile, msg *protogen.Message) {
+// goOptionName returns the name of the functional option setting a field of a
+// message generated in file, With<Message><Field>. When another field of the
+// Go package would give the same name, as Order.item_count and OrderItem.count
+// both give WithOrderItemCount, the options are named With<Message>_<Field>.
+func goOptionName(file *protogen.File, msg *protogen.Message, field *protogen.Field, config *Config) string {
+	name := "With" + msg.GoIdent.GoName + field.GoName
+	if config.state.goOptionNameCounts(file.GoImportPath)[name] > 1 {
+		return "With" + msg.GoIdent.GoName + "_" + field.GoName
+	}
+	return name
+}
+
+func generateGoMessage(g *protogen.GeneratedFile, file *protogen.File, msg *protogen.Message, config *Config) {
 	// Generate message comment
-	writeGoComment(g, msg.Comments)
+	writeGoDocComment(g, "", msg.Comments, msg.Desc)
 
 	// Generate struct
 	g.P("type ", msg.GoIdent.GoName, " struct {")
 	for _, field := range msg.Fields {
 		// Generate field comment
-		if commentLines := formatGoComment(field.Comments); len(commentLines) > 0 {
-			for _, line := range commentLines {
-				g.P("	", line)
-			}
-		}
+		writeGoDocComment(g, "	", field.Comments, field.Desc)
 
-		fieldType := getGoFieldType(field)
-		g.P("	", field.GoName, " ", fieldType, " `json:\"", field.Desc.JSONName(), "\"`")
+		fieldType := getGoFieldType(field, config)
+		jsonTag := jsonFieldName(field, config)
+		if fieldJSONConfig(field, config).OmitEmpty {
+			jsonTag += ",omitempty"
+		}
+		g.P("	", field.GoName, " ", fieldType, " `json:\"", jsonTag, "\"`")
 	}
 	g.P("}")
 	g.P()
 
-	// Generate constructor
-	g.P("func New", msg.GoIdent.GoName, "() *", msg.GoIdent.GoName, " {")
+	// Generate functional options and constructor
+	g.P("// ", msg.GoIdent.GoName, "Option sets a field of a new ", msg.GoIdent.GoName)
+	g.P("type ", msg.GoIdent.GoName, "Option func(*", msg.GoIdent.GoName, ")")
+	g.P()
+	for _, field := range msg.Fields {
+		optionName := goOptionName(file, msg, field, config)
+		for _, line := range withGoDeprecation([]string{"// " + optionName + " sets the " + field.GoName + " field"}, field.Desc) {
+			g.P(line)
+		}
+		g.P("func ", optionName, "(value ", getGoFieldType(field, config), ") ", msg.GoIdent.GoName, "Option {")
+		g.P("	return func(m *", msg.GoIdent.GoName, ") {")
+		g.P("		m.", field.GoName, " = value")
+		g.P("	}")
+		g.P("}")
+		g.P()
+	}
+
+	g.P("func New", msg.GoIdent.GoName, "(opts ...", msg.GoIdent.GoName, "Option) *", msg.GoIdent.GoName, " {")
 	
 	// Check if any fields have default values
 	hasDefaults := false
 	for _, field := range msg.Fields {
-		if getGoDefaultValue(field) != "" {
+		if getGoDefaultValue(field, config) != "" {
 			hasDefaults = true
 			break
 		}
 	}
 	
 	if hasDefaults {
-		g.P("	return &", msg.GoIdent.GoName, "{")
+		g.P("	m := &", msg.GoIdent.GoName, "{")
 		for _, field := range msg.Fields {
-			defaultValue := getGoDefaultValue(field)
+			defaultValue := getGoDefaultValue(field, config)
 			if defaultValue != "" {
 				g.P("		", field.GoName, ": ", defaultValue, ",")
 			}
 		}
 		g.P("	}")
 	} else {
-		g.P("	return &", msg.GoIdent.GoName, "{}")
+		g.P("	m := &", msg.GoIdent.GoName, "{}")
 	}
+	g.P("	for _, opt := range opts {")
+	g.P("		opt(m)")
+	g.P("	}")
+	g.P("	return m")
 	g.P("}")
 	g.P()
 
+	generateGoGetters(g, msg, config)
+	generateGoClone(g, msg, config)
+	generateGoEqual(g, msg)
+	generateGoFieldPaths(g, msg)
+	generateGoApplyMask(g, msg, config)
+	generateGoMerge(g, msg, config)
+	generateGoFieldMaskMethods(g, msg)
+	generateGoRedacted(g, msg, config)
+	generateGoLogValue(g, msg, config)
+
 	// Generate validation method
-	g.P("func (m *", msg.GoIdent.GoName, ") Validate() error {")
-	g.P("	// Add custom validation logic here")
-	g.P("	return nil")
-	g.P("}")
-	g.P()
+	if config.Features.Validation {
+		g.P("func (m *", msg.GoIdent.GoName, ") Validate() error {")
+		g.P("	// Add custom validation logic here")
+		g.P("	return nil")
+		g.P("}")
+		g.P()
+	}
 
 	// Generate JSON serialization methods
 	g.P("func (m *", msg.GoIdent.GoName, ") ToJSON() ([]byte, error) {")
-	g.P("	return json.Marshal(m)")
+	g.P("	return ", jsonPackage.Ident("Marshal"), "(m)")
 	g.P("}")
 	g.P()
 
 	g.P("func (m *", msg.GoIdent.GoName, ") FromJSON(data []byte) error {")
-	g.P("	return json.Unmarshal(data, m)")
+	g.P("	return ", jsonPackage.Ident("Unmarshal"), "(data, m)")
 	g.P("}")
 	g.P()
 
-	// Generate nested messages
+	generateGoUnmarshalJSON(g, msg, config)
+
+	// Generate nested messages; map entries become Go maps
 	for _, nested := range msg.Messages {
-		generateGoMessage(g, nested)
+		if !nested.Desc.IsMapEntry() {
+			generateGoMessage(g, file, nested, config)
+		}
+	}
+
+	if !config.Features.Metadata {
+		return
 	}
 
 	// Generate message metadata if available
-	messageMetadata := parseMessageMetadata(msg.Comments)
+	messageMetadata := parseMessageMetadata(msg, config)
 	if messageMetadata != nil {
 		g.P("// ", msg.GoIdent.GoName, "Metadata contains metadata for ", msg.GoIdent.GoName)
-		g.P("var ", msg.GoIdent.GoName, "Metadata = map[string]string{")
-		// Sort keys for consistent output
-		var keys []string
-		for key := range messageMetadata {
-			keys = append(keys, key)
-		}
-		sort.Strings(keys)
-
-		for _, key := range keys {
-			value := messageMetadata[key]
-			g.P("	\"", key, "\": \"", value, "\",")
+		g.P("var ", msg.GoIdent.GoName, "Metadata = map[string]any{")
+		for _, key := range sortedKeys(messageMetadata) {
+			g.P("	", strconv.Quote(key), ": ", goMetadataLiteral(messageMetadata[key]), ",")
 		}
 		g.P("}")
 		g.P()
@@ -418,7 +560,7 @@ func generateGoMessage(g *protogen.GeneratedFile, msg *protogen.Message) {
 	// Generate field constants and metadata if any fields have metadata
 	hasFieldMetadata := false
 	for _, field := range msg.Fields {
-		fieldMetadata := parseFieldMetadata(field.Comments)
+		fieldMetadata := parseFieldMetadata(field, config)
 		if fieldMetadata != nil {
 			hasFieldMetadata = true
 			break
@@ -430,7 +572,7 @@ func generateGoMessage(g *protogen.GeneratedFile, msg *protogen.Message) {
 		g.P("// Field name constants for ", msg.GoIdent.GoName)
 		g.P("const (")
 		for _, field := range msg.Fields {
-			fieldMetadata := parseFieldMetadata(field.Comments)
+			fieldMetadata := parseFieldMetadata(field, config)
 			if fieldMetadata != nil {
 				constName := msg.GoIdent.GoName + "_" + field.GoName + "_FIELD"
 				constValue := msg.GoIdent.GoName + "_" + field.GoName
@@ -442,23 +584,15 @@ func generateGoMessage(g *protogen.GeneratedFile, msg *protogen.Message) {
 
 		// Generate MessageField metadata map
 		g.P("// MessageField metadata for ", msg.GoIdent.GoName)
-		g.P("var ", msg.GoIdent.GoName, "FieldMetadata = map[string]map[string]string{")
+		g.P("var ", msg.GoIdent.GoName, "FieldMetadata = map[string]map[string]any{")
 		for _, field := range msg.Fields {
-			fieldMetadata := parseFieldMetadata(field.Comments)
+			fieldMetadata := parseFieldMetadata(field, config)
 			if fieldMetadata != nil {
 				constName := msg.GoIdent.GoName + "_" + field.GoName + "_FIELD"
 				g.P("	", constName, ": {")
 
-				// Sort keys for consistent output
-				var keys []string
-				for key := range fieldMetadata {
-					keys = append(keys, key)
-				}
-				sort.Strings(keys)
-
-				for _, key := range keys {
-					value := fieldMetadata[key]
-					g.P("		\"", key, "\": \"", value, "\",")
+				for _, key := range sortedKeys(fieldMetadata) {
+					g.P("		", strconv.Quote(key), ": ", goMetadataLiteral(fieldMetadata[key]), ",")
 				}
 				g.P("	},")
 			}
@@ -468,25 +602,164 @@ func generateGoMessage(g *protogen.GeneratedFile, msg *protogen.Message) {
 	}
 }
 
+// generateGoGetters writes nil-safe GetXxx methods for every field
+func generateGoGetters(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	for _, field := range msg.Fields {
+		for _, line := range withGoDeprecation([]string{"// Get" + field.GoName + " returns the " + field.GoName + " field, or its zero value if m is nil"}, field.Desc) {
+			g.P(line)
+		}
+		g.P("func (m *", msg.GoIdent.GoName, ") Get", field.GoName, "() ", getGoFieldType(field, config), " {")
+		g.P("	if m != nil {")
+		g.P("		return m.", field.GoName)
+		g.P("	}")
+		g.P("	return ", goZeroValue(field, config))
+		g.P("}")
+		g.P()
+	}
+}
+
+// generateGoClone writes a Clone method copying slices, maps, byte slices and nested messages
+func generateGoClone(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	g.P("// Clone returns a deep copy of m")
+	g.P("func (m *", msg.GoIdent.GoName, ") Clone() *", msg.GoIdent.GoName, " {")
+	g.P("	if m == nil {")
+	g.P("		return nil")
+	g.P("	}")
+	g.P("	c := *m")
+	for _, field := range msg.Fields {
+		name := field.GoName
+		isBytes := field.Desc.Kind().String() == "bytes"
+		switch {
+		case field.Desc.IsMap():
+			value := field.Message.Fields[1]
+			g.P("	if m.", name, " != nil {")
+			g.P("		c.", name, " = make(", getGoFieldType(field, config), ", len(m.", name, "))")
+			g.P("		for k, v := range m.", name, " {")
+			switch {
+			case value.Message != nil:
+				g.P("			c.", name, "[k] = v.Clone()")
+			case value.Desc.Kind().String() == "bytes":
+				g.P("			if v != nil {")
+				g.P("				v = append([]byte{}, v...)")
+				g.P("			}")
+				g.P("			c.", name, "[k] = v")
+			default:
+				g.P("			c.", name, "[k] = v")
+			}
+			g.P("		}")
+			g.P("	}")
+		case field.Desc.IsList():
+			g.P("	if m.", name, " != nil {")
+			g.P("		c.", name, " = make(", getGoFieldType(field, config), ", len(m.", name, "))")
+			switch {
+			case field.Message != nil:
+				g.P("		for i, v := range m.", name, " {")
+				g.P("			c.", name, "[i] = v.Clone()")
+				g.P("		}")
+			case isBytes:
+				g.P("		for i, v := range m.", name, " {")
+				g.P("			if v != nil {")
+				g.P("				c.", name, "[i] = append([]byte{}, v...)")
+				g.P("			}")
+				g.P("		}")
+			default:
+				g.P("		copy(c.", name, ", m.", name, ")")
+			}
+			g.P("	}")
+		case field.Message != nil:
+			g.P("	c.", name, " = m.", name, ".Clone()")
+		case isBytes:
+			g.P("	if m.", name, " != nil {")
+			g.P("		c.", name, " = append([]byte{}, m.", name, "...)")
+			g.P("	}")
+		}
+	}
+	g.P("	return &c")
+	g.P("}")
+	g.P()
+}
+
+// generateGoEqual writes an Equal method with proto semantics: nil and empty
+// slices, maps and byte slices are equal, unset and empty messages are not
+func generateGoEqual(g *protogen.GeneratedFile, msg *protogen.Message) {
+	g.P("// Equal reports whether m and other hold the same field values. Nil and")
+	g.P("// empty lists, maps and bytes are equal, as in proto; unset and empty messages are not.")
+	g.P("func (m *", msg.GoIdent.GoName, ") Equal(other *", msg.GoIdent.GoName, ") bool {")
+	g.P("	if m == nil || other == nil {")
+	g.P("		return m == other")
+	g.P("	}")
+	for _, field := range msg.Fields {
+		name := field.GoName
+		isBytes := field.Desc.Kind().String() == "bytes"
+		switch {
+		case field.Desc.IsMap():
+			value := field.Message.Fields[1]
+			g.P("	if len(m.", name, ") != len(other.", name, ") {")
+			g.P("		return false")
+			g.P("	}")
+			g.P("	for k, v := range m.", name, " {")
+			g.P("		w, ok := other.", name, "[k]")
+			switch {
+			case value.Message != nil:
+				g.P("		if !ok || !v.Equal(w) {")
+			case value.Desc.Kind().String() == "bytes":
+				g.P("		if !ok || !", bytesPackage.Ident("Equal"), "(v, w) {")
+			default:
+				g.P("		if !ok || v != w {")
+			}
+			g.P("			return false")
+			g.P("		}")
+			g.P("	}")
+		case field.Desc.IsList():
+			g.P("	if len(m.", name, ") != len(other.", name, ") {")
+			g.P("		return false")
+			g.P("	}")
+			g.P("	for i := range m.", name, " {")
+			switch {
+			case field.Message != nil:
+				g.P("		if !m.", name, "[i].Equal(other.", name, "[i]) {")
+			case isBytes:
+				g.P("		if !", bytesPackage.Ident("Equal"), "(m.", name, "[i], other.", name, "[i]) {")
+			default:
+				g.P("		if m.", name, "[i] != other.", name, "[i] {")
+			}
+			g.P("			return false")
+			g.P("		}")
+			g.P("	}")
+		case field.Message != nil:
+			g.P("	if !m.", name, ".Equal(other.", name, ") {")
+			g.P("		return false")
+			g.P("	}")
+		case isBytes:
+			g.P("	if !", bytesPackage.Ident("Equal"), "(m.", name, ", other.", name, ") {")
+			g.P("		return false")
+			g.P("	}")
+		default:
+			g.P("	if m.", name, " != other.", name, " {")
+			g.P("		return false")
+			g.P("	}")
+		}
+	}
+	g.P("	return true")
+	g.P("}")
+	g.P()
+}
+
 func generateGoService(g *protogen.GeneratedFile, service *protogen.Service) {
 	serviceName := service.GoName
 
 	// Generate service comment
-	writeGoComment(g, service.Comments)
+	writeGoDocComment(g, "", service.Comments, service.Desc)
 
 	// Generate interface
 	g.P("type ", serviceName, "Service interface {")
 	for _, method := range service.Methods {
 		// Generate method comment
-		if commentLines := formatGoComment(method.Comments); len(commentLines) > 0 {
-			for _, line := range commentLines {
-				g.P("	", line)
-			}
-		}
+		writeGoDocComment(g, "	", method.Comments, method.Desc)
 
 		inputType := method.Input.GoIdent.GoName
 		outputType := method.Output.GoIdent.GoName
-		g.P("	", method.GoName, "(ctx context.Context, req *", inputType, ") (*", outputType, ", error)")
+		g.P("	", method.GoName, "(ctx ", contextPackage.Ident("Context"), ", req *", inputType, ") (*", outputType, ", error)")
 	}
 	g.P("}")
 	g.P()
@@ -497,38 +770,29 @@ func generateGoService(g *protogen.GeneratedFile, service *protogen.Service) {
 
 	for _, method := range service.Methods {
 		// Generate method comment
-		if commentLines := formatGoComment(method.Comments); len(commentLines) > 0 {
-			for _, line := range commentLines {
-				g.P(line)
-			}
-		}
+		writeGoDocComment(g, "", method.Comments, method.Desc)
 
 		inputType := method.Input.GoIdent.GoName
 		outputType := method.Output.GoIdent.GoName
-		g.P("func (s *Default", serviceName, "Service) ", method.GoName, "(ctx context.Context, req *", inputType, ") (*", outputType, ", error) {")
+		g.P("func (s *Default", serviceName, "Service) ", method.GoName, "(ctx ", contextPackage.Ident("Context"), ", req *", inputType, ") (*", outputType, ", error) {")
 		g.P("	// TODO: Implement ", method.GoName)
-		g.P("	return &", outputType, "{}, fmt.Errorf(\"method ", method.GoName, " not implemented\")")
+		g.P("	return &", outputType, "{}, ", fmtPackage.Ident("Errorf"), "(\"method ", method.GoName, " not implemented\")")
 		g.P("}")
 		g.P()
 	}
 }
 
-func generateGoClient(g *protogen.GeneratedFile, service *protogen.Service, commonNamespace string, file *protogen.File) {
+func generateGoClient(g *protogen.GeneratedFile, service *protogen.Service, config *Config) {
 	serviceName := service.GoName
 
-	// Only generate Transport interface if no global namespace and no per-package transport is provided
-	// (This case should not happen anymore, but keeping for backward compatibility)
-	if commonNamespace == "" {
-		// Per-package transport is generated separately, so no inline transport needed
-	}
-
 	// Generate client struct
 	var transportTypeName string
-	if commonNamespace != "" {
-		// For global namespace, use qualified name
-		parts := strings.Split(commonNamespace, ".")
-		packageName := parts[len(parts)-1]
-		transportTypeName = packageName + ".PuregenTransport"
+	if config.CommonNamespace != "" {
+		// For global namespace, protogen qualifies the name and adds the import
+		transportTypeName = g.QualifiedGoIdent(protogen.GoIdent{
+			GoName:       "PuregenTransport",
+			GoImportPath: commonGoImportPath(config),
+		})
 	} else {
 		// For per-package transport, use direct name
 		transportTypeName = "PuregenTransport"
@@ -548,19 +812,17 @@ func generateGoClient(g *protogen.GeneratedFile, service *protogen.Service, comm
 	// Generate client methods
 	for _, method := range service.Methods {
 		// Generate method comment
-		if commentLines := formatGoComment(method.Comments); len(commentLines) > 0 {
-			for _, line := range commentLines {
-				g.P(line)
-			}
-		}
+		writeGoDocComment(g, "", method.Comments, method.Desc)
 
 		inputType := method.Input.GoIdent.GoName
 		outputType := method.Output.GoIdent.GoName
 		constName := serviceName + "_" + method.GoName
-		g.P("func (c *", serviceName, "Client) ", method.GoName, "(ctx context.Context, req *", inputType, ") (*", outputType, ", error) {")
-		g.P("	if metadata, exists := ", serviceName, "MethodMetadata[", constName, "]; exists {")
-		g.P("		ctx = context.WithValue(ctx, \"method_metadata\", metadata)")
-		g.P("	}")
+		g.P("func (c *", serviceName, "Client) ", method.GoName, "(ctx ", contextPackage.Ident("Context"), ", req *", inputType, ") (*", outputType, ", error) {")
+		if config.Features.Metadata {
+			g.P("	if metadata, exists := ", serviceName, "MethodMetadata[", constName, "]; exists {")
+			g.P("		ctx = ", contextPackage.Ident("WithValue"), "(ctx, \"method_metadata\", metadata)")
+			g.P("	}")
+		}
 		g.P("	result, err := c.transport.Send(ctx, ", constName, ", req, (*", outputType, ")(nil))")
 		g.P("	if err != nil {")
 		g.P("		return nil, err")
@@ -568,13 +830,18 @@ func generateGoClient(g *protogen.GeneratedFile, service *protogen.Service, comm
 		g.P("	if response, ok := result.(*", outputType, "); ok {")
 		g.P("		return response, nil")
 		g.P("	}")
-		g.P("	return nil, fmt.Errorf(\"invalid response type for ", method.GoName, "\")")
+		g.P("	return nil, ", fmtPackage.Ident("Errorf"), "(\"invalid response type for ", method.GoName, "\")")
 		g.P("}")
 		g.P()
 	}
 }
 
-func getGoFieldType(field *protogen.Field) string {
+func getGoFieldType(field *protogen.Field, config *Config) string {
+	// Map fields are Go maps keyed by the entry's key type
+	if field.Desc.IsMap() {
+		return "map[" + getGoFieldType(field.Message.Fields[0], config) + "]" + getGoFieldType(field.Message.Fields[1], config)
+	}
+
 	var baseType string
 
 	switch field.Desc.Kind().String() {
@@ -598,16 +865,15 @@ func getGoFieldType(field *protogen.Field) string {
 		baseType = "[]byte"
 	case "enum":
 		// Check if enum is using string constants
-		directive := parsePuregenDirective(field.Enum.Comments)
-		if directive == nil || directive.EnumType != "int" {
+		if isGoTypedStringEnum(field.Enum, config) || !isStringEnum(field.Enum, config) {
+			// Typed string and integer enums have a type of their own
+			baseType = field.Enum.GoIdent.GoName
+		} else {
 			// Default to string constants
 			baseType = "string"
-		} else {
-			// Use integer enum type
-			baseType = field.Enum.GoIdent.GoName
 		}
 	case "message":
-		baseType = "*" + field.Message.GoIdent.GoName
+		baseType = "*" + messageTypeName(field.Message)
 	default:
 		baseType = "interface{}"
 	}
@@ -620,9 +886,113 @@ func getGoFieldType(field *protogen.Field) string {
 	return baseType
 }
 
+// isGoTypedStringEnum reports whether an enum is generated as a named string
+// type, with {"enumType": "typed_string"}
+func isGoTypedStringEnum(enum *protogen.Enum, config *Config) bool {
+	directive := parseEnumDirective(enum, config)
+	return directive != nil && directive.EnumType == "typed_string"
+}
+
+// generateGoTypedStringEnum writes a named string type with typed constants,
+// Values, IsValid and Parse helpers. Strict enums also reject unknown values
+// when decoding JSON.
+func generateGoTypedStringEnum(g *protogen.GeneratedFile, enum *protogen.Enum, strict bool) {
+	enumName := enum.GoIdent.GoName
+
+	for _, line := range withGoDeprecation([]string{"// " + enumName + " enum values as a string type"}, enum.Desc) {
+		g.P(line)
+	}
+	g.P("type ", enumName, " string")
+	g.P()
+	g.P("const (")
+	for _, value := range enum.Values {
+		writeGoDocComment(g, "	", protogen.CommentSet{}, value.Desc)
+		g.P("	", value.GoIdent.GoName, " ", enumName, " = ", strconv.Quote(string(value.Desc.Name())))
+	}
+	g.P(")")
+	g.P()
+
+	g.P("// ", enumName, "Values returns every ", enumName, " value")
+	g.P("func ", enumName, "Values() []", enumName, " {")
+	g.P("	return []", enumName, "{")
+	for _, value := range enum.Values {
+		g.P("		", value.GoIdent.GoName, ",")
+	}
+	g.P("	}")
+	g.P("}")
+	g.P()
+
+	g.P("func (x ", enumName, ") String() string {")
+	g.P("	return string(x)")
+	g.P("}")
+	g.P()
+
+	g.P("// IsValid reports whether x is one of the ", enumName, " values")
+	g.P("func (x ", enumName, ") IsValid() bool {")
+	g.P("	switch x {")
+	names := make([]string, len(enum.Values))
+	for i, value := range enum.Values {
+		names[i] = value.GoIdent.GoName
+	}
+	g.P("	case ", strings.Join(names, ", "), ":")
+	g.P("		return true")
+	g.P("	}")
+	g.P("	return false")
+	g.P("}")
+	g.P()
+
+	g.P("func Parse", enumName, "(s string) (", enumName, ", error) {")
+	g.P("	if x := ", enumName, "(s); x.IsValid() {")
+	g.P("		return x, nil")
+	g.P("	}")
+	g.P("	return \"\", ", fmtPackage.Ident("Errorf"), "(\"invalid ", enumName, " value: %s\", s)")
+	g.P("}")
+	g.P()
+
+	if !strict {
+		return
+	}
+	g.P("// UnmarshalJSON rejects values other than the ", enumName, " values")
+	g.P("func (x *", enumName, ") UnmarshalJSON(data []byte) error {")
+	g.P("	var s string")
+	g.P("	if err := ", jsonPackage.Ident("Unmarshal"), "(data, &s); err != nil {")
+	g.P("		return err")
+	g.P("	}")
+	g.P("	value, err := Parse", enumName, "(s)")
+	g.P("	if err != nil {")
+	g.P("		return err")
+	g.P("	}")
+	g.P("	*x = value")
+	g.P("	return nil")
+	g.P("}")
+	g.P()
+}
+
+// goZeroValue returns the zero value literal of a field's Go type
+func goZeroValue(field *protogen.Field, config *Config) string {
+	if field.Desc.IsList() {
+		return "nil"
+	}
+	if field.Enum != nil && isGoTypedStringEnum(field.Enum, config) {
+		return `""`
+	}
+	switch getGoFieldType(field, config) {
+	case "bool":
+		return "false"
+	case "string":
+		return `""`
+	case "[]byte", "interface{}":
+		return "nil"
+	}
+	if field.Message != nil {
+		return "nil"
+	}
+	return "0"
+}
+
 // getGoDefaultValue returns the Go default value for a field based on puregen directive
-func getGoDefaultValue(field *protogen.Field) string {
-	directive := parseFieldDirective(field.Comments)
+func getGoDefaultValue(field *protogen.Field, config *Config) string {
+	directive := parseFieldDirective(field, config)
 	if directive != nil && directive.Value != "" {
 		// Convert the value to Go syntax based on field type
 		switch field.Desc.Kind().String() {
@@ -647,32 +1017,24 @@ func getGoDefaultValue(field *protogen.Field) string {
 		}
 	}
 	// Special case: check for empty string directive
-	if directive != nil && directive.Value == "" && field.Desc.Kind().String() == "string" {
+	if directive != nil && directive.Value == "" && field.Desc.Kind().String() == "string" && !field.Desc.IsList() {
 		return `""`
 	}
 	return ""
 }
 
-// Track created transport namespaces to avoid duplicates for Go
-var createdTransportNamespacesGo = make(map[string]bool)
-
-// Track created per-package transports to avoid duplicates for Go
-var createdPackageTransportsGo = make(map[string]bool)
-
 // generatePackageTransportGo creates a Transport interface in the same package as the proto file
-func generatePackageTransportGo(gen *protogen.Plugin, file *protogen.File) {
-	// Use the package path as the key to avoid duplicates
-	packageKey := string(file.GoImportPath)
-	
-	// Only create once per package
-	if createdPackageTransportsGo[packageKey] {
+func generatePackageTransportGo(gen *protogen.Plugin, file *protogen.File, config *Config) {
+	// Create the transport Go file in the same package
+	fileDir := filepath.Dir(goFilenamePrefix(file, config))
+	filename := outputPath(config.Output.Go, filepath.Join(fileDir, "puregen_transport.go"))
+
+	// Only create once per output file: with paths=source_relative, protos of
+	// several packages can share a directory
+	if !config.state.createOnce(filename) {
 		return
 	}
-	createdPackageTransportsGo[packageKey] = true
 
-	// Create the transport Go file in the same package
-	fileDir := filepath.Dir(file.GeneratedFilenamePrefix)
-	filename := filepath.Join(fileDir, "puregen_transport.go")
 	g := gen.NewGeneratedFile(filename, file.GoImportPath)
 
 	// Generate file header
@@ -681,29 +1043,28 @@ func generatePackageTransportGo(gen *protogen.Plugin, file *protogen.File) {
 	g.P()
 	g.P("package ", file.GoPackageName)
 	g.P()
-	g.P("import (")
-	g.P(`	"context"`)
-	g.P(")")
-	g.P()
 
 	// Generate Transport interface
 	g.P("// PuregenTransport defines the interface for client communication")
 	g.P("type PuregenTransport interface {")
-	g.P("	Send(ctx context.Context, methodName string, inputData interface{}, outputType interface{}) (interface{}, error)")
+	g.P("	Send(ctx ", contextPackage.Ident("Context"), ", methodName string, inputData interface{}, outputType interface{}) (interface{}, error)")
 	g.P("}")
 }
 
 // generateGlobalTransportGo creates a global Transport interface in the specified namespace
-func generateGlobalTransportGo(gen *protogen.Plugin, commonNamespace string) {
-	// Only create once per namespace
-	if createdTransportNamespacesGo[commonNamespace] {
+func generateGlobalTransportGo(gen *protogen.Plugin, config *Config) {
+	commonNamespace := config.CommonNamespace
+
+	// Create the transport Go file at its import path, like any other Go package;
+	// protogen strips the module= prefix from it
+	importPath := commonGoImportPath(config)
+	filename := outputPath(config.Output.Go, path.Join(string(importPath), "transport.go"))
+
+	// Only create once per output file
+	if !config.state.createOnce(filename) {
 		return
 	}
-	createdTransportNamespacesGo[commonNamespace] = true
-
-	// Create the transport Go file
-	filename := strings.ReplaceAll(commonNamespace, ".", "/") + "/transport.go"
-	g := gen.NewGeneratedFile(filename, "")
+	g := gen.NewGeneratedFile(filename, importPath)
 
 	// Get package name (last part of namespace)
 	parts := strings.Split(commonNamespace, ".")
@@ -715,14 +1076,33 @@ func generateGlobalTransportGo(gen *protogen.Plugin, commonNamespace string) {
 	g.P()
 	g.P("package ", packageName)
 	g.P()
-	g.P("import (")
-	g.P(`	"context"`)
-	g.P(")")
-	g.P()
 
 	// Generate Transport interface
 	g.P("// PuregenTransport defines the interface for client communication")
 	g.P("type PuregenTransport interface {")
-	g.P("	Send(ctx context.Context, methodName string, inputData interface{}, outputType interface{}) (interface{}, error)")
+	g.P("	Send(ctx ", contextPackage.Ident("Context"), ", methodName string, inputData interface{}, outputType interface{}) (interface{}, error)")
 	g.P("}")
 }
+
+// commonGoImportPath returns the import path of the common_namespace Go package.
+// Without common_go_import_path it is the namespace as a path, under go_module if set.
+func commonGoImportPath(config *Config) protogen.GoImportPath {
+	if config.CommonGoImportPath != "" {
+		return protogen.GoImportPath(config.CommonGoImportPath)
+	}
+	importPath := strings.ReplaceAll(config.CommonNamespace, ".", "/")
+	if config.GoModule != "" {
+		importPath = path.Join(config.GoModule, importPath)
+	}
+	return protogen.GoImportPath(importPath)
+}
+
+// goFilenamePrefix returns the path of a generated Go file without extension.
+// protogen already honors paths=source_relative passed on the command line; a
+// layout set in the config file is applied here.
+func goFilenamePrefix(file *protogen.File, config *Config) string {
+	if config.Paths == sourceRelativePaths {
+		return strings.TrimSuffix(file.Desc.Path(), ".proto")
+	}
+	return file.GeneratedFilenamePrefix
+}
@@ -0,0 +1,233 @@
+package generator
+
+import (
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// generateGoDescriptors writes a descriptor for every message of a file and
+// registers them with the package registry, creating the registry file first
+func generateGoDescriptors(gen *protogen.Plugin, g *protogen.GeneratedFile, file *protogen.File, config *Config) {
+	messages := collectDescribedMessages(file)
+	if !config.Features.Descriptors || len(messages) == 0 {
+		return
+	}
+	generatePackageDescriptorsGo(gen, file, config)
+
+	g.P("// Descriptors")
+	g.P()
+	names := make([]string, len(messages))
+	for i, msg := range messages {
+		names[i] = msg.GoIdent.GoName + "Descriptor"
+		generateGoMessageDescriptor(g, msg, config)
+	}
+	g.P("func init() {")
+	g.P("	registerMessageDescriptors(", strings.Join(names, ", "), ")")
+	g.P("}")
+	g.P()
+}
+
+// generateGoMessageDescriptor writes the XxxDescriptor variable of a message and
+// its Descriptor method. Metadata is shared with the XxxMetadata and
+// XxxFieldMetadata maps.
+func generateGoMessageDescriptor(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	msgName := msg.GoIdent.GoName
+
+	g.P("// ", msgName, "Descriptor describes ", msgName, " and its fields")
+	g.P("var ", msgName, "Descriptor = &PuregenMessageDescriptor{")
+	g.P("	FullName: ", strconv.Quote(string(msg.Desc.FullName())), ",")
+	g.P("	Name:     ", strconv.Quote(string(msg.Desc.Name())), ",")
+	g.P("	GoName:   ", strconv.Quote(msgName), ",")
+	if len(msg.Fields) > 0 {
+		g.P("	Fields: []*PuregenFieldDescriptor{")
+		for _, field := range msg.Fields {
+			g.P("		{")
+			g.P("			Name:     ", strconv.Quote(string(field.Desc.Name())), ",")
+			g.P("			GoName:   ", strconv.Quote(field.GoName), ",")
+			g.P("			JSONName: ", strconv.Quote(jsonFieldName(field, config)), ",")
+			g.P("			Number:   ", field.Desc.Number(), ",")
+			g.P("			Kind:     ", strconv.Quote(descriptorKind(field)), ",")
+			if typeName := descriptorTypeName(field); typeName != "" {
+				g.P("			TypeName: ", strconv.Quote(typeName), ",")
+			}
+			if mapKey := descriptorMapKey(field); mapKey != "" {
+				g.P("			MapKey:   ", strconv.Quote(mapKey), ",")
+			}
+			if field.Desc.IsList() {
+				g.P("			Repeated: true,")
+			}
+			if field.Desc.IsMap() {
+				g.P("			Map:      true,")
+			}
+			if field.Desc.HasOptionalKeyword() {
+				g.P("			Optional: true,")
+			}
+			if parseFieldMetadata(field, config) != nil && config.Features.Metadata {
+				g.P("			Metadata: ", msgName, "FieldMetadata[", msgName, "_", field.GoName, "_FIELD],")
+			}
+			g.P("		},")
+		}
+		g.P("	},")
+	}
+	if parseMessageMetadata(msg, config) != nil && config.Features.Metadata {
+		g.P("	Metadata: ", msgName, "Metadata,")
+	}
+	g.P("}")
+	g.P()
+
+	g.P("// Descriptor returns ", msgName, "Descriptor")
+	g.P("func (*", msgName, ") Descriptor() *PuregenMessageDescriptor {")
+	g.P("	return ", msgName, "Descriptor")
+	g.P("}")
+	g.P()
+}
+
+// generatePackageDescriptorsGo creates the descriptor registry of a package.
+// The descriptor types are declared in the same file, or in the common
+// namespace package and aliased, so descriptors of every package share them.
+func generatePackageDescriptorsGo(gen *protogen.Plugin, file *protogen.File, config *Config) {
+	fileDir := filepath.Dir(goFilenamePrefix(file, config))
+	filename := outputPath(config.Output.Go, filepath.Join(fileDir, "puregen_descriptors.go"))
+
+	// Only create once per output file: with paths=source_relative, protos of
+	// several packages can share a directory
+	if !config.state.createOnce(filename) {
+		return
+	}
+
+	g := gen.NewGeneratedFile(filename, file.GoImportPath)
+
+	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P("// Package message descriptors")
+	g.P()
+	g.P("package ", file.GoPackageName)
+	g.P()
+
+	if config.CommonNamespace != "" {
+		generateGlobalDescriptorsGo(gen, config)
+		importPath := commonGoImportPath(config)
+		g.P("// Descriptor types shared by all packages")
+		g.P("type (")
+		for _, name := range []string{"PuregenMessageDescriptor", "PuregenFieldDescriptor"} {
+			g.P("	", name, " = ", g.QualifiedGoIdent(importPath.Ident(name)))
+		}
+		g.P(")")
+		g.P()
+	} else {
+		writeGoDescriptorTypes(g)
+	}
+
+	g.P("// messageDescriptors holds the descriptors of the package's messages by full proto name")
+	g.P("var messageDescriptors = map[string]*PuregenMessageDescriptor{}")
+	g.P()
+	g.P("func registerMessageDescriptors(descriptors ...*PuregenMessageDescriptor) {")
+	g.P("	for _, d := range descriptors {")
+	g.P("		messageDescriptors[d.FullName] = d")
+	g.P("	}")
+	g.P("}")
+	g.P()
+	g.P("// FindMessageDescriptor returns the descriptor of a message by full proto name")
+	g.P("func FindMessageDescriptor(fullName string) (*PuregenMessageDescriptor, bool) {")
+	g.P("	d, ok := messageDescriptors[fullName]")
+	g.P("	return d, ok")
+	g.P("}")
+	g.P()
+	g.P("// MessageDescriptors returns the descriptors of the package's messages, ordered by full name")
+	g.P("func MessageDescriptors() []*PuregenMessageDescriptor {")
+	g.P("	descriptors := make([]*PuregenMessageDescriptor, 0, len(messageDescriptors))")
+	g.P("	for _, d := range messageDescriptors {")
+	g.P("		descriptors = append(descriptors, d)")
+	g.P("	}")
+	g.P("	", sortPackage.Ident("Slice"), "(descriptors, func(i, j int) bool {")
+	g.P("		return descriptors[i].FullName < descriptors[j].FullName")
+	g.P("	})")
+	g.P("	return descriptors")
+	g.P("}")
+}
+
+// generateGlobalDescriptorsGo creates the descriptor types in the common namespace package
+func generateGlobalDescriptorsGo(gen *protogen.Plugin, config *Config) {
+	importPath := commonGoImportPath(config)
+	filename := outputPath(config.Output.Go, path.Join(string(importPath), "descriptor.go"))
+	if !config.state.createOnce(filename) {
+		return
+	}
+	g := gen.NewGeneratedFile(filename, importPath)
+
+	parts := strings.Split(config.CommonNamespace, ".")
+	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P("// Global message descriptor types")
+	g.P()
+	g.P("package ", parts[len(parts)-1])
+	g.P()
+	writeGoDescriptorTypes(g)
+}
+
+// writeGoDescriptorTypes writes the PuregenMessageDescriptor and PuregenFieldDescriptor types
+func writeGoDescriptorTypes(g *protogen.GeneratedFile) {
+	g.P("// PuregenMessageDescriptor describes a generated message")
+	g.P("type PuregenMessageDescriptor struct {")
+	g.P("	// FullName is the full proto name, e.g. \"acme.v1.User\"")
+	g.P("	FullName string")
+	g.P("	// Name is the proto name")
+	g.P("	Name string")
+	g.P("	// GoName is the name of the Go struct")
+	g.P("	GoName string")
+	g.P("	// Fields are the fields in declaration order")
+	g.P("	Fields []*PuregenFieldDescriptor")
+	g.P("	// Metadata is the message metadata, or nil")
+	g.P("	Metadata map[string]any")
+	g.P("}")
+	g.P()
+	g.P("// FieldByName returns the field with a proto or JSON name, or nil")
+	g.P("func (d *PuregenMessageDescriptor) FieldByName(name string) *PuregenFieldDescriptor {")
+	g.P("	for _, f := range d.Fields {")
+	g.P("		if f.Name == name || f.JSONName == name {")
+	g.P("			return f")
+	g.P("		}")
+	g.P("	}")
+	g.P("	return nil")
+	g.P("}")
+	g.P()
+	g.P("// FieldByNumber returns the field with a field number, or nil")
+	g.P("func (d *PuregenMessageDescriptor) FieldByNumber(number int32) *PuregenFieldDescriptor {")
+	g.P("	for _, f := range d.Fields {")
+	g.P("		if f.Number == number {")
+	g.P("			return f")
+	g.P("		}")
+	g.P("	}")
+	g.P("	return nil")
+	g.P("}")
+	g.P()
+	g.P("// PuregenFieldDescriptor describes a field of a generated message")
+	g.P("type PuregenFieldDescriptor struct {")
+	g.P("	// Name is the proto name")
+	g.P("	Name string")
+	g.P("	// GoName is the name of the Go struct field")
+	g.P("	GoName string")
+	g.P("	// JSONName is the name used in JSON")
+	g.P("	JSONName string")
+	g.P("	// Number is the field number")
+	g.P("	Number int32")
+	g.P("	// Kind is the proto type: \"string\", \"int64\", \"message\", \"enum\", ...;")
+	g.P("	// for map fields, the type of the values")
+	g.P("	Kind string")
+	g.P("	// TypeName is the full proto name of the message or enum type, or \"\"")
+	g.P("	TypeName string")
+	g.P("	// MapKey is the proto type of the keys of a map field, or \"\"")
+	g.P("	MapKey string")
+	g.P("	// Repeated is set for repeated fields other than maps")
+	g.P("	Repeated bool")
+	g.P("	// Map is set for map fields")
+	g.P("	Map bool")
+	g.P("	// Optional is set for fields declared optional")
+	g.P("	Optional bool")
+	g.P("	// Metadata is the field metadata, or nil")
+	g.P("	Metadata map[string]any")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,170 @@
+package generator
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// generatePackageFieldMaskGo creates the PuregenFieldMask type of a package,
+// taken by the ApplyFieldMask and MergeFieldMask methods of its messages. The
+// type is declared in the same file, or in the common namespace package and
+// aliased, so masks of every package share it.
+func generatePackageFieldMaskGo(gen *protogen.Plugin, file *protogen.File, config *Config) {
+	if len(collectDescribedMessages(file)) == 0 {
+		return
+	}
+	fileDir := filepath.Dir(goFilenamePrefix(file, config))
+	filename := outputPath(config.Output.Go, filepath.Join(fileDir, "puregen_fieldmask.go"))
+
+	// Only create once per output file: with paths=source_relative, protos of
+	// several packages can share a directory
+	if !config.state.createOnce(filename) {
+		return
+	}
+
+	g := gen.NewGeneratedFile(filename, file.GoImportPath)
+
+	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P("// Package field mask type")
+	g.P()
+	g.P("package ", file.GoPackageName)
+	g.P()
+
+	if config.CommonNamespace != "" {
+		generateGlobalFieldMaskGo(gen, config)
+		importPath := commonGoImportPath(config)
+		g.P("// PuregenFieldMask is the field mask type shared by all packages")
+		g.P("type PuregenFieldMask = ", g.QualifiedGoIdent(importPath.Ident("PuregenFieldMask")))
+		return
+	}
+	writeGoFieldMaskType(g)
+}
+
+// generateGlobalFieldMaskGo creates the field mask type in the common namespace package
+func generateGlobalFieldMaskGo(gen *protogen.Plugin, config *Config) {
+	importPath := commonGoImportPath(config)
+	filename := outputPath(config.Output.Go, path.Join(string(importPath), "fieldmask.go"))
+	if !config.state.createOnce(filename) {
+		return
+	}
+	g := gen.NewGeneratedFile(filename, importPath)
+
+	parts := strings.Split(config.CommonNamespace, ".")
+	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P("// Global field mask type")
+	g.P()
+	g.P("package ", parts[len(parts)-1])
+	g.P()
+	writeGoFieldMaskType(g)
+}
+
+// writeGoFieldMaskType writes the PuregenFieldMask type, which encodes as the
+// comma-separated string of google.protobuf.FieldMask's JSON form
+func writeGoFieldMaskType(g *protogen.GeneratedFile) {
+	g.P("// PuregenFieldMask names a set of fields, as google.protobuf.FieldMask does.")
+	g.P("// Fields of type google.protobuf.FieldMask are field masks. In JSON a mask")
+	g.P("// is a string of comma-separated lowerCamelCase paths, e.g.")
+	g.P("// \"displayName,profile.bio\".")
+	g.P("type PuregenFieldMask struct {")
+	g.P("	// Paths are proto field names and may select nested fields, e.g. \"profile.bio\"")
+	g.P("	Paths []string")
+	g.P("}")
+	g.P()
+	g.P("// GetPaths returns the Paths field, or nil if m is nil")
+	g.P("func (m *PuregenFieldMask) GetPaths() []string {")
+	g.P("	if m == nil {")
+	g.P("		return nil")
+	g.P("	}")
+	g.P("	return m.Paths")
+	g.P("}")
+	g.P()
+	g.P("// MarshalJSON encodes the mask as a string of comma-separated lowerCamelCase paths")
+	g.P("func (m *PuregenFieldMask) MarshalJSON() ([]byte, error) {")
+	g.P("	paths := make([]string, len(m.GetPaths()))")
+	g.P("	for i, p := range m.GetPaths() {")
+	g.P("		paths[i] = puregenPathToJSON(p)")
+	g.P("	}")
+	g.P("	return ", jsonPackage.Ident("Marshal"), "(", stringsPackage.Ident("Join"), "(paths, \",\"))")
+	g.P("}")
+	g.P()
+	g.P("// UnmarshalJSON decodes a string of comma-separated lowerCamelCase paths")
+	g.P("func (m *PuregenFieldMask) UnmarshalJSON(data []byte) error {")
+	g.P("	var value string")
+	g.P("	if err := ", jsonPackage.Ident("Unmarshal"), "(data, &value); err != nil {")
+	g.P("		return ", fmtPackage.Ident("Errorf"), "(\"field mask: %w\", err)")
+	g.P("	}")
+	g.P("	m.Paths = nil")
+	g.P("	for _, p := range ", stringsPackage.Ident("Split"), "(value, \",\") {")
+	g.P("		if p != \"\" {")
+	g.P("			m.Paths = append(m.Paths, puregenPathFromJSON(p))")
+	g.P("		}")
+	g.P("	}")
+	g.P("	return nil")
+	g.P("}")
+	g.P()
+	g.P("// puregenPathToJSON turns the snake_case names of a path into lowerCamelCase")
+	g.P("func puregenPathToJSON(p string) string {")
+	g.P("	var b ", stringsPackage.Ident("Builder"))
+	g.P("	for i := 0; i < len(p); i++ {")
+	g.P("		if p[i] == '_' && i+1 < len(p) && 'a' <= p[i+1] && p[i+1] <= 'z' {")
+	g.P("			i++")
+	g.P("			b.WriteByte(p[i] - 'a' + 'A')")
+	g.P("			continue")
+	g.P("		}")
+	g.P("		b.WriteByte(p[i])")
+	g.P("	}")
+	g.P("	return b.String()")
+	g.P("}")
+	g.P()
+	g.P("// puregenPathFromJSON turns the lowerCamelCase names of a path into snake_case")
+	g.P("func puregenPathFromJSON(p string) string {")
+	g.P("	var b ", stringsPackage.Ident("Builder"))
+	g.P("	for i := 0; i < len(p); i++ {")
+	g.P("		if 'A' <= p[i] && p[i] <= 'Z' {")
+	g.P("			b.WriteByte('_')")
+	g.P("			b.WriteByte(p[i] - 'A' + 'a')")
+	g.P("			continue")
+	g.P("		}")
+	g.P("		b.WriteByte(p[i])")
+	g.P("	}")
+	g.P("	return b.String()")
+	g.P("}")
+	g.P()
+	g.P("// Clone returns a deep copy of m")
+	g.P("func (m *PuregenFieldMask) Clone() *PuregenFieldMask {")
+	g.P("	if m == nil {")
+	g.P("		return nil")
+	g.P("	}")
+	g.P("	return &PuregenFieldMask{Paths: append([]string(nil), m.Paths...)}")
+	g.P("}")
+	g.P()
+	g.P("// Equal reports whether m and other name the same paths in the same order")
+	g.P("func (m *PuregenFieldMask) Equal(other *PuregenFieldMask) bool {")
+	g.P("	if m == nil || other == nil {")
+	g.P("		return m == other")
+	g.P("	}")
+	g.P("	if len(m.Paths) != len(other.Paths) {")
+	g.P("		return false")
+	g.P("	}")
+	g.P("	for i := range m.Paths {")
+	g.P("		if m.Paths[i] != other.Paths[i] {")
+	g.P("			return false")
+	g.P("		}")
+	g.P("	}")
+	g.P("	return true")
+	g.P("}")
+	g.P()
+	g.P("// Merge appends the paths of src, as proto.Merge does")
+	g.P("func (m *PuregenFieldMask) Merge(src *PuregenFieldMask) {")
+	g.P("	m.Paths = append(m.Paths, src.GetPaths()...)")
+	g.P("}")
+	g.P()
+	g.P("// Redacted returns a copy of m: paths hold no sensitive values")
+	g.P("func (m *PuregenFieldMask) Redacted() *PuregenFieldMask {")
+	g.P("	return m.Clone()")
+	g.P("}")
+	g.P()
+}
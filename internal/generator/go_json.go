@@ -0,0 +1,93 @@
+package generator
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// generateGoUnmarshalJSON writes UnmarshalJSON for messages whose fields are
+// also accepted under other names (see jsonFieldAliases). It renames aliased
+// keys to the names in the struct tags, which win when both are present, and
+// decodes the result as usual.
+func generateGoUnmarshalJSON(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	if !hasJSONFieldAliases(msg, config) {
+		return
+	}
+	msgName := msg.GoIdent.GoName
+	rawMessage := jsonPackage.Ident("RawMessage")
+
+	g.P("// UnmarshalJSON accepts both the proto field names and the JSON names of the fields")
+	g.P("func (m *", msgName, ") UnmarshalJSON(data []byte) error {")
+	g.P("	var fields map[string]", rawMessage)
+	g.P("	if err := ", jsonPackage.Ident("Unmarshal"), "(data, &fields); err != nil {")
+	g.P("		return err")
+	g.P("	}")
+	g.P("	for alias, name := range map[string]string{")
+	for _, field := range msg.Fields {
+		for _, alias := range jsonFieldAliases(field, config) {
+			g.P("		", strconv.Quote(alias), ": ", strconv.Quote(jsonFieldName(field, config)), ",")
+		}
+	}
+	g.P("	} {")
+	g.P("		if value, ok := fields[alias]; ok {")
+	g.P("			delete(fields, alias)")
+	g.P("			if _, ok := fields[name]; !ok {")
+	g.P("				fields[name] = value")
+	g.P("			}")
+	g.P("		}")
+	g.P("	}")
+	g.P("	data, err := ", jsonPackage.Ident("Marshal"), "(fields)")
+	g.P("	if err != nil {")
+	g.P("		return err")
+	g.P("	}")
+	g.P("	// plain has the fields of ", msgName, " but not this method")
+	g.P("	type plain ", msgName)
+	g.P("	return ", jsonPackage.Ident("Unmarshal"), "(data, (*plain)(m))")
+	g.P("}")
+	g.P()
+}
+
+// generateGoEnumJSON writes UnmarshalJSON for an int enum, which accepts
+// numbers and names. Numbers without a value are kept as they are, so they
+// survive a round trip; unknown names are errors. With go.int_enums set to
+// name, MarshalJSON writes the names in turn.
+func generateGoEnumJSON(g *protogen.GeneratedFile, enum *protogen.Enum, config *Config) {
+	enumName := enum.GoIdent.GoName
+
+	if config.Go.IntEnums == nameIntEnums {
+		g.P("// MarshalJSON writes a ", enumName, " as its name, or as its number when it")
+		g.P("// has none")
+		g.P("func (x ", enumName, ") MarshalJSON() ([]byte, error) {")
+		g.P("	if name, ok := ", enumName, "_name[int32(x)]; ok {")
+		g.P("		return ", jsonPackage.Ident("Marshal"), "(name)")
+		g.P("	}")
+		g.P("	return ", jsonPackage.Ident("Marshal"), "(int32(x))")
+		g.P("}")
+		g.P()
+	}
+
+	g.P("// UnmarshalJSON accepts a ", enumName, " as its number or its name. Unknown")
+	g.P("// numbers are kept as is; IsValid reports them.")
+	g.P("func (x *", enumName, ") UnmarshalJSON(data []byte) error {")
+	g.P("	if string(data) == \"null\" {")
+	g.P("		return nil")
+	g.P("	}")
+	g.P("	var number int32")
+	g.P("	if err := ", jsonPackage.Ident("Unmarshal"), "(data, &number); err == nil {")
+	g.P("		*x = ", enumName, "(number)")
+	g.P("		return nil")
+	g.P("	}")
+	g.P("	var name string")
+	g.P("	if err := ", jsonPackage.Ident("Unmarshal"), "(data, &name); err != nil {")
+	g.P("		return ", fmtPackage.Ident("Errorf"), "(\"invalid ", enumName, " value: %s\", data)")
+	g.P("	}")
+	g.P("	value, err := Parse", enumName, "(name)")
+	g.P("	if err != nil {")
+	g.P("		return err")
+	g.P("	}")
+	g.P("	*x = value")
+	g.P("	return nil")
+	g.P("}")
+	g.P()
+}
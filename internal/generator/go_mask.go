@@ -0,0 +1,237 @@
+package generator
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// generateGoFieldPaths writes a path constant for every field of a message
+func generateGoFieldPaths(g *protogen.GeneratedFile, msg *protogen.Message) {
+	if len(msg.Fields) == 0 {
+		return
+	}
+	g.P("// Field paths of ", msg.GoIdent.GoName, ", for ApplyMask and field masks")
+	g.P("const (")
+	for _, field := range msg.Fields {
+		g.P("	", fieldPathConstant(msg, field), " = ", strconv.Quote(string(field.Desc.Name())))
+	}
+	g.P(")")
+	g.P()
+}
+
+// generateGoApplyMask writes ApplyMask, copying the fields named by a field
+// mask from another message
+func generateGoApplyMask(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	msgName := msg.GoIdent.GoName
+
+	g.P("// ApplyMask copies the fields named by paths from src into m. Paths are proto")
+	g.P("// (or JSON) field names and may select nested fields, e.g. \"profile.bio\".")
+	g.P("// A nil src counts as New", msgName, "().")
+	g.P("func (m *", msgName, ") ApplyMask(src *", msgName, ", paths []string) error {")
+	if len(msg.Fields) == 0 {
+		g.P("	if len(paths) > 0 {")
+		g.P("		return ", fmtPackage.Ident("Errorf"), "(\"", msgName, ": unknown field path %q\", paths[0])")
+		g.P("	}")
+		g.P("	return nil")
+		g.P("}")
+		g.P()
+		return
+	}
+
+	hasNested := false
+	for _, field := range msg.Fields {
+		hasNested = hasNested || isSingularMessage(field)
+	}
+	rest := "_"
+	if hasNested {
+		rest = "rest"
+	}
+
+	g.P("	if src == nil {")
+	g.P("		src = New", msgName, "()")
+	g.P("	}")
+	g.P("	for _, path := range paths {")
+	g.P("		name, ", rest, ", nested := ", stringsPackage.Ident("Cut"), "(path, \".\")")
+	g.P("		switch name {")
+	for _, field := range msg.Fields {
+		name := field.GoName
+		g.P("		case ", fieldPathCases(msg, field, strconv.Quote), ":")
+		if isSingularMessage(field) {
+			g.P("			if !nested {")
+			g.P("				m.", name, " = src.", name, ".Clone()")
+			g.P("				continue")
+			g.P("			}")
+			g.P("			if m.", name, " == nil {")
+			g.P("				m.", name, " = &", messageTypeName(field.Message), "{}")
+			g.P("			}")
+			g.P("			if err := m.", name, ".ApplyMask(src.", name, ", []string{rest}); err != nil {")
+			g.P("				return err")
+			g.P("			}")
+			continue
+		}
+		g.P("			if nested {")
+		g.P("				return ", fmtPackage.Ident("Errorf"), "(\"", msgName, ": field %q has no subfields\", name)")
+		g.P("			}")
+		writeGoFieldCopy(g, field, config)
+	}
+	g.P("		default:")
+	g.P("			return ", fmtPackage.Ident("Errorf"), "(\"", msgName, ": unknown field path %q\", path)")
+	g.P("		}")
+	g.P("	}")
+	g.P("	return nil")
+	g.P("}")
+	g.P()
+}
+
+// writeGoFieldCopy assigns a deep copy of a field of src that masks do not
+// descend into to m
+func writeGoFieldCopy(g *protogen.GeneratedFile, field *protogen.Field, config *Config) {
+	name := field.GoName
+	isBytes := field.Desc.Kind().String() == "bytes"
+	switch {
+	case field.Desc.IsMap():
+		g.P("			m.", name, " = nil")
+		g.P("			if src.", name, " != nil {")
+		g.P("				m.", name, " = make(", getGoFieldType(field, config), ", len(src.", name, "))")
+		g.P("				for k, v := range src.", name, " {")
+		g.P("					m.", name, "[k] = ", goMapValueCopy(field, "v"))
+		g.P("				}")
+		g.P("			}")
+	case field.Desc.IsList() && (field.Message != nil || isBytes):
+		g.P("			m.", name, " = make(", getGoFieldType(field, config), ", len(src.", name, "))")
+		g.P("			for i, v := range src.", name, " {")
+		if isBytes {
+			g.P("				m.", name, "[i] = append([]byte(nil), v...)")
+		} else {
+			g.P("				m.", name, "[i] = v.Clone()")
+		}
+		g.P("			}")
+	case field.Desc.IsList():
+		g.P("			m.", name, " = append(", getGoFieldType(field, config), "(nil), src.", name, "...)")
+	case isBytes:
+		g.P("			m.", name, " = append([]byte(nil), src.", name, "...)")
+	case field.Message != nil:
+		g.P("			m.", name, " = src.", name, ".Clone()")
+	default:
+		g.P("			m.", name, " = src.", name)
+	}
+}
+
+// generateGoMerge writes Merge with proto merge semantics
+func generateGoMerge(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	msgName := msg.GoIdent.GoName
+
+	g.P("// Merge merges src into m as proto.Merge does: non-zero scalars and bytes")
+	g.P("// overwrite, lists are appended, map entries are replaced and messages are")
+	g.P("// merged recursively")
+	g.P("func (m *", msgName, ") Merge(src *", msgName, ") {")
+	g.P("	if src == nil {")
+	g.P("		return")
+	g.P("	}")
+	for _, field := range msg.Fields {
+		name := field.GoName
+		isBytes := field.Desc.Kind().String() == "bytes"
+		switch {
+		case field.Desc.IsMap():
+			g.P("	if len(src.", name, ") > 0 && m.", name, " == nil {")
+			g.P("		m.", name, " = make(", getGoFieldType(field, config), ", len(src.", name, "))")
+			g.P("	}")
+			g.P("	for k, v := range src.", name, " {")
+			g.P("		m.", name, "[k] = ", goMapValueCopy(field, "v"))
+			g.P("	}")
+		case field.Desc.IsList() && (field.Message != nil || isBytes):
+			g.P("	for _, v := range src.", name, " {")
+			if isBytes {
+				g.P("		m.", name, " = append(m.", name, ", append([]byte(nil), v...))")
+			} else {
+				g.P("		m.", name, " = append(m.", name, ", v.Clone())")
+			}
+			g.P("	}")
+		case field.Desc.IsList():
+			g.P("	m.", name, " = append(m.", name, ", src.", name, "...)")
+		case field.Message != nil:
+			g.P("	if src.", name, " != nil {")
+			g.P("		if m.", name, " == nil {")
+			g.P("			m.", name, " = &", messageTypeName(field.Message), "{}")
+			g.P("		}")
+			g.P("		m.", name, ".Merge(src.", name, ")")
+			g.P("	}")
+		case isBytes:
+			g.P("	if len(src.", name, ") > 0 {")
+			g.P("		m.", name, " = append([]byte(nil), src.", name, "...)")
+			g.P("	}")
+		case getGoFieldType(field, config) == "bool":
+			g.P("	if src.", name, " {")
+			g.P("		m.", name, " = true")
+			g.P("	}")
+		default:
+			g.P("	if src.", name, " != ", goZeroValue(field, config), " {")
+			g.P("		m.", name, " = src.", name)
+			g.P("	}")
+		}
+	}
+	g.P("}")
+	g.P()
+}
+
+// generateGoFieldMaskMethods writes ApplyFieldMask and MergeFieldMask, which
+// take the paths of a PuregenFieldMask, such as the update_mask of an update
+// request
+func generateGoFieldMaskMethods(g *protogen.GeneratedFile, msg *protogen.Message) {
+	msgName := msg.GoIdent.GoName
+
+	g.P("// ApplyFieldMask copies the fields named by mask from src into m, as ApplyMask")
+	g.P("// does with its paths")
+	g.P("func (m *", msgName, ") ApplyFieldMask(src *", msgName, ", mask *PuregenFieldMask) error {")
+	g.P("	return m.ApplyMask(src, mask.GetPaths())")
+	g.P("}")
+	g.P()
+	g.P("// MergeFieldMask merges the fields named by mask from src into m: the fields")
+	g.P("// of src outside the mask are left out, the others are merged as Merge does")
+	g.P("func (m *", msgName, ") MergeFieldMask(src *", msgName, ", mask *PuregenFieldMask) error {")
+	// The zero value, unlike New, has no field defaults to merge
+	g.P("	masked := &", msgName, "{}")
+	g.P("	if err := masked.ApplyMask(src, mask.GetPaths()); err != nil {")
+	g.P("		return err")
+	g.P("	}")
+	g.P("	m.Merge(masked)")
+	g.P("	return nil")
+	g.P("}")
+	g.P()
+}
+
+// goMapValueCopy returns an expression copying the value v of a map field
+func goMapValueCopy(field *protogen.Field, v string) string {
+	value := field.Message.Fields[1]
+	switch {
+	case value.Message != nil:
+		return v + ".Clone()"
+	case value.Desc.Kind().String() == "bytes":
+		return "append([]byte(nil), " + v + "...)"
+	default:
+		return v
+	}
+}
+
+// isSingularMessage reports whether a field holds a single nested message,
+// which field mask paths can descend into. Envelopes and field masks are
+// opaque to masks.
+func isSingularMessage(field *protogen.Field) bool {
+	return field.Message != nil && !field.Desc.IsList() && !field.Desc.IsMap() && !isSharedMessage(field.Message)
+}
+
+// fieldPathConstant returns the name of a field's path constant
+func fieldPathConstant(msg *protogen.Message, field *protogen.Field) string {
+	return msg.GoIdent.GoName + "_" + field.GoName + "_PATH"
+}
+
+// fieldPathCases returns the case labels matching a field path segment: the
+// path constant, plus the JSON name when it differs from the proto name
+func fieldPathCases(msg *protogen.Message, field *protogen.Field, quote func(string) string) string {
+	cases := fieldPathConstant(msg, field)
+	if jsonName := field.Desc.JSONName(); jsonName != string(field.Desc.Name()) {
+		cases += ", " + quote(jsonName)
+	}
+	return cases
+}
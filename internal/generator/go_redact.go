@@ -0,0 +1,86 @@
+package generator
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// generateGoRedacted writes Redacted, a copy of a message with its sensitive
+// fields masked, recursing into nested messages
+func generateGoRedacted(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	msgName := msg.GoIdent.GoName
+
+	g.P("// Redacted returns a deep copy of m that is safe to log: sensitive strings")
+	g.P("// that are set become ", strconv.Quote(redactedValue), " and other sensitive fields are")
+	g.P("// cleared, in m and in every nested message")
+	g.P("func (m *", msgName, ") Redacted() *", msgName, " {")
+	g.P("	if m == nil {")
+	g.P("		return nil")
+	g.P("	}")
+	g.P("	r := m.Clone()")
+	for _, field := range msg.Fields {
+		name := field.GoName
+		switch {
+		case isSensitiveField(field, config) && isRedactableString(field):
+			g.P("	if r.", name, " != \"\" {")
+			g.P("		r.", name, " = ", strconv.Quote(redactedValue))
+			g.P("	}")
+		case isSensitiveField(field, config):
+			g.P("	r.", name, " = ", goZeroValue(field, config))
+		case field.Desc.IsMap():
+			if field.Message.Fields[1].Message != nil {
+				g.P("	for k, v := range r.", name, " {")
+				g.P("		r.", name, "[k] = v.Redacted()")
+				g.P("	}")
+			}
+		case field.Desc.IsList():
+			if field.Message != nil {
+				g.P("	for i, v := range r.", name, " {")
+				g.P("		r.", name, "[i] = v.Redacted()")
+				g.P("	}")
+			}
+		case field.Message != nil:
+			g.P("	r.", name, " = r.", name, ".Redacted()")
+		}
+	}
+	g.P("	return r")
+	g.P("}")
+	g.P()
+}
+
+// generateGoLogValue writes String and slog LogValue methods, which render a
+// message through Redacted so sensitive fields never reach logs
+func generateGoLogValue(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	msgName := msg.GoIdent.GoName
+
+	g.P("// String renders m with sensitive fields redacted")
+	g.P("func (m *", msgName, ") String() string {")
+	g.P("	if m == nil {")
+	g.P("		return \"<nil>\"")
+	g.P("	}")
+	g.P("	return ", fmtPackage.Ident("Sprintf"), "(\"%+v\", *m.Redacted())")
+	g.P("}")
+	g.P()
+
+	g.P("// LogValue implements slog.LogValuer, logging m as a group keyed by JSON")
+	g.P("// field names with sensitive fields redacted")
+	g.P("func (m *", msgName, ") LogValue() ", slogPackage.Ident("Value"), " {")
+	g.P("	if m == nil {")
+	g.P("		return ", slogPackage.Ident("AnyValue"), "(nil)")
+	g.P("	}")
+	if len(msg.Fields) == 0 {
+		g.P("	return ", slogPackage.Ident("GroupValue"), "()")
+		g.P("}")
+		g.P()
+		return
+	}
+	g.P("	r := m.Redacted()")
+	g.P("	return ", slogPackage.Ident("GroupValue"), "(")
+	for _, field := range msg.Fields {
+		g.P("		", slogPackage.Ident("Any"), "(", strconv.Quote(jsonFieldName(field, config)), ", r.", field.GoName, "),")
+	}
+	g.P("	)")
+	g.P("}")
+	g.P()
+}
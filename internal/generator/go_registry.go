@@ -0,0 +1,259 @@
+package generator
+
+import (
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// generateGoTypeRegistry writes the ProtoName method of every message of a
+// file and registers their constructors with the package registry, creating
+// the registry file first
+func generateGoTypeRegistry(gen *protogen.Plugin, g *protogen.GeneratedFile, file *protogen.File, config *Config) {
+	if !hasTypeRegistry(file, config) {
+		return
+	}
+	generatePackageRegistryGo(gen, file, config)
+	if !config.Features.Registry {
+		return
+	}
+
+	messages := collectDescribedMessages(file)
+	g.P("// Message types")
+	g.P()
+	for _, msg := range messages {
+		msgName := msg.GoIdent.GoName
+		g.P("// ProtoName returns the full proto name of ", msgName)
+		g.P("func (*", msgName, ") ProtoName() string {")
+		g.P("	return ", strconv.Quote(string(msg.Desc.FullName())))
+		g.P("}")
+		g.P()
+	}
+	g.P("func init() {")
+	g.P("	registerMessageTypes(map[string]func() PuregenMessage{")
+	for _, msg := range messages {
+		g.P("		", strconv.Quote(string(msg.Desc.FullName())), ": func() PuregenMessage { return New", msg.GoIdent.GoName, "() },")
+	}
+	g.P("	})")
+	g.P("}")
+	g.P()
+}
+
+// generatePackageRegistryGo creates the message type registry of a package.
+// The message and envelope types are declared in the same file, or in the
+// common namespace package and aliased, so envelopes of every package share
+// them. With a common namespace the package registers its messages with the
+// registry of that package, which NewMessage and Unpack resolve from.
+func generatePackageRegistryGo(gen *protogen.Plugin, file *protogen.File, config *Config) {
+	fileDir := filepath.Dir(goFilenamePrefix(file, config))
+	filename := outputPath(config.Output.Go, filepath.Join(fileDir, "puregen_registry.go"))
+
+	// Only create once per output file: with paths=source_relative, protos of
+	// several packages can share a directory
+	if !config.state.createOnce(filename) {
+		return
+	}
+
+	g := gen.NewGeneratedFile(filename, file.GoImportPath)
+
+	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P("// Package message type registry")
+	g.P()
+	g.P("package ", file.GoPackageName)
+	g.P()
+
+	if config.CommonNamespace != "" {
+		generateGlobalEnvelopeGo(gen, config)
+		importPath := commonGoImportPath(config)
+		g.P("// Message and envelope types shared by all packages")
+		g.P("type (")
+		for _, name := range []string{"PuregenMessage", "PuregenEnvelope"} {
+			g.P("	", name, " = ", g.QualifiedGoIdent(importPath.Ident(name)))
+		}
+		g.P(")")
+		g.P()
+		g.P("// Pack wraps msg in an envelope named by its type URL")
+		g.P("func Pack(msg PuregenMessage) (*PuregenEnvelope, error) {")
+		g.P("	return ", g.QualifiedGoIdent(importPath.Ident("Pack")), "(msg)")
+		g.P("}")
+		g.P()
+		g.P("// registerMessageTypes adds the package's messages to the registry shared by all packages")
+		g.P("func registerMessageTypes(types map[string]func() PuregenMessage) {")
+		g.P("	", g.QualifiedGoIdent(importPath.Ident("RegisterMessageTypes")), "(types)")
+		g.P("}")
+		g.P()
+		g.P("// NewMessage returns a new message of any package by full proto name, from")
+		g.P("// the registry shared by all packages")
+		g.P("func NewMessage(fullName string) (PuregenMessage, bool) {")
+		g.P("	return ", g.QualifiedGoIdent(importPath.Ident("NewMessage")), "(fullName)")
+		g.P("}")
+		g.P()
+		g.P("// Unpack decodes the payload of an envelope into a new message of the type")
+		g.P("// its type URL names, which may be a message of any package linked into")
+		g.P("// the program")
+		g.P("func Unpack(e *PuregenEnvelope) (PuregenMessage, error) {")
+		g.P("	return ", g.QualifiedGoIdent(importPath.Ident("Unpack")), "(e)")
+		g.P("}")
+		return
+	}
+
+	writeGoEnvelopeTypes(g)
+	g.P("// messageTypes holds the constructors of the package's messages by full proto name")
+	writeGoMessageRegistry(g, "registerMessageTypes", "a message of the package")
+}
+
+// writeGoMessageRegistry writes the messageTypes registry, the register
+// function adding constructors to it, and NewMessage and Unpack, which
+// resolve messages from it. The caller documents messageTypes.
+func writeGoMessageRegistry(g *protogen.GeneratedFile, register, resolved string) {
+	g.P("var messageTypes = map[string]func() PuregenMessage{}")
+	g.P()
+	g.P("// ", register, " adds message constructors to the registry by full proto name")
+	g.P("func ", register, "(types map[string]func() PuregenMessage) {")
+	g.P("	for fullName, newMessage := range types {")
+	g.P("		messageTypes[fullName] = newMessage")
+	g.P("	}")
+	g.P("}")
+	g.P()
+	g.P("// NewMessage returns a new message by full proto name, which must be ", resolved)
+	g.P("func NewMessage(fullName string) (PuregenMessage, bool) {")
+	g.P("	newMessage, ok := messageTypes[fullName]")
+	g.P("	if !ok {")
+	g.P("		return nil, false")
+	g.P("	}")
+	g.P("	return newMessage(), true")
+	g.P("}")
+	g.P()
+	g.P("// Unpack decodes the payload of an envelope into a new message of the type")
+	g.P("// its type URL names, which must be ", resolved)
+	g.P("func Unpack(e *PuregenEnvelope) (PuregenMessage, error) {")
+	g.P("	if e == nil {")
+	g.P("		return nil, ", fmtPackage.Ident("Errorf"), "(\"unpack: nil envelope\")")
+	g.P("	}")
+	g.P("	msg, ok := NewMessage(e.MessageName())")
+	g.P("	if !ok {")
+	g.P("		return nil, ", fmtPackage.Ident("Errorf"), "(\"unpack: unknown message type %q\", e.TypeURL)")
+	g.P("	}")
+	g.P("	if err := msg.FromJSON(e.Payload); err != nil {")
+	g.P("		return nil, err")
+	g.P("	}")
+	g.P("	return msg, nil")
+	g.P("}")
+}
+
+// generateGlobalEnvelopeGo creates the message and envelope types in the common namespace package
+func generateGlobalEnvelopeGo(gen *protogen.Plugin, config *Config) {
+	importPath := commonGoImportPath(config)
+	filename := outputPath(config.Output.Go, path.Join(string(importPath), "envelope.go"))
+	if !config.state.createOnce(filename) {
+		return
+	}
+	g := gen.NewGeneratedFile(filename, importPath)
+
+	parts := strings.Split(config.CommonNamespace, ".")
+	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P("// Global message and envelope types and message type registry")
+	g.P()
+	g.P("package ", parts[len(parts)-1])
+	g.P()
+	writeGoEnvelopeTypes(g)
+	g.P("// messageTypes holds the constructors of the messages of every package by")
+	g.P("// full proto name. Generated packages register their messages when")
+	g.P("// initialized.")
+	writeGoMessageRegistry(g, "RegisterMessageTypes", "a registered message")
+}
+
+// writeGoEnvelopeTypes writes the PuregenMessage interface, the PuregenEnvelope
+// type and Pack
+func writeGoEnvelopeTypes(g *protogen.GeneratedFile) {
+	g.P("// PuregenMessage is implemented by the generated messages")
+	g.P("type PuregenMessage interface {")
+	g.P("	// ProtoName returns the full proto name of the message")
+	g.P("	ProtoName() string")
+	g.P("	ToJSON() ([]byte, error)")
+	g.P("	FromJSON(data []byte) error")
+	g.P("}")
+	g.P()
+	g.P("// puregenTypeURLPrefix prefixes the full names of packed messages, as in google.protobuf.Any")
+	g.P("const puregenTypeURLPrefix = \"type.googleapis.com/\"")
+	g.P()
+	g.P("// PuregenEnvelope holds a message of any type as JSON, named by its type")
+	g.P("// URL. Fields of type google.protobuf.Any are envelopes.")
+	g.P("type PuregenEnvelope struct {")
+	g.P("	// TypeURL is \"type.googleapis.com/\" followed by the full proto name of the payload")
+	g.P("	TypeURL string `json:\"type_url\"`")
+	g.P("	// Payload is the JSON encoding of the message")
+	g.P("	Payload ", jsonPackage.Ident("RawMessage"), " `json:\"payload,omitempty\"`")
+	g.P("}")
+	g.P()
+	g.P("// Pack wraps msg in an envelope named by its type URL")
+	g.P("func Pack(msg PuregenMessage) (*PuregenEnvelope, error) {")
+	g.P("	payload, err := msg.ToJSON()")
+	g.P("	if err != nil {")
+	g.P("		return nil, err")
+	g.P("	}")
+	g.P("	return &PuregenEnvelope{TypeURL: puregenTypeURLPrefix + msg.ProtoName(), Payload: payload}, nil")
+	g.P("}")
+	g.P()
+	g.P("// MessageName returns the full proto name of the payload: the part of the")
+	g.P("// type URL after its last '/'")
+	g.P("func (e *PuregenEnvelope) MessageName() string {")
+	g.P("	if e == nil {")
+	g.P("		return \"\"")
+	g.P("	}")
+	g.P("	return e.TypeURL[", stringsPackage.Ident("LastIndex"), "(e.TypeURL, \"/\")+1:]")
+	g.P("}")
+	g.P()
+	g.P("// Is reports whether the envelope holds a message of the type of msg")
+	g.P("func (e *PuregenEnvelope) Is(msg PuregenMessage) bool {")
+	g.P("	return e.MessageName() == msg.ProtoName()")
+	g.P("}")
+	g.P()
+	g.P("// UnpackTo decodes the payload into msg, which must be of the packed type")
+	g.P("func (e *PuregenEnvelope) UnpackTo(msg PuregenMessage) error {")
+	g.P("	if !e.Is(msg) {")
+	g.P("		return ", fmtPackage.Ident("Errorf"), "(\"unpack: envelope holds %q, not %q\", e.MessageName(), msg.ProtoName())")
+	g.P("	}")
+	g.P("	return msg.FromJSON(e.Payload)")
+	g.P("}")
+	g.P()
+	g.P("// Clone returns a deep copy of e")
+	g.P("func (e *PuregenEnvelope) Clone() *PuregenEnvelope {")
+	g.P("	if e == nil {")
+	g.P("		return nil")
+	g.P("	}")
+	g.P("	c := *e")
+	g.P("	if e.Payload != nil {")
+	g.P("		c.Payload = append(", jsonPackage.Ident("RawMessage"), "{}, e.Payload...)")
+	g.P("	}")
+	g.P("	return &c")
+	g.P("}")
+	g.P()
+	g.P("// Equal reports whether e and other hold the same type URL and payload bytes")
+	g.P("func (e *PuregenEnvelope) Equal(other *PuregenEnvelope) bool {")
+	g.P("	if e == nil || other == nil {")
+	g.P("		return e == other")
+	g.P("	}")
+	g.P("	return e.TypeURL == other.TypeURL && ", bytesPackage.Ident("Equal"), "(e.Payload, other.Payload)")
+	g.P("}")
+	g.P()
+	g.P("// Merge replaces the content of e with that of src, if src holds a message")
+	g.P("func (e *PuregenEnvelope) Merge(src *PuregenEnvelope) {")
+	g.P("	if src == nil || src.TypeURL == \"\" {")
+	g.P("		return")
+	g.P("	}")
+	g.P("	*e = *src.Clone()")
+	g.P("}")
+	g.P()
+	g.P("// Redacted returns a copy without the payload, which may hold sensitive fields")
+	g.P("func (e *PuregenEnvelope) Redacted() *PuregenEnvelope {")
+	g.P("	if e == nil {")
+	g.P("		return nil")
+	g.P("	}")
+	g.P("	return &PuregenEnvelope{TypeURL: e.TypeURL}")
+	g.P("}")
+	g.P()
+}
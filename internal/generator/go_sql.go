@@ -0,0 +1,142 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// generateGoSQL writes the column list, queries and database/sql scan and
+// insert helpers of every message of a file that declares a table
+func generateGoSQL(g *protogen.GeneratedFile, file *protogen.File, config *Config) {
+	dialect := config.SQL.Dialect
+	tables := collectSQLTables(file, config)
+	if dialect == "" || len(tables) == 0 {
+		return
+	}
+
+	g.P("// Tables")
+	g.P()
+	for _, table := range tables {
+		generateGoSQLTable(g, table, dialect)
+	}
+}
+
+// generateGoSQLTable writes the SQL helpers of one table
+func generateGoSQLTable(g *protogen.GeneratedFile, table *sqlTable, dialect string) {
+	msgName := table.msg.GoIdent.GoName
+	columns := table.columnNames()
+	placeholders := make([]string, len(columns))
+	for i := range placeholders {
+		if dialect == postgresSQLDialect {
+			placeholders[i] = "$" + strconv.Itoa(i+1)
+		} else {
+			placeholders[i] = "?"
+		}
+	}
+	selectSQL := "SELECT " + strings.Join(columns, ", ") + " FROM " + table.qualifiedName(dialect)
+	insertSQL := "INSERT INTO " + table.qualifiedName(dialect) + " (" + strings.Join(columns, ", ") + ") VALUES (" + strings.Join(placeholders, ", ") + ")"
+
+	g.P("// ", msgName, "Columns are the columns of the ", strconv.Quote(table.name), " table, in the order")
+	g.P("// Scan", msgName, " reads them")
+	g.P("var ", msgName, "Columns = []string{")
+	for _, column := range table.columns {
+		g.P("	", strconv.Quote(column.name), ",")
+	}
+	g.P("}")
+	g.P()
+	g.P("// Select", msgName, "SQL selects the columns of ", msgName, " rows, for Scan", msgName)
+	g.P("const Select", msgName, "SQL = ", goRawString(selectSQL))
+	g.P()
+	g.P("// Insert", msgName, "SQL inserts a row of the ", strconv.Quote(table.name), " table")
+	g.P("const Insert", msgName, "SQL = ", goRawString(insertSQL))
+	g.P()
+
+	// Scan reads JSON columns into NullStrings, then decodes them
+	g.P("// Scan", msgName, " reads the current row of rows, selected with Select", msgName, "SQL,")
+	g.P("// into a new ", msgName)
+	g.P("func Scan", msgName, "(rows *", sqlPackage.Ident("Rows"), ") (*", msgName, ", error) {")
+	g.P("	m := New", msgName, "()")
+	var dests []string
+	for _, column := range table.columns {
+		if column.json {
+			variable := goSQLJSONVariable(column)
+			g.P("	var ", variable, " ", sqlPackage.Ident("NullString"))
+			dests = append(dests, "&"+variable)
+		} else {
+			dests = append(dests, "&m."+column.field.GoName)
+		}
+	}
+	g.P("	if err := rows.Scan(", strings.Join(dests, ", "), "); err != nil {")
+	g.P("		return nil, err")
+	g.P("	}")
+	for _, column := range table.columns {
+		if !column.json {
+			continue
+		}
+		variable := goSQLJSONVariable(column)
+		g.P("	if ", variable, ".Valid {")
+		g.P("		if err := ", jsonPackage.Ident("Unmarshal"), "([]byte(", variable, ".String), &m.", column.field.GoName, "); err != nil {")
+		g.P("			return nil, ", fmtPackage.Ident("Errorf"), "(\"", msgName, ": column ", column.name, ": %w\", err)")
+		g.P("		}")
+		g.P("	}")
+	}
+	g.P("	return m, nil")
+	g.P("}")
+	g.P()
+
+	// Insert passes JSON columns as encoded strings, or NULL for nil fields.
+	// database/sql rejects uint64 values at or above 2^63, so they are passed
+	// as decimal strings, which Scan parses back.
+	g.P("// Insert", msgName, " inserts m as a row of the ", strconv.Quote(table.name), " table")
+	g.P("func Insert", msgName, "(ctx ", contextPackage.Ident("Context"), ", db *", sqlPackage.Ident("DB"), ", m *", msgName, ") error {")
+	var args []string
+	for _, column := range table.columns {
+		if isSQLUnsigned64(column) {
+			args = append(args, g.QualifiedGoIdent(strconvPackage.Ident("FormatUint"))+"(m."+column.field.GoName+", 10)")
+			continue
+		}
+		if !column.json {
+			args = append(args, "m."+column.field.GoName)
+			continue
+		}
+		variable := goSQLJSONVariable(column)
+		g.P("	var ", variable, " any")
+		g.P("	if m.", column.field.GoName, " != nil {")
+		g.P("		data, err := ", jsonPackage.Ident("Marshal"), "(m.", column.field.GoName, ")")
+		g.P("		if err != nil {")
+		g.P("			return ", fmtPackage.Ident("Errorf"), "(\"", msgName, ": column ", column.name, ": %w\", err)")
+		g.P("		}")
+		g.P("		", variable, " = string(data)")
+		g.P("	}")
+		args = append(args, variable)
+	}
+	g.P("	_, err := db.ExecContext(ctx, Insert", msgName, "SQL, ", strings.Join(args, ", "), ")")
+	g.P("	return err")
+	g.P("}")
+	g.P()
+}
+
+// isSQLUnsigned64 reports whether a column holds a uint64 or fixed64 field
+func isSQLUnsigned64(column *sqlColumn) bool {
+	switch column.field.Desc.Kind().String() {
+	case "uint64", "fixed64":
+		return !column.json
+	}
+	return false
+}
+
+// goSQLJSONVariable names the local variable holding a JSON column
+func goSQLJSONVariable(column *sqlColumn) string {
+	return "json" + column.field.GoName
+}
+
+// goRawString returns s as a raw string literal, or a quoted one if s holds
+// a backtick
+func goRawString(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
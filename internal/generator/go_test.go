@@ -0,0 +1,283 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGoValueMethods(t *testing.T) {
+	files := mustGenerate(t, "language=go", "values/values.proto")
+	source := files["example.com/puregentest/values/values.go"]
+
+	// Map fields are Go maps, and map entries get no types of their own
+	for _, want := range []string{
+		"Counters map[string]int64",
+		"TagsById map[int32]*Tag",
+		"Blobs map[string][]byte",
+	} {
+		if !strings.Contains(strings.Join(strings.Fields(source), " "), want) {
+			t.Errorf("values.go lacks %q", want)
+		}
+	}
+	if strings.Contains(source, "CountersEntry") {
+		t.Error("values.go declares a map entry type")
+	}
+
+	checkGo(t, files, "example.com/puregentest")
+}
+
+func TestGoDeprecation(t *testing.T) {
+	files := mustGenerate(t, "language=go", "deprecation/deprecation.proto")
+	source := files["example.com/puregentest/deprecation/deprecation.go"]
+	lines := strings.Split(source, "\n")
+
+	// Each deprecated declaration ends its doc comment with the notice
+	notice := "Deprecated: Marked as deprecated in deprecation/deprecation.proto."
+	for _, decl := range []string{
+		"Channel_CHANNEL_FAX =",
+		"Priority_PRIORITY_URGENT ",
+		"Fax ",
+		"func WithContactFax(",
+		"func (m *Contact) GetFax(",
+		"type LegacyContact struct",
+		"GetLegacyContact(ctx ",
+		"func (c *ContactServiceClient) GetLegacyContact(",
+	} {
+		found := false
+		for i, line := range lines {
+			if strings.HasPrefix(strings.TrimSpace(line), decl) {
+				found = true
+				if i == 0 || !strings.HasSuffix(lines[i-1], notice) {
+					t.Errorf("%q is not marked deprecated", strings.TrimSpace(line))
+				}
+			}
+		}
+		if !found {
+			t.Errorf("deprecation.go lacks %q", decl)
+		}
+	}
+	// String enums are constant groups, documented as a whole
+	if !strings.Contains(source, notice+"\nconst (\n\tLegacyStatus_") {
+		t.Error("LegacyStatus is not marked deprecated")
+	}
+
+	checkGo(t, files, "example.com/puregentest")
+}
+
+func TestGoJSONNaming(t *testing.T) {
+	tests := []struct {
+		naming string
+		tags   []string
+	}{
+		{naming: "camel", tags: []string{`json:"displayName"`, `json:"mail"`, `json:"leadProfile"`}},
+		{naming: "proto", tags: []string{`json:"display_name"`, `json:"email_address"`, `json:"lead_profile"`}},
+		{naming: "custom", tags: []string{`json:"display_name"`, `json:"mail"`, `json:"lead_profile"`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.naming, func(t *testing.T) {
+			files := mustGenerate(t, "language=go,json_naming="+tt.naming, "jsonnames/jsonnames.proto")
+			source := files["example.com/puregentest/jsonnames/jsonnames.go"]
+			for _, tag := range tt.tags {
+				if !strings.Contains(source, tag) {
+					t.Errorf("jsonnames.go lacks %s", tag)
+				}
+			}
+			// Tag has no other names to accept
+			if strings.Contains(source, "func (m *Tag) UnmarshalJSON") {
+				t.Error("jsonnames.go declares Tag.UnmarshalJSON")
+			}
+			checkGo(t, files, "example.com/puregentest")
+		})
+	}
+}
+
+func TestGoJSONMode(t *testing.T) {
+	files := mustGenerate(t, "language=go,json_naming=camel", "jsonmode/accounts.proto", "jsonmode/orders.proto")
+	for filename, tags := range map[string][]string{
+		"example.com/puregentest/jsonmode/accounts.go": {
+			`json:"account_id,omitempty"`,
+			`json:"emailAddress,omitempty"`,
+			`json:"phone_number"`,
+		},
+		"example.com/puregentest/jsonmode/orders.go": {`json:"order_id"`, `json:"total_cents"`},
+	} {
+		for _, tag := range tags {
+			if !strings.Contains(files[filename], tag) {
+				t.Errorf("%s lacks %s", filename, tag)
+			}
+		}
+	}
+	checkGo(t, files, "example.com/puregentest")
+}
+
+func TestGoEnums(t *testing.T) {
+	for _, param := range []string{
+		"language=go",
+		// Int enums marshal as their names
+		"language=go,go_int_enums=name",
+	} {
+		t.Run(param, func(t *testing.T) {
+			files := mustGenerate(t, param, "enums/enums.proto")
+			checkGo(t, files, "example.com/puregentest")
+		})
+	}
+}
+
+// The common_namespace package is placed and imported at a path of the module
+// the generated code belongs to
+func TestGoCommonImportPath(t *testing.T) {
+	for _, tc := range []struct {
+		param, transport, client, importLine string
+	}{
+		{
+			"language=go,common_namespace=shared.transport,go_module=example.com/puregentest",
+			"example.com/puregentest/shared/transport/transport.go",
+			"example.com/puregentest/layout/a.go",
+			`transport "example.com/puregentest/shared/transport"`,
+		},
+		{
+			"language=go,common_namespace=shared,common_go_import_path=example.com/puregentest/common",
+			"example.com/puregentest/common/transport.go",
+			"example.com/puregentest/layout/a.go",
+			`common "example.com/puregentest/common"`,
+		},
+		// protoc's module option strips the module from the file names
+		{
+			"language=go,common_namespace=shared.transport,module=example.com/puregentest",
+			"shared/transport/transport.go",
+			"layout/a.go",
+			`transport "example.com/puregentest/shared/transport"`,
+		},
+	} {
+		t.Run(tc.param, func(t *testing.T) {
+			files := mustGenerate(t, tc.param, "layout/a.proto")
+			if _, ok := files[tc.transport]; !ok {
+				t.Errorf("%s not generated; got %v", tc.transport, fileNames(files))
+			}
+			if !strings.Contains(files[tc.client], tc.importLine) {
+				t.Errorf("%s lacks the import %s", tc.client, tc.importLine)
+			}
+			if strings.HasPrefix(tc.client, "example.com/puregentest/") {
+				checkGo(t, files, "example.com/puregentest")
+			}
+		})
+	}
+}
+
+// Custom options set what comment directives do, and win over them
+func TestGoCustomOptions(t *testing.T) {
+	files := mustGenerate(t, "language=go", "options/options.proto")
+	checkGo(t, files, "example.com/puregentest")
+}
+
+func TestGoRedaction(t *testing.T) {
+	files := mustGenerate(t, "language=go", "redact/redact.proto")
+	checkGo(t, files, "example.com/puregentest")
+}
+
+func TestGoDescriptors(t *testing.T) {
+	for _, param := range []string{
+		"language=go",
+		// Descriptor types declared once in the common namespace package
+		"language=go,common_namespace=shared,go_module=example.com/puregentest",
+	} {
+		t.Run(param, func(t *testing.T) {
+			files := mustGenerate(t, param, "descriptors/descriptors.proto")
+			checkGo(t, files, "example.com/puregentest")
+		})
+	}
+}
+
+func TestGoRegistry(t *testing.T) {
+	for _, param := range []string{
+		"language=go",
+		// Envelope type declared once in the common namespace package
+		"language=go,common_namespace=shared,go_module=example.com/puregentest",
+	} {
+		t.Run(param, func(t *testing.T) {
+			files := mustGenerate(t, param, "registry/registry.proto", "registry/shipping/shipping.proto")
+			source := files["example.com/puregentest/registry/registry.go"]
+			// Any is an envelope, not a message redefined locally
+			if strings.Contains(source, "type Any struct") {
+				t.Error("registry.go declares Any")
+			}
+			checkGo(t, files, "example.com/puregentest")
+		})
+	}
+}
+
+func TestGoFieldMask(t *testing.T) {
+	for _, param := range []string{
+		"language=go",
+		// Field mask type declared once in the common namespace package
+		"language=go,common_namespace=shared,go_module=example.com/puregentest",
+	} {
+		t.Run(param, func(t *testing.T) {
+			files := mustGenerate(t, param, "fieldmask/fieldmask.proto")
+			source := files["example.com/puregentest/fieldmask/fieldmask.go"]
+			// FieldMask is the shared mask type, not a message redefined locally
+			if strings.Contains(source, "type FieldMask struct") {
+				t.Error("fieldmask.go declares FieldMask")
+			}
+			checkGo(t, files, "example.com/puregentest")
+		})
+	}
+}
+
+func TestGoSQL(t *testing.T) {
+	for _, tc := range []struct {
+		dialect string
+		want    []string
+	}{
+		{"postgres", []string{
+			`CREATE TABLE IF NOT EXISTS "commerce"."orders" (`,
+			`"order_id" UUID NOT NULL,`,
+			`"customer_id" TEXT NOT NULL REFERENCES "customers" ("id"),`,
+			`"reference" TEXT NOT NULL UNIQUE,`,
+			`"sequence" NUMERIC(20) NOT NULL,`,
+			`"status" INTEGER NOT NULL,`,
+			`"shipping_address" JSONB,`,
+			`PRIMARY KEY ("order_id")`,
+			`CREATE INDEX IF NOT EXISTS "orders_customer_id_idx" ON "commerce"."orders" ("customer_id");`,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "customers_name_idx" ON "customers" ("name");`,
+			`"tier" TEXT NOT NULL,`,
+		}},
+		{"sqlite", []string{
+			`CREATE TABLE IF NOT EXISTS "orders" (`,
+			`"paid" INTEGER NOT NULL,`,
+			// SQLite integers are signed
+			`"sequence" TEXT NOT NULL,`,
+			`"signature" BLOB NOT NULL,`,
+			`"shipping_address" TEXT,`,
+		}},
+	} {
+		t.Run(tc.dialect, func(t *testing.T) {
+			files := mustGenerate(t, "language=go,sql_dialect="+tc.dialect, "sql/sql.proto")
+			ddl, ok := files["sql/sql.sql"]
+			if !ok {
+				t.Fatal("sql/sql.sql not generated")
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(ddl, want) {
+					t.Errorf("sql.sql lacks %q", want)
+				}
+			}
+			// Lists need a column or type, and Cart declares no table
+			for _, unwanted := range []string{`"tags"`, "Cart"} {
+				if strings.Contains(ddl, unwanted) {
+					t.Errorf("sql.sql maps %s", unwanted)
+				}
+			}
+			checkGo(t, files, "example.com/puregentest")
+		})
+	}
+
+	// Without a dialect there is no SQL
+	files := mustGenerate(t, "language=go", "sql/sql.proto")
+	if _, ok := files["sql/sql.sql"]; ok {
+		t.Error("sql/sql.sql generated without a dialect")
+	}
+	if strings.Contains(files["example.com/puregentest/sql/sql.go"], "func ScanOrder(") {
+		t.Error("sql.go declares ScanOrder without a dialect")
+	}
+}
@@ -1,13 +1,17 @@
 package generator
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"path"
 	"path/filepath"
-	"sort"
 	"strconv"
 	"strings"
 	"unicode"
 
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // titleCase converts a string to title case (capitalize first letter)
@@ -20,9 +24,6 @@ func titleCase(s string) string {
 	return string(runes)
 }
 
-// Track created metadata files to avoid duplicates
-var createdJavaMetadataFiles = make(map[string]bool)
-
 // formatJavaComment formats a comment for Java code
 func formatJavaComment(comments protogen.CommentSet) []string {
 	var result []string
@@ -64,6 +65,111 @@ func formatJavaComment(comments protogen.CommentSet) []string {
 	return result
 }
 
+// javaString renders s as a Java string literal
+func javaString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				b.WriteString(fmt.Sprintf(`\u%04x`, r))
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// javaMetadataLiteral renders a metadata value as a Java expression of type Object.
+// Nested objects use the metadataMap helper emitted by writeJavaMetadataMapHelper.
+func javaMetadataLiteral(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return javaString(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			if n >= math.MinInt32 && n <= math.MaxInt32 {
+				return v.String()
+			}
+			return v.String() + "L"
+		}
+		if f, err := v.Float64(); err == nil {
+			return strconv.FormatFloat(f, 'g', -1, 64) + "d"
+		}
+		return javaString(v.String())
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, javaMetadataLiteral(item))
+		}
+		return "Arrays.<Object>asList(" + strings.Join(items, ", ") + ")"
+	case map[string]any:
+		entries := make([]string, 0, len(v))
+		for _, key := range sortedKeys(v) {
+			entries = append(entries, javaString(key), javaMetadataLiteral(v[key]))
+		}
+		return "metadataMap(" + strings.Join(entries, ", ") + ")"
+	default:
+		return "null"
+	}
+}
+
+// javaMetadataNeedsMapHelper reports whether any value in the given metadata
+// maps contains a nested object and therefore needs the metadataMap helper
+func javaMetadataNeedsMapHelper(metadata ...map[string]any) bool {
+	var nested func(value any) bool
+	nested = func(value any) bool {
+		switch v := value.(type) {
+		case map[string]any:
+			return true
+		case []any:
+			for _, item := range v {
+				if nested(item) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	for _, m := range metadata {
+		for _, value := range m {
+			if nested(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// writeJavaMetadataMapHelper writes the private helper used to build nested metadata objects
+func writeJavaMetadataMapHelper(g *protogen.GeneratedFile) {
+	g.P("    private static Map<String, Object> metadataMap(Object... entries) {")
+	g.P("        Map<String, Object> map = new LinkedHashMap<>();")
+	g.P("        for (int i = 0; i < entries.length; i += 2) {")
+	g.P("            map.put((String) entries[i], entries[i + 1]);")
+	g.P("        }")
+	g.P("        return map;")
+	g.P("    }")
+	g.P()
+}
+
 // writeJavaComment writes formatted comments to the generator
 func writeJavaComment(g *protogen.GeneratedFile, comments protogen.CommentSet) {
 	commentLines := formatJavaComment(comments)
@@ -72,57 +178,112 @@ func writeJavaComment(g *protogen.GeneratedFile, comments protogen.CommentSet) {
 	}
 }
 
+// javaJSONAnnotations returns the Jackson annotations naming a field in JSON:
+// its name under json.naming, and the other names it is accepted under. A
+// field whose omitEmpty setting differs from its class's has its own @JsonInclude.
+func javaJSONAnnotations(field *protogen.Field, config *Config) []string {
+	annotations := []string{"@JsonProperty(" + javaString(jsonFieldName(field, config)) + ")"}
+	if omitEmpty := fieldJSONConfig(field, config).OmitEmpty; field.Parent != nil && omitEmpty != messageJSONConfig(field.Parent, config).OmitEmpty {
+		if omitEmpty {
+			annotations = append(annotations, "@JsonInclude(JsonInclude.Include.NON_EMPTY)")
+		} else {
+			annotations = append(annotations, "@JsonInclude(JsonInclude.Include.ALWAYS)")
+		}
+	}
+	if aliases := jsonFieldAliases(field, config); len(aliases) > 0 {
+		quoted := make([]string, len(aliases))
+		for i, alias := range aliases {
+			quoted[i] = javaString(alias)
+		}
+		annotations = append(annotations, "@JsonAlias({"+strings.Join(quoted, ", ")+"})")
+	}
+	return annotations
+}
+
+// writeJavaDeprecated annotates a deprecated element with @Deprecated
+func writeJavaDeprecated(g *protogen.GeneratedFile, indent string, desc protoreflect.Descriptor) {
+	if isDeprecated(desc) {
+		g.P(indent, "@Deprecated")
+	}
+}
+
 
 
 // GenerateJavaFile generates Java code for the given protobuf file
-func GenerateJavaFile(gen *protogen.Plugin, file *protogen.File, commonNamespace string) {
+func GenerateJavaFile(gen *protogen.Plugin, file *protogen.File, config *Config) {
 	if len(file.Messages) == 0 && len(file.Services) == 0 {
 		return
 	}
 
 	// Generate transport interface based on namespace configuration
-	if len(file.Services) > 0 {
-		if commonNamespace != "" {
+	if len(file.Services) > 0 && config.Features.Clients {
+		if config.CommonNamespace != "" {
 			// Generate global transport if namespace is provided
-			generateGlobalTransportJava(gen, commonNamespace)
+			generateGlobalTransportJava(gen, config)
 		} else {
 			// Generate per-package transport if no common namespace is provided
-			generatePackageTransportJava(gen, file)
+			generatePackageTransportJava(gen, file, config)
 		}
 	}
 
+	// Messages share the PuregenJson mapper, next to the transport
+	if config.CommonNamespace != "" {
+		generateGlobalJsonJava(gen, config)
+	} else {
+		generatePackageJsonJava(gen, file, config)
+	}
+	generateFieldMaskJava(gen, file, config)
+
+	// Messages are listed in the PuregenDescriptors registry of their package
+	if len(collectDescribedMessages(file)) > 0 {
+		generatePackageDescriptorsJava(gen, file, config)
+	}
+
+	// Message classes are listed in the PuregenTypes registry of their package
+	if hasTypeRegistry(file, config) {
+		generatePackageRegistryJava(gen, file, config)
+	}
+
 	// Get package name
 	javaPackage := getJavaPackage(file)
-	packageDir := strings.ReplaceAll(javaPackage, ".", "/")
+	packageDir := outputPath(config.Output.Java, getJavaPackageDir(file, config))
 
 	// Collect and generate imported messages first
 	importedMessages := collectImportedMessages(file)
 	for _, message := range importedMessages {
-		generateJavaMessage(gen, file, message, javaPackage, packageDir)
+		generateJavaMessage(gen, file, message, javaPackage, packageDir, config)
 	}
 
 	// Generate all enums (including nested and unreferenced)
 	// Only generate file-level enums here; nested enums will be generated with their parent messages
 	for _, enum := range file.Enums {
-		generateJavaEnum(gen, file, enum, javaPackage, packageDir)
+		generateJavaEnum(gen, file, enum, javaPackage, packageDir, config)
 	}
 
 	// Generate messages
 	for _, message := range file.Messages {
-		generateJavaMessage(gen, file, message, javaPackage, packageDir)
+		generateJavaMessage(gen, file, message, javaPackage, packageDir, config)
 	}
 
 	// Generate services
 	for _, service := range file.Services {
-		generateJavaService(gen, file, service, javaPackage, packageDir)
-		// Generate method constants
-		generateJavaMethodConstants(gen, file, service, javaPackage, packageDir)
-		// Generate client
-		generateJavaClient(gen, file, service, javaPackage, packageDir, commonNamespace)
+		if config.Features.Services {
+			generateJavaService(gen, file, service, javaPackage, packageDir)
+			generateJavaAsyncService(gen, file, service, javaPackage, packageDir)
+		}
+		if config.Features.Services || config.Features.Clients {
+			// Generate method constants
+			generateJavaMethodConstants(gen, file, service, javaPackage, packageDir, config)
+		}
+		if config.Features.Clients {
+			// Generate client
+			generateJavaClient(gen, file, service, javaPackage, packageDir, config)
+			generateJavaAsyncClient(gen, file, service, javaPackage, packageDir, config)
+		}
 	}
 }
 
-func generateJavaMethodConstants(gen *protogen.Plugin, _ *protogen.File, service *protogen.Service, javaPackage, packageDir string) {
+func generateJavaMethodConstants(gen *protogen.Plugin, _ *protogen.File, service *protogen.Service, javaPackage, packageDir string, config *Config) {
 	serviceName := service.GoName
 	constantsFilename := filepath.Join(packageDir, serviceName+"Methods.java")
 	g := gen.NewGeneratedFile(constantsFilename, "")
@@ -138,31 +299,38 @@ func generateJavaMethodConstants(gen *protogen.Plugin, _ *protogen.File, service
 	g.P("    private ", serviceName, "Methods() {} // Prevent instantiation")
 	g.P()
 
+	var allMetadata []map[string]any
+	if config.Features.Metadata {
+		for _, method := range service.Methods {
+			allMetadata = append(allMetadata, parseMethodMetadata(method, config))
+		}
+	}
+	if javaMetadataNeedsMapHelper(allMetadata...) {
+		writeJavaMetadataMapHelper(g)
+	}
+
 	for _, method := range service.Methods {
 		constName := serviceName + "_" + method.GoName
 		g.P("    public static final String ", constName, " = \"", constName, "\";")
 	}
+
+	if !config.Features.Metadata {
+		g.P("}")
+		return
+	}
 	g.P()
 
 	// Generate method metadata map
-	g.P("    public static final Map<String, Map<String, String>> METHOD_METADATA = new HashMap<>();")
+	g.P("    public static final Map<String, Map<String, Object>> METHOD_METADATA = new HashMap<>();")
 	g.P("    static {")
 	for _, method := range service.Methods {
 		constName := serviceName + "_" + method.GoName
-		metadata := parseMethodMetadata(method.Comments)
+		metadata := parseMethodMetadata(method, config)
 		if metadata != nil {
-			g.P("        Map<String, String> ", strings.ToLower(method.GoName), "Metadata = new HashMap<>();")
-
-			// Sort keys for consistent output
-			var keys []string
-			for key := range metadata {
-				keys = append(keys, key)
-			}
-			sort.Strings(keys)
+			g.P("        Map<String, Object> ", strings.ToLower(method.GoName), "Metadata = new HashMap<>();")
 
-			for _, key := range keys {
-				value := metadata[key]
-				g.P("        ", strings.ToLower(method.GoName), "Metadata.put(\"", key, "\", \"", value, "\");")
+			for _, key := range sortedKeys(metadata) {
+				g.P("        ", strings.ToLower(method.GoName), "Metadata.put(", javaString(key), ", ", javaMetadataLiteral(metadata[key]), ");")
 			}
 			g.P("        METHOD_METADATA.put(", constName, ", ", strings.ToLower(method.GoName), "Metadata);")
 		}
@@ -171,13 +339,13 @@ func generateJavaMethodConstants(gen *protogen.Plugin, _ *protogen.File, service
 	g.P("}")
 }
 
-func generateJavaEnum(gen *protogen.Plugin, _ *protogen.File, enum *protogen.Enum, javaPackage, packageDir string) {
+func generateJavaEnum(gen *protogen.Plugin, _ *protogen.File, enum *protogen.Enum, javaPackage, packageDir string, config *Config) {
 	enumName := enum.GoIdent.GoName
 	filename := filepath.Join(packageDir, enumName+".java")
 	g := gen.NewGeneratedFile(filename, "")
 
 	// Parse puregen directive to determine enum type
-	directive := parsePuregenDirective(enum.Comments)
+	directive := parseEnumDirective(enum, config)
 	useStringConstants := true // Default to string constants
 	
 	if directive != nil && directive.EnumType == "int" {
@@ -189,9 +357,14 @@ func generateJavaEnum(gen *protogen.Plugin, _ *protogen.File, enum *protogen.Enu
 	g.P()
 	g.P("package ", javaPackage, ";")
 	g.P()
+	if !useStringConstants {
+		g.P("import com.fasterxml.jackson.annotation.*;")
+		g.P()
+	}
 
 	// Generate enum comment
 	writeJavaComment(g, enum.Comments)
+	writeJavaDeprecated(g, "", enum.Desc)
 
 	if useStringConstants {
 		// Generate string constants class
@@ -201,6 +374,7 @@ func generateJavaEnum(gen *protogen.Plugin, _ *protogen.File, enum *protogen.Enu
 
 		for _, value := range enum.Values {
 			valueName := strings.ToUpper(string(value.Desc.Name()))
+			writeJavaDeprecated(g, "    ", value.Desc)
 			g.P("    public static final String ", valueName, " = \"", value.Desc.Name(), "\";")
 		}
 		g.P()
@@ -227,21 +401,48 @@ func generateJavaEnum(gen *protogen.Plugin, _ *protogen.File, enum *protogen.Enu
 		g.P("        }")
 		g.P("        return false;")
 		g.P("    }")
+		if hasEnumDisplayNames(enum) {
+			g.P()
+			g.P("    public static String getDisplayName(String value) {")
+			g.P("        switch (value) {")
+			for _, value := range enum.Values {
+				g.P("            case ", strings.ToUpper(string(value.Desc.Name())), ":")
+				g.P("                return ", javaString(enumDisplayName(enum, value)), ";")
+			}
+			g.P("            default:")
+			g.P("                return value;")
+			g.P("        }")
+			g.P("    }")
+		}
 		g.P("}")
 	} else {
 		// Generate traditional Java enum
 		g.P("public enum ", enumName, " {")
 		
-		for i, value := range enum.Values {
-			valueName := strings.ToUpper(string(value.Desc.Name()))
-			if i == len(enum.Values)-1 {
-				g.P("    ", valueName, "(", value.Desc.Number(), ");")
-			} else {
-				g.P("    ", valueName, "(", value.Desc.Number(), "),")
+		// UNRECOGNIZED stands for numbers and names this version does not know
+		var aliases []*protogen.EnumValue
+		for _, value := range enum.Values {
+			if canonicalEnumValue(enum, value) != value {
+				aliases = append(aliases, value)
+				continue
 			}
+			valueName := strings.ToUpper(string(value.Desc.Name()))
+			writeJavaDeprecated(g, "    ", value.Desc)
+			g.P("    ", valueName, "(", value.Desc.Number(), "),")
 		}
+		g.P("    UNRECOGNIZED(-1);")
 		g.P()
 
+		// Aliases are further names of the constant with their number
+		for _, alias := range aliases {
+			canonicalName := strings.ToUpper(string(canonicalEnumValue(enum, alias).Desc.Name()))
+			writeJavaDeprecated(g, "    ", alias.Desc)
+			g.P("    public static final ", enumName, " ", strings.ToUpper(string(alias.Desc.Name())), " = ", canonicalName, ";")
+		}
+		if len(aliases) > 0 {
+			g.P()
+		}
+
 		// Generate fields and constructor
 		g.P("    private final int value;")
 		g.P()
@@ -250,7 +451,10 @@ func generateJavaEnum(gen *protogen.Plugin, _ *protogen.File, enum *protogen.Enu
 		g.P("    }")
 		g.P()
 
-		// Generate getValue method
+		// Generate getValue method, which is also the JSON form unless names are requested
+		if config.Java.IntEnums == numberIntEnums {
+			g.P("    @JsonValue")
+		}
 		g.P("    public int getValue() {")
 		g.P("        return value;")
 		g.P("    }")
@@ -259,36 +463,75 @@ func generateJavaEnum(gen *protogen.Plugin, _ *protogen.File, enum *protogen.Enu
 		// Generate fromValue method
 		g.P("    public static ", enumName, " fromValue(int value) {")
 		g.P("        for (", enumName, " e : values()) {")
-		g.P("            if (e.value == value) {")
+		g.P("            if (e != UNRECOGNIZED && e.value == value) {")
 		g.P("                return e;")
 		g.P("            }")
 		g.P("        }")
-		g.P("        throw new IllegalArgumentException(\"Invalid ", enumName, " value: \" + value);")
+		g.P("        return UNRECOGNIZED;")
+		g.P("    }")
+		g.P()
+
+		// Generate fromName method, which knows the aliases too
+		g.P("    public static ", enumName, " fromName(String name) {")
+		g.P("        switch (name) {")
+		for _, value := range enum.Values {
+			canonicalName := strings.ToUpper(string(canonicalEnumValue(enum, value).Desc.Name()))
+			g.P("            case ", javaString(string(value.Desc.Name())), ":")
+			g.P("                return ", canonicalName, ";")
+		}
+		g.P("            default:")
+		g.P("                return UNRECOGNIZED;")
+		g.P("        }")
+		g.P("    }")
+		g.P()
+
+		// Generate JSON creator accepting both numbers and names
+		g.P("    @JsonCreator(mode = JsonCreator.Mode.DELEGATING)")
+		g.P("    public static ", enumName, " fromJsonValue(Object value) {")
+		g.P("        if (value instanceof Number) {")
+		g.P("            return fromValue(((Number) value).intValue());")
+		g.P("        }")
+		g.P("        return fromName(String.valueOf(value));")
 		g.P("    }")
 		g.P()
 
 		// Generate isValid method
 		g.P("    public static boolean isValid(int value) {")
 		g.P("        for (", enumName, " e : values()) {")
-		g.P("            if (e.value == value) {")
+		g.P("            if (e != UNRECOGNIZED && e.value == value) {")
 		g.P("                return true;")
 		g.P("            }")
 		g.P("        }")
 		g.P("        return false;")
 		g.P("    }")
+		if hasEnumDisplayNames(enum) {
+			g.P()
+			g.P("    public String getDisplayName() {")
+			g.P("        switch (this) {")
+			for _, value := range enum.Values {
+				if canonicalEnumValue(enum, value) != value {
+					continue
+				}
+				g.P("            case ", strings.ToUpper(string(value.Desc.Name())), ":")
+				g.P("                return ", javaString(enumDisplayName(enum, value)), ";")
+			}
+			g.P("            default:")
+			g.P("                return name();")
+			g.P("        }")
+			g.P("    }")
+		}
 		g.P("}")
 	}
 
-	// Generate separate metadata class for the enum if it has metadata
-	enumMetadata := parseEnumMetadata(enum.Comments)
-	if enumMetadata != nil {
+	// Generate separate metadata class for the enum if it or its values have metadata
+	enumMetadata := parseEnumMetadata(enum, config)
+	if (enumMetadata != nil || hasEnumValueMetadata(enum)) && config.Features.Metadata {
 		metadataFilename := filepath.Join(packageDir, enumName+"Metadata.java")
 		
 		// Check if we've already created this metadata file
-		if createdJavaMetadataFiles[metadataFilename] {
+		if !config.state.createOnce(metadataFilename) {
 			return
 		}
-		createdJavaMetadataFiles[metadataFilename] = true
 		
 		metaG := gen.NewGeneratedFile(metadataFilename, "")
 
@@ -301,25 +544,51 @@ func generateJavaEnum(gen *protogen.Plugin, _ *protogen.File, enum *protogen.Enu
 		metaG.P("public final class ", enumName, "Metadata {")
 		metaG.P("    private ", enumName, "Metadata() {} // Prevent instantiation")
 		metaG.P()
-		metaG.P("    public static final Map<String, String> METADATA = new HashMap<>();")
-		metaG.P("    static {")
-		// Sort keys for consistent output
-		var keys []string
-		for key := range enumMetadata {
-			keys = append(keys, key)
+		valueMetadata := make([]map[string]any, len(enum.Values))
+		for i, value := range enum.Values {
+			valueMetadata[i] = parseEnumValueMetadata(value)
 		}
-		sort.Strings(keys)
-
-		for _, key := range keys {
-			value := enumMetadata[key]
-			metaG.P("        METADATA.put(\"", key, "\", \"", value, "\");")
+		if javaMetadataNeedsMapHelper(append(valueMetadata, enumMetadata)...) {
+			writeJavaMetadataMapHelper(metaG)
+		}
+		if enumMetadata != nil {
+			metaG.P("    public static final Map<String, Object> METADATA = new HashMap<>();")
+			metaG.P("    static {")
+			for _, key := range sortedKeys(enumMetadata) {
+				metaG.P("        METADATA.put(", javaString(key), ", ", javaMetadataLiteral(enumMetadata[key]), ");")
+			}
+			metaG.P("    }")
+		}
+		if hasEnumValueMetadata(enum) {
+			// Value metadata is keyed by the proto name of each value
+			if enumMetadata != nil {
+				metaG.P()
+			}
+			metaG.P("    public static final Map<String, Map<String, Object>> VALUE_METADATA = new HashMap<>();")
+			metaG.P("    static {")
+			for i, value := range enum.Values {
+				if valueMetadata[i] == nil {
+					continue
+				}
+				varName := strings.ToLower(string(value.Desc.Name())) + "Meta"
+				metaG.P("        Map<String, Object> ", varName, " = new HashMap<>();")
+				for _, key := range sortedKeys(valueMetadata[i]) {
+					metaG.P("        ", varName, ".put(", javaString(key), ", ", javaMetadataLiteral(valueMetadata[i][key]), ");")
+				}
+				metaG.P("        VALUE_METADATA.put(", javaString(string(value.Desc.Name())), ", ", varName, ");")
+			}
+			metaG.P("    }")
 		}
-		metaG.P("    }")
 		metaG.P("}")
 	}
 }
 
-func generateJavaMessage(gen *protogen.Plugin, file *protogen.File, msg *protogen.Message, javaPackage, packageDir string) {
+func generateJavaMessage(gen *protogen.Plugin, file *protogen.File, msg *protogen.Message, javaPackage, packageDir string, config *Config) {
+	// Map entries are the Map<K, V> of their field
+	if msg.Desc.IsMapEntry() {
+		return
+	}
+
 	filename := filepath.Join(packageDir, msg.GoIdent.GoName+".java")
 	g := gen.NewGeneratedFile(filename, "")
 
@@ -332,141 +601,46 @@ func generateJavaMessage(gen *protogen.Plugin, file *protogen.File, msg *protoge
 	g.P("import java.io.*;")
 	g.P("import com.fasterxml.jackson.annotation.*;")
 	g.P("import com.fasterxml.jackson.databind.*;")
+	if config.CommonNamespace != "" {
+		g.P("import ", config.CommonNamespace, ".PuregenJson;")
+	}
+	writeJavaDescriptorImports(g, config)
+	writeJavaRegistryImports(g, file, config)
+	writeJavaFieldMaskImports(g, config)
 	g.P()
 
 	// Generate class comment
 	writeJavaComment(g, msg.Comments)
+	writeJavaDeprecated(g, "", msg.Desc)
 
-	// Generate class
-	g.P("public class ", msg.GoIdent.GoName, " {")
-
-	// Generate fields
-	for _, field := range msg.Fields {
-		// Generate field comment
-		if commentLines := formatJavaComment(field.Comments); len(commentLines) > 0 {
-			for _, line := range commentLines {
-				g.P(line)
-			}
-		}
-
-		fieldType := getJavaFieldType(field)
-		fieldName := getJavaFieldName(field.GoName)
-		g.P("    @JsonProperty(\"", field.Desc.JSONName(), "\")")
-		end := ";"
-		if field.Desc.IsList() {
-			end = " = new ArrayList<>();"
-		}
-		g.P("    private ", fieldType, " ", fieldName, end)
-		g.P()
-	}
-
-	// Generate default constructor
-	g.P("    public ", msg.GoIdent.GoName, "() {")
-	
-	// Check if any fields have default values and initialize them
-	for _, field := range msg.Fields {
-		defaultValue := getJavaDefaultValue(field)
-		if defaultValue != "" {
-			fieldName := getJavaFieldName(field.GoName)
-			g.P("        this.", fieldName, " = ", defaultValue, ";")
-		}
-	}
-	
-	g.P("    }")
-	g.P()
-
-	// Generate getters and setters
-	for _, field := range msg.Fields {
-		fieldType := getJavaFieldType(field)
-		fieldName := getJavaFieldName(field.GoName)
-		methodName := titleCase(fieldName)
-
-		g.P("    public ", fieldType, " get", methodName, "() {")
-		g.P("        return ", fieldName, ";")
-		g.P("    }")
-		g.P()
-
-		g.P("    public void set", methodName, "(", fieldType, " ", fieldName, ") {")
-		g.P("        this.", fieldName, " = ", fieldName, ";")
-		g.P("    }")
-		g.P()
-
-		// Add convenience methods for repeated fields
-		if field.Desc.IsList() {
-			elementType := strings.TrimPrefix(strings.TrimSuffix(fieldType, ">"), "List<")
-			g.P("    public void add", methodName, "(", elementType, " item) {")
-			g.P("        if (this.", fieldName, " == null) {")
-			g.P("            this.", fieldName, " = new ArrayList<>();")
-			g.P("        }")
-			g.P("        this.", fieldName, ".add(item);")
-			g.P("    }")
-			g.P()
-		}
-	}
-
-	// Generate builder pattern
-	g.P("    public static class Builder {")
-	g.P("        private ", msg.GoIdent.GoName, " instance = new ", msg.GoIdent.GoName, "();")
-	g.P()
-
-	for _, field := range msg.Fields {
-		fieldType := getJavaFieldType(field)
-		fieldName := getJavaFieldName(field.GoName)
-		methodName := titleCase(fieldName)
-
-		g.P("        public Builder set", methodName, "(", fieldType, " ", fieldName, ") {")
-		g.P("            instance.set", methodName, "(", fieldName, ");")
-		g.P("            return this;")
-		g.P("        }")
-		g.P()
+	switch config.Java.Style {
+	case recordsJavaStyle, immutableJavaStyle:
+		generateJavaImmutableClass(g, msg, config)
+	default:
+		generateJavaMutableClass(g, msg, config)
 	}
 
-	g.P("        public ", msg.GoIdent.GoName, " build() {")
-	g.P("            return instance;")
-	g.P("        }")
-	g.P("    }")
-	g.P()
-
-	// Generate validation method
-	g.P("    public boolean validate() {")
-	g.P("        // Add custom validation logic here")
-	g.P("        return true;")
-	g.P("    }")
-	g.P()
-
-	// Generate JSON serialization methods
-	g.P("    public String toJson() throws Exception {")
-	g.P("        ObjectMapper mapper = new ObjectMapper();")
-	g.P("        return mapper.writeValueAsString(this);")
-	g.P("    }")
-	g.P()
-
-	g.P("    public static ", msg.GoIdent.GoName, " fromJson(String json) throws Exception {")
-	g.P("        ObjectMapper mapper = new ObjectMapper();")
-	g.P("        return mapper.readValue(json, ", msg.GoIdent.GoName, ".class);")
-	g.P("    }")
-	g.P()
-
-	g.P("}")
-
 	// Generate nested enums
 	for _, enum := range msg.Enums {
-		generateJavaEnum(gen, file, enum, javaPackage, packageDir)
+		generateJavaEnum(gen, file, enum, javaPackage, packageDir, config)
 	}
 
 	// Generate nested messages
 	for _, nested := range msg.Messages {
-		generateJavaMessage(gen, file, nested, javaPackage, packageDir)
+		generateJavaMessage(gen, file, nested, javaPackage, packageDir, config)
+	}
+
+	if !config.Features.Metadata {
+		return
 	}
 
 	// Generate message metadata if available
-	messageMetadata := parseMessageMetadata(msg.Comments)
+	messageMetadata := parseMessageMetadata(msg, config)
 	if messageMetadata != nil {
 		metadataFilename := filepath.Join(packageDir, msg.GoIdent.GoName+"Metadata.java")
 		
 		// Check if we've already created this metadata file
-		if !createdJavaMetadataFiles[metadataFilename] {
-			createdJavaMetadataFiles[metadataFilename] = true
+		if config.state.createOnce(metadataFilename) {
 			metaG := gen.NewGeneratedFile(metadataFilename, "")
 
 			metaG.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
@@ -478,18 +652,13 @@ func generateJavaMessage(gen *protogen.Plugin, file *protogen.File, msg *protoge
 			metaG.P("public final class ", msg.GoIdent.GoName, "Metadata {")
 			metaG.P("    private ", msg.GoIdent.GoName, "Metadata() {} // Prevent instantiation")
 			metaG.P()
-			metaG.P("    public static final Map<String, String> METADATA = new HashMap<>();")
-			metaG.P("    static {")
-			// Sort keys for consistent output
-			var keys []string
-			for key := range messageMetadata {
-				keys = append(keys, key)
+			if javaMetadataNeedsMapHelper(messageMetadata) {
+				writeJavaMetadataMapHelper(metaG)
 			}
-			sort.Strings(keys)
-
-			for _, key := range keys {
-				value := messageMetadata[key]
-				metaG.P("        METADATA.put(\"", key, "\", \"", value, "\");")
+			metaG.P("    public static final Map<String, Object> METADATA = new HashMap<>();")
+			metaG.P("    static {")
+			for _, key := range sortedKeys(messageMetadata) {
+				metaG.P("        METADATA.put(", javaString(key), ", ", javaMetadataLiteral(messageMetadata[key]), ");")
 			}
 			metaG.P("    }")
 			metaG.P("}")
@@ -499,7 +668,7 @@ func generateJavaMessage(gen *protogen.Plugin, file *protogen.File, msg *protoge
 	// Generate field constants and metadata if any fields have metadata
 	hasFieldMetadata := false
 	for _, field := range msg.Fields {
-		fieldMetadata := parseFieldMetadata(field.Comments)
+		fieldMetadata := parseFieldMetadata(field, config)
 		if fieldMetadata != nil {
 			hasFieldMetadata = true
 			break
@@ -511,8 +680,7 @@ func generateJavaMessage(gen *protogen.Plugin, file *protogen.File, msg *protoge
 		fieldMetadataFilename := filepath.Join(packageDir, msg.GoIdent.GoName+"FieldMetadata.java")
 		
 		// Check if we've already created this metadata file
-		if !createdJavaMetadataFiles[fieldMetadataFilename] {
-			createdJavaMetadataFiles[fieldMetadataFilename] = true
+		if config.state.createOnce(fieldMetadataFilename) {
 			fieldG := gen.NewGeneratedFile(fieldMetadataFilename, "")
 
 			fieldG.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
@@ -524,11 +692,18 @@ func generateJavaMessage(gen *protogen.Plugin, file *protogen.File, msg *protoge
 			fieldG.P("public final class ", msg.GoIdent.GoName, "FieldMetadata {")
 			fieldG.P("    private ", msg.GoIdent.GoName, "FieldMetadata() {} // Prevent instantiation")
 			fieldG.P()
+			var allFieldMetadata []map[string]any
+			for _, field := range msg.Fields {
+				allFieldMetadata = append(allFieldMetadata, parseFieldMetadata(field, config))
+			}
+			if javaMetadataNeedsMapHelper(allFieldMetadata...) {
+				writeJavaMetadataMapHelper(fieldG)
+			}
 
 			// Generate field constants
 			fieldG.P("    // Field name constants")
 			for _, field := range msg.Fields {
-				fieldMetadata := parseFieldMetadata(field.Comments)
+				fieldMetadata := parseFieldMetadata(field, config)
 				if fieldMetadata != nil {
 					constName := msg.GoIdent.GoName + "_" + field.GoName + "_FIELD"
 					constValue := msg.GoIdent.GoName + "_" + field.GoName
@@ -538,25 +713,17 @@ func generateJavaMessage(gen *protogen.Plugin, file *protogen.File, msg *protoge
 			fieldG.P()
 
 			// Generate field metadata map
-			fieldG.P("    public static final Map<String, Map<String, String>> FIELD_METADATA = new HashMap<>();")
+			fieldG.P("    public static final Map<String, Map<String, Object>> FIELD_METADATA = new HashMap<>();")
 			fieldG.P("    static {")
 			for _, field := range msg.Fields {
-				fieldMetadata := parseFieldMetadata(field.Comments)
+				fieldMetadata := parseFieldMetadata(field, config)
 				if fieldMetadata != nil {
 					constName := msg.GoIdent.GoName + "_" + field.GoName + "_FIELD"
 					fieldVarName := strings.ToLower(field.GoName)
-					fieldG.P("        Map<String, String> ", fieldVarName, "Meta = new HashMap<>();")
+					fieldG.P("        Map<String, Object> ", fieldVarName, "Meta = new HashMap<>();")
 
-					// Sort keys for consistent output
-					var keys []string
-					for key := range fieldMetadata {
-						keys = append(keys, key)
-					}
-					sort.Strings(keys)
-
-					for _, key := range keys {
-						value := fieldMetadata[key]
-						fieldG.P("        ", fieldVarName, "Meta.put(\"", key, "\", \"", value, "\");")
+					for _, key := range sortedKeys(fieldMetadata) {
+						fieldG.P("        ", fieldVarName, "Meta.put(", javaString(key), ", ", javaMetadataLiteral(fieldMetadata[key]), ");")
 					}
 					fieldG.P("        FIELD_METADATA.put(", constName, ", ", fieldVarName, "Meta);")
 				}
@@ -567,6 +734,143 @@ func generateJavaMessage(gen *protogen.Plugin, file *protogen.File, msg *protoge
 	}
 }
 
+// generateJavaMutableClass writes a message as a class with setters and a Builder
+func generateJavaMutableClass(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	// Generate class
+	if messageJSONConfig(msg, config).OmitEmpty {
+		g.P("@JsonInclude(JsonInclude.Include.NON_EMPTY)")
+	}
+	g.P("public class ", msg.GoIdent.GoName, " {")
+	writeJavaFieldPaths(g, msg)
+	writeJavaDescriptor(g, msg, config)
+
+	// Generate fields
+	for _, field := range msg.Fields {
+		// Generate field comment
+		if commentLines := formatJavaComment(field.Comments); len(commentLines) > 0 {
+			for _, line := range commentLines {
+				g.P(line)
+			}
+		}
+
+		fieldType := getJavaFieldType(field, config)
+		fieldName := javaFieldName(field, config)
+		writeJavaDeprecated(g, "    ", field.Desc)
+		for _, annotation := range javaJSONAnnotations(field, config) {
+			g.P("    ", annotation)
+		}
+		end := ";"
+		if field.Desc.IsMap() {
+			end = " = new HashMap<>();"
+		} else if field.Desc.IsList() {
+			end = " = new ArrayList<>();"
+		}
+		g.P("    private ", fieldType, " ", fieldName, end)
+		g.P()
+	}
+
+	// Generate default constructor
+	g.P("    public ", msg.GoIdent.GoName, "() {")
+	
+	// Check if any fields have default values and initialize them
+	for _, field := range msg.Fields {
+		defaultValue := getJavaDefaultValue(field, config)
+		if defaultValue != "" {
+			fieldName := javaFieldName(field, config)
+			g.P("        this.", fieldName, " = ", defaultValue, ";")
+		}
+	}
+	
+	g.P("    }")
+	g.P()
+
+	// Generate getters and setters
+	for _, field := range msg.Fields {
+		fieldType := getJavaFieldType(field, config)
+		fieldName := javaFieldName(field, config)
+		methodName := titleCase(getJavaFieldName(field.GoName))
+
+		writeJavaDeprecated(g, "    ", field.Desc)
+		g.P("    public ", fieldType, " get", methodName, "() {")
+		g.P("        return ", fieldName, ";")
+		g.P("    }")
+		g.P()
+
+		writeJavaDeprecated(g, "    ", field.Desc)
+		g.P("    public void set", methodName, "(", fieldType, " ", fieldName, ") {")
+		g.P("        this.", fieldName, " = ", fieldName, ";")
+		g.P("    }")
+		g.P()
+
+		// Add convenience methods for repeated fields
+		if field.Desc.IsList() {
+			elementType := strings.TrimPrefix(strings.TrimSuffix(fieldType, ">"), "List<")
+			writeJavaDeprecated(g, "    ", field.Desc)
+			g.P("    public void add", methodName, "(", elementType, " item) {")
+			g.P("        if (this.", fieldName, " == null) {")
+			g.P("            this.", fieldName, " = new ArrayList<>();")
+			g.P("        }")
+			g.P("        this.", fieldName, ".add(item);")
+			g.P("    }")
+			g.P()
+		}
+
+		// And for map fields
+		if field.Desc.IsMap() {
+			writeJavaDeprecated(g, "    ", field.Desc)
+			g.P("    public void put", methodName, "(", javaMapKeyType(field, config), " key, ", javaMapValueType(field, config), " value) {")
+			g.P("        if (this.", fieldName, " == null) {")
+			g.P("            this.", fieldName, " = new HashMap<>();")
+			g.P("        }")
+			g.P("        this.", fieldName, ".put(key, value);")
+			g.P("    }")
+			g.P()
+		}
+	}
+
+	// Generate builder pattern
+	g.P("    public static class Builder {")
+	g.P("        private ", msg.GoIdent.GoName, " instance = new ", msg.GoIdent.GoName, "();")
+	g.P()
+
+	for _, field := range msg.Fields {
+		fieldType := getJavaFieldType(field, config)
+		fieldName := javaFieldName(field, config)
+		methodName := titleCase(getJavaFieldName(field.GoName))
+
+		writeJavaDeprecated(g, "        ", field.Desc)
+		g.P("        public Builder set", methodName, "(", fieldType, " ", fieldName, ") {")
+		g.P("            instance.set", methodName, "(", fieldName, ");")
+		g.P("            return this;")
+		g.P("        }")
+		g.P()
+	}
+
+	g.P("        public ", msg.GoIdent.GoName, " build() {")
+	g.P("            return instance;")
+	g.P("        }")
+	g.P("    }")
+	g.P()
+
+	writeJavaValueMethods(g, msg, config)
+	writeJavaMaskMethods(g, msg, config)
+	writeJavaRedacted(g, msg, config)
+
+	// Generate validation method
+	if config.Features.Validation {
+		g.P("    public boolean validate() {")
+		g.P("        // Add custom validation logic here")
+		g.P("        return true;")
+		g.P("    }")
+		g.P()
+	}
+
+	// Generate JSON serialization methods
+	writeJavaJsonMethods(g, msg.GoIdent.GoName)
+
+	g.P("}")
+}
+
 func generateJavaService(gen *protogen.Plugin, _ *protogen.File, service *protogen.Service, javaPackage, packageDir string) {
 	serviceName := service.GoName
 
@@ -578,9 +882,12 @@ func generateJavaService(gen *protogen.Plugin, _ *protogen.File, service *protog
 	g.P()
 	g.P("package ", javaPackage, ";")
 	g.P()
+	g.P("import java.util.*;")
+	g.P()
 
 	// Generate service comment
 	writeJavaComment(g, service.Comments)
+	writeJavaDeprecated(g, "", service.Desc)
 
 	g.P("public interface ", serviceName, "Service {")
 	for _, method := range service.Methods {
@@ -590,6 +897,7 @@ func generateJavaService(gen *protogen.Plugin, _ *protogen.File, service *protog
 				g.P(line)
 			}
 		}
+		writeJavaDeprecated(g, "    ", method.Desc)
 
 		inputType := method.Input.GoIdent.GoName
 		outputType := method.Output.GoIdent.GoName
@@ -605,7 +913,10 @@ func generateJavaService(gen *protogen.Plugin, _ *protogen.File, service *protog
 	impl.P()
 	impl.P("package ", javaPackage, ";")
 	impl.P()
+	impl.P("import java.util.*;")
+	impl.P()
 
+	writeJavaDeprecated(impl, "", service.Desc)
 	impl.P("public class Default", serviceName, "Service implements ", serviceName, "Service {")
 	for _, method := range service.Methods {
 		// Generate method comment
@@ -614,6 +925,7 @@ func generateJavaService(gen *protogen.Plugin, _ *protogen.File, service *protog
 				impl.P(line)
 			}
 		}
+		writeJavaDeprecated(impl, "    ", method.Desc)
 
 		inputType := method.Input.GoIdent.GoName
 		outputType := method.Output.GoIdent.GoName
@@ -629,7 +941,41 @@ func generateJavaService(gen *protogen.Plugin, _ *protogen.File, service *protog
 	impl.P("}")
 }
 
-func generateJavaClient(gen *protogen.Plugin, _ *protogen.File, service *protogen.Service, javaPackage, packageDir string, commonNamespace string) {
+// generateJavaAsyncService generates the non-blocking variant of a service interface
+func generateJavaAsyncService(gen *protogen.Plugin, _ *protogen.File, service *protogen.Service, javaPackage, packageDir string) {
+	serviceName := service.GoName
+
+	interfaceFilename := filepath.Join(packageDir, serviceName+"AsyncService.java")
+	g := gen.NewGeneratedFile(interfaceFilename, "")
+
+	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P()
+	g.P("package ", javaPackage, ";")
+	g.P()
+	g.P("import java.util.*;")
+	g.P("import java.util.concurrent.CompletableFuture;")
+	g.P()
+
+	writeJavaComment(g, service.Comments)
+	writeJavaDeprecated(g, "", service.Desc)
+
+	g.P("public interface ", serviceName, "AsyncService {")
+	for _, method := range service.Methods {
+		if commentLines := formatJavaComment(method.Comments); len(commentLines) > 0 {
+			for _, line := range commentLines {
+				g.P(line)
+			}
+		}
+		writeJavaDeprecated(g, "    ", method.Desc)
+
+		inputType := method.Input.GoIdent.GoName
+		outputType := method.Output.GoIdent.GoName
+		g.P("    CompletableFuture<", outputType, "> ", getJavaMethodName(method.GoName), "(Map<String, Object> ctx, ", inputType, " request);")
+	}
+	g.P("}")
+}
+
+func generateJavaClient(gen *protogen.Plugin, _ *protogen.File, service *protogen.Service, javaPackage, packageDir string, config *Config) {
 	serviceName := service.GoName
 
 	// No need to generate inline Transport interface anymore since we have per-package transport
@@ -645,13 +991,12 @@ func generateJavaClient(gen *protogen.Plugin, _ *protogen.File, service *protoge
 	g.P()
 	g.P("import java.util.*;")
 	// Always use PuregenTransport, but import from global namespace if provided
-	if commonNamespace != "" {
-		// Convert namespace to Java import
-		transportPackage := strings.ReplaceAll(commonNamespace, ".", "/")
-		g.P("import ", transportPackage, ".PuregenTransport;")
+	if config.CommonNamespace != "" {
+		g.P("import ", config.CommonNamespace, ".PuregenTransport;")
 	}
 	g.P()
 
+	writeJavaDeprecated(g, "", service.Desc)
 	g.P("public class ", serviceName, "Client {")
 	g.P("    private final PuregenTransport transport;")
 	g.P()
@@ -668,6 +1013,7 @@ func generateJavaClient(gen *protogen.Plugin, _ *protogen.File, service *protoge
 				g.P(line)
 			}
 		}
+		writeJavaDeprecated(g, "    ", method.Desc)
 
 		inputType := method.Input.GoIdent.GoName
 		outputType := method.Output.GoIdent.GoName
@@ -675,11 +1021,7 @@ func generateJavaClient(gen *protogen.Plugin, _ *protogen.File, service *protoge
 		constName := serviceName + "Methods." + serviceName + "_" + method.GoName
 
 		g.P("    public ", outputType, " ", methodName, "(Map<String, Object> ctx, ", inputType, " request) throws Exception {")
-		g.P("        Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());")
-		g.P("        Map<String, String> methodMetadata = ", serviceName, "Methods.METHOD_METADATA.get(", constName, ");")
-		g.P("        if (methodMetadata != null) {")
-		g.P("            enhancedCtx.put(\"method_metadata\", methodMetadata);")
-		g.P("        }")
+		writeJavaClientContext(g, serviceName, constName, config)
 		g.P("        return transport.send(enhancedCtx, ", constName, ", request, ", outputType, ".class);")
 		g.P("    }")
 		g.P()
@@ -687,6 +1029,66 @@ func generateJavaClient(gen *protogen.Plugin, _ *protogen.File, service *protoge
 	g.P("}")
 }
 
+// generateJavaAsyncClient generates a non-blocking client returning CompletableFutures
+func generateJavaAsyncClient(gen *protogen.Plugin, _ *protogen.File, service *protogen.Service, javaPackage, packageDir string, config *Config) {
+	serviceName := service.GoName
+
+	clientFilename := filepath.Join(packageDir, serviceName+"AsyncClient.java")
+	g := gen.NewGeneratedFile(clientFilename, "")
+
+	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P()
+	g.P("package ", javaPackage, ";")
+	g.P()
+	g.P("import java.util.*;")
+	g.P("import java.util.concurrent.CompletableFuture;")
+	if config.CommonNamespace != "" {
+		g.P("import ", config.CommonNamespace, ".AsyncPuregenTransport;")
+	}
+	g.P()
+
+	writeJavaDeprecated(g, "", service.Desc)
+	g.P("public class ", serviceName, "AsyncClient {")
+	g.P("    private final AsyncPuregenTransport transport;")
+	g.P()
+	g.P("    public ", serviceName, "AsyncClient(AsyncPuregenTransport transport) {")
+	g.P("        this.transport = transport;")
+	g.P("    }")
+	g.P()
+
+	for _, method := range service.Methods {
+		if commentLines := formatJavaComment(method.Comments); len(commentLines) > 0 {
+			for _, line := range commentLines {
+				g.P(line)
+			}
+		}
+		writeJavaDeprecated(g, "    ", method.Desc)
+
+		inputType := method.Input.GoIdent.GoName
+		outputType := method.Output.GoIdent.GoName
+		methodName := getJavaMethodName(method.GoName)
+		constName := serviceName + "Methods." + serviceName + "_" + method.GoName
+
+		g.P("    public CompletableFuture<", outputType, "> ", methodName, "(Map<String, Object> ctx, ", inputType, " request) {")
+		writeJavaClientContext(g, serviceName, constName, config)
+		g.P("        return transport.sendAsync(enhancedCtx, ", constName, ", request, ", outputType, ".class);")
+		g.P("    }")
+		g.P()
+	}
+	g.P("}")
+}
+
+// writeJavaClientContext copies the caller's context and adds the method metadata
+func writeJavaClientContext(g *protogen.GeneratedFile, serviceName, constName string, config *Config) {
+	g.P("        Map<String, Object> enhancedCtx = new HashMap<>(ctx != null ? ctx : new HashMap<>());")
+	if config.Features.Metadata {
+		g.P("        Map<String, Object> methodMetadata = ", serviceName, "Methods.METHOD_METADATA.get(", constName, ");")
+		g.P("        if (methodMetadata != null) {")
+		g.P("            enhancedCtx.put(\"method_metadata\", methodMetadata);")
+		g.P("        }")
+	}
+}
+
 func getJavaPackage(file *protogen.File) string {
 	if file.Proto.GetOptions().GetJavaPackage() != "" {
 		return file.Proto.GetOptions().GetJavaPackage()
@@ -707,7 +1109,24 @@ func getJavaPackage(file *protogen.File) string {
 	return strings.Join(parts, ".")
 }
 
-func getJavaFieldType(field *protogen.Field) string {
+// getJavaPackageDir returns the directory for a file's Java classes: the
+// java_package path, or the .proto file's directory with paths=source_relative
+func getJavaPackageDir(file *protogen.File, config *Config) string {
+	packageDir := strings.ReplaceAll(getJavaPackage(file), ".", "/")
+	if config.Paths == sourceRelativePaths {
+		// Keep the package directories below the .proto file's directory, so
+		// protos of different Java packages in one directory stay apart
+		return path.Join(sourceRelativeDir(file.Desc.Path()), packageDir)
+	}
+	return packageDir
+}
+
+func getJavaFieldType(field *protogen.Field, config *Config) string {
+	// Map fields are Java maps keyed by the entry's key type
+	if field.Desc.IsMap() {
+		return "Map<" + javaMapKeyType(field, config) + ", " + javaMapValueType(field, config) + ">"
+	}
+
 	baseType := ""
 	switch field.Desc.Kind().String() {
 	case "bool":
@@ -730,8 +1149,7 @@ func getJavaFieldType(field *protogen.Field) string {
 		baseType = "byte[]"
 	case "enum":
 		// Check if enum is using string constants
-		directive := parsePuregenDirective(field.Enum.Comments)
-		if directive == nil || directive.EnumType != "int" {
+		if isStringEnum(field.Enum, config) {
 			// Default to string constants
 			baseType = "String"
 		} else {
@@ -739,18 +1157,46 @@ func getJavaFieldType(field *protogen.Field) string {
 			baseType = field.Enum.GoIdent.GoName
 		}
 	case "message":
-		baseType = field.Message.GoIdent.GoName
+		baseType = messageTypeName(field.Message)
 	default:
 		baseType = "Object"
 	}
 
 	if field.Desc.IsList() {
-		return "List<" + baseType + ">"
+		return "List<" + javaBoxedType(baseType) + ">"
 	}
 
 	return baseType
 }
 
+// javaMapKeyType returns the boxed key type of a map field
+func javaMapKeyType(field *protogen.Field, config *Config) string {
+	return javaBoxedType(getJavaFieldType(field.Message.Fields[0], config))
+}
+
+// javaMapValueType returns the boxed value type of a map field
+func javaMapValueType(field *protogen.Field, config *Config) string {
+	return javaBoxedType(getJavaFieldType(field.Message.Fields[1], config))
+}
+
+// javaBoxedType returns the wrapper class of a primitive type, for use as a
+// type argument of List and Map
+func javaBoxedType(javaType string) string {
+	switch javaType {
+	case "boolean":
+		return "Boolean"
+	case "int":
+		return "Integer"
+	case "long":
+		return "Long"
+	case "float":
+		return "Float"
+	case "double":
+		return "Double"
+	}
+	return javaType
+}
+
 func getJavaFieldName(goName string) string {
 	if len(goName) == 0 {
 		return goName
@@ -784,13 +1230,21 @@ func getJavaFieldName(goName string) string {
 	return result.String()
 }
 
+// javaFieldName returns the Java field name for a proto field under the configured naming
+func javaFieldName(field *protogen.Field, config *Config) string {
+	if config.Naming.Fields == protoFieldNaming {
+		return string(field.Desc.Name())
+	}
+	return getJavaFieldName(field.GoName)
+}
+
 func getJavaMethodName(goName string) string {
 	return getJavaFieldName(goName)
 }
 
 // getJavaDefaultValue returns the Java default value for a field based on puregen directive
-func getJavaDefaultValue(field *protogen.Field) string {
-	directive := parseFieldDirective(field.Comments)
+func getJavaDefaultValue(field *protogen.Field, config *Config) string {
+	directive := parseFieldDirective(field, config)
 	if directive != nil && directive.Value != "" {
 		// Convert the value to Java syntax based on field type
 		switch field.Desc.Kind().String() {
@@ -825,35 +1279,26 @@ func getJavaDefaultValue(field *protogen.Field) string {
 		}
 	}
 	// Special case: check for empty string directive
-	if directive != nil && directive.Value == "" && field.Desc.Kind().String() == "string" {
+	if directive != nil && directive.Value == "" && field.Desc.Kind().String() == "string" && !field.Desc.IsList() {
 		return `""`
 	}
 	return ""
 }
 
-// Track created transport namespaces to avoid duplicates for Java
-var createdTransportNamespacesJava = make(map[string]bool)
-
-// Track created per-package transports to avoid duplicates for Java
-var createdPackageTransportsJava = make(map[string]bool)
-
 // generatePackageTransportJava creates a Transport interface in the same package as the proto file
-func generatePackageTransportJava(gen *protogen.Plugin, file *protogen.File) {
-	// Get package name and use it as the key to avoid duplicates
+func generatePackageTransportJava(gen *protogen.Plugin, file *protogen.File, config *Config) {
 	javaPackage := getJavaPackage(file)
-	packageKey := javaPackage
-	
-	// Only create once per package
-	if createdPackageTransportsJava[packageKey] {
-		return
-	}
-	createdPackageTransportsJava[packageKey] = true
 
 	// Convert package name to directory structure
-	packageDir := strings.ReplaceAll(javaPackage, ".", "/")
+	packageDir := outputPath(config.Output.Java, getJavaPackageDir(file, config))
 
-	// Generate Transport interface
+	// Only create once per output file
 	transportFilename := filepath.Join(packageDir, "PuregenTransport.java")
+	if !config.state.createOnce(transportFilename) {
+		return
+	}
+
+	// Generate Transport interface
 	g := gen.NewGeneratedFile(transportFilename, "")
 
 	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
@@ -866,21 +1311,31 @@ func generatePackageTransportJava(gen *protogen.Plugin, file *protogen.File) {
 	g.P("public interface PuregenTransport {")
 	g.P("    <T> T send(Map<String, Object> ctx, String methodName, Object inputData, Class<T> responseClass) throws Exception;")
 	g.P("}")
+
+	// Generate AsyncTransport interface
+	asyncFilename := filepath.Join(packageDir, "AsyncPuregenTransport.java")
+	a := gen.NewGeneratedFile(asyncFilename, "")
+
+	a.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	a.P("// Package AsyncTransport interface")
+	a.P()
+	a.P("package ", javaPackage, ";")
+	a.P()
+	writeJavaAsyncTransport(a)
 }
 
 // generateGlobalTransportJava creates a global Transport interface in the specified namespace
-func generateGlobalTransportJava(gen *protogen.Plugin, commonNamespace string) {
-	// Only create once per namespace
-	if createdTransportNamespacesJava[commonNamespace] {
-		return
-	}
-	createdTransportNamespacesJava[commonNamespace] = true
+func generateGlobalTransportJava(gen *protogen.Plugin, config *Config) {
+	commonNamespace := config.CommonNamespace
 
 	// Convert namespace to package directory
-	packageDir := strings.ReplaceAll(commonNamespace, ".", "/")
+	packageDir := outputPath(config.Output.Java, strings.ReplaceAll(commonNamespace, ".", "/"))
 
-	// Create the transport Java file
+	// Create the transport Java file, once per output file
 	filename := filepath.Join(packageDir, "PuregenTransport.java")
+	if !config.state.createOnce(filename) {
+		return
+	}
 	g := gen.NewGeneratedFile(filename, "")
 
 	// Generate file header
@@ -899,4 +1354,106 @@ func generateGlobalTransportJava(gen *protogen.Plugin, commonNamespace string) {
 	g.P("public interface PuregenTransport {")
 	g.P("    <T> T send(Map<String, Object> ctx, String methodName, Object inputData, Class<T> responseClass) throws Exception;")
 	g.P("}")
+
+	// Create the async transport Java file
+	asyncFilename := filepath.Join(packageDir, "AsyncPuregenTransport.java")
+	a := gen.NewGeneratedFile(asyncFilename, "")
+
+	a.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	a.P("// Global AsyncPuregenTransport interface")
+	a.P()
+	a.P("package ", commonNamespace, ";")
+	a.P()
+	writeJavaAsyncTransport(a)
+}
+
+// writeJavaAsyncTransport writes the imports and body of the AsyncPuregenTransport interface
+func writeJavaAsyncTransport(g *protogen.GeneratedFile) {
+	g.P("import java.util.*;")
+	g.P("import java.util.concurrent.CompletableFuture;")
+	g.P()
+	g.P("/**")
+	g.P(" * AsyncPuregenTransport interface for non-blocking client communication")
+	g.P(" */")
+	g.P("public interface AsyncPuregenTransport {")
+	g.P("    <T> CompletableFuture<T> sendAsync(Map<String, Object> ctx, String methodName, Object inputData, Class<T> responseClass);")
+	g.P("}")
+}
+
+// generatePackageJsonJava creates the PuregenJson mapper in the same package as the proto file
+func generatePackageJsonJava(gen *protogen.Plugin, file *protogen.File, config *Config) {
+	packageDir := outputPath(config.Output.Java, getJavaPackageDir(file, config))
+	writeJavaJsonClass(gen, filepath.Join(packageDir, "PuregenJson.java"), getJavaPackage(file), config)
+}
+
+// generateGlobalJsonJava creates the PuregenJson mapper in the common namespace
+func generateGlobalJsonJava(gen *protogen.Plugin, config *Config) {
+	packageDir := outputPath(config.Output.Java, strings.ReplaceAll(config.CommonNamespace, ".", "/"))
+	writeJavaJsonClass(gen, filepath.Join(packageDir, "PuregenJson.java"), config.CommonNamespace, config)
+}
+
+// writeJavaJsonClass writes the PuregenJson class holding the ObjectMapper
+// used by the toJson and fromJson methods of generated messages
+func writeJavaJsonClass(gen *protogen.Plugin, filename, javaPackage string, config *Config) {
+	if !config.state.createOnce(filename) {
+		return
+	}
+
+	g := gen.NewGeneratedFile(filename, "")
+
+	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P()
+	g.P("package ", javaPackage, ";")
+	g.P()
+	g.P("import java.util.*;")
+	g.P("import com.fasterxml.jackson.databind.*;")
+	g.P()
+	g.P("/**")
+	g.P(" * Shared Jackson configuration of the generated messages. Unknown fields")
+	g.P(" * are ignored so older clients can read messages from newer servers.")
+	g.P(" */")
+	g.P("public final class PuregenJson {")
+	g.P("    private PuregenJson() {} // Prevent instantiation")
+	g.P()
+	g.P("    private static volatile ObjectMapper mapper = newMapper();")
+	g.P()
+	g.P("    /** Returns a new ObjectMapper with the puregen settings. */")
+	g.P("    public static ObjectMapper newMapper() {")
+	g.P("        ObjectMapper mapper = new ObjectMapper();")
+	g.P("        mapper.configure(DeserializationFeature.FAIL_ON_UNKNOWN_PROPERTIES, false);")
+	g.P("        mapper.configure(SerializationFeature.FAIL_ON_EMPTY_BEANS, false);")
+	g.P("        return mapper;")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the mapper used by toJson and fromJson. */")
+	g.P("    public static ObjectMapper mapper() {")
+	g.P("        return mapper;")
+	g.P("    }")
+	g.P()
+	g.P("    /** Replaces the mapper used by toJson and fromJson, e.g. one built from newMapper(). */")
+	g.P("    public static void setMapper(ObjectMapper mapper) {")
+	g.P("        PuregenJson.mapper = Objects.requireNonNull(mapper);")
+	g.P("    }")
+	g.P()
+	g.P("    public static String toJson(Object value) throws Exception {")
+	g.P("        return mapper.writeValueAsString(value);")
+	g.P("    }")
+	g.P()
+	g.P("    public static <T> T fromJson(String json, Class<T> type) throws Exception {")
+	g.P("        return mapper.readValue(json, type);")
+	g.P("    }")
+	g.P("}")
+}
+
+// writeJavaJsonMethods writes the toJson and fromJson methods of a message
+func writeJavaJsonMethods(g *protogen.GeneratedFile, className string) {
+	g.P("    public String toJson() throws Exception {")
+	g.P("        return PuregenJson.toJson(this);")
+	g.P("    }")
+	g.P()
+
+	g.P("    public static ", className, " fromJson(String json) throws Exception {")
+	g.P("        return PuregenJson.fromJson(json, ", className, ".class);")
+	g.P("    }")
+	g.P()
 }
@@ -0,0 +1,280 @@
+package generator
+
+import (
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// writeJavaDescriptor writes the DESCRIPTOR constant of a message; map entries
+// are described by their field. Metadata is shared with the XxxMetadata and
+// XxxFieldMetadata classes.
+func writeJavaDescriptor(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	if !config.Features.Descriptors || msg.Desc.IsMapEntry() {
+		return
+	}
+	className := msg.GoIdent.GoName
+
+	g.P("    public static final PuregenMessageDescriptor DESCRIPTOR = new PuregenMessageDescriptor(")
+	g.P("        ", javaString(string(msg.Desc.FullName())), ", ", javaString(string(msg.Desc.Name())), ", ", javaString(className), ",")
+	if len(msg.Fields) == 0 {
+		g.P("        Collections.emptyList(),")
+	} else {
+		g.P("        Arrays.asList(")
+		for i, field := range msg.Fields {
+			metadata := "Collections.emptyMap()"
+			if parseFieldMetadata(field, config) != nil && config.Features.Metadata {
+				metadata = className + "FieldMetadata.FIELD_METADATA.get(" + className + "FieldMetadata." + className + "_" + field.GoName + "_FIELD)"
+			}
+			end := "),"
+			if i == len(msg.Fields)-1 {
+				end = ")),"
+			}
+			g.P("            new PuregenFieldDescriptor(", javaString(string(field.Desc.Name())), ", ",
+				javaString(javaFieldName(field, config)), ", ", javaString(jsonFieldName(field, config)), ", ",
+				field.Desc.Number(), ", ", javaString(descriptorKind(field)), ", ",
+				javaNullableString(descriptorTypeName(field)), ", ", javaNullableString(descriptorMapKey(field)), ", ",
+				field.Desc.IsList(), ", ", field.Desc.IsMap(), ", ", field.Desc.HasOptionalKeyword(), ", ",
+				metadata, end)
+		}
+	}
+	if parseMessageMetadata(msg, config) != nil && config.Features.Metadata {
+		g.P("        ", className, "Metadata.METADATA);")
+	} else {
+		g.P("        Collections.emptyMap());")
+	}
+	g.P()
+}
+
+// javaNullableString renders s as a Java string literal, or null if it is empty
+func javaNullableString(s string) string {
+	if s == "" {
+		return "null"
+	}
+	return javaString(s)
+}
+
+// writeJavaDescriptorImports imports the descriptor types from the common namespace
+func writeJavaDescriptorImports(g *protogen.GeneratedFile, config *Config) {
+	if config.Features.Descriptors && config.CommonNamespace != "" {
+		g.P("import ", config.CommonNamespace, ".PuregenFieldDescriptor;")
+		g.P("import ", config.CommonNamespace, ".PuregenMessageDescriptor;")
+	}
+}
+
+// generatePackageDescriptorsJava creates the PuregenDescriptors registry of a
+// Java package, listing the messages of every generated file in the package.
+// The descriptor types are created next to it, or in the common namespace.
+func generatePackageDescriptorsJava(gen *protogen.Plugin, file *protogen.File, config *Config) {
+	if !config.Features.Descriptors {
+		return
+	}
+	javaPackage := getJavaPackage(file)
+	packageDir := outputPath(config.Output.Java, getJavaPackageDir(file, config))
+	filename := filepath.Join(packageDir, "PuregenDescriptors.java")
+	if !config.state.createOnce(filename) {
+		return
+	}
+
+	if config.CommonNamespace != "" {
+		commonDir := outputPath(config.Output.Java, strings.ReplaceAll(config.CommonNamespace, ".", "/"))
+		writeJavaDescriptorClasses(gen, commonDir, config.CommonNamespace, config)
+	} else {
+		writeJavaDescriptorClasses(gen, packageDir, javaPackage, config)
+	}
+
+	seen := make(map[string]bool)
+	var classNames []string
+	for _, f := range gen.Files {
+		if !f.Generate || getJavaPackage(f) != javaPackage || outputPath(config.Output.Java, getJavaPackageDir(f, config)) != packageDir {
+			continue
+		}
+		for _, msg := range collectDescribedMessages(f) {
+			if !seen[msg.GoIdent.GoName] {
+				seen[msg.GoIdent.GoName] = true
+				classNames = append(classNames, msg.GoIdent.GoName)
+			}
+		}
+	}
+
+	g := gen.NewGeneratedFile(filename, "")
+	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P()
+	g.P("package ", javaPackage, ";")
+	g.P()
+	g.P("import java.util.*;")
+	writeJavaDescriptorImports(g, config)
+	g.P()
+	g.P("/** Registry of the message descriptors of this package, by full proto name. */")
+	g.P("public final class PuregenDescriptors {")
+	g.P("    private PuregenDescriptors() {} // Prevent instantiation")
+	g.P()
+	g.P("    private static final Map<String, PuregenMessageDescriptor> DESCRIPTORS = new TreeMap<>();")
+	g.P("    static {")
+	for _, className := range classNames {
+		g.P("        register(", className, ".DESCRIPTOR);")
+	}
+	g.P("    }")
+	g.P()
+	g.P("    private static void register(PuregenMessageDescriptor descriptor) {")
+	g.P("        DESCRIPTORS.put(descriptor.getFullName(), descriptor);")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the descriptor of a message by full proto name, or null. */")
+	g.P("    public static PuregenMessageDescriptor find(String fullName) {")
+	g.P("        return DESCRIPTORS.get(fullName);")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the descriptors of all messages, ordered by full name. */")
+	g.P("    public static Collection<PuregenMessageDescriptor> all() {")
+	g.P("        return Collections.unmodifiableCollection(DESCRIPTORS.values());")
+	g.P("    }")
+	g.P("}")
+}
+
+// writeJavaDescriptorClasses writes the PuregenMessageDescriptor and
+// PuregenFieldDescriptor classes into a package directory
+func writeJavaDescriptorClasses(gen *protogen.Plugin, packageDir, javaPackage string, config *Config) {
+	filename := filepath.Join(packageDir, "PuregenMessageDescriptor.java")
+	if !config.state.createOnce(filename) {
+		return
+	}
+
+	g := gen.NewGeneratedFile(filename, "")
+	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P()
+	g.P("package ", javaPackage, ";")
+	g.P()
+	g.P("import java.util.*;")
+	g.P()
+	g.P("/** Describes a generated message. */")
+	g.P("public final class PuregenMessageDescriptor {")
+	g.P("    private final String fullName;")
+	g.P("    private final String name;")
+	g.P("    private final String javaName;")
+	g.P("    private final List<PuregenFieldDescriptor> fields;")
+	g.P("    private final Map<String, Object> metadata;")
+	g.P()
+	g.P("    public PuregenMessageDescriptor(String fullName, String name, String javaName,")
+	g.P("            List<PuregenFieldDescriptor> fields, Map<String, Object> metadata) {")
+	g.P("        this.fullName = fullName;")
+	g.P("        this.name = name;")
+	g.P("        this.javaName = javaName;")
+	g.P("        this.fields = Collections.unmodifiableList(new ArrayList<>(fields));")
+	g.P("        this.metadata = Collections.unmodifiableMap(metadata);")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the full proto name, e.g. \"acme.v1.User\". */")
+	g.P("    public String getFullName() {")
+	g.P("        return fullName;")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the proto name. */")
+	g.P("    public String getName() {")
+	g.P("        return name;")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the name of the Java class. */")
+	g.P("    public String getJavaName() {")
+	g.P("        return javaName;")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the fields in declaration order. */")
+	g.P("    public List<PuregenFieldDescriptor> getFields() {")
+	g.P("        return fields;")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the message metadata, empty if it has none. */")
+	g.P("    public Map<String, Object> getMetadata() {")
+	g.P("        return metadata;")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the field with a proto or JSON name, or null. */")
+	g.P("    public PuregenFieldDescriptor findFieldByName(String name) {")
+	g.P("        for (PuregenFieldDescriptor field : fields) {")
+	g.P("            if (field.getName().equals(name) || field.getJsonName().equals(name)) {")
+	g.P("                return field;")
+	g.P("            }")
+	g.P("        }")
+	g.P("        return null;")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the field with a field number, or null. */")
+	g.P("    public PuregenFieldDescriptor findFieldByNumber(int number) {")
+	g.P("        for (PuregenFieldDescriptor field : fields) {")
+	g.P("            if (field.getNumber() == number) {")
+	g.P("                return field;")
+	g.P("            }")
+	g.P("        }")
+	g.P("        return null;")
+	g.P("    }")
+	g.P()
+	g.P("    @Override")
+	g.P("    public String toString() {")
+	g.P("        return \"PuregenMessageDescriptor(\" + fullName + \")\";")
+	g.P("    }")
+	g.P("}")
+
+	f := gen.NewGeneratedFile(filepath.Join(packageDir, "PuregenFieldDescriptor.java"), "")
+	f.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	f.P()
+	f.P("package ", javaPackage, ";")
+	f.P()
+	f.P("import java.util.*;")
+	f.P()
+	f.P("/** Describes a field of a generated message. */")
+	f.P("public final class PuregenFieldDescriptor {")
+	f.P("    private final String name;")
+	f.P("    private final String javaName;")
+	f.P("    private final String jsonName;")
+	f.P("    private final int number;")
+	f.P("    private final String kind;")
+	f.P("    private final String typeName;")
+	f.P("    private final String mapKey;")
+	f.P("    private final boolean repeated;")
+	f.P("    private final boolean map;")
+	f.P("    private final boolean optional;")
+	f.P("    private final Map<String, Object> metadata;")
+	f.P()
+	f.P("    public PuregenFieldDescriptor(String name, String javaName, String jsonName, int number,")
+	f.P("            String kind, String typeName, String mapKey, boolean repeated, boolean map,")
+	f.P("            boolean optional, Map<String, Object> metadata) {")
+	f.P("        this.name = name;")
+	f.P("        this.javaName = javaName;")
+	f.P("        this.jsonName = jsonName;")
+	f.P("        this.number = number;")
+	f.P("        this.kind = kind;")
+	f.P("        this.typeName = typeName;")
+	f.P("        this.mapKey = mapKey;")
+	f.P("        this.repeated = repeated;")
+	f.P("        this.map = map;")
+	f.P("        this.optional = optional;")
+	f.P("        this.metadata = Collections.unmodifiableMap(metadata);")
+	f.P("    }")
+	f.P()
+	for _, getter := range []struct{ doc, typ, name, field string }{
+		{"Returns the proto name.", "String", "getName", "name"},
+		{"Returns the name of the Java field.", "String", "getJavaName", "javaName"},
+		{"Returns the name used in JSON.", "String", "getJsonName", "jsonName"},
+		{"Returns the field number.", "int", "getNumber", "number"},
+		{"Returns the proto type (\"string\", \"int64\", \"message\", \"enum\", ...); for maps, the type of the values.", "String", "getKind", "kind"},
+		{"Returns the full proto name of the message or enum type, or null.", "String", "getTypeName", "typeName"},
+		{"Returns the proto type of the keys of a map field, or null.", "String", "getMapKey", "mapKey"},
+		{"Returns whether the field is repeated, maps excluded.", "boolean", "isRepeated", "repeated"},
+		{"Returns whether the field is a map.", "boolean", "isMap", "map"},
+		{"Returns whether the field is declared optional.", "boolean", "isOptional", "optional"},
+		{"Returns the field metadata, empty if it has none.", "Map<String, Object>", "getMetadata", "metadata"},
+	} {
+		f.P("    /** ", getter.doc, " */")
+		f.P("    public ", getter.typ, " ", getter.name, "() {")
+		f.P("        return ", getter.field, ";")
+		f.P("    }")
+		f.P()
+	}
+	f.P("    @Override")
+	f.P("    public String toString() {")
+	f.P("        return \"PuregenFieldDescriptor(\" + name + \")\";")
+	f.P("    }")
+	f.P("}")
+}
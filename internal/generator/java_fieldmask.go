@@ -0,0 +1,147 @@
+package generator
+
+import (
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// writeJavaFieldMaskImports imports the field mask class from the common namespace
+func writeJavaFieldMaskImports(g *protogen.GeneratedFile, config *Config) {
+	if config.CommonNamespace != "" {
+		g.P("import ", config.CommonNamespace, ".PuregenFieldMask;")
+	}
+}
+
+// generateFieldMaskJava creates the PuregenFieldMask class taken by the
+// applyMask and merge overloads of the messages of a file, next to them or
+// in the common namespace
+func generateFieldMaskJava(gen *protogen.Plugin, file *protogen.File, config *Config) {
+	if len(collectDescribedMessages(file)) == 0 {
+		return
+	}
+	if config.CommonNamespace != "" {
+		packageDir := outputPath(config.Output.Java, strings.ReplaceAll(config.CommonNamespace, ".", "/"))
+		writeJavaFieldMaskClass(gen, filepath.Join(packageDir, "PuregenFieldMask.java"), config.CommonNamespace, config)
+	} else {
+		packageDir := outputPath(config.Output.Java, getJavaPackageDir(file, config))
+		writeJavaFieldMaskClass(gen, filepath.Join(packageDir, "PuregenFieldMask.java"), getJavaPackage(file), config)
+	}
+}
+
+// writeJavaFieldMaskClass writes the PuregenFieldMask class, which encodes as
+// the comma-separated string of google.protobuf.FieldMask's JSON form
+func writeJavaFieldMaskClass(gen *protogen.Plugin, filename, javaPackage string, config *Config) {
+	if !config.state.createOnce(filename) {
+		return
+	}
+
+	g := gen.NewGeneratedFile(filename, "")
+	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P()
+	g.P("package ", javaPackage, ";")
+	g.P()
+	g.P("import java.util.*;")
+	g.P("import com.fasterxml.jackson.annotation.*;")
+	g.P()
+	g.P("/**")
+	g.P(" * Names a set of fields, as google.protobuf.FieldMask does. Fields of type")
+	g.P(" * google.protobuf.FieldMask are field masks. In JSON a mask is a string of")
+	g.P(" * comma-separated lowerCamelCase paths, e.g. \"displayName,profile.bio\".")
+	g.P(" */")
+	g.P("public final class PuregenFieldMask {")
+	g.P("    private final List<String> paths;")
+	g.P()
+	g.P("    /** Creates a mask of proto field paths, e.g. \"profile.bio\". */")
+	g.P("    public PuregenFieldMask(Collection<String> paths) {")
+	g.P("        this.paths = List.copyOf(paths);")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns a mask of proto field paths, e.g. \"profile.bio\". */")
+	g.P("    public static PuregenFieldMask of(String... paths) {")
+	g.P("        return new PuregenFieldMask(Arrays.asList(paths));")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the proto field paths of the mask. */")
+	g.P("    public List<String> getPaths() {")
+	g.P("        return paths;")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the JSON form of the mask: its paths in lowerCamelCase, separated by commas. */")
+	g.P("    @JsonValue")
+	g.P("    public String toJsonString() {")
+	g.P("        StringJoiner joiner = new StringJoiner(\",\");")
+	g.P("        for (String path : paths) {")
+	g.P("            StringBuilder json = new StringBuilder();")
+	g.P("            for (int i = 0; i < path.length(); i++) {")
+	g.P("                char c = path.charAt(i);")
+	g.P("                if (c == '_' && i + 1 < path.length() && Character.isLowerCase(path.charAt(i + 1))) {")
+	g.P("                    json.append(Character.toUpperCase(path.charAt(++i)));")
+	g.P("                } else {")
+	g.P("                    json.append(c);")
+	g.P("                }")
+	g.P("            }")
+	g.P("            joiner.add(json);")
+	g.P("        }")
+	g.P("        return joiner.toString();")
+	g.P("    }")
+	g.P()
+	g.P("    /** Parses the JSON form of a mask: lowerCamelCase paths separated by commas. */")
+	g.P("    @JsonCreator(mode = JsonCreator.Mode.DELEGATING)")
+	g.P("    public static PuregenFieldMask fromJsonString(String value) {")
+	g.P("        List<String> paths = new ArrayList<>();")
+	g.P("        for (String json : value.split(\",\")) {")
+	g.P("            if (json.isEmpty()) {")
+	g.P("                continue;")
+	g.P("            }")
+	g.P("            StringBuilder path = new StringBuilder();")
+	g.P("            for (char c : json.toCharArray()) {")
+	g.P("                if (Character.isUpperCase(c)) {")
+	g.P("                    path.append('_').append(Character.toLowerCase(c));")
+	g.P("                } else {")
+	g.P("                    path.append(c);")
+	g.P("                }")
+	g.P("            }")
+	g.P("            paths.add(path.toString());")
+	g.P("        }")
+	g.P("        return new PuregenFieldMask(paths);")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns a mask with the paths of src appended, as proto merge does. */")
+	g.P("    public PuregenFieldMask merge(PuregenFieldMask src) {")
+	g.P("        if (src == null || src.paths.isEmpty()) {")
+	g.P("            return this;")
+	g.P("        }")
+	g.P("        List<String> merged = new ArrayList<>(paths);")
+	g.P("        merged.addAll(src.paths);")
+	g.P("        return new PuregenFieldMask(merged);")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns this mask: paths hold no sensitive values. */")
+	g.P("    public PuregenFieldMask redacted() {")
+	g.P("        return this;")
+	g.P("    }")
+	g.P()
+	g.P("    @Override")
+	g.P("    public boolean equals(Object o) {")
+	g.P("        if (this == o) {")
+	g.P("            return true;")
+	g.P("        }")
+	g.P("        if (o == null || getClass() != o.getClass()) {")
+	g.P("            return false;")
+	g.P("        }")
+	g.P("        return paths.equals(((PuregenFieldMask) o).paths);")
+	g.P("    }")
+	g.P()
+	g.P("    @Override")
+	g.P("    public int hashCode() {")
+	g.P("        return paths.hashCode();")
+	g.P("    }")
+	g.P()
+	g.P("    @Override")
+	g.P("    public String toString() {")
+	g.P("        return \"PuregenFieldMask{paths=\" + paths + \"}\";")
+	g.P("    }")
+	g.P("}")
+}
@@ -0,0 +1,435 @@
+package generator
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// generateJavaImmutableClass writes a message as a record (java_style=records)
+// or a final class (java_style=immutable). Both have copy-on-write withXxx
+// methods, unmodifiable lists and maps and a Builder producing new instances.
+func generateJavaImmutableClass(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	className := msg.GoIdent.GoName
+	isRecord := config.Java.Style == recordsJavaStyle
+
+	if messageJSONConfig(msg, config).OmitEmpty {
+		g.P("@JsonInclude(JsonInclude.Include.NON_EMPTY)")
+	}
+
+	if isRecord {
+		g.P("public record ", className, "(")
+		writeJavaConstructorParameters(g, msg, config, "    ", true)
+		g.P(") {")
+		writeJavaFieldPaths(g, msg)
+		writeJavaDescriptor(g, msg, config)
+		if len(msg.Fields) > 0 {
+			// Jackson must use the canonical constructor, not the no-argument one
+			g.P("    @JsonCreator")
+			g.P("    public ", className, " {")
+			for _, field := range msg.Fields {
+				if value := javaImmutableValue(field, javaFieldName(field, config), config); value != javaFieldName(field, config) {
+					g.P("        ", javaFieldName(field, config), " = ", value, ";")
+				}
+			}
+			g.P("    }")
+			g.P()
+		}
+	} else {
+		g.P("public final class ", className, " {")
+		writeJavaFieldPaths(g, msg)
+		writeJavaDescriptor(g, msg, config)
+		for _, field := range msg.Fields {
+			if commentLines := formatJavaComment(field.Comments); len(commentLines) > 0 {
+				for _, line := range commentLines {
+					g.P(line)
+				}
+			}
+			writeJavaDeprecated(g, "    ", field.Desc)
+			for _, annotation := range javaJSONAnnotations(field, config) {
+				g.P("    ", annotation)
+			}
+			g.P("    private final ", getJavaFieldType(field, config), " ", javaFieldName(field, config), ";")
+			g.P()
+		}
+
+		g.P("    @JsonCreator")
+		g.P("    public ", className, "(")
+		writeJavaConstructorParameters(g, msg, config, "        ", false)
+		g.P("    ) {")
+		for _, field := range msg.Fields {
+			fieldName := javaFieldName(field, config)
+			g.P("        this.", fieldName, " = ", javaImmutableValue(field, fieldName, config), ";")
+		}
+		g.P("    }")
+		g.P()
+	}
+
+	// The no-argument constructor applies the field defaults
+	if len(msg.Fields) > 0 {
+		defaults := make([]string, len(msg.Fields))
+		for i, field := range msg.Fields {
+			defaults[i] = javaInitialValue(field, config)
+		}
+		g.P("    public ", className, "() {")
+		g.P("        this(", strings.Join(defaults, ", "), ");")
+		g.P("    }")
+		g.P()
+	}
+
+	// Generate accessors and copy-on-write withers
+	for _, field := range msg.Fields {
+		fieldType := getJavaFieldType(field, config)
+		fieldName := javaFieldName(field, config)
+		methodName := titleCase(getJavaFieldName(field.GoName))
+
+		if isRecord {
+			if field.Desc.Kind().String() == "bytes" && !field.Desc.IsList() {
+				g.P("    @Override")
+				g.P("    public byte[] ", fieldName, "() {")
+				g.P("        return ", fieldName, " != null ? ", fieldName, ".clone() : null;")
+				g.P("    }")
+				g.P()
+			}
+		} else {
+			writeJavaDeprecated(g, "    ", field.Desc)
+			g.P("    public ", fieldType, " get", methodName, "() {")
+			if field.Desc.Kind().String() == "bytes" && !field.Desc.IsList() {
+				g.P("        return ", fieldName, " != null ? ", fieldName, ".clone() : null;")
+			} else {
+				g.P("        return ", fieldName, ";")
+			}
+			g.P("    }")
+			g.P()
+		}
+
+		args := make([]string, len(msg.Fields))
+		for i, other := range msg.Fields {
+			args[i] = javaFieldName(other, config)
+		}
+		writeJavaDeprecated(g, "    ", field.Desc)
+		g.P("    public ", className, " with", methodName, "(", fieldType, " ", fieldName, ") {")
+		g.P("        return new ", className, "(", strings.Join(args, ", "), ");")
+		g.P("    }")
+		g.P()
+	}
+
+	// Generate builder
+	g.P("    public static Builder builder() {")
+	g.P("        return new Builder();")
+	g.P("    }")
+	g.P()
+	g.P("    public Builder toBuilder() {")
+	g.P("        return new Builder(this);")
+	g.P("    }")
+	g.P()
+	g.P("    public static final class Builder {")
+	for _, field := range msg.Fields {
+		initial := ""
+		if value := javaInitialValue(field, config); value != javaZeroValue(field, config) {
+			initial = " = " + value
+		}
+		g.P("        private ", getJavaFieldType(field, config), " ", javaFieldName(field, config), initial, ";")
+	}
+	if len(msg.Fields) > 0 {
+		g.P()
+	}
+	g.P("        public Builder() {")
+	g.P("        }")
+	g.P()
+	g.P("        private Builder(", className, " source) {")
+	for _, field := range msg.Fields {
+		fieldName := javaFieldName(field, config)
+		g.P("            this.", fieldName, " = source.", fieldName, ";")
+	}
+	g.P("        }")
+	g.P()
+	for _, field := range msg.Fields {
+		fieldType := getJavaFieldType(field, config)
+		fieldName := javaFieldName(field, config)
+		methodName := titleCase(getJavaFieldName(field.GoName))
+
+		writeJavaDeprecated(g, "        ", field.Desc)
+		g.P("        public Builder set", methodName, "(", fieldType, " ", fieldName, ") {")
+		g.P("            this.", fieldName, " = ", fieldName, ";")
+		g.P("            return this;")
+		g.P("        }")
+		g.P()
+	}
+	args := make([]string, len(msg.Fields))
+	for i, field := range msg.Fields {
+		args[i] = javaFieldName(field, config)
+	}
+	g.P("        public ", className, " build() {")
+	g.P("            return new ", className, "(", strings.Join(args, ", "), ");")
+	g.P("        }")
+	g.P("    }")
+	g.P()
+
+	// Records get equals, hashCode and toString for free, except that arrays
+	// would compare by identity and sensitive fields would be printed
+	if !isRecord || javaHasBytesField(msg) || hasSensitiveFields(msg, config) {
+		writeJavaValueMethods(g, msg, config)
+	}
+	writeJavaMaskMethods(g, msg, config)
+	writeJavaRedacted(g, msg, config)
+
+	if config.Features.Validation {
+		g.P("    public boolean validate() {")
+		g.P("        // Add custom validation logic here")
+		g.P("        return true;")
+		g.P("    }")
+		g.P()
+	}
+
+	writeJavaJsonMethods(g, className)
+
+	g.P("}")
+}
+
+// writeJavaConstructorParameters writes the annotated constructor (or record
+// component) parameters of a message, one per line
+func writeJavaConstructorParameters(g *protogen.GeneratedFile, msg *protogen.Message, config *Config, indent string, withComments bool) {
+	for i, field := range msg.Fields {
+		if withComments {
+			if commentLines := formatJavaComment(field.Comments); len(commentLines) > 0 {
+				for _, line := range commentLines {
+					g.P(line)
+				}
+			}
+			writeJavaDeprecated(g, indent, field.Desc)
+		}
+		end := ","
+		if i == len(msg.Fields)-1 {
+			end = ""
+		}
+		annotations := strings.Join(javaJSONAnnotations(field, config), " ")
+		g.P(indent, annotations, " ", getJavaFieldType(field, config), " ", javaFieldName(field, config), end)
+	}
+}
+
+// javaImmutableValue returns the expression stored for a constructor argument:
+// lists and maps become unmodifiable copies, arrays are copied, and null
+// references fall back to the field's default
+func javaImmutableValue(field *protogen.Field, name string, config *Config) string {
+	if field.Desc.IsMap() {
+		return name + " != null ? Map.copyOf(" + name + ") : Map.of()"
+	}
+	if field.Desc.IsList() {
+		return name + " != null ? List.copyOf(" + name + ") : List.of()"
+	}
+	if field.Desc.Kind().String() == "bytes" {
+		return name + " != null ? " + name + ".clone() : null"
+	}
+	if defaultValue := getJavaDefaultValue(field, config); defaultValue != "" && !javaIsPrimitive(field, config) {
+		return name + " != null ? " + name + " : " + defaultValue
+	}
+	return name
+}
+
+// javaInitialValue returns the value a field holds in a new instance
+func javaInitialValue(field *protogen.Field, config *Config) string {
+	if field.Desc.IsMap() {
+		return "Map.of()"
+	}
+	if field.Desc.IsList() {
+		return "List.of()"
+	}
+	if defaultValue := getJavaDefaultValue(field, config); defaultValue != "" {
+		return defaultValue
+	}
+	return javaZeroValue(field, config)
+}
+
+// javaZeroValue returns the value Java gives an uninitialized field
+func javaZeroValue(field *protogen.Field, config *Config) string {
+	switch getJavaFieldType(field, config) {
+	case "boolean":
+		return "false"
+	case "int":
+		return "0"
+	case "long":
+		return "0L"
+	case "float":
+		return "0.0f"
+	case "double":
+		return "0.0"
+	}
+	return "null"
+}
+
+// javaIsPrimitive reports whether a field has a primitive Java type
+func javaIsPrimitive(field *protogen.Field, config *Config) bool {
+	switch getJavaFieldType(field, config) {
+	case "boolean", "int", "long", "float", "double":
+		return true
+	}
+	return false
+}
+
+// javaHasBytesField reports whether a message has a bytes field, singular,
+// repeated or as map values, whose arrays would compare by identity
+func javaHasBytesField(msg *protogen.Message) bool {
+	for _, field := range msg.Fields {
+		if field.Desc.Kind().String() == "bytes" || javaIsBytesMap(field) {
+			return true
+		}
+	}
+	return false
+}
+
+// javaIsBytesMap reports whether a field is a map with bytes values
+func javaIsBytesMap(field *protogen.Field) bool {
+	return field.Desc.IsMap() && field.Message.Fields[1].Desc.Kind().String() == "bytes"
+}
+
+// writeJavaBytesMapHelpers writes the static helpers comparing, hashing and
+// rendering maps with byte[] values by content
+func writeJavaBytesMapHelpers(g *protogen.GeneratedFile) {
+	g.P("    private static boolean bytesMapEquals(Map<?, byte[]> a, Map<?, byte[]> b) {")
+	g.P("        if (a == null || b == null) {")
+	g.P("            return a == b;")
+	g.P("        }")
+	g.P("        if (a.size() != b.size()) {")
+	g.P("            return false;")
+	g.P("        }")
+	g.P("        for (Map.Entry<?, byte[]> entry : a.entrySet()) {")
+	g.P("            if (!b.containsKey(entry.getKey()) || !Arrays.equals(entry.getValue(), b.get(entry.getKey()))) {")
+	g.P("                return false;")
+	g.P("            }")
+	g.P("        }")
+	g.P("        return true;")
+	g.P("    }")
+	g.P()
+	g.P("    private static int bytesMapHashCode(Map<?, byte[]> map) {")
+	g.P("        int hash = 0;")
+	g.P("        if (map != null) {")
+	g.P("            for (Map.Entry<?, byte[]> entry : map.entrySet()) {")
+	g.P("                hash += Objects.hashCode(entry.getKey()) ^ Arrays.hashCode(entry.getValue());")
+	g.P("            }")
+	g.P("        }")
+	g.P("        return hash;")
+	g.P("    }")
+	g.P()
+	g.P("    private static String bytesMapToString(Map<?, byte[]> map) {")
+	g.P("        if (map == null) {")
+	g.P("            return \"null\";")
+	g.P("        }")
+	g.P("        StringJoiner joiner = new StringJoiner(\", \", \"{\", \"}\");")
+	g.P("        for (Map.Entry<?, byte[]> entry : map.entrySet()) {")
+	g.P("            joiner.add(entry.getKey() + \"=\" + Arrays.toString(entry.getValue()));")
+	g.P("        }")
+	g.P("        return joiner.toString();")
+	g.P("    }")
+	g.P()
+}
+
+// javaListArray converts a possibly null list expression to an array, so the
+// byte[] elements of repeated bytes fields compare by content in Arrays.deep*
+func javaListArray(expr string) string {
+	return expr + " == null ? null : " + expr + ".toArray()"
+}
+
+// writeJavaValueMethods writes field-by-field equals, hashCode and toString methods
+func writeJavaValueMethods(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	className := msg.GoIdent.GoName
+	for _, field := range msg.Fields {
+		if javaIsBytesMap(field) {
+			writeJavaBytesMapHelpers(g)
+			break
+		}
+	}
+
+	g.P("    @Override")
+	g.P("    public boolean equals(Object o) {")
+	g.P("        if (this == o) {")
+	g.P("            return true;")
+	g.P("        }")
+	g.P("        if (o == null || getClass() != o.getClass()) {")
+	g.P("            return false;")
+	g.P("        }")
+	if len(msg.Fields) == 0 {
+		g.P("        return true;")
+	} else {
+		g.P("        ", className, " other = (", className, ") o;")
+		comparisons := make([]string, len(msg.Fields))
+		for i, field := range msg.Fields {
+			fieldName := javaFieldName(field, config)
+			switch {
+			case javaIsBytesMap(field):
+				comparisons[i] = "bytesMapEquals(" + fieldName + ", other." + fieldName + ")"
+			case field.Desc.Kind().String() == "bytes" && !field.Desc.IsList():
+				comparisons[i] = "Arrays.equals(" + fieldName + ", other." + fieldName + ")"
+			case field.Desc.Kind().String() == "bytes":
+				// List<byte[]> compares its arrays by reference
+				comparisons[i] = "Arrays.deepEquals(" + javaListArray(fieldName) + ", " + javaListArray("other."+fieldName) + ")"
+			case getJavaFieldType(field, config) == "float":
+				comparisons[i] = "Float.compare(" + fieldName + ", other." + fieldName + ") == 0"
+			case getJavaFieldType(field, config) == "double":
+				comparisons[i] = "Double.compare(" + fieldName + ", other." + fieldName + ") == 0"
+			case javaIsPrimitive(field, config):
+				comparisons[i] = fieldName + " == other." + fieldName
+			default:
+				comparisons[i] = "Objects.equals(" + fieldName + ", other." + fieldName + ")"
+			}
+		}
+		g.P("        return ", strings.Join(comparisons, "\n            && "), ";")
+	}
+	g.P("    }")
+	g.P()
+
+	values := make([]string, len(msg.Fields))
+	for i, field := range msg.Fields {
+		fieldName := javaFieldName(field, config)
+		if javaIsBytesMap(field) {
+			values[i] = "bytesMapHashCode(" + fieldName + ")"
+		} else if field.Desc.Kind().String() == "bytes" && !field.Desc.IsList() {
+			values[i] = "Arrays.hashCode(" + fieldName + ")"
+		} else if field.Desc.Kind().String() == "bytes" {
+			values[i] = "Arrays.deepHashCode(" + javaListArray(fieldName) + ")"
+		} else {
+			values[i] = fieldName
+		}
+	}
+	g.P("    @Override")
+	g.P("    public int hashCode() {")
+	g.P("        return Objects.hash(", strings.Join(values, ", "), ");")
+	g.P("    }")
+	g.P()
+
+	// Messages with sensitive fields render their redacted copy
+	source := ""
+	g.P("    @Override")
+	g.P("    public String toString() {")
+	if hasSensitiveFields(msg, config) {
+		g.P("        ", className, " redacted = redacted();")
+		source = "redacted."
+	}
+	if len(msg.Fields) == 0 {
+		g.P("        return \"", className, "{}\";")
+	} else {
+		g.P("        return \"", className, "{\"")
+		for i, field := range msg.Fields {
+			name := javaFieldName(field, config)
+			fieldName := source + name
+			separator := ", "
+			if i == 0 {
+				separator = ""
+			}
+			value := fieldName
+			if javaIsBytesMap(field) {
+				value = "bytesMapToString(" + fieldName + ")"
+			} else if field.Desc.Kind().String() == "bytes" && !field.Desc.IsList() {
+				value = "Arrays.toString(" + fieldName + ")"
+			} else if field.Desc.Kind().String() == "bytes" {
+				value = "Arrays.deepToString(" + javaListArray(fieldName) + ")"
+			} else if getJavaFieldType(field, config) == "String" {
+				value = "(" + fieldName + " != null ? \"'\" + " + fieldName + " + \"'\" : null)"
+			}
+			g.P("            + \"", separator, name, "=\" + ", value)
+		}
+		g.P("            + \"}\";")
+	}
+	g.P("    }")
+	g.P()
+}
@@ -0,0 +1,247 @@
+package generator
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// writeJavaFieldPaths writes a path constant for every field of a message
+func writeJavaFieldPaths(g *protogen.GeneratedFile, msg *protogen.Message) {
+	if len(msg.Fields) == 0 {
+		return
+	}
+	g.P("    // Field paths, for applyMask and field masks")
+	for _, field := range msg.Fields {
+		g.P("    public static final String ", fieldPathConstant(msg, field), " = ", javaString(string(field.Desc.Name())), ";")
+	}
+	g.P()
+}
+
+// writeJavaMaskMethods writes applyMask and merge. Mutable classes are
+// updated in place; records and immutable classes return a new instance.
+func writeJavaMaskMethods(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	className := msg.GoIdent.GoName
+	immutable := config.Java.Style == recordsJavaStyle || config.Java.Style == immutableJavaStyle
+	returnType, target := "void", "this"
+	if immutable {
+		returnType, target = className, "builder"
+	}
+
+	writeJavaZeroValue(g, msg, config, immutable)
+
+	g.P("    /**")
+	g.P("     * Copies the fields named by paths from src. Paths are proto (or JSON) field")
+	g.P("     * names and may select nested fields, e.g. \"profile.bio\". A null src counts")
+	g.P("     * as a new ", className, ".")
+	g.P("     */")
+	g.P("    public ", returnType, " applyMask(", className, " src, Collection<String> paths) {")
+	g.P("        ", className, " source = src != null ? src : new ", className, "();")
+	if immutable {
+		g.P("        Builder builder = toBuilder();")
+	}
+	g.P("        for (String path : paths) {")
+	g.P("            int dot = path.indexOf('.');")
+	g.P("            String name = dot < 0 ? path : path.substring(0, dot);")
+	g.P("            String rest = dot < 0 ? null : path.substring(dot + 1);")
+	g.P("            switch (name) {")
+	for _, field := range msg.Fields {
+		fieldName := javaFieldName(field, config)
+		dst := target + "." + fieldName
+		g.P("                case ", fieldPathConstant(msg, field), ":")
+		if jsonName := field.Desc.JSONName(); jsonName != string(field.Desc.Name()) {
+			g.P("                case ", javaString(jsonName), ":")
+		}
+		if isSingularMessage(field) {
+			g.P("                    if (rest == null) {")
+			g.P("                        ", dst, " = source.", fieldName, ";")
+			g.P("                    } else {")
+			if immutable {
+				g.P("                        ", dst, " = (", dst, " != null ? ", dst, " : ", messageTypeName(field.Message), ".zeroValue())")
+				g.P("                            .applyMask(source.", fieldName, ", List.of(rest));")
+			} else {
+				g.P("                        if (", dst, " == null) {")
+				g.P("                            ", dst, " = ", messageTypeName(field.Message), ".zeroValue();")
+				g.P("                        }")
+				g.P("                        ", dst, ".applyMask(source.", fieldName, ", List.of(rest));")
+			}
+			g.P("                    }")
+			g.P("                    break;")
+			continue
+		}
+		g.P("                    if (rest != null) {")
+		g.P("                        throw new IllegalArgumentException(\"", className, ": field \" + name + \" has no subfields\");")
+		g.P("                    }")
+		switch {
+		case immutable:
+			g.P("                    ", dst, " = source.", fieldName, ";")
+		case field.Desc.IsMap():
+			g.P("                    ", dst, " = source.", fieldName, " != null ? new HashMap<>(source.", fieldName, ") : new HashMap<>();")
+		case field.Desc.IsList():
+			g.P("                    ", dst, " = source.", fieldName, " != null ? new ArrayList<>(source.", fieldName, ") : new ArrayList<>();")
+		case field.Desc.Kind().String() == "bytes":
+			g.P("                    ", dst, " = source.", fieldName, " != null ? source.", fieldName, ".clone() : null;")
+		default:
+			g.P("                    ", dst, " = source.", fieldName, ";")
+		}
+		g.P("                    break;")
+	}
+	g.P("                default:")
+	g.P("                    throw new IllegalArgumentException(\"", className, ": unknown field path \" + path);")
+	g.P("            }")
+	g.P("        }")
+	if immutable {
+		g.P("        return builder.build();")
+	}
+	g.P("    }")
+	g.P()
+
+	g.P("    /**")
+	g.P("     * Merges src as proto merge does: non-zero scalars and bytes overwrite,")
+	g.P("     * lists are appended, map entries are replaced and messages are merged")
+	g.P("     * recursively.")
+	g.P("     */")
+	g.P("    public ", returnType, " merge(", className, " src) {")
+	g.P("        if (src == null) {")
+	if immutable {
+		g.P("            return this;")
+	} else {
+		g.P("            return;")
+	}
+	g.P("        }")
+	if immutable {
+		g.P("        Builder builder = toBuilder();")
+	}
+	for _, field := range msg.Fields {
+		fieldName := javaFieldName(field, config)
+		dst := target + "." + fieldName
+		value := "src." + fieldName
+		switch {
+		case field.Desc.IsMap():
+			if immutable {
+				g.P("        if (!", value, ".isEmpty()) {")
+				g.P("            ", getJavaFieldType(field, config), " merged", titleCase(fieldName), " = new HashMap<>(", dst, ");")
+				g.P("            merged", titleCase(fieldName), ".putAll(", value, ");")
+				g.P("            ", dst, " = merged", titleCase(fieldName), ";")
+			} else {
+				g.P("        if (", value, " != null) {")
+				g.P("            if (", dst, " == null) {")
+				g.P("                ", dst, " = new HashMap<>();")
+				g.P("            }")
+				g.P("            ", dst, ".putAll(", value, ");")
+			}
+			g.P("        }")
+		case field.Desc.IsList():
+			elementType := getJavaFieldType(field, config)
+			if immutable {
+				g.P("        if (!", value, ".isEmpty()) {")
+				g.P("            ", elementType, " merged", titleCase(fieldName), " = new ArrayList<>(", dst, ");")
+				g.P("            merged", titleCase(fieldName), ".addAll(", value, ");")
+				g.P("            ", dst, " = merged", titleCase(fieldName), ";")
+			} else {
+				g.P("        if (", value, " != null) {")
+				g.P("            if (", dst, " == null) {")
+				g.P("                ", dst, " = new ArrayList<>();")
+				g.P("            }")
+				g.P("            ", dst, ".addAll(", value, ");")
+			}
+			g.P("        }")
+		case field.Message != nil:
+			g.P("        if (", value, " != null) {")
+			// Envelopes and field masks are immutable, like the messages of the
+			// immutable styles
+			if immutable || isSharedMessage(field.Message) {
+				g.P("            ", dst, " = ", dst, " != null ? ", dst, ".merge(", value, ") : ", value, ";")
+			} else {
+				g.P("            if (", dst, " == null) {")
+				g.P("                ", dst, " = ", messageTypeName(field.Message), ".zeroValue();")
+				g.P("            }")
+				g.P("            ", dst, ".merge(", value, ");")
+			}
+			g.P("        }")
+		default:
+			g.P("        if (", javaNonZeroCheck(field, value, config), ") {")
+			if field.Desc.Kind().String() == "bytes" && !immutable {
+				g.P("            ", dst, " = ", value, ".clone();")
+			} else {
+				g.P("            ", dst, " = ", value, ";")
+			}
+			g.P("        }")
+		}
+	}
+	if immutable {
+		g.P("        return builder.build();")
+	}
+	g.P("    }")
+	g.P()
+
+	ret := ""
+	if immutable {
+		ret = "return "
+	}
+	g.P("    /** Copies the fields named by a field mask from src, as applyMask does with its paths. */")
+	g.P("    public ", returnType, " applyMask(", className, " src, PuregenFieldMask mask) {")
+	g.P("        ", ret, "applyMask(src, mask != null ? mask.getPaths() : List.of());")
+	g.P("    }")
+	g.P()
+	g.P("    /**")
+	g.P("     * Merges the fields named by a field mask from src: the fields of src")
+	g.P("     * outside the mask are left out, the others are merged as merge does.")
+	g.P("     */")
+	g.P("    public ", returnType, " merge(", className, " src, PuregenFieldMask mask) {")
+	if immutable {
+		g.P("        return merge(zeroValue().applyMask(src, mask));")
+	} else {
+		g.P("        ", className, " masked = zeroValue();")
+		g.P("        masked.applyMask(src, mask);")
+		g.P("        merge(masked);")
+	}
+	g.P("    }")
+	g.P()
+}
+
+// writeJavaZeroValue writes zeroValue, which returns an instance with every
+// field at its zero value: the masked merge starts from it so that the field
+// defaults of new instances are not merged
+func writeJavaZeroValue(g *protogen.GeneratedFile, msg *protogen.Message, config *Config, immutable bool) {
+	className := msg.GoIdent.GoName
+
+	g.P("    /** Returns a ", className, " with every field at its zero value, unlike new ", className, "(), which applies the field defaults. */")
+	g.P("    public static ", className, " zeroValue() {")
+	if immutable {
+		zeros := make([]string, len(msg.Fields))
+		for i, field := range msg.Fields {
+			zeros[i] = javaZeroValue(field, config)
+			// The constructor replaces a null string with its default
+			if zeros[i] == "null" && getJavaFieldType(field, config) == "String" && getJavaDefaultValue(field, config) != "" {
+				zeros[i] = `""`
+			}
+		}
+		g.P("        return new ", className, "(", strings.Join(zeros, ", "), ");")
+	} else {
+		g.P("        ", className, " zero = new ", className, "();")
+		for _, field := range msg.Fields {
+			if getJavaDefaultValue(field, config) != "" {
+				g.P("        zero.", javaFieldName(field, config), " = ", javaZeroValue(field, config), ";")
+			}
+		}
+		g.P("        return zero;")
+	}
+	g.P("    }")
+	g.P()
+}
+
+// javaNonZeroCheck returns a condition that holds when a scalar value is set
+func javaNonZeroCheck(field *protogen.Field, value string, config *Config) string {
+	switch getJavaFieldType(field, config) {
+	case "boolean":
+		return value
+	case "int", "long", "float", "double":
+		return value + " != 0"
+	case "String":
+		return value + " != null && !" + value + ".isEmpty()"
+	case "byte[]":
+		return value + " != null && " + value + ".length > 0"
+	}
+	return value + " != null"
+}
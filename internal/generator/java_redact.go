@@ -0,0 +1,93 @@
+package generator
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// writeJavaRedacted writes redacted, a copy of a message with its sensitive
+// fields masked, recursing into nested messages. Mutable classes copy every
+// field; records and immutable classes go through toBuilder.
+func writeJavaRedacted(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	className := msg.GoIdent.GoName
+	immutable := config.Java.Style == recordsJavaStyle || config.Java.Style == immutableJavaStyle
+
+	g.P("    /**")
+	g.P("     * Returns a deep copy that is safe to log: sensitive strings that are set")
+	g.P("     * become ", javaString(redactedValue), " and other sensitive fields are cleared,")
+	g.P("     * in this message and in every nested message.")
+	g.P("     */")
+	g.P("    public ", className, " redacted() {")
+	if immutable {
+		g.P("        Builder copy = toBuilder();")
+	} else {
+		g.P("        ", className, " copy = new ", className, "();")
+	}
+	for _, field := range msg.Fields {
+		fieldName := javaFieldName(field, config)
+		dst := "copy." + fieldName
+		switch {
+		case isSensitiveField(field, config) && isRedactableString(field):
+			g.P("        ", dst, " = ", fieldName, " != null && !", fieldName, ".isEmpty() ? ", javaString(redactedValue), " : ", fieldName, ";")
+		case isSensitiveField(field, config) && field.Desc.IsMap():
+			if immutable {
+				g.P("        ", dst, " = Map.of();")
+			} else {
+				g.P("        ", dst, " = new HashMap<>();")
+			}
+		case isSensitiveField(field, config) && field.Desc.IsList():
+			if immutable {
+				g.P("        ", dst, " = List.of();")
+			} else {
+				g.P("        ", dst, " = new ArrayList<>();")
+			}
+		case isSensitiveField(field, config):
+			g.P("        ", dst, " = ", javaZeroValue(field, config), ";")
+		case field.Desc.IsMap() && field.Message.Fields[1].Message != nil:
+			if immutable {
+				g.P("        ", dst, " = new HashMap<>(", fieldName, ");")
+				g.P("        ", dst, ".replaceAll((key, item) -> item != null ? item.redacted() : null);")
+			} else {
+				g.P("        if (", fieldName, " != null) {")
+				g.P("            ", dst, " = new HashMap<>(", fieldName, ");")
+				g.P("            ", dst, ".replaceAll((key, item) -> item != null ? item.redacted() : null);")
+				g.P("        } else {")
+				g.P("            ", dst, " = null;")
+				g.P("        }")
+			}
+		case field.Desc.IsMap():
+			// The builder of immutable messages already holds the value
+			if !immutable {
+				g.P("        ", dst, " = ", fieldName, " != null ? new HashMap<>(", fieldName, ") : null;")
+			}
+		case field.Desc.IsList() && field.Message != nil:
+			if immutable {
+				g.P("        ", dst, " = new ArrayList<>(", fieldName, ");")
+				g.P("        ", dst, ".replaceAll(item -> item != null ? item.redacted() : null);")
+			} else {
+				g.P("        if (", fieldName, " != null) {")
+				g.P("            ", dst, " = new ArrayList<>(", fieldName, ");")
+				g.P("            ", dst, ".replaceAll(item -> item != null ? item.redacted() : null);")
+				g.P("        } else {")
+				g.P("            ", dst, " = null;")
+				g.P("        }")
+			}
+		case field.Message != nil:
+			g.P("        ", dst, " = ", fieldName, " != null ? ", fieldName, ".redacted() : null;")
+		case immutable:
+			// The builder already holds the value, and build() copies lists and arrays
+		case field.Desc.IsList():
+			g.P("        ", dst, " = ", fieldName, " != null ? new ArrayList<>(", fieldName, ") : null;")
+		case field.Desc.Kind().String() == "bytes":
+			g.P("        ", dst, " = ", fieldName, " != null ? ", fieldName, ".clone() : null;")
+		default:
+			g.P("        ", dst, " = ", fieldName, ";")
+		}
+	}
+	if immutable {
+		g.P("        return copy.build();")
+	} else {
+		g.P("        return copy;")
+	}
+	g.P("    }")
+	g.P()
+}
@@ -0,0 +1,309 @@
+package generator
+
+import (
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// writeJavaRegistryImports imports the envelope type from the common namespace
+func writeJavaRegistryImports(g *protogen.GeneratedFile, file *protogen.File, config *Config) {
+	if config.CommonNamespace != "" && hasTypeRegistry(file, config) {
+		g.P("import ", config.CommonNamespace, ".PuregenEnvelope;")
+	}
+}
+
+// generatePackageRegistryJava creates the PuregenTypes registry of a Java
+// package, listing the message classes of every generated file in the package.
+// The envelope type is created next to it, or in the common namespace. With a
+// common namespace the classes are registered with the PuregenRegistry of that
+// package, which PuregenTypes resolves messages from.
+func generatePackageRegistryJava(gen *protogen.Plugin, file *protogen.File, config *Config) {
+	javaPackage := getJavaPackage(file)
+	packageDir := outputPath(config.Output.Java, getJavaPackageDir(file, config))
+	filename := filepath.Join(packageDir, "PuregenTypes.java")
+	if !config.state.createOnce(filename) {
+		return
+	}
+
+	if config.CommonNamespace != "" {
+		commonDir := outputPath(config.Output.Java, strings.ReplaceAll(config.CommonNamespace, ".", "/"))
+		writeJavaEnvelopeClass(gen, commonDir, config.CommonNamespace, config)
+		writeJavaGlobalRegistryClass(gen, commonDir, config)
+	} else {
+		writeJavaEnvelopeClass(gen, packageDir, javaPackage, config)
+	}
+
+	seen := make(map[string]bool)
+	var messages []*protogen.Message
+	for _, f := range gen.Files {
+		if !f.Generate || !config.Features.Registry || getJavaPackage(f) != javaPackage || outputPath(config.Output.Java, getJavaPackageDir(f, config)) != packageDir {
+			continue
+		}
+		for _, msg := range collectDescribedMessages(f) {
+			if !seen[msg.GoIdent.GoName] {
+				seen[msg.GoIdent.GoName] = true
+				messages = append(messages, msg)
+			}
+		}
+	}
+
+	g := gen.NewGeneratedFile(filename, "")
+	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P()
+	g.P("package ", javaPackage, ";")
+	g.P()
+	if config.CommonNamespace != "" {
+		g.P("import ", config.CommonNamespace, ".PuregenEnvelope;")
+		g.P("import ", config.CommonNamespace, ".PuregenRegistry;")
+		g.P()
+		g.P("/**")
+		g.P(" * Registers the message classes of this package with PuregenRegistry, which")
+		g.P(" * resolves the messages of every package.")
+		g.P(" */")
+		g.P("public final class PuregenTypes {")
+		g.P("    private PuregenTypes() {} // Prevent instantiation")
+		g.P()
+		g.P("    static {")
+		for _, msg := range messages {
+			g.P("        PuregenRegistry.register(", javaString(string(msg.Desc.FullName())), ", ", msg.GoIdent.GoName, ".class);")
+		}
+		g.P("    }")
+		g.P()
+		g.P("    /** Returns the class of a message of any package by full proto name, or null. */")
+		g.P("    public static Class<?> find(String fullName) {")
+		g.P("        return PuregenRegistry.find(fullName);")
+		g.P("    }")
+		g.P()
+		g.P("    /** Returns the full proto name of a message class, or null. */")
+		g.P("    public static String nameOf(Class<?> type) {")
+		g.P("        return PuregenRegistry.nameOf(type);")
+		g.P("    }")
+		g.P()
+		g.P("    /** Wraps a registered message in an envelope named by its type URL. */")
+		g.P("    public static PuregenEnvelope pack(Object message) {")
+		g.P("        return PuregenRegistry.pack(message);")
+		g.P("    }")
+		g.P()
+		g.P("    /**")
+		g.P("     * Decodes the payload of an envelope into a new message of the type its")
+		g.P("     * type URL names, which may be a message of any registered package.")
+		g.P("     */")
+		g.P("    public static Object unpack(PuregenEnvelope envelope) throws Exception {")
+		g.P("        return PuregenRegistry.unpack(envelope);")
+		g.P("    }")
+		g.P()
+		g.P("    /** Decodes the payload of an envelope into a message of type, which must be the packed type. */")
+		g.P("    public static <T> T unpack(PuregenEnvelope envelope, Class<T> type) throws Exception {")
+		g.P("        return PuregenRegistry.unpack(envelope, type);")
+		g.P("    }")
+		g.P("}")
+		return
+	}
+	g.P("import java.util.*;")
+	g.P("import com.fasterxml.jackson.databind.*;")
+	g.P()
+	g.P("/** Registry of the message classes of this package, by full proto name. */")
+	g.P("public final class PuregenTypes {")
+	g.P("    private PuregenTypes() {} // Prevent instantiation")
+	g.P()
+	g.P("    private static final Map<String, Class<?>> TYPES = new TreeMap<>();")
+	g.P("    private static final Map<Class<?>, String> NAMES = new HashMap<>();")
+	g.P("    static {")
+	for _, msg := range messages {
+		g.P("        register(", javaString(string(msg.Desc.FullName())), ", ", msg.GoIdent.GoName, ".class);")
+	}
+	g.P("    }")
+	g.P()
+	g.P("    private static void register(String fullName, Class<?> type) {")
+	writeJavaRegistryMethods(g, "this package", "a message of this package")
+}
+
+// writeJavaGlobalRegistryClass writes the PuregenRegistry class into the
+// common namespace. It loads the PuregenTypes of the packages generated with
+// it, which register their message classes; packages generated separately
+// register when their PuregenTypes class is first used.
+func writeJavaGlobalRegistryClass(gen *protogen.Plugin, commonDir string, config *Config) {
+	filename := filepath.Join(commonDir, "PuregenRegistry.java")
+	if !config.state.createOnce(filename) {
+		return
+	}
+
+	var registries []string
+	seen := make(map[string]bool)
+	for _, f := range gen.Files {
+		if !f.Generate || !config.Features.Registry || !hasTypeRegistry(f, config) || seen[getJavaPackage(f)] {
+			continue
+		}
+		seen[getJavaPackage(f)] = true
+		registries = append(registries, javaString(getJavaPackage(f)+".PuregenTypes"))
+	}
+
+	g := gen.NewGeneratedFile(filename, "")
+	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P()
+	g.P("package ", config.CommonNamespace, ";")
+	g.P()
+	g.P("import java.util.*;")
+	g.P("import com.fasterxml.jackson.databind.*;")
+	g.P()
+	g.P("/**")
+	g.P(" * Registry of the message classes of every package, by full proto name. The")
+	g.P(" * PuregenTypes class of each package registers its messages when loaded.")
+	g.P(" */")
+	g.P("public final class PuregenRegistry {")
+	g.P("    private PuregenRegistry() {} // Prevent instantiation")
+	g.P()
+	g.P("    private static final Map<String, Class<?>> TYPES = new TreeMap<>();")
+	g.P("    private static final Map<Class<?>, String> NAMES = new HashMap<>();")
+	g.P("    static {")
+	g.P("        // Packages generated separately register when their PuregenTypes is first used")
+	g.P("        for (String registry : List.of(", strings.Join(registries, ", "), ")) {")
+	g.P("            try {")
+	g.P("                Class.forName(registry);")
+	g.P("            } catch (ClassNotFoundException e) {")
+	g.P("                // The package is not on the classpath")
+	g.P("            }")
+	g.P("        }")
+	g.P("    }")
+	g.P()
+	g.P("    /** Adds a message class to the registry by full proto name. */")
+	g.P("    public static synchronized void register(String fullName, Class<?> type) {")
+	writeJavaRegistryMethods(g, "any registered package", "a message of any registered package")
+}
+
+// writeJavaRegistryMethods writes the body of register, opened by the caller,
+// and the find, nameOf, pack and unpack methods of a registry class
+func writeJavaRegistryMethods(g *protogen.GeneratedFile, registered, resolved string) {
+	g.P("        TYPES.put(fullName, type);")
+	g.P("        NAMES.put(type, fullName);")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the class of a message of ", registered, " by full proto name, or null. */")
+	g.P("    public static Class<?> find(String fullName) {")
+	g.P("        return TYPES.get(fullName);")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the full proto name of a message class, or null. */")
+	g.P("    public static String nameOf(Class<?> type) {")
+	g.P("        return NAMES.get(type);")
+	g.P("    }")
+	g.P()
+	g.P("    /** Wraps a message of ", registered, " in an envelope named by its type URL. */")
+	g.P("    public static PuregenEnvelope pack(Object message) {")
+	g.P("        String fullName = NAMES.get(message.getClass());")
+	g.P("        if (fullName == null) {")
+	g.P("            throw new IllegalArgumentException(\"pack: \" + message.getClass().getName() + \" is not a registered message\");")
+	g.P("        }")
+	g.P("        return new PuregenEnvelope(PuregenEnvelope.TYPE_URL_PREFIX + fullName, PuregenJson.mapper().valueToTree(message));")
+	g.P("    }")
+	g.P()
+	g.P("    /**")
+	g.P("     * Decodes the payload of an envelope into a new message of the type its")
+	g.P("     * type URL names, which must be ", resolved, ".")
+	g.P("     */")
+	g.P("    public static Object unpack(PuregenEnvelope envelope) throws Exception {")
+	g.P("        Class<?> type = TYPES.get(envelope.getMessageName());")
+	g.P("        if (type == null) {")
+	g.P("            throw new IllegalArgumentException(\"unpack: unknown message type \" + envelope.getTypeUrl());")
+	g.P("        }")
+	g.P("        return unpack(envelope, type);")
+	g.P("    }")
+	g.P()
+	g.P("    /** Decodes the payload of an envelope into a message of type, which must be the packed type. */")
+	g.P("    public static <T> T unpack(PuregenEnvelope envelope, Class<T> type) throws Exception {")
+	g.P("        if (!envelope.getMessageName().equals(NAMES.get(type))) {")
+	g.P("            throw new IllegalArgumentException(\"unpack: envelope holds \" + envelope.getMessageName() + \", not \" + type.getName());")
+	g.P("        }")
+	g.P("        JsonNode payload = envelope.getPayload() != null ? envelope.getPayload() : PuregenJson.mapper().createObjectNode();")
+	g.P("        return PuregenJson.mapper().treeToValue(payload, type);")
+	g.P("    }")
+	g.P("}")
+}
+
+// writeJavaEnvelopeClass writes the PuregenEnvelope class into a package directory
+func writeJavaEnvelopeClass(gen *protogen.Plugin, packageDir, javaPackage string, config *Config) {
+	filename := filepath.Join(packageDir, "PuregenEnvelope.java")
+	if !config.state.createOnce(filename) {
+		return
+	}
+
+	g := gen.NewGeneratedFile(filename, "")
+	g.P("// Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P()
+	g.P("package ", javaPackage, ";")
+	g.P()
+	g.P("import java.util.*;")
+	g.P("import com.fasterxml.jackson.annotation.*;")
+	g.P("import com.fasterxml.jackson.databind.*;")
+	g.P()
+	g.P("/**")
+	g.P(" * Holds a message of any type as JSON, named by its type URL. Fields of")
+	g.P(" * type google.protobuf.Any are envelopes.")
+	g.P(" */")
+	g.P("public final class PuregenEnvelope {")
+	g.P("    /** Prefixes the full names of packed messages, as in google.protobuf.Any. */")
+	g.P("    public static final String TYPE_URL_PREFIX = \"type.googleapis.com/\";")
+	g.P()
+	g.P("    private final String typeUrl;")
+	g.P("    private final JsonNode payload;")
+	g.P()
+	g.P("    @JsonCreator")
+	g.P("    public PuregenEnvelope(@JsonProperty(\"type_url\") String typeUrl, @JsonProperty(\"payload\") JsonNode payload) {")
+	g.P("        this.typeUrl = typeUrl != null ? typeUrl : \"\";")
+	g.P("        this.payload = payload;")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns \"type.googleapis.com/\" followed by the full proto name of the payload. */")
+	g.P("    @JsonProperty(\"type_url\")")
+	g.P("    public String getTypeUrl() {")
+	g.P("        return typeUrl;")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the JSON encoding of the message, or null. */")
+	g.P("    @JsonProperty(\"payload\")")
+	g.P("    @JsonInclude(JsonInclude.Include.NON_NULL)")
+	g.P("    public JsonNode getPayload() {")
+	g.P("        return payload;")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns the full proto name of the payload: the part of the type URL after its last '/'. */")
+	g.P("    @JsonIgnore")
+	g.P("    public String getMessageName() {")
+	g.P("        return typeUrl.substring(typeUrl.lastIndexOf('/') + 1);")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns src if it holds a message, else this: merging replaces the content of an envelope. */")
+	g.P("    public PuregenEnvelope merge(PuregenEnvelope src) {")
+	g.P("        return src != null && !src.typeUrl.isEmpty() ? src : this;")
+	g.P("    }")
+	g.P()
+	g.P("    /** Returns a copy without the payload, which may hold sensitive fields. */")
+	g.P("    public PuregenEnvelope redacted() {")
+	g.P("        return new PuregenEnvelope(typeUrl, null);")
+	g.P("    }")
+	g.P()
+	g.P("    @Override")
+	g.P("    public boolean equals(Object o) {")
+	g.P("        if (this == o) {")
+	g.P("            return true;")
+	g.P("        }")
+	g.P("        if (o == null || getClass() != o.getClass()) {")
+	g.P("            return false;")
+	g.P("        }")
+	g.P("        PuregenEnvelope other = (PuregenEnvelope) o;")
+	g.P("        return typeUrl.equals(other.typeUrl) && Objects.equals(payload, other.payload);")
+	g.P("    }")
+	g.P()
+	g.P("    @Override")
+	g.P("    public int hashCode() {")
+	g.P("        return Objects.hash(typeUrl, payload);")
+	g.P("    }")
+	g.P()
+	g.P("    @Override")
+	g.P("    public String toString() {")
+	g.P("        return \"PuregenEnvelope{typeUrl=\" + typeUrl + \"}\";")
+	g.P("    }")
+	g.P("}")
+}
@@ -0,0 +1,228 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestJavaMapFields(t *testing.T) {
+	for _, tc := range []struct {
+		style string
+		want  []string
+	}{
+		{"class", []string{
+			"private Map<String, Long> counters = new HashMap<>();",
+			"private Map<Integer, Tag> tagsById = new HashMap<>();",
+			"private Map<String, byte[]> blobs = new HashMap<>();",
+			"public void putTagsById(Integer key, Tag value) {",
+			"this.counters = source.counters != null ? new HashMap<>(source.counters) : new HashMap<>();",
+			"this.tagsById.putAll(src.tagsById);",
+			"copy.tagsById.replaceAll((key, item) -> item != null ? item.redacted() : null);",
+			"&& bytesMapEquals(blobs, other.blobs)",
+		}},
+		{"records", []string{
+			"@JsonProperty(\"counters\") Map<String, Long> counters,",
+			"counters = counters != null ? Map.copyOf(counters) : Map.of();",
+			"mergedCounters.putAll(src.counters);",
+			// Records compare byte[] map values by identity, so equals is generated
+			"&& bytesMapEquals(blobs, other.blobs)",
+		}},
+	} {
+		t.Run(tc.style, func(t *testing.T) {
+			files := mustGenerate(t, "language=java,java_style="+tc.style, "values/values.proto")
+			source := files["com/example/puregentest/values/Order.java"]
+			for _, want := range tc.want {
+				if !strings.Contains(source, want) {
+					t.Errorf("Order.java lacks %q", want)
+				}
+			}
+			// Map entries are not classes of their own
+			for name := range files {
+				if strings.HasSuffix(name, "Entry.java") {
+					t.Errorf("%s generated", name)
+				}
+			}
+			// The descriptor describes the map values and keys
+			if want := `new PuregenFieldDescriptor("tags_by_id", "tagsById", "tagsById", 10, "message", "puregen.test.values.Tag", "int32", false, true, false,`; !strings.Contains(source, want) {
+				t.Errorf("Order.java lacks %q", want)
+			}
+		})
+	}
+}
+
+func TestJavaFieldMask(t *testing.T) {
+	for _, tc := range []struct {
+		param, maskFile string
+		want            []string
+	}{
+		{"language=java", "com/example/puregentest/fieldmask/PuregenFieldMask.java", []string{
+			"private PuregenFieldMask updateMask;",
+			"public void applyMask(UpdateUserRequest src, PuregenFieldMask mask) {",
+			"User masked = zeroValue();",
+			// The masked merge starts from a user without the default name
+			"zero.displayName = null;",
+		}},
+		// Masks of every package share the class in the common namespace
+		{"language=java,java_style=records,common_namespace=com.example.shared", "com/example/shared/PuregenFieldMask.java", []string{
+			"import com.example.shared.PuregenFieldMask;",
+			"@JsonProperty(\"updateMask\") @JsonAlias({\"update_mask\"}) PuregenFieldMask updateMask",
+			"return merge(zeroValue().applyMask(src, mask));",
+			"return new User(null, \"\", null, null);",
+		}},
+	} {
+		t.Run(tc.param, func(t *testing.T) {
+			files := mustGenerate(t, tc.param, "fieldmask/fieldmask.proto")
+			source := files["com/example/puregentest/fieldmask/UpdateUserRequest.java"] + files["com/example/puregentest/fieldmask/User.java"]
+			for _, want := range tc.want {
+				if !strings.Contains(source, want) {
+					t.Errorf("UpdateUserRequest.java and User.java lack %q", want)
+				}
+			}
+			// FieldMask is the shared mask class, which encodes as a string
+			if _, ok := files["com/example/puregentest/fieldmask/FieldMask.java"]; ok {
+				t.Error("FieldMask.java generated")
+			}
+			mask := files[tc.maskFile]
+			for _, want := range []string{"@JsonValue", "public static PuregenFieldMask fromJsonString(String value) {"} {
+				if !strings.Contains(mask, want) {
+					t.Errorf("%s lacks %q", tc.maskFile, want)
+				}
+			}
+		})
+	}
+}
+
+func TestJavaRegistryOfSeveralPackages(t *testing.T) {
+	// With a common namespace the packages register with one registry
+	files := mustGenerate(t, "language=java,common_namespace=com.example.shared", "registry/registry.proto", "registry/shipping/shipping.proto")
+	registry := files["com/example/shared/PuregenRegistry.java"]
+	for _, want := range []string{
+		`List.of("com.example.puregentest.registry.PuregenTypes", "com.example.puregentest.registry.shipping.PuregenTypes")`,
+		"public static synchronized void register(String fullName, Class<?> type) {",
+		"public static Object unpack(PuregenEnvelope envelope) throws Exception {",
+	} {
+		if !strings.Contains(registry, want) {
+			t.Errorf("PuregenRegistry.java lacks %q", want)
+		}
+	}
+	types := files["com/example/puregentest/registry/shipping/PuregenTypes.java"]
+	for _, want := range []string{
+		`PuregenRegistry.register("puregen.test.registry.shipping.ShipmentSent", ShipmentSent.class);`,
+		"return PuregenRegistry.unpack(envelope);",
+	} {
+		if !strings.Contains(types, want) {
+			t.Errorf("PuregenTypes.java lacks %q", want)
+		}
+	}
+
+	// Otherwise each package keeps a registry of its own
+	files = mustGenerate(t, "language=java", "registry/registry.proto", "registry/shipping/shipping.proto")
+	if _, ok := files["com/example/puregentest/registry/PuregenRegistry.java"]; ok {
+		t.Error("PuregenRegistry.java generated without a common namespace")
+	}
+	types = files["com/example/puregentest/registry/shipping/PuregenTypes.java"]
+	if want := `register("puregen.test.registry.shipping.ShipmentSent", ShipmentSent.class);`; !strings.Contains(types, want) {
+		t.Errorf("PuregenTypes.java lacks %q", want)
+	}
+}
+
+func TestJavaJSONMode(t *testing.T) {
+	files := mustGenerate(t, "language=java,java_style=records", "jsonmode/accounts.proto", "jsonmode/orders.proto")
+	for filename, want := range map[string][]string{
+		// Proto names from the package, omitEmpty from the file
+		"com/example/puregentest/jsonmode/Account.java": {
+			"@JsonInclude(JsonInclude.Include.NON_EMPTY)\npublic record Account(",
+			`@JsonProperty("account_id")`,
+		},
+		// Camel names from the message, overridden by a field
+		"com/example/puregentest/jsonmode/Contact.java": {
+			`@JsonProperty("emailAddress") @JsonAlias({"email_address"}) String emailAddress,`,
+			`@JsonProperty("phone_number") @JsonInclude(JsonInclude.Include.ALWAYS)`,
+		},
+		"com/example/puregentest/jsonmode/Order.java": {`@JsonProperty("order_id")`},
+	} {
+		source := files[filename]
+		for _, w := range want {
+			if !strings.Contains(source, w) {
+				t.Errorf("%s lacks %q", filename, w)
+			}
+		}
+	}
+	// The file-level omitEmpty does not apply to the other file of the package
+	if strings.Contains(files["com/example/puregentest/jsonmode/Order.java"], "JsonInclude") {
+		t.Error("Order.java omits empty fields")
+	}
+}
+
+func TestJavaAsyncClients(t *testing.T) {
+	files := mustGenerate(t, "language=java", "layout/a.proto")
+	dir := "com/example/puregentest/layout/a/"
+	for filename, wants := range map[string][]string{
+		"AsyncPuregenTransport.java": {
+			"public interface AsyncPuregenTransport {",
+			"<T> CompletableFuture<T> sendAsync(Map<String, Object> ctx, String methodName, Object inputData, Class<T> responseClass);",
+		},
+		"PingServiceAsyncClient.java": {
+			"public PingServiceAsyncClient(AsyncPuregenTransport transport) {",
+			"public CompletableFuture<PingResponse> ping(Map<String, Object> ctx, PingRequest request) {",
+			"return transport.sendAsync(enhancedCtx, PingServiceMethods.PingService_Ping, request, PingResponse.class);",
+		},
+		"PingServiceAsyncService.java": {
+			"public interface PingServiceAsyncService {",
+			"CompletableFuture<PingResponse> ping(Map<String, Object> ctx, PingRequest request);",
+		},
+		// The blocking client is generated alongside
+		"PingServiceClient.java": {
+			"public PingServiceClient(PuregenTransport transport) {",
+		},
+	} {
+		source, ok := files[dir+filename]
+		if !ok {
+			t.Errorf("%s not generated", filename)
+			continue
+		}
+		for _, want := range wants {
+			if !strings.Contains(source, want) {
+				t.Errorf("%s lacks %q", filename, want)
+			}
+		}
+	}
+}
+
+func TestJavaValueMethods(t *testing.T) {
+	files := mustGenerate(t, "language=java", "enums/enums.proto")
+	dir := "com/example/puregentest/enums/"
+	for filename, wants := range map[string][]string{
+		"Job.java": {
+			"public boolean equals(Object o) {",
+			"&& Objects.equals(stateByStep, other.stateByStep)",
+			"return Objects.hash(id, state, history, stateByStep, priority, colors);",
+			`+ "id=" + (id != null ? "'" + id + "'" : null)`,
+			"return PuregenJson.toJson(this);",
+			"return PuregenJson.fromJson(json, Job.class);",
+		},
+		// One mapper per package, which ignores unknown fields
+		"PuregenJson.java": {
+			"private static volatile ObjectMapper mapper = newMapper();",
+			"mapper.configure(DeserializationFeature.FAIL_ON_UNKNOWN_PROPERTIES, false);",
+			"public static void setMapper(ObjectMapper mapper) {",
+		},
+		// Int enums are written as numbers and read from numbers or names
+		"State.java": {
+			"@JsonValue\n    public int getValue() {",
+			"@JsonCreator(mode = JsonCreator.Mode.DELEGATING)",
+		},
+	} {
+		for _, want := range wants {
+			if !strings.Contains(files[dir+filename], want) {
+				t.Errorf("%s lacks %q", filename, want)
+			}
+		}
+	}
+
+	// With java_int_enums=name Jackson writes the constant names
+	files = mustGenerate(t, "language=java,java_int_enums=name", "enums/enums.proto")
+	if source := files[dir+"State.java"]; strings.Contains(source, "@JsonValue") || !strings.Contains(source, "@JsonCreator") {
+		t.Error("State.java with java_int_enums=name still writes numbers")
+	}
+}
@@ -0,0 +1,526 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// The tests in this file run protoc-gen-puregen on the protos in testdata the
+// way protoc does, then check and compile the generated files. The plugin is
+// built once per test run; it keeps state across the files of one request,
+// so every request needs a fresh process.
+
+var (
+	pluginOnce sync.Once
+	pluginDir  string
+	pluginPath string
+	pluginErr  error
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if pluginDir != "" {
+		os.RemoveAll(pluginDir)
+	}
+	os.Exit(code)
+}
+
+// buildPlugin builds protoc-gen-puregen, skipping the test without a Go toolchain
+func buildPlugin(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go toolchain not found")
+	}
+	pluginOnce.Do(func() {
+		pluginDir, pluginErr = os.MkdirTemp("", "puregen-plugin")
+		if pluginErr != nil {
+			return
+		}
+		pluginPath = filepath.Join(pluginDir, "protoc-gen-puregen")
+		out, err := exec.Command("go", "build", "-o", pluginPath, "github.com/nnanto/puregen/cmd/protoc-gen-puregen").CombinedOutput()
+		if err != nil {
+			pluginErr = fmt.Errorf("%v\n%s", err, out)
+		}
+	})
+	if pluginErr != nil {
+		t.Fatalf("building the plugin: %v", pluginErr)
+	}
+	return pluginPath
+}
+
+// generate compiles protos from testdata and runs the plugin on them with
+// parameter. It returns the generated files by name, or the plugin's error.
+func generate(t *testing.T, parameter string, protos ...string) (map[string]string, error) {
+	t.Helper()
+	plugin := buildPlugin(t)
+
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			ImportPaths: []string{"testdata", filepath.Join("..", "..", "proto")},
+		}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	compiled, err := compiler.Compile(context.Background(), protos...)
+	if err != nil {
+		t.Fatalf("compiling %v: %v", protos, err)
+	}
+
+	// The request lists every file once, after its imports
+	var protoFiles []*descriptorpb.FileDescriptorProto
+	seen := make(map[string]bool)
+	var add func(file protoreflect.FileDescriptor)
+	add = func(file protoreflect.FileDescriptor) {
+		if seen[file.Path()] {
+			return
+		}
+		seen[file.Path()] = true
+		for i := 0; i < file.Imports().Len(); i++ {
+			add(file.Imports().Get(i).FileDescriptor)
+		}
+		protoFiles = append(protoFiles, protodesc.ToFileDescriptorProto(file))
+	}
+	for _, file := range compiled {
+		add(file)
+	}
+	request, err := proto.Marshal(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: protos,
+		Parameter:      proto.String(parameter),
+		ProtoFile:      protoFiles,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(plugin)
+	cmd.Stdin = bytes.NewReader(request)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("running the plugin: %v\n%s", err, stderr.String())
+	}
+	response := &pluginpb.CodeGeneratorResponse{}
+	if err := proto.Unmarshal(stdout.Bytes(), response); err != nil {
+		t.Fatal(err)
+	}
+	if response.Error != nil {
+		return nil, errors.New(response.GetError())
+	}
+
+	// protoc rejects responses that name a file twice
+	files := make(map[string]string)
+	for _, file := range response.File {
+		if _, ok := files[file.GetName()]; ok {
+			t.Errorf("%s generated twice", file.GetName())
+		}
+		files[file.GetName()] = file.GetContent()
+	}
+	return files, nil
+}
+
+// mustGenerate is generate for runs expected to succeed
+func mustGenerate(t *testing.T, parameter string, protos ...string) map[string]string {
+	t.Helper()
+	files, err := generate(t, parameter, protos...)
+	if err != nil {
+		t.Fatalf("plugin error: %v", err)
+	}
+	return files
+}
+
+// checkGo writes the generated Go files below root into a module named root
+// and runs go vet and go test on it. Tests in testdata/<dir> are copied into
+// the package generated at root/<dir>, so they run against the generated code.
+func checkGo(t *testing.T, files map[string]string, root string) {
+	t.Helper()
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "go.mod"), "module "+root+"\n\ngo 1.23\n")
+	packages := make(map[string]bool)
+	for name, content := range files {
+		rel, ok := strings.CutPrefix(name, root+"/")
+		if !ok || !strings.HasSuffix(name, ".go") {
+			continue
+		}
+		writeFile(t, filepath.Join(dir, rel), content)
+		packages[filepath.Dir(rel)] = true
+	}
+	if len(packages) == 0 {
+		t.Fatalf("no Go files generated below %s", root)
+	}
+	for pkg := range packages {
+		tests, _ := filepath.Glob(filepath.Join("testdata", pkg, "*_test.go"))
+		for _, test := range tests {
+			content, err := os.ReadFile(test)
+			if err != nil {
+				t.Fatal(err)
+			}
+			writeFile(t, filepath.Join(dir, pkg, filepath.Base(test)), string(content))
+		}
+	}
+	for _, args := range [][]string{{"vet", "./..."}, {"test", "./..."}} {
+		cmd := exec.Command("go", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOWORK=off")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("go %s: %v\n%s", strings.Join(args, " "), err, out)
+		}
+	}
+}
+
+// runPython writes the generated Python files into a directory and runs
+// script there with python3, skipping the test without an interpreter
+func runPython(t *testing.T, files map[string]string, script string) {
+	t.Helper()
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		t.Skip("python3 not found")
+	}
+	dir := t.TempDir()
+	for name, content := range files {
+		if strings.HasSuffix(name, ".py") || strings.HasSuffix(name, ".pyi") {
+			writeFile(t, filepath.Join(dir, name), content)
+		}
+	}
+	cmd := exec.Command(python, "-c", script)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("python3: %v\n%s", err, out)
+	}
+}
+
+// skipWithoutPythonModule skips the test when python3 cannot import module
+func skipWithoutPythonModule(t *testing.T, module string) {
+	t.Helper()
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		t.Skip("python3 not found")
+	}
+	if err := exec.Command(python, "-c", "import "+module).Run(); err != nil {
+		t.Skipf("python3 cannot import %s", module)
+	}
+}
+
+// writeFile writes content to filename, creating its directory
+func writeFile(t *testing.T, filename, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// fileNames returns the sorted names of files
+func fileNames(files map[string]string) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestSourceRelativePaths(t *testing.T) {
+	files := mustGenerate(t, "paths=source_relative,go_out_prefix=go,java_out_prefix=java,python_out_prefix=python",
+		"layout/a.proto", "layout/b.proto")
+
+	for _, name := range []string{
+		"go/layout/a.go",
+		"go/layout/b.go",
+		"go/layout/puregen_transport.go",
+		"java/layout/com/example/puregentest/layout/a/Error.java",
+		"java/layout/com/example/puregentest/layout/a/PuregenTransport.java",
+		"java/layout/com/example/puregentest/layout/b/Failure.java",
+		"java/layout/com/example/puregentest/layout/b/PuregenTransport.java",
+		"python/layout/a.py",
+		"python/layout/b.py",
+		"python/layout/puregen_transport.py",
+	} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("%s not generated; got %v", name, fileNames(files))
+		}
+	}
+
+	// Options of fields of either proto that would share a name are told apart
+	for name, option := range map[string]string{
+		"go/layout/a.go": "func WithFailureDetail_Text(",
+		"go/layout/b.go": "func WithFailure_DetailText(",
+	} {
+		if !strings.Contains(files[name], option) {
+			t.Errorf("%s lacks %s", name, option)
+		}
+	}
+	if !strings.Contains(files["go/layout/b.go"], "func WithFailureReason(") {
+		t.Error("go/layout/b.go lacks func WithFailureReason(")
+	}
+
+	// Both protos share one Go package, which builds with a single transport
+	goFiles := make(map[string]string)
+	for name, content := range files {
+		if rel, ok := strings.CutPrefix(name, "go/"); ok {
+			goFiles["example.com/puregentest/"+rel] = content
+		}
+	}
+	checkGo(t, goFiles, "example.com/puregentest")
+}
+
+// Map fields cross languages as JSON objects keyed by strings, with base64
+// bytes values. testdata/values checks the Go side of the same JSON.
+func TestMapJSONAcrossLanguages(t *testing.T) {
+	files := mustGenerate(t, "language=python", "values/values.proto")
+	runPython(t, files, `
+import json
+from puregen.test.values import Order
+
+# As written by Go
+order = Order.from_json('{"counters":{"a":1},"tagsById":{"7":{"name":"seven","data":null}},"blobs":{"b":"YmxvYg=="}}')
+assert order.counters == {"a": 1}, order.counters
+assert list(order.tags_by_id) == [7] and order.tags_by_id[7].name == "seven", order.tags_by_id
+assert order.blobs == {"b": b"blob"}, order.blobs
+
+fields = json.loads(order.to_json())
+assert fields["counters"] == {"a": 1}, fields
+assert fields["tagsById"] == {"7": {"name": "seven"}}, fields
+assert fields["blobs"] == {"b": "YmxvYg=="}, fields
+`)
+}
+
+// Field masks cross languages as strings of lowerCamelCase paths.
+// testdata/fieldmask checks the Go side of the same JSON.
+func TestFieldMaskAcrossLanguages(t *testing.T) {
+	files := mustGenerate(t, "language=python", "fieldmask/fieldmask.proto")
+	runPython(t, files, `
+import json
+from puregen.test.fieldmask import Profile, PuregenFieldMask, UpdateUserRequest, User
+
+# As written by Go
+request = UpdateUserRequest.from_json('{"user":{"id":"u-2","displayName":"Grace","profile":{"bio":"navy","avatarUrl":"g.png"},"tags":["x"]},"updateMask":"displayName,profile.avatarUrl"}')
+assert request.update_mask == PuregenFieldMask(paths=["display_name", "profile.avatar_url"]), request.update_mask
+assert json.loads(request.to_json())["updateMask"] == "displayName,profile.avatarUrl", request.to_json()
+assert UpdateUserRequest.from_json(request.to_json()) == request
+
+stored = User(id="u-2", display_name="Ada", profile=Profile(bio="math", avatar_url="a.png"), tags=["old"])
+stored.apply_mask(request.user, request.update_mask)
+assert stored == User(id="u-2", display_name="Grace", profile=Profile(bio="math", avatar_url="g.png"), tags=["old"]), stored
+
+stored.merge(request.user, PuregenFieldMask(paths=["tags", "profile.bio"]))
+assert stored == User(id="u-2", display_name="Grace", profile=Profile(bio="navy", avatar_url="g.png"), tags=["old", "x"]), stored
+
+# As in Go, the fields outside the mask keep their values rather than taking
+# the defaults, and a masked field set to its default is applied
+assert User().display_name == "Anonymous"
+merged = User(id="u-2", display_name="Grace")
+merged.merge(User(display_name="Ada", profile=Profile(bio="navy")), PuregenFieldMask(paths=["profile.bio"]))
+assert merged == User(id="u-2", display_name="Grace", profile=Profile(bio="navy")), merged
+merged.merge(User(), PuregenFieldMask(paths=["display_name"]))
+assert merged.display_name == "Anonymous", merged
+merged.merge(User(display_name=""))
+assert merged.display_name == "Anonymous", merged
+`)
+}
+
+// The pydantic models are valid Python without pydantic, and read the JSON
+// written by Go when it is installed
+func TestPythonPydantic(t *testing.T) {
+	files := mustGenerate(t, "language=python,python_style=pydantic", "fieldmask/fieldmask.proto")
+	runPython(t, files, `
+import ast, pathlib
+for path in pathlib.Path(".").rglob("*.py"):
+    ast.parse(path.read_text(), str(path))
+`)
+	skipWithoutPythonModule(t, "pydantic")
+	runPython(t, files, `
+import json
+from puregen.test.fieldmask import Profile, PuregenFieldMask, UpdateUserRequest, User
+
+request = UpdateUserRequest.from_json('{"user":{"id":"u-2","displayName":"Grace","profile":{"bio":"navy","avatarUrl":"g.png"},"tags":["x"]},"updateMask":"displayName,profile.avatarUrl"}')
+assert request.user == User(id="u-2", display_name="Grace", profile=Profile(bio="navy", avatar_url="g.png"), tags=["x"]), request.user
+assert request.update_mask == PuregenFieldMask(paths=["display_name", "profile.avatar_url"]), request.update_mask
+assert json.loads(request.to_json())["updateMask"] == "displayName,profile.avatarUrl", request.to_json()
+assert UpdateUserRequest.from_json(request.to_json()) == request
+assert User().display_name == "Anonymous"
+
+merged = User(id="u-2", display_name="Ada")
+merged.merge(request.user, PuregenFieldMask(paths=["profile.bio"]))
+assert merged == User(id="u-2", display_name="Ada", profile=Profile(bio="navy")), merged
+`)
+}
+
+// Every stub parses, uses each name it imports, and types integer enum
+// fields as their IntEnum class
+func TestPythonStubs(t *testing.T) {
+	for _, param := range []string{
+		"language=python,python_stubs=true",
+		"language=python,python_stubs=true,python_style=pydantic,common_namespace=shared",
+	} {
+		t.Run(param, func(t *testing.T) {
+			files := mustGenerate(t, param, "enums/enums.proto", "layout/a.proto", "registry/registry.proto", "registry/shipping/shipping.proto")
+			runPython(t, files, `
+import ast, pathlib
+
+stubs = sorted(pathlib.Path(".").rglob("*.pyi"))
+assert len(stubs) == 4, stubs
+for path in stubs:
+    tree = ast.parse(path.read_text(), str(path))
+    imported = set()
+    for node in ast.walk(tree):
+        if isinstance(node, (ast.Import, ast.ImportFrom)):
+            imported.update((alias.asname or alias.name).split(".")[0] for alias in node.names)
+    used = {node.id for node in ast.walk(tree) if isinstance(node, ast.Name)}
+    # Forward references are strings
+    used |= {node.value for node in ast.walk(tree) if isinstance(node, ast.Constant) and str(node.value).isidentifier()}
+    assert imported - used == set(), (path, imported - used)
+
+stub = pathlib.Path("puregen/test/enums/enums.pyi").read_text()
+for annotation in ["state: State = ...", "history: List[State] = ...", "state_by_step: Dict[str, State] = ..."]:
+    assert annotation in stub, annotation
+`)
+		})
+	}
+}
+
+// An awaited call goes from the asyncio client through an
+// AsyncPuregenTransport to an async service
+func TestPythonAsyncClients(t *testing.T) {
+	files := mustGenerate(t, "language=python,python_async_services=true", "layout/a.proto")
+	runPython(t, files, `
+import asyncio
+from puregen.test.layout.a import AsyncPingServiceClient, AsyncPuregenTransport, Error, PingRequest, PingResponse
+from puregen.test.layout.a.a import PingServiceMethods, PingServiceService
+
+class Pinger(PingServiceService):
+    async def ping(self, ctx, request):
+        return PingResponse(error=Error(code="pong"))
+
+class LocalTransport(AsyncPuregenTransport):
+    def __init__(self, service):
+        self.service = service
+
+    async def send(self, ctx, method_name, input_data, output_type):
+        assert method_name == PingServiceMethods.PingService_Ping, method_name
+        assert "method_metadata" in ctx, ctx
+        response = await self.service.ping(ctx, input_data)
+        # As a transport decoding JSON would
+        return response.to_dict()
+
+assert asyncio.iscoroutinefunction(PingServiceService.ping)
+client = AsyncPingServiceClient(LocalTransport(Pinger()))
+response = asyncio.run(client.ping({}, PingRequest()))
+assert response == PingResponse(error=Error(code="pong")), response
+`)
+}
+
+// Custom options set what comment directives do, and win over them.
+// testdata/options checks the Go side.
+func TestPythonCustomOptions(t *testing.T) {
+	files := mustGenerate(t, "language=python", "options/options.proto")
+	runPython(t, files, `
+from puregen.test.options import Account, Status
+from puregen.test.options.options import AccountMetadata, AccountServiceMethods, Region
+
+account = Account()
+assert (account.name, account.plan, account.zone) == ("Anonymous", "free", "eu"), account
+assert Status.STATUS_ACTIVE == 1 and Region.REGION_EU == "REGION_EU"
+assert AccountMetadata["table"] == "accounts", AccountMetadata
+assert AccountServiceMethods.METHOD_METADATA[AccountServiceMethods.AccountService_GetAccount] == {"method": "GET", "path": "/accounts/{id}"}
+`)
+}
+
+// The common namespace package and each proto package re-export what they
+// declare, and the clients take the transport of the common namespace
+func TestPythonCommonNamespaceExports(t *testing.T) {
+	files := mustGenerate(t, "language=python,common_namespace=shared.transport", "layout/a.proto")
+	for _, marker := range []string{"shared/transport/py.typed", "puregen/test/layout/a/py.typed"} {
+		if _, ok := files[marker]; !ok {
+			t.Errorf("%s not generated", marker)
+		}
+	}
+	runPython(t, files, `
+import typing
+import shared.transport
+from puregen.test.layout import a
+
+for package in (shared.transport, a):
+    for name in package.__all__:
+        assert hasattr(package, name), (package.__name__, name)
+assert {"PuregenTransport", "AsyncPuregenTransport"} <= set(shared.transport.__all__), shared.transport.__all__
+assert {"Error", "PingRequest", "PingResponse", "PingServiceClient", "AsyncPingServiceClient"} <= set(a.__all__), a.__all__
+assert typing.get_type_hints(a.PingServiceClient.__init__)["transport"] is shared.transport.PuregenTransport
+assert typing.get_type_hints(a.AsyncPingServiceClient.__init__)["transport"] is shared.transport.AsyncPuregenTransport
+`)
+}
+
+// With a common namespace the packages share one registry, so unpack resolves
+// the messages of every package. Without one, each package resolves its own
+// messages and envelopes are unpacked by the package that names their type.
+// testdata/registry checks the Go side.
+func TestPythonRegistryOfSeveralPackages(t *testing.T) {
+	t.Run("common_namespace", func(t *testing.T) {
+		files := mustGenerate(t, "language=python,common_namespace=shared", "registry/registry.proto", "registry/shipping/shipping.proto")
+		runPython(t, files, `
+from puregen.test import registry
+from puregen.test.registry import shipping
+import shared
+
+sent = shipping.ShipmentSent(order_id="o-1", carrier="post")
+event = registry.Event(id="e-1", payload=shipping.pack(sent))
+decoded = registry.Event.from_json(event.to_json())
+assert registry.unpack(decoded.payload) == sent, decoded.payload
+assert registry.find_message_type("puregen.test.registry.shipping.ShipmentSent") is shipping.ShipmentSent
+assert registry.PuregenEnvelope is shipping.PuregenEnvelope is shared.PuregenEnvelope
+assert shared.unpack(registry.pack(registry.OrderPlaced(order_id="o-1"))) == registry.OrderPlaced(order_id="o-1")
+`)
+	})
+	t.Run("per_package", func(t *testing.T) {
+		files := mustGenerate(t, "language=python", "registry/registry.proto", "registry/shipping/shipping.proto")
+		runPython(t, files, `
+from puregen.test import registry
+from puregen.test.registry import shipping
+
+sent = shipping.ShipmentSent(order_id="o-1", carrier="post")
+event = registry.Event(id="e-1", payload=shipping.pack(sent))
+decoded = registry.Event.from_json(event.to_json())
+assert registry.find_message_type(decoded.payload.message_name()) is None
+
+def unpack_any(envelope):
+    for package in (registry, shipping):
+        if package.find_message_type(envelope.message_name()) is not None:
+            return package.unpack(envelope)
+    raise ValueError(envelope.type_url)
+
+assert unpack_any(decoded.payload) == sent, decoded.payload
+`)
+	})
+}
+
+// JSON settings resolve from the package, then the file, then the message,
+// then the field. testdata/jsonmode checks the Go side of the same JSON.
+func TestPythonJSONMode(t *testing.T) {
+	files := mustGenerate(t, "language=python", "jsonmode/accounts.proto", "jsonmode/orders.proto")
+	runPython(t, files, `
+from puregen.test.jsonmode import Account, Contact, Order
+
+assert Account(account_id="a-1").to_dict() == {"account_id": "a-1"}, Account(account_id="a-1").to_dict()
+assert Contact().to_dict() == {"phone_number": ""}, Contact().to_dict()
+assert Contact(email_address="a@example.com").to_dict() == {"emailAddress": "a@example.com", "phone_number": ""}
+assert Order(order_id="o-1").to_dict() == {"order_id": "o-1", "total_cents": 0}, Order(order_id="o-1").to_dict()
+assert Contact.from_json('{"email_address": "a@example.com", "phoneNumber": "1"}') == Contact(email_address="a@example.com", phone_number="1")
+`)
+}
@@ -1,12 +1,15 @@
 package generator
 
 import (
+	"encoding/json"
+	"path"
 	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // formatPythonComment formats a comment for Python code
@@ -50,6 +53,44 @@ func formatPythonComment(comments protogen.CommentSet) []string {
 	return result
 }
 
+// pythonString renders s as a Python string literal
+func pythonString(s string) string {
+	// JSON string escapes are a subset of Python's
+	encoded, _ := json.Marshal(s)
+	return string(encoded)
+}
+
+// pythonMetadataLiteral renders a metadata value as a Python literal
+func pythonMetadataLiteral(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "None"
+	case string:
+		return pythonString(v)
+	case bool:
+		if v {
+			return "True"
+		}
+		return "False"
+	case json.Number:
+		return v.String()
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, pythonMetadataLiteral(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]any:
+		entries := make([]string, 0, len(v))
+		for _, key := range sortedKeys(v) {
+			entries = append(entries, pythonString(key)+": "+pythonMetadataLiteral(v[key]))
+		}
+		return "{" + strings.Join(entries, ", ") + "}"
+	default:
+		return "None"
+	}
+}
+
 // writePythonComment writes formatted comments to the generator
 func writePythonComment(g *protogen.GeneratedFile, comments protogen.CommentSet) {
 	commentLines := formatPythonComment(comments)
@@ -58,86 +99,83 @@ func writePythonComment(g *protogen.GeneratedFile, comments protogen.CommentSet)
 	}
 }
 
-// Track created package directories to avoid duplicates
-var createdPythonPackages = make(map[string]bool)
+// writePythonJSONAliases renames the keys of data that name fields by an
+// alias (see jsonFieldAliases) to their JSON names, which win when both are present
+func writePythonJSONAliases(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	if !hasJSONFieldAliases(msg, config) {
+		return
+	}
+	var pairs []string
+	for _, field := range msg.Fields {
+		for _, alias := range jsonFieldAliases(field, config) {
+			pairs = append(pairs, "("+pythonString(alias)+", "+pythonString(jsonFieldName(field, config))+")")
+		}
+	}
+	g.P("        data = dict(data)")
+	g.P("        for alias, name in [", strings.Join(pairs, ", "), "]:")
+	g.P("            if alias in data:")
+	g.P("                data.setdefault(name, data.pop(alias))")
+}
+
+// writePythonDeprecated writes a deprecation comment for a deprecated element
+func writePythonDeprecated(g *protogen.GeneratedFile, indent string, desc protoreflect.Descriptor) {
+	if isDeprecated(desc) {
+		g.P(indent, "# ", deprecationNotice(desc))
+	}
+}
 
 
 
 
 
 // GeneratePythonFile generates Python code for the given protobuf file
-func GeneratePythonFile(gen *protogen.Plugin, file *protogen.File, commonNamespace string) {
+func GeneratePythonFile(gen *protogen.Plugin, file *protogen.File, config *Config) {
 	if len(file.Messages) == 0 && len(file.Services) == 0 {
 		return
 	}
 
+	hasServices := len(file.Services) > 0 && config.Features.Services
+	hasClients := len(file.Services) > 0 && config.Features.Clients
+
 	// Generate transport interface based on namespace configuration
-	if len(file.Services) > 0 {
-		if commonNamespace != "" {
+	if hasClients {
+		if config.CommonNamespace != "" {
 			// Generate global transport if namespace is provided
-			generateGlobalTransport(gen, commonNamespace)
+			generateGlobalTransport(gen, config)
 		} else {
 			// Generate per-package transport if no common namespace is provided
-			generatePackageTransportPython(gen, file)
+			generatePackageTransportPython(gen, file, config)
 		}
 	}
 
+	// Messages are registered in the puregen_descriptors module of their package
+	if hasPythonDescriptors(file, config) {
+		generatePackageDescriptorsPython(gen, file, config)
+	}
+
+	// Message classes are registered in the puregen_registry module of their package
+	if hasTypeRegistry(file, config) {
+		generatePackageRegistryPython(gen, file, config)
+	}
+	if hasPythonFieldMask(file) {
+		generatePackageFieldMaskPython(gen, file, config)
+	}
+
 	// Get Python module name and create package structure
-	moduleName := getPythonModuleName(file)
+	moduleName := getPythonModuleName(file, config)
 
 	// Create package directories with __init__.py files
-	createPythonPackageStructure(gen, moduleName)
+	createPythonPackageStructure(gen, moduleName, config)
 
 	// Create the main module file in the package directory
 	baseFilename := filepath.Base(strings.ReplaceAll(*file.Proto.Name, ".proto", ""))
-	filename := strings.ReplaceAll(moduleName, ".", "/") + "/" + baseFilename + ".py"
+	filename := outputPath(config.Output.Python, strings.ReplaceAll(moduleName, ".", "/")+"/"+baseFilename+".py")
 	g := gen.NewGeneratedFile(filename, "")
 
 	// Generate file header
 	g.P("# Code generated by protoc-gen-puregen. DO NOT EDIT.")
 	g.P()
-	g.P("from dataclasses import dataclass, field")
-	g.P("from typing import Optional, List, Dict, Any")
-	g.P("from abc import ABC, abstractmethod")
-	g.P("import json")
-
-	// Check if we need IntEnum import
-	enumsForImport := collectAllEnums(file)
-	needsIntEnum := false
-	for _, enum := range enumsForImport {
-		directive := parsePuregenDirective(enum.Comments)
-		if directive != nil && directive.EnumType == "int" {
-			needsIntEnum = true
-			break
-		}
-	}
-	if needsIntEnum {
-		g.P("from enum import IntEnum")
-	}
-
-	// Import transport interface
-	if len(file.Services) > 0 {
-		if commonNamespace != "" {
-			// Import global transport if namespace is provided
-			g.P("from ", commonNamespace, " import PuregenTransport")
-		} else {
-			// For per-package transport, import from the transport module in the same package
-			g.P("from .puregen_transport import PuregenTransport")
-		}
-	}
-
-	// Collect and generate imports for same package messages
-	samePackageMessages := collectSamePackageMessages(file)
-	if len(samePackageMessages) > 0 {
-		for _, message := range samePackageMessages {
-			// Get the module name for the message (based on its file)
-			msgFilename := strings.TrimSuffix(filepath.Base(message.Desc.ParentFile().Path()), ".proto")
-			// Use full package path with dots for import
-			packagePath := getPythonImportModuleName(file)
-			g.P("from ", packagePath, ".", msgFilename, " import ", message.GoIdent.GoName)
-		}
-	}
-	g.P()
+	writePythonImports(gen, g, file, config)
 
 	// Collect and generate imported messages first
 	importedMessages := collectImportedMessages(file)
@@ -145,7 +183,7 @@ func GeneratePythonFile(gen *protogen.Plugin, file *protogen.File, commonNamespa
 		g.P("# Imported Messages (redefined locally)")
 		g.P()
 		for _, message := range importedMessages {
-			generatePythonMessage(g, message)
+			generatePythonMessageClass(g, file, message, config)
 		}
 	}
 
@@ -156,7 +194,7 @@ func GeneratePythonFile(gen *protogen.Plugin, file *protogen.File, commonNamespa
 		g.P()
 	}
 	for _, enum := range allEnums {
-		generatePythonEnum(g, enum)
+		generatePythonEnum(g, enum, config)
 	}
 
 	// Generate messages
@@ -165,37 +203,53 @@ func GeneratePythonFile(gen *protogen.Plugin, file *protogen.File, commonNamespa
 		g.P()
 	}
 	for _, message := range file.Messages {
-		generatePythonMessage(g, message)
+		generatePythonMessageClass(g, file, message, config)
+	}
+
+	// Resolve the quoted forward references between pydantic models
+	if config.Python.Style == pydanticPythonStyle && len(file.Messages)+len(importedMessages) > 0 {
+		for _, message := range append(importedMessages, file.Messages...) {
+			writePydanticModelRebuild(g, message)
+		}
+		g.P()
 	}
 
+	generatePythonDescriptors(g, file, config)
+	generatePythonTypeRegistry(g, file, config)
+
 	// Generate services
-	if len(file.Services) > 0 {
+	if hasServices {
 		g.P("# Services")
 		g.P()
-	}
-	for _, service := range file.Services {
-		generatePythonService(g, service)
+		for _, service := range file.Services {
+			generatePythonService(g, service, config)
+		}
 	}
 
 	// Generate method name constants
-	if len(file.Services) > 0 {
+	if hasServices || hasClients {
 		g.P("# Method name constants")
 		g.P()
 		for _, service := range file.Services {
-			generatePythonMethodConstants(g, service)
+			generatePythonMethodConstants(g, service, config)
 		}
 	}
 
-	if len(file.Services) > 0 {
+	if hasClients {
 		g.P("# Client")
 		g.P()
+		for _, service := range file.Services {
+			generatePythonClient(g, service, config)
+		}
 	}
-	for _, service := range file.Services {
-		generatePythonClient(g, service, commonNamespace)
+
+	// Write a .pyi stub next to the module when requested
+	if config.Python.Stubs {
+		generatePythonStub(gen, file, strings.TrimSuffix(filename, ".py")+".pyi", config)
 	}
 }
 
-func generatePythonMethodConstants(g *protogen.GeneratedFile, service *protogen.Service) {
+func generatePythonMethodConstants(g *protogen.GeneratedFile, service *protogen.Service, config *Config) {
 	serviceName := service.GoName
 
 	g.P("class ", serviceName, "Methods:")
@@ -207,24 +261,20 @@ func generatePythonMethodConstants(g *protogen.GeneratedFile, service *protogen.
 	}
 	g.P()
 
+	if !config.Features.Metadata {
+		return
+	}
+
 	// Generate method metadata dictionary
-	g.P("    METHOD_METADATA = {")
+	g.P("    METHOD_METADATA: Dict[str, Dict[str, Any]] = {")
 	for _, method := range service.Methods {
 		constName := serviceName + "_" + method.GoName
-		metadata := parseMethodMetadata(method.Comments)
+		metadata := parseMethodMetadata(method, config)
 		if metadata != nil {
 			g.P("        ", constName, ": {")
 
-			// Sort keys for consistent output
-			var keys []string
-			for key := range metadata {
-				keys = append(keys, key)
-			}
-			sort.Strings(keys)
-
-			for _, key := range keys {
-				value := metadata[key]
-				g.P("            \"", key, "\": \"", value, "\",")
+			for _, key := range sortedKeys(metadata) {
+				g.P("            ", pythonString(key), ": ", pythonMetadataLiteral(metadata[key]), ",")
 			}
 			g.P("        },")
 		}
@@ -233,11 +283,11 @@ func generatePythonMethodConstants(g *protogen.GeneratedFile, service *protogen.
 	g.P()
 }
 
-func generatePythonEnum(g *protogen.GeneratedFile, enum *protogen.Enum) {
+func generatePythonEnum(g *protogen.GeneratedFile, enum *protogen.Enum, config *Config) {
 	enumName := enum.GoIdent.GoName
 
 	// Parse puregen directive to determine enum type
-	directive := parsePuregenDirective(enum.Comments)
+	directive := parseEnumDirective(enum, config)
 	useStringConstants := true // Default to string constants
 	
 	if directive != nil && directive.EnumType == "int" {
@@ -246,6 +296,7 @@ func generatePythonEnum(g *protogen.GeneratedFile, enum *protogen.Enum) {
 
 	// Generate enum comment
 	writePythonComment(g, enum.Comments)
+	writePythonDeprecated(g, "", enum.Desc)
 
 	if useStringConstants {
 		// Generate string constants class
@@ -254,12 +305,13 @@ func generatePythonEnum(g *protogen.GeneratedFile, enum *protogen.Enum) {
 		
 		for _, value := range enum.Values {
 			valueName := strings.ToUpper(string(value.Desc.Name()))
-			g.P("    ", valueName, " = \"", value.Desc.Name(), "\"")
+			writePythonDeprecated(g, "    ", value.Desc)
+			g.P("    ", valueName, ": Final = \"", value.Desc.Name(), "\"")
 		}
 		g.P()
 
 		// Generate list of all values for validation
-		g.P("    VALUES = [")
+		g.P("    VALUES: Final[List[str]] = [")
 		for _, value := range enum.Values {
 			valueName := strings.ToUpper(string(value.Desc.Name()))
 			g.P("        ", valueName, ",")
@@ -273,6 +325,14 @@ func generatePythonEnum(g *protogen.GeneratedFile, enum *protogen.Enum) {
 		g.P("        \"\"\"Check if value is a valid ", enumName, "\"\"\"")
 		g.P("        return value in cls.VALUES")
 		g.P()
+		if hasEnumDisplayNames(enum) {
+			g.P("    @classmethod")
+			g.P("    def display_name(cls, value: str) -> str:")
+			g.P("        \"\"\"Display name of a ", enumName, " value, or the value itself\"\"\"")
+			writePythonDisplayNames(g, enum, false)
+			g.P("        }.get(value, value)")
+			g.P()
+		}
 	} else {
 		// Generate IntEnum class
 		g.P("class ", enumName, "(IntEnum):")
@@ -280,47 +340,108 @@ func generatePythonEnum(g *protogen.GeneratedFile, enum *protogen.Enum) {
 		
 		for _, value := range enum.Values {
 			valueName := strings.ToUpper(string(value.Desc.Name()))
+			if canonical := canonicalEnumValue(enum, value); canonical != value {
+				g.P("    # Alias of ", strings.ToUpper(string(canonical.Desc.Name())))
+			}
+			writePythonDeprecated(g, "    ", value.Desc)
 			g.P("    ", valueName, " = ", value.Desc.Number())
 		}
 		g.P()
 
+		// Names, aliases included, and unknown numbers are accepted as well
+		g.P("    @classmethod")
+		g.P("    def _missing_(cls, value: object) -> Optional['", enumName, "']:")
+		g.P("        \"\"\"Look up names, and keep numbers without a value as UNRECOGNIZED members\"\"\"")
+		g.P("        if isinstance(value, str):")
+		g.P("            return cls.__members__.get(value)")
+		g.P("        if isinstance(value, int):")
+		g.P("            member = int.__new__(cls, value)")
+		g.P("            member._name_ = \"UNRECOGNIZED\"")
+		g.P("            member._value_ = value")
+		g.P("            return member")
+		g.P("        return None")
+		g.P()
+
 		// Generate validation method
 		g.P("    @classmethod")
 		g.P("    def is_valid(cls, value: int) -> bool:")
 		g.P("        \"\"\"Check if value is a valid ", enumName, "\"\"\"")
 		g.P("        return value in [item.value for item in cls]")
 		g.P()
+		if hasEnumDisplayNames(enum) {
+			g.P("    def display_name(self) -> str:")
+			g.P("        \"\"\"Display name of the value, or its name if it has none\"\"\"")
+			writePythonDisplayNames(g, enum, true)
+			g.P("        }.get(self.name, self.name)")
+			g.P()
+		}
 	}
 
 	// Generate enum metadata if available
-	enumMetadata := parseEnumMetadata(enum.Comments)
-	if enumMetadata != nil {
+	enumMetadata := parseEnumMetadata(enum, config)
+	if enumMetadata != nil && config.Features.Metadata {
 		g.P("# Metadata for ", enumName)
-		g.P(enumName, "Metadata = {")
-		// Sort keys for consistent output
-		var keys []string
-		for key := range enumMetadata {
-			keys = append(keys, key)
+		g.P(enumName, "Metadata: Dict[str, Any] = {")
+		for _, key := range sortedKeys(enumMetadata) {
+			g.P("    ", pythonString(key), ": ", pythonMetadataLiteral(enumMetadata[key]), ",")
 		}
-		sort.Strings(keys)
+		g.P("}")
+		g.P()
+	}
 
-		for _, key := range keys {
-			value := enumMetadata[key]
-			g.P("    \"", key, "\": \"", value, "\",")
+	// Value metadata is keyed by the proto name of each value
+	if hasEnumValueMetadata(enum) && config.Features.Metadata {
+		g.P("# Metadata for each ", enumName, " value")
+		g.P(enumName, "ValueMetadata: Dict[str, Dict[str, Any]] = {")
+		for _, value := range enum.Values {
+			metadata := parseEnumValueMetadata(value)
+			if metadata == nil {
+				continue
+			}
+			g.P("    ", pythonString(string(value.Desc.Name())), ": {")
+			for _, key := range sortedKeys(metadata) {
+				g.P("        ", pythonString(key), ": ", pythonMetadataLiteral(metadata[key]), ",")
+			}
+			g.P("    },")
 		}
 		g.P("}")
 		g.P()
 	}
 }
 
-func generatePythonMessage(g *protogen.GeneratedFile, msg *protogen.Message) {
+// writePythonDisplayNames opens the dict literal mapping value names to display
+// names; the caller closes it. Integer enums skip aliases, whose members carry
+// the name of the value they alias.
+func writePythonDisplayNames(g *protogen.GeneratedFile, enum *protogen.Enum, skipAliases bool) {
+	g.P("        return {")
+	for _, value := range enum.Values {
+		if skipAliases && canonicalEnumValue(enum, value) != value {
+			continue
+		}
+		g.P("            ", pythonString(string(value.Desc.Name())), ": ", pythonString(enumDisplayName(enum, value)), ",")
+	}
+}
+
+// generatePythonMessageClass generates a message in the configured python_style
+func generatePythonMessageClass(g *protogen.GeneratedFile, file *protogen.File, msg *protogen.Message, config *Config) {
+	if config.Python.Style == pydanticPythonStyle {
+		generatePydanticMessage(g, file, msg, config)
+		return
+	}
+	generatePythonMessage(g, file, msg, config)
+}
+
+func generatePythonMessage(g *protogen.GeneratedFile, file *protogen.File, msg *protogen.Message, config *Config) {
 	// Generate message comment
 	writePythonComment(g, msg.Comments)
+	writePythonDeprecated(g, "", msg.Desc)
 
 	// Generate dataclass
 	g.P("@dataclass")
 	g.P("class ", msg.GoIdent.GoName, ":")
 	g.P("    \"\"\"Generated message class for ", msg.GoIdent.GoName, "\"\"\"")
+	writePythonFieldPaths(g, msg)
+	writePythonDescriptorAnnotation(g, msg, config)
 
 	// Generate fields with default values
 	if len(msg.Fields) == 0 {
@@ -333,21 +454,28 @@ func generatePythonMessage(g *protogen.GeneratedFile, msg *protogen.Message) {
 					g.P("    ", line)
 				}
 			}
+			writePythonDeprecated(g, "    ", field.Desc)
 
-			fieldType := getPythonFieldType(field)
-			fieldName := getPythonFieldName(field.GoName)
-			defaultValue := getPythonDefaultValue(field)
+			fieldType := getPythonFieldType(field, config)
+			fieldName := pythonFieldName(field, config)
+			defaultValue := getPythonDefaultValue(field, config)
 			g.P("    ", fieldName, ": ", fieldType, " = ", defaultValue)
 		}
 	}
 	g.P()
 
 	// Generate validation method
-	g.P("    def validate(self) -> bool:")
-	g.P("        \"\"\"Validate the message fields\"\"\"")
-	g.P("        # Add custom validation logic here")
-	g.P("        return True")
-	g.P()
+	if config.Features.Validation {
+		g.P("    def validate(self) -> bool:")
+		g.P("        \"\"\"Validate the message fields\"\"\"")
+		g.P("        # Add custom validation logic here")
+		g.P("        return True")
+		g.P()
+	}
+
+	writePythonDeprecationWarnings(g, file, msg, config)
+	writePythonMaskMethods(g, msg, config)
+	writePythonRedactMethods(g, file, msg, config)
 
 	// Generate JSON serialization methods
 	g.P("    def to_json(self) -> str:")
@@ -357,19 +485,43 @@ func generatePythonMessage(g *protogen.GeneratedFile, msg *protogen.Message) {
 
 	g.P("    def to_dict(self) -> Dict[str, Any]:")
 	g.P("        \"\"\"Convert message to dictionary\"\"\"")
-	g.P("        result = {}")
+	g.P("        result: Dict[str, Any] = {}")
 	for _, field := range msg.Fields {
-		fieldName := getPythonFieldName(field.GoName)
-		jsonName := field.Desc.JSONName()
-		g.P("        if self.", fieldName, " is not None:")
-		if field.Desc.IsList() {
-			if field.Message != nil {
+		fieldName := pythonFieldName(field, config)
+		jsonName := jsonFieldName(field, config)
+		if fieldJSONConfig(field, config).OmitEmpty {
+			g.P("        if self.", fieldName, ":")
+		} else {
+			g.P("        if self.", fieldName, " is not None:")
+		}
+		if field.Desc.IsMap() {
+			if valueField := field.Message.Fields[1]; isFieldMaskMessage(valueField.Message) {
+				g.P("            result['", jsonName, "'] = {key: value.to_json_string() for key, value in self.", fieldName, ".items()}")
+			} else if valueField.Message != nil {
+				g.P("            result['", jsonName, "'] = {key: value.to_dict() for key, value in self.", fieldName, ".items()}")
+			} else if field.Message.Fields[1].Desc.Kind().String() == "bytes" {
+				g.P("            result['", jsonName, "'] = {key: base64.b64encode(value).decode('ascii') for key, value in self.", fieldName, ".items()}")
+			} else {
+				g.P("            result['", jsonName, "'] = dict(self.", fieldName, ")")
+			}
+		} else if field.Desc.IsList() {
+			if isFieldMaskMessage(field.Message) {
+				g.P("            result['", jsonName, "'] = [item.to_json_string() for item in self.", fieldName, "]")
+			} else if field.Message != nil {
 				g.P("            result['", jsonName, "'] = [item.to_dict() if hasattr(item, 'to_dict') else item for item in self.", fieldName, "]")
+			} else if field.Desc.Kind().String() == "bytes" {
+				g.P("            result['", jsonName, "'] = [base64.b64encode(item).decode('ascii') for item in self.", fieldName, "]")
 			} else {
 				g.P("            result['", jsonName, "'] = self.", fieldName)
 			}
+		} else if isFieldMaskMessage(field.Message) {
+			// Field masks are strings in JSON
+			g.P("            result['", jsonName, "'] = self.", fieldName, ".to_json_string()")
 		} else if field.Message != nil {
 			g.P("            result['", jsonName, "'] = self.", fieldName, ".to_dict() if hasattr(self.", fieldName, ", 'to_dict') else self.", fieldName)
+		} else if field.Desc.Kind().String() == "bytes" {
+			// Bytes are base64 encoded in JSON, as in the Go and Java output
+			g.P("            result['", jsonName, "'] = base64.b64encode(self.", fieldName, ").decode('ascii')")
 		} else {
 			g.P("            result['", jsonName, "'] = self.", fieldName)
 		}
@@ -378,30 +530,62 @@ func generatePythonMessage(g *protogen.GeneratedFile, msg *protogen.Message) {
 	g.P()
 
 	g.P("    @classmethod")
-	g.P("    def from_json(cls, json_str: str) -> '", msg.GoIdent.GoName, "':")
+	g.P("    def from_json(cls, json_str: str) -> Self:")
 	g.P("        \"\"\"Create message from JSON string\"\"\"")
 	g.P("        data = json.loads(json_str)")
 	g.P("        return cls.from_dict(data)")
 	g.P()
 
 	g.P("    @classmethod")
-	g.P("    def from_dict(cls, data: Dict[str, Any]) -> '", msg.GoIdent.GoName, "':")
+	g.P("    def from_dict(cls, data: Dict[str, Any]) -> Self:")
 	g.P("        \"\"\"Create message from dictionary\"\"\"")
-	g.P("        kwargs = {}")
+	g.P("        kwargs: Dict[str, Any] = {}")
+	writePythonJSONAliases(g, msg, config)
 	for _, field := range msg.Fields {
-		fieldName := getPythonFieldName(field.GoName)
-		jsonName := field.Desc.JSONName()
-		if field.Desc.IsList() {
+		fieldName := pythonFieldName(field, config)
+		jsonName := jsonFieldName(field, config)
+		if field.Desc.IsMap() {
+			// JSON object keys are strings, so integer keys are converted back
+			key := "key"
+			if getPythonElementType(field.Message.Fields[0], config) == "int" {
+				key = "int(key)"
+			}
+			g.P("        if '", jsonName, "' in data:")
+			if valueField := field.Message.Fields[1]; valueField.Message != nil {
+				g.P("            kwargs['", fieldName, "'] = {", key, ": ", pythonMessageFromJSON(valueField.Message, "value"), " for key, value in data['", jsonName, "'].items()}")
+			} else if enumName := pythonIntEnumName(valueField, file, config); enumName != "" {
+				g.P("            kwargs['", fieldName, "'] = {", key, ": ", enumName, "(value) for key, value in data['", jsonName, "'].items()}")
+			} else if valueField.Desc.Kind().String() == "bytes" {
+				g.P("            kwargs['", fieldName, "'] = {", key, ": base64.b64decode(value) if isinstance(value, str) else value for key, value in data['", jsonName, "'].items()}")
+			} else if key != "key" {
+				g.P("            kwargs['", fieldName, "'] = {", key, ": value for key, value in data['", jsonName, "'].items()}")
+			} else {
+				g.P("            kwargs['", fieldName, "'] = dict(data['", jsonName, "'])")
+			}
+		} else if field.Desc.IsList() {
 			if field.Message != nil {
 				g.P("        if '", jsonName, "' in data:")
-				g.P("            kwargs['", fieldName, "'] = [", field.Message.GoIdent.GoName, ".from_dict(item) if isinstance(item, dict) else item for item in data['", jsonName, "']]")
+				g.P("            kwargs['", fieldName, "'] = [", pythonMessageFromJSON(field.Message, "item"), " for item in data['", jsonName, "']]")
+			} else if field.Desc.Kind().String() == "bytes" {
+				g.P("        if '", jsonName, "' in data:")
+				g.P("            kwargs['", fieldName, "'] = [base64.b64decode(item) if isinstance(item, str) else item for item in data['", jsonName, "']]")
+			} else if enumName := pythonIntEnumName(field, file, config); enumName != "" {
+				g.P("        if '", jsonName, "' in data:")
+				g.P("            kwargs['", fieldName, "'] = [", enumName, "(item) for item in data['", jsonName, "']]")
 			} else {
 				g.P("        if '", jsonName, "' in data:")
 				g.P("            kwargs['", fieldName, "'] = data['", jsonName, "']")
 			}
 		} else if field.Message != nil {
 			g.P("        if '", jsonName, "' in data:")
-			g.P("            kwargs['", fieldName, "'] = ", field.Message.GoIdent.GoName, ".from_dict(data['", jsonName, "']) if isinstance(data['", jsonName, "'], dict) else data['", jsonName, "']")
+			g.P("            kwargs['", fieldName, "'] = ", pythonMessageFromJSON(field.Message, "data['"+jsonName+"']"))
+		} else if field.Desc.Kind().String() == "bytes" {
+			g.P("        if '", jsonName, "' in data:")
+			g.P("            kwargs['", fieldName, "'] = base64.b64decode(data['", jsonName, "']) if isinstance(data['", jsonName, "'], str) else data['", jsonName, "']")
+		} else if enumName := pythonIntEnumName(field, file, config); enumName != "" {
+			// Names and unknown numbers both become members
+			g.P("        if data.get('", jsonName, "') is not None:")
+			g.P("            kwargs['", fieldName, "'] = ", enumName, "(data['", jsonName, "'])")
 		} else {
 			g.P("        if '", jsonName, "' in data:")
 			g.P("            kwargs['", fieldName, "'] = data['", jsonName, "']")
@@ -412,24 +596,35 @@ func generatePythonMessage(g *protogen.GeneratedFile, msg *protogen.Message) {
 
 	// Generate nested messages
 	for _, nested := range msg.Messages {
-		generatePythonMessage(g, nested)
+		generatePythonMessage(g, file, nested, config)
+	}
+
+	generatePythonMessageMetadata(g, msg, config)
+}
+
+// pythonMessageFromJSON returns an expression creating a message of msg's type
+// from v, its JSON value. Field masks are strings in JSON; values that are
+// already messages are kept.
+func pythonMessageFromJSON(msg *protogen.Message, v string) string {
+	if isFieldMaskMessage(msg) {
+		return "PuregenFieldMask.from_json_string(" + v + ") if isinstance(" + v + ", str) else " + v
+	}
+	return messageTypeName(msg) + ".from_dict(" + v + ") if isinstance(" + v + ", dict) else " + v
+}
+
+// generatePythonMessageMetadata writes the metadata map and field constants of a message
+func generatePythonMessageMetadata(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	if !config.Features.Metadata {
+		return
 	}
 
 	// Generate message metadata if available
-	messageMetadata := parseMessageMetadata(msg.Comments)
+	messageMetadata := parseMessageMetadata(msg, config)
 	if messageMetadata != nil {
 		g.P("# Metadata for ", msg.GoIdent.GoName)
-		g.P(msg.GoIdent.GoName, "Metadata = {")
-		// Sort keys for consistent output
-		var keys []string
-		for key := range messageMetadata {
-			keys = append(keys, key)
-		}
-		sort.Strings(keys)
-
-		for _, key := range keys {
-			value := messageMetadata[key]
-			g.P("    \"", key, "\": \"", value, "\",")
+		g.P(msg.GoIdent.GoName, "Metadata: Dict[str, Any] = {")
+		for _, key := range sortedKeys(messageMetadata) {
+			g.P("    ", pythonString(key), ": ", pythonMetadataLiteral(messageMetadata[key]), ",")
 		}
 		g.P("}")
 		g.P()
@@ -438,7 +633,7 @@ func generatePythonMessage(g *protogen.GeneratedFile, msg *protogen.Message) {
 	// Generate field constants and metadata if any fields have metadata
 	hasFieldMetadata := false
 	for _, field := range msg.Fields {
-		fieldMetadata := parseFieldMetadata(field.Comments)
+		fieldMetadata := parseFieldMetadata(field, config)
 		if fieldMetadata != nil {
 			hasFieldMetadata = true
 			break
@@ -449,7 +644,7 @@ func generatePythonMessage(g *protogen.GeneratedFile, msg *protogen.Message) {
 		// Generate field constants
 		g.P("# Field name constants for ", msg.GoIdent.GoName)
 		for _, field := range msg.Fields {
-			fieldMetadata := parseFieldMetadata(field.Comments)
+			fieldMetadata := parseFieldMetadata(field, config)
 			if fieldMetadata != nil {
 				constName := msg.GoIdent.GoName + "_" + field.GoName + "_FIELD"
 				constValue := msg.GoIdent.GoName + "_" + field.GoName
@@ -460,23 +655,15 @@ func generatePythonMessage(g *protogen.GeneratedFile, msg *protogen.Message) {
 
 		// Generate MessageField metadata map
 		g.P("# MessageField metadata for ", msg.GoIdent.GoName)
-		g.P(msg.GoIdent.GoName, "FieldMetadata = {")
+		g.P(msg.GoIdent.GoName, "FieldMetadata: Dict[str, Dict[str, Any]] = {")
 		for _, field := range msg.Fields {
-			fieldMetadata := parseFieldMetadata(field.Comments)
+			fieldMetadata := parseFieldMetadata(field, config)
 			if fieldMetadata != nil {
 				constName := msg.GoIdent.GoName + "_" + field.GoName + "_FIELD"
 				g.P("    ", constName, ": {")
 
-				// Sort keys for consistent output
-				var keys []string
-				for key := range fieldMetadata {
-					keys = append(keys, key)
-				}
-				sort.Strings(keys)
-
-				for _, key := range keys {
-					value := fieldMetadata[key]
-					g.P("        \"", key, "\": \"", value, "\",")
+				for _, key := range sortedKeys(fieldMetadata) {
+					g.P("        ", pythonString(key), ": ", pythonMetadataLiteral(fieldMetadata[key]), ",")
 				}
 				g.P("    },")
 			}
@@ -486,11 +673,18 @@ func generatePythonMessage(g *protogen.GeneratedFile, msg *protogen.Message) {
 	}
 }
 
-func generatePythonService(g *protogen.GeneratedFile, service *protogen.Service) {
+func generatePythonService(g *protogen.GeneratedFile, service *protogen.Service, config *Config) {
 	serviceName := service.GoName
 
+	// Service methods are coroutines when async services are requested
+	def := "def "
+	if config.Python.AsyncServices {
+		def = "async def "
+	}
+
 	// Generate service comment
 	writePythonComment(g, service.Comments)
+	writePythonDeprecated(g, "", service.Desc)
 
 	// Generate abstract service interface
 	g.P("class ", serviceName, "Service(ABC):")
@@ -504,13 +698,14 @@ func generatePythonService(g *protogen.GeneratedFile, service *protogen.Service)
 				g.P("    ", line)
 			}
 		}
+		writePythonDeprecated(g, "    ", method.Desc)
 
 		inputType := method.Input.GoIdent.GoName
 		outputType := method.Output.GoIdent.GoName
 		methodName := getPythonMethodName(method.GoName)
 
 		g.P("    @abstractmethod")
-		g.P("    def ", methodName, "(self, ctx: Dict[str, Any], request: ", inputType, ") -> ", outputType, ":")
+		g.P("    ", def, methodName, "(self, ctx: Dict[str, Any], request: ", inputType, ") -> ", outputType, ":")
 		g.P("        \"\"\"", method.GoName, " method\"\"\"")
 		g.P("        pass")
 		g.P()
@@ -528,12 +723,13 @@ func generatePythonService(g *protogen.GeneratedFile, service *protogen.Service)
 				g.P("    ", line)
 			}
 		}
+		writePythonDeprecated(g, "    ", method.Desc)
 
 		inputType := method.Input.GoIdent.GoName
 		outputType := method.Output.GoIdent.GoName
 		methodName := getPythonMethodName(method.GoName)
 
-		g.P("    def ", methodName, "(self, ctx: Dict[str, Any], request: ", inputType, ") -> ", outputType, ":")
+		g.P("    ", def, methodName, "(self, ctx: Dict[str, Any], request: ", inputType, ") -> ", outputType, ":")
 		g.P("        \"\"\"", method.GoName, " method implementation\"\"\"")
 		g.P("        # TODO: Implement ", methodName)
 		g.P("        raise NotImplementedError(\"Method ", methodName, " not implemented\")")
@@ -541,17 +737,35 @@ func generatePythonService(g *protogen.GeneratedFile, service *protogen.Service)
 	}
 }
 
-func generatePythonClient(g *protogen.GeneratedFile, service *protogen.Service, commonNamespace string) {
+// generatePythonClient generates the synchronous and asyncio clients of a service
+func generatePythonClient(g *protogen.GeneratedFile, service *protogen.Service, config *Config) {
+	generatePythonClientClass(g, service, config, false)
+	generatePythonClientClass(g, service, config, true)
+}
+
+func generatePythonClientClass(g *protogen.GeneratedFile, service *protogen.Service, config *Config, async bool) {
 	serviceName := service.GoName
 
-	// No need to generate inline Transport interface anymore since we have per-package transport
-	// when no global namespace is provided
+	className := serviceName + "Client"
+	transportType := "PuregenTransport"
+	def := "def "
+	send := "self.transport.send("
+	if async {
+		className = "Async" + className
+		transportType = "AsyncPuregenTransport"
+		def = "async def "
+		send = "await self.transport.send("
+	}
 
 	// Generate client class
-	g.P("class ", serviceName, "Client:")
-	g.P("    \"\"\"Client for ", serviceName, " service\"\"\"")
+	g.P("class ", className, ":")
+	if async {
+		g.P("    \"\"\"Asyncio client for ", serviceName, " service\"\"\"")
+	} else {
+		g.P("    \"\"\"Client for ", serviceName, " service\"\"\"")
+	}
 	g.P()
-	g.P("    def __init__(self, transport: PuregenTransport):")
+	g.P("    def __init__(self, transport: ", transportType, ") -> None:")
 	g.P("        self.transport = transport")
 	g.P()
 
@@ -562,12 +776,17 @@ func generatePythonClient(g *protogen.GeneratedFile, service *protogen.Service,
 		methodName := getPythonMethodName(method.GoName)
 		constName := serviceName + "Methods." + serviceName + "_" + method.GoName
 
-		g.P("    def ", methodName, "(self, ctx: Dict[str, Any], request: ", inputType, ") -> ", outputType, ":")
+		g.P("    ", def, methodName, "(self, ctx: Dict[str, Any], request: ", inputType, ") -> ", outputType, ":")
 		g.P("        \"\"\"", method.GoName, " client method\"\"\"")
+		if isDeprecated(method.Desc) {
+			g.P("        warnings.warn(", pythonString(pythonDeprecationWarning(method.Desc)), ", DeprecationWarning, stacklevel=2)")
+		}
 		g.P("        enhanced_ctx = ctx.copy() if ctx else {}")
-		g.P("        method_metadata = ", serviceName, "Methods.METHOD_METADATA.get(", constName, ", {})")
-		g.P("        enhanced_ctx['method_metadata'] = method_metadata")
-		g.P("        result = self.transport.send(enhanced_ctx, ", constName, ", request, ", outputType, ")")
+		if config.Features.Metadata {
+			g.P("        method_metadata = ", serviceName, "Methods.METHOD_METADATA.get(", constName, ", {})")
+			g.P("        enhanced_ctx['method_metadata'] = method_metadata")
+		}
+		g.P("        result = ", send, "enhanced_ctx, ", constName, ", request, ", outputType, ")")
 		g.P("        if isinstance(result, ", outputType, "):")
 		g.P("            return result")
 		g.P("        if isinstance(result, dict):")
@@ -577,44 +796,139 @@ func generatePythonClient(g *protogen.GeneratedFile, service *protogen.Service,
 	}
 }
 
-// createPythonPackageStructure creates directories and __init__.py files for the package hierarchy
-func createPythonPackageStructure(gen *protogen.Plugin, moduleName string) {
-	// For single level package, create __init__.py in the module directory
-	if !strings.Contains(moduleName, ".") {
-		initFile := moduleName + "/__init__.py"
-		if !createdPythonPackages[initFile] {
-			// Check if __init__.py already exists, if so, ignore it
-			if fileExists(gen, initFile) {
-				createdPythonPackages[initFile] = true
-				return
-			}
-			initGen := gen.NewGeneratedFile(initFile, "")
-			initGen.P("# Package initialization file")
-			initGen.P("# Generated by protoc-gen-puregen")
-			createdPythonPackages[initFile] = true
-		}
+// createPythonPackageStructure creates the package __init__.py, which re-exports
+// the messages, enums and clients of every file in the package, and a py.typed marker
+func createPythonPackageStructure(gen *protogen.Plugin, moduleName string, config *Config) {
+	packageDir := outputPath(config.Output.Python, strings.ReplaceAll(moduleName, ".", "/"))
+	initFile := packageDir + "/__init__.py"
+	if !config.state.createOnce(initFile) {
 		return
 	}
 
-	// For multi-level package, only create __init__.py in the final directory
-	parts := strings.Split(moduleName, ".")
-	finalPath := strings.Join(parts, "/")
-	initFile := finalPath + "/__init__.py"
+	// Check if __init__.py already exists, if so, ignore it
+	if fileExists(gen, initFile) {
+		return
+	}
 
-	if !createdPythonPackages[initFile] {
-		// Check if __init__.py already exists, if so, ignore it
-		if fileExists(gen, initFile) {
-			createdPythonPackages[initFile] = true
-			return
+	initGen := gen.NewGeneratedFile(initFile, "")
+	initGen.P("# Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	initGen.P("# Package initialization file")
+	initGen.P()
+
+	files := collectPythonPackageFiles(gen, moduleName, config)
+	hasServices, hasDescriptors, hasRegistry, hasFieldMask := false, false, false, false
+	for _, file := range files {
+		hasServices = hasServices || len(file.Services) > 0
+		hasDescriptors = hasDescriptors || hasPythonDescriptors(file, config)
+		hasRegistry = hasRegistry || hasTypeRegistry(file, config)
+		hasFieldMask = hasFieldMask || hasPythonFieldMask(file)
+	}
+
+	// Re-export the per-package transport alongside the clients that need it
+	var exports []string
+	if hasServices && config.Features.Clients && config.CommonNamespace == "" {
+		initGen.P("from .puregen_transport import PuregenTransport, AsyncPuregenTransport")
+		exports = append(exports, "PuregenTransport", "AsyncPuregenTransport")
+	}
+	if hasDescriptors {
+		names := []string{"PuregenFieldDescriptor", "PuregenMessageDescriptor", "find_message_descriptor", "message_descriptors"}
+		initGen.P("from .puregen_descriptors import ", strings.Join(names, ", "))
+		exports = append(exports, names...)
+	}
+	if hasRegistry {
+		initGen.P("from .puregen_registry import ", strings.Join(pythonRegistryExports, ", "))
+		exports = append(exports, pythonRegistryExports...)
+	}
+	if hasFieldMask {
+		initGen.P("from .puregen_fieldmask import PuregenFieldMask")
+		exports = append(exports, "PuregenFieldMask")
+	}
+	for _, file := range files {
+		names := pythonModuleExports(file, config)
+		if len(names) == 0 {
+			continue
 		}
-		initGen := gen.NewGeneratedFile(initFile, "")
-		initGen.P("# Package initialization file")
-		initGen.P("# Generated by protoc-gen-puregen")
-		createdPythonPackages[initFile] = true
+		baseFilename := strings.TrimSuffix(filepath.Base(file.Desc.Path()), ".proto")
+		initGen.P("from .", baseFilename, " import (")
+		for _, name := range names {
+			initGen.P("    ", name, ",")
+		}
+		initGen.P(")")
+		exports = append(exports, names...)
+	}
+	if len(exports) > 0 {
+		initGen.P()
+		initGen.P("__all__ = [")
+		for _, name := range exports {
+			initGen.P("    ", pythonString(name), ",")
+		}
+		initGen.P("]")
 	}
+
+	createPythonTypedMarker(gen, packageDir, config)
 }
 
-func getPythonModuleName(file *protogen.File) string {
+// collectPythonPackageFiles returns the generated files whose modules live in
+// the given Python package, ordered by path
+func collectPythonPackageFiles(gen *protogen.Plugin, moduleName string, config *Config) []*protogen.File {
+	var files []*protogen.File
+	for _, file := range gen.Files {
+		if !file.Generate || len(file.Messages) == 0 && len(file.Services) == 0 {
+			continue
+		}
+		if getPythonModuleName(file, config) == moduleName {
+			files = append(files, file)
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Desc.Path() < files[j].Desc.Path()
+	})
+	return files
+}
+
+// pythonModuleExports returns the public names a generated module defines:
+// its enums, messages (including nested ones) and clients
+func pythonModuleExports(file *protogen.File, config *Config) []string {
+	var names []string
+	for _, enum := range collectAllEnums(file) {
+		names = append(names, enum.GoIdent.GoName)
+	}
+	var addMessages func(messages []*protogen.Message)
+	addMessages = func(messages []*protogen.Message) {
+		for _, msg := range messages {
+			if msg.Desc.IsMapEntry() {
+				continue
+			}
+			names = append(names, msg.GoIdent.GoName)
+			addMessages(msg.Messages)
+		}
+	}
+	addMessages(file.Messages)
+	if config.Features.Clients {
+		for _, service := range file.Services {
+			names = append(names, service.GoName+"Client", "Async"+service.GoName+"Client")
+		}
+	}
+	return names
+}
+
+// createPythonTypedMarker writes the PEP 561 py.typed marker into a package directory
+func createPythonTypedMarker(gen *protogen.Plugin, packageDir string, config *Config) {
+	markerFile := packageDir + "/py.typed"
+	if !config.state.createOnce(markerFile) {
+		return
+	}
+	gen.NewGeneratedFile(markerFile, "")
+}
+
+func getPythonModuleName(file *protogen.File, config *Config) string {
+	// With paths=source_relative the module lives next to the .proto file.
+	// Files at the source root keep the package layout, since a Python
+	// package needs a directory.
+	if dir := sourceRelativeDir(file.Desc.Path()); config.Paths == sourceRelativePaths && dir != "" {
+		return strings.ReplaceAll(dir, "-", "_")
+	}
+
 	// Convert proto package to Python module name
 	pkg := string(file.Desc.Package())
 	if pkg == "" {
@@ -631,7 +945,11 @@ func getPythonModuleName(file *protogen.File) string {
 	return pkg
 }
 
-func getPythonImportModuleName(file *protogen.File) string {
+func getPythonImportModuleName(file *protogen.File, config *Config) string {
+	if dir := sourceRelativeDir(file.Desc.Path()); config.Paths == sourceRelativePaths && dir != "" {
+		return strings.ReplaceAll(strings.ReplaceAll(dir, "-", "_"), "/", ".")
+	}
+
 	// Convert proto package to Python import module name (with dots)
 	pkg := string(file.Desc.Package())
 	if pkg == "" {
@@ -645,49 +963,148 @@ func getPythonImportModuleName(file *protogen.File) string {
 	return pkg
 }
 
-func getPythonFieldType(field *protogen.Field) string {
-	baseType := ""
+func getPythonFieldType(field *protogen.Field, config *Config) string {
+	if field.Desc.IsMap() {
+		keyType := getPythonElementType(field.Message.Fields[0], config)
+		valueType := getPythonElementType(field.Message.Fields[1], config)
+		return "Dict[" + keyType + ", " + valueType + "]"
+	}
+
+	baseType := getPythonElementType(field, config)
+	if field.Desc.IsList() {
+		return "List[" + baseType + "]"
+	}
+
+	// Messages and proto3 optional fields may be unset
+	if field.Desc.Kind().String() == "message" || field.Desc.HasOptionalKeyword() {
+		return "Optional[" + baseType + "]"
+	}
+
+	return baseType
+}
+
+// getPythonElementType returns the type of a single field value, ignoring
+// repeated and optional
+func getPythonElementType(field *protogen.Field, config *Config) string {
 	switch field.Desc.Kind().String() {
 	case "bool":
-		baseType = "bool"
+		return "bool"
 	case "int32", "sint32", "sfixed32", "int64", "sint64", "sfixed64",
 		"uint32", "fixed32", "uint64", "fixed64":
-		baseType = "int"
+		return "int"
 	case "float", "double":
-		baseType = "float"
+		return "float"
 	case "string":
-		baseType = "str"
+		return "str"
 	case "bytes":
-		baseType = "bytes"
+		return "bytes"
 	case "enum":
-		// Check if enum is using string constants
-		directive := parsePuregenDirective(field.Enum.Comments)
-		if directive == nil || directive.EnumType != "int" {
-			// Default to string constants
-			baseType = "str"
-		} else {
-			// Use integer enum type
-			baseType = "int"
+		if isStringEnum(field.Enum, config) {
+			// String enums are typed as the literal set of their names
+			return pythonEnumLiteral(field.Enum)
 		}
+		// Use integer enum type
+		return "int"
 	case "message":
-		baseType = field.Message.GoIdent.GoName
+		// Quoted so messages can reference ones declared later in the module
+		return "'" + messageTypeName(field.Message) + "'"
 	default:
-		baseType = "Any"
+		return "Any"
 	}
+}
 
-	if field.Desc.IsList() {
-		if field.Desc.Kind().String() == "message" {
-			return "List['" + baseType + "']"
+// pythonIntEnumName returns the IntEnum class of a field whose values are an
+// int enum declared in file, or "" for other fields
+func pythonIntEnumName(field *protogen.Field, file *protogen.File, config *Config) string {
+	if field.Enum == nil || isStringEnum(field.Enum, config) || field.Enum.Desc.ParentFile().Path() != file.Desc.Path() {
+		return ""
+	}
+	return field.Enum.GoIdent.GoName
+}
+
+// pythonEnumLiteral returns the Literal type of a string enum's value names
+func pythonEnumLiteral(enum *protogen.Enum) string {
+	names := make([]string, len(enum.Values))
+	for i, value := range enum.Values {
+		names[i] = pythonString(string(value.Desc.Name()))
+	}
+	return "Literal[" + strings.Join(names, ", ") + "]"
+}
+
+// writePythonImports writes the imports shared by a generated module and its stub
+func writePythonImports(gen *protogen.Plugin, g *protogen.GeneratedFile, file *protogen.File, config *Config) {
+	g.P("from dataclasses import dataclass, field")
+	g.P("from typing import Optional, List, Dict, Any, ClassVar, Final, Literal, Union")
+	g.P("from abc import ABC, abstractmethod")
+	g.P("import base64")
+	g.P("import copy")
+	g.P("import json")
+	g.P("import sys")
+	g.P("import warnings")
+
+	// Check if we need IntEnum import
+	enumsForImport := collectAllEnums(file)
+	needsIntEnum := false
+	for _, enum := range enumsForImport {
+		if !isStringEnum(enum, config) {
+			needsIntEnum = true
+			break
+		}
+	}
+	if needsIntEnum {
+		g.P("from enum import IntEnum")
+	}
+	if config.Python.Style == pydanticPythonStyle {
+		g.P("import re")
+		g.P("from pydantic import AliasChoices, BaseModel, ConfigDict, Field, field_serializer, field_validator")
+	}
+
+	// Import transport interface
+	if len(file.Services) > 0 && config.Features.Clients {
+		if config.CommonNamespace != "" {
+			// Import global transport if namespace is provided
+			g.P("from ", config.CommonNamespace, " import PuregenTransport, AsyncPuregenTransport")
 		} else {
-			return "List[" + baseType + "]"
+			// For per-package transport, import from the transport module in the same package
+			g.P("from .puregen_transport import PuregenTransport, AsyncPuregenTransport")
 		}
 	}
 
-	if field.Desc.Kind().String() == "message" {
-		return "Optional['" + baseType + "']"
+	if hasPythonDescriptors(file, config) {
+		g.P("from .puregen_descriptors import PuregenFieldDescriptor, PuregenMessageDescriptor, register_message_descriptors")
+	}
+	if hasTypeRegistry(file, config) {
+		g.P("from .puregen_registry import PuregenEnvelope, register_message_types")
+	}
+	if hasPythonFieldMask(file) {
+		g.P("from .puregen_fieldmask import PuregenFieldMask")
 	}
 
-	return baseType
+	// Collect and generate imports for same package messages
+	samePackageMessages := collectSamePackageMessages(file)
+	if len(samePackageMessages) > 0 {
+		for _, message := range samePackageMessages {
+			// Get the module name for the message (based on its file)
+			msgFilename := strings.TrimSuffix(filepath.Base(message.Desc.ParentFile().Path()), ".proto")
+			// Use full package path with dots for import
+			packagePath := getPythonImportModuleName(file, config)
+			if msgFile, ok := gen.FilesByPath[message.Desc.ParentFile().Path()]; ok {
+				packagePath = getPythonImportModuleName(msgFile, config)
+			}
+			g.P("from ", packagePath, ".", msgFilename, " import ", message.GoIdent.GoName)
+		}
+	}
+	g.P()
+	writePythonSelfImport(g)
+}
+
+// writePythonSelfImport imports typing.Self, from typing_extensions before Python 3.11
+func writePythonSelfImport(g *protogen.GeneratedFile) {
+	g.P("if sys.version_info >= (3, 11):")
+	g.P("    from typing import Self")
+	g.P("else:")
+	g.P("    from typing_extensions import Self")
+	g.P()
 }
 
 func getPythonFieldName(goName string) string {
@@ -718,13 +1135,21 @@ func getPythonFieldName(goName string) string {
 	return strings.ToLower(result.String())
 }
 
+// pythonFieldName returns the Python attribute name for a proto field under the configured naming
+func pythonFieldName(field *protogen.Field, config *Config) string {
+	if config.Naming.Fields == protoFieldNaming {
+		return string(field.Desc.Name())
+	}
+	return getPythonFieldName(field.GoName)
+}
+
 func getPythonMethodName(goName string) string {
 	return getPythonFieldName(goName)
 }
 
-func getPythonDefaultValue(field *protogen.Field) string {
+func getPythonDefaultValue(field *protogen.Field, config *Config) string {
 	// First check for puregen value directive
-	directive := parseFieldDirective(field.Comments)
+	directive := parseFieldDirective(field, config)
 	if directive != nil && directive.Value != "" {
 		// Convert the value to Python syntax based on field type
 		switch field.Desc.Kind().String() {
@@ -747,18 +1172,43 @@ func getPythonDefaultValue(field *protogen.Field) string {
 			if _, err := strconv.ParseFloat(directive.Value, 64); err == nil {
 				return directive.Value
 			}
+		case "enum":
+			if value := field.Enum.Desc.Values().ByName(protoreflect.Name(directive.Value)); value != nil {
+				if isStringEnum(field.Enum, config) {
+					return pythonString(directive.Value)
+				}
+				return strconv.Itoa(int(value.Number()))
+			}
 		}
 	}
 	
 	// Special case: check for empty string directive
-	if directive != nil && directive.Value == "" && field.Desc.Kind().String() == "string" {
+	if directive != nil && directive.Value == "" && field.Desc.Kind().String() == "string" && !field.Desc.IsList() {
 		return `""`
 	}
 
 	// Fall back to standard default values
+	if field.Desc.IsMap() {
+		return "field(default_factory=dict)"
+	}
 	if field.Desc.IsList() {
 		return "field(default_factory=list)"
 	}
+	return pythonZeroValue(field, config)
+}
+
+// pythonZeroValue returns the zero value of a field, which it holds when it is
+// not set, whatever its default
+func pythonZeroValue(field *protogen.Field, config *Config) string {
+	if field.Desc.IsMap() {
+		return "{}"
+	}
+	if field.Desc.IsList() {
+		return "[]"
+	}
+	if field.Desc.HasOptionalKeyword() {
+		return "None"
+	}
 
 	switch field.Desc.Kind().String() {
 	case "bool":
@@ -773,6 +1223,10 @@ func getPythonDefaultValue(field *protogen.Field) string {
 	case "bytes":
 		return "b''"
 	case "enum":
+		// String enums default to the name of their zero value
+		if isStringEnum(field.Enum, config) {
+			return pythonString(string(field.Enum.Values[0].Desc.Name()))
+		}
 		return "0"
 	case "message":
 		return "None"
@@ -781,26 +1235,9 @@ func getPythonDefaultValue(field *protogen.Field) string {
 	}
 }
 
-// Track created transport namespaces to avoid duplicates
-var createdTransportNamespaces = make(map[string]bool)
-
-// Track created per-package transports to avoid duplicates for Python
-var createdPackageTransportsPython = make(map[string]bool)
-
 // generatePackageTransportPython creates a Transport class in the same package as the proto file
-func generatePackageTransportPython(gen *protogen.Plugin, file *protogen.File) {
-	// Use the module name as the key to avoid duplicates
-	moduleName := getPythonModuleName(file)
-	packageKey := moduleName
-	
-	// Only create once per package
-	if createdPackageTransportsPython[packageKey] {
-		return
-	}
-	createdPackageTransportsPython[packageKey] = true
-
-	// Create package directories with __init__.py files
-	createPythonPackageStructure(gen, moduleName)
+func generatePackageTransportPython(gen *protogen.Plugin, file *protogen.File, config *Config) {
+	moduleName := getPythonModuleName(file, config)
 
 	// Create the transport module file in the package directory
 	var filename string
@@ -809,6 +1246,16 @@ func generatePackageTransportPython(gen *protogen.Plugin, file *protogen.File) {
 	} else {
 		filename = "puregen_transport.py"
 	}
+	filename = outputPath(config.Output.Python, filename)
+
+	// Only create once per output file: with paths=source_relative, protos of
+	// several packages can share a directory
+	if !config.state.createOnce(filename) {
+		return
+	}
+
+	// Create package directories with __init__.py files
+	createPythonPackageStructure(gen, moduleName, config)
 
 	g := gen.NewGeneratedFile(filename, "")
 
@@ -817,33 +1264,25 @@ func generatePackageTransportPython(gen *protogen.Plugin, file *protogen.File) {
 	g.P("# Package Transport interface")
 	g.P()
 	g.P("from abc import ABC, abstractmethod")
-	g.P("from typing import Dict, Any")
+	g.P("from typing import Dict, Any, Type")
 	g.P()
 
-	// Generate Transport interface
-	g.P("class PuregenTransport(ABC):")
-	g.P("    \"\"\"Abstract transport interface for client communication\"\"\"")
-	g.P()
-	g.P("    @abstractmethod")
-	g.P("    def send(self, ctx: Dict[str, Any], method_name: str, input_data: Any, output_type: type) -> Any:")
-	g.P("        \"\"\"Send request and return response\"\"\"")
-	g.P("        pass")
+	writePythonTransportInterfaces(g)
 }
 
 // generateGlobalTransport creates a global Transport class in the specified namespace
-func generateGlobalTransport(gen *protogen.Plugin, commonNamespace string) {
-	// Only create once per namespace
-	if createdTransportNamespaces[commonNamespace] {
+func generateGlobalTransport(gen *protogen.Plugin, config *Config) {
+	commonNamespace := config.CommonNamespace
+
+	// Create the transport module file, once per output file
+	filename := outputPath(config.Output.Python, strings.ReplaceAll(commonNamespace, ".", "/")+"/transport.py")
+	if !config.state.createOnce(filename) {
 		return
 	}
-	createdTransportNamespaces[commonNamespace] = true
 
 	// Create package structure for parent directories
-	createTransportPackageStructure(gen, commonNamespace)
+	createTransportPackageStructure(gen, config)
 
-	// Create the transport module file
-	filename := strings.ReplaceAll(commonNamespace, ".", "/") + "/transport.py"
-	
 	// Check if transport.py already exists, if so, ignore it
 	if fileExists(gen, filename) {
 		return
@@ -856,35 +1295,77 @@ func generateGlobalTransport(gen *protogen.Plugin, commonNamespace string) {
 	g.P("# Global Transport interface")
 	g.P()
 	g.P("from abc import ABC, abstractmethod")
-	g.P("from typing import Dict, Any")
+	g.P("from typing import Dict, Any, Type")
 	g.P()
 
-	// Generate Transport interface
-	g.P("class PuregenTransport(ABC):")
-	g.P("    \"\"\"Abstract transport interface for client communication\"\"\"")
-	g.P()
-	g.P("    @abstractmethod")
-	g.P("    def send(self, ctx: Dict[str, Any], method_name: str, input_data: Any, output_type: type) -> Any:")
-	g.P("        \"\"\"Send request and return response\"\"\"")
-	g.P("        pass")
+	writePythonTransportInterfaces(g)
+
+	generateCommonPackageInitPython(gen, config)
+}
+
+// generateCommonPackageInitPython creates the __init__.py of the common
+// namespace package, exporting the transports and the message type registry
+// of the files generated in this run
+func generateCommonPackageInitPython(gen *protogen.Plugin, config *Config) {
+	initFilename := outputPath(config.Output.Python, strings.ReplaceAll(config.CommonNamespace, ".", "/")+"/__init__.py")
 
-	// Create a proper __init__.py file to export PuregenTransport
-	initFilename := strings.ReplaceAll(commonNamespace, ".", "/") + "/__init__.py"
-	
 	// Check if __init__.py already exists, if so, ignore it
-	if fileExists(gen, initFilename) {
+	if fileExists(gen, initFilename) || !config.state.createOnce(initFilename) {
 		return
 	}
-	
+
+	var hasClients, hasRegistry bool
+	for _, f := range gen.Files {
+		if f.Generate {
+			hasClients = hasClients || (len(f.Services) > 0 && config.Features.Clients)
+			hasRegistry = hasRegistry || hasTypeRegistry(f, config)
+		}
+	}
+
+	var exports []string
 	initG := gen.NewGeneratedFile(initFilename, "")
 	initG.P("# Code generated by protoc-gen-puregen. DO NOT EDIT.")
-	initG.P("from .transport import Transport")
+	if hasClients {
+		initG.P("from .transport import PuregenTransport, AsyncPuregenTransport")
+		exports = append(exports, "PuregenTransport", "AsyncPuregenTransport")
+	}
+	if hasRegistry {
+		initG.P("from .puregen_registry import ", strings.Join(pythonRegistryExports, ", "))
+		exports = append(exports, pythonRegistryExports...)
+	}
 	initG.P()
-	initG.P("__all__ = ['Transport']")
+	initG.P("__all__ = [")
+	for _, name := range exports {
+		initG.P("    ", pythonString(name), ",")
+	}
+	initG.P("]")
+
+	createPythonTypedMarker(gen, path.Dir(initFilename), config)
+}
+
+// writePythonTransportInterfaces writes the synchronous and asyncio transport interfaces
+func writePythonTransportInterfaces(g *protogen.GeneratedFile) {
+	g.P("class PuregenTransport(ABC):")
+	g.P("    \"\"\"Abstract transport interface for client communication\"\"\"")
+	g.P()
+	g.P("    @abstractmethod")
+	g.P("    def send(self, ctx: Dict[str, Any], method_name: str, input_data: Any, output_type: Type[Any]) -> Any:")
+	g.P("        \"\"\"Send request and return response\"\"\"")
+	g.P("        pass")
+	g.P()
+	g.P("class AsyncPuregenTransport(ABC):")
+	g.P("    \"\"\"Abstract asyncio transport interface for client communication\"\"\"")
+	g.P()
+	g.P("    @abstractmethod")
+	g.P("    async def send(self, ctx: Dict[str, Any], method_name: str, input_data: Any, output_type: Type[Any]) -> Any:")
+	g.P("        \"\"\"Send request and return response\"\"\"")
+	g.P("        pass")
 }
 
 // createTransportPackageStructure creates package directories for transport namespace
-func createTransportPackageStructure(gen *protogen.Plugin, commonNamespace string) {
+func createTransportPackageStructure(gen *protogen.Plugin, config *Config) {
+	commonNamespace := config.CommonNamespace
+
 	if !strings.Contains(commonNamespace, ".") {
 		// Single level package, nothing more to create
 		return
@@ -894,18 +1375,13 @@ func createTransportPackageStructure(gen *protogen.Plugin, commonNamespace strin
 	parts := strings.Split(commonNamespace, ".")
 	for i := 1; i < len(parts); i++ {
 		parentPath := strings.Join(parts[:i], "/")
-		initFile := parentPath + "/__init__.py"
+		initFile := outputPath(config.Output.Python, parentPath+"/__init__.py")
 
-		if !createdPythonPackages[initFile] {
-			// Check if __init__.py already exists, if so, ignore it
-			if fileExists(gen, initFile) {
-				createdPythonPackages[initFile] = true
-				continue
-			}
+		// Check if __init__.py already exists, if so, ignore it
+		if config.state.createOnce(initFile) && !fileExists(gen, initFile) {
 			parentG := gen.NewGeneratedFile(initFile, "")
 			parentG.P("# Code generated by protoc-gen-puregen. DO NOT EDIT.")
 			parentG.P("# Package initialization file")
-			createdPythonPackages[initFile] = true
 		}
 	}
 }
@@ -0,0 +1,46 @@
+package generator
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// writePythonDeprecationWarnings writes the post-init hook that warns when a
+// deprecated message is constructed or a deprecated field is set to anything
+// but its default. Dataclasses use __post_init__, pydantic models model_post_init.
+func writePythonDeprecationWarnings(g *protogen.GeneratedFile, file *protogen.File, msg *protogen.Message, config *Config) {
+	var fields []*protogen.Field
+	for _, field := range msg.Fields {
+		if isDeprecated(field.Desc) {
+			fields = append(fields, field)
+		}
+	}
+	if !isDeprecated(msg.Desc) && len(fields) == 0 {
+		return
+	}
+
+	if config.Python.Style == pydanticPythonStyle {
+		g.P("    def model_post_init(self, __context: Any) -> None:")
+	} else {
+		g.P("    def __post_init__(self) -> None:")
+	}
+	if isDeprecated(msg.Desc) {
+		g.P("        warnings.warn(", pythonString(pythonDeprecationWarning(msg.Desc)), ", DeprecationWarning, stacklevel=3)")
+	}
+	for _, field := range fields {
+		value := "self." + pythonFieldName(field, config)
+		if defaultValue := pythonClearedValue(field, file, config); defaultValue == "None" {
+			g.P("        if ", value, " is not None:")
+		} else {
+			g.P("        if ", value, " != ", defaultValue, ":")
+		}
+		g.P("            warnings.warn(", pythonString(pythonDeprecationWarning(field.Desc)), ", DeprecationWarning, stacklevel=3)")
+	}
+	g.P()
+}
+
+// pythonDeprecationWarning is the message of the DeprecationWarning raised
+// when a deprecated element is used
+func pythonDeprecationWarning(desc protoreflect.Descriptor) string {
+	return string(desc.FullName()) + " is deprecated"
+}
@@ -0,0 +1,175 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// hasPythonDescriptors reports whether a generated module describes any messages
+func hasPythonDescriptors(file *protogen.File, config *Config) bool {
+	return config.Features.Descriptors && len(collectDescribedMessages(file)) > 0
+}
+
+// writePythonDescriptorAnnotation declares the DESCRIPTOR class attribute of a
+// message class; map entries are described by their field
+func writePythonDescriptorAnnotation(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	if config.Features.Descriptors && !msg.Desc.IsMapEntry() {
+		g.P("    DESCRIPTOR: ClassVar[PuregenMessageDescriptor]")
+	}
+}
+
+// generatePythonDescriptors sets the DESCRIPTOR of every message class of a
+// module and registers them with the package registry. Metadata is shared with
+// the XxxMetadata and XxxFieldMetadata maps.
+func generatePythonDescriptors(g *protogen.GeneratedFile, file *protogen.File, config *Config) {
+	if !hasPythonDescriptors(file, config) {
+		return
+	}
+	messages := collectDescribedMessages(file)
+
+	g.P("# Descriptors")
+	g.P()
+	names := make([]string, len(messages))
+	for i, msg := range messages {
+		className := msg.GoIdent.GoName
+		names[i] = className + ".DESCRIPTOR"
+
+		g.P(className, ".DESCRIPTOR = PuregenMessageDescriptor(")
+		g.P("    full_name=", pythonString(string(msg.Desc.FullName())), ",")
+		g.P("    name=", pythonString(string(msg.Desc.Name())), ",")
+		g.P("    python_name=", pythonString(className), ",")
+		if len(msg.Fields) > 0 {
+			g.P("    fields=[")
+			for _, field := range msg.Fields {
+				args := []string{
+					"name=" + pythonString(string(field.Desc.Name())),
+					"python_name=" + pythonString(pythonFieldName(field, config)),
+					"json_name=" + pythonString(jsonFieldName(field, config)),
+					"number=" + strconv.Itoa(int(field.Desc.Number())),
+					"kind=" + pythonString(descriptorKind(field)),
+				}
+				if typeName := descriptorTypeName(field); typeName != "" {
+					args = append(args, "type_name="+pythonString(typeName))
+				}
+				if mapKey := descriptorMapKey(field); mapKey != "" {
+					args = append(args, "map_key="+pythonString(mapKey))
+				}
+				if field.Desc.IsList() {
+					args = append(args, "repeated=True")
+				}
+				if field.Desc.IsMap() {
+					args = append(args, "map=True")
+				}
+				if field.Desc.HasOptionalKeyword() {
+					args = append(args, "optional=True")
+				}
+				if parseFieldMetadata(field, config) != nil && config.Features.Metadata {
+					args = append(args, "metadata="+className+"FieldMetadata["+className+"_"+field.GoName+"_FIELD]")
+				}
+				g.P("        PuregenFieldDescriptor(", strings.Join(args, ", "), "),")
+			}
+			g.P("    ],")
+		}
+		if parseMessageMetadata(msg, config) != nil && config.Features.Metadata {
+			g.P("    metadata=", className, "Metadata,")
+		}
+		g.P(")")
+		g.P()
+	}
+	g.P("register_message_descriptors(")
+	for _, name := range names {
+		g.P("    ", name, ",")
+	}
+	g.P(")")
+	g.P()
+}
+
+// generatePackageDescriptorsPython creates the puregen_descriptors module of a
+// Python package: the descriptor types and the registry of the package
+func generatePackageDescriptorsPython(gen *protogen.Plugin, file *protogen.File, config *Config) {
+	moduleName := getPythonModuleName(file, config)
+	var filename string
+	if moduleName != "" {
+		filename = strings.ReplaceAll(moduleName, ".", "/") + "/puregen_descriptors.py"
+	} else {
+		filename = "puregen_descriptors.py"
+	}
+	filename = outputPath(config.Output.Python, filename)
+
+	// Only create once per output file: with paths=source_relative, protos of
+	// several packages can share a directory
+	if !config.state.createOnce(filename) {
+		return
+	}
+
+	g := gen.NewGeneratedFile(filename, "")
+	g.P("# Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P("# Package message descriptors")
+	g.P()
+	g.P("from dataclasses import dataclass, field")
+	g.P("from typing import Any, Dict, List, Optional")
+	g.P()
+	g.P()
+	g.P("@dataclass(frozen=True)")
+	g.P("class PuregenFieldDescriptor:")
+	g.P("    \"\"\"Describes a field of a generated message\"\"\"")
+	g.P("    name: str")
+	g.P("    python_name: str")
+	g.P("    json_name: str")
+	g.P("    number: int")
+	g.P("    # Proto type (\"string\", \"int64\", \"message\", \"enum\", ...); for maps, the type of the values")
+	g.P("    kind: str")
+	g.P("    # Full proto name of the message or enum type")
+	g.P("    type_name: Optional[str] = None")
+	g.P("    # Proto type of the keys of a map field")
+	g.P("    map_key: Optional[str] = None")
+	g.P("    repeated: bool = False")
+	g.P("    map: bool = False")
+	g.P("    optional: bool = False")
+	g.P("    metadata: Dict[str, Any] = field(default_factory=dict)")
+	g.P()
+	g.P()
+	g.P("@dataclass(frozen=True)")
+	g.P("class PuregenMessageDescriptor:")
+	g.P("    \"\"\"Describes a generated message\"\"\"")
+	g.P("    full_name: str")
+	g.P("    name: str")
+	g.P("    python_name: str")
+	g.P("    fields: List[PuregenFieldDescriptor] = field(default_factory=list)")
+	g.P("    metadata: Dict[str, Any] = field(default_factory=dict)")
+	g.P()
+	g.P("    def field_by_name(self, name: str) -> Optional[PuregenFieldDescriptor]:")
+	g.P("        \"\"\"Return the field with a proto or JSON name\"\"\"")
+	g.P("        for f in self.fields:")
+	g.P("            if f.name == name or f.json_name == name:")
+	g.P("                return f")
+	g.P("        return None")
+	g.P()
+	g.P("    def field_by_number(self, number: int) -> Optional[PuregenFieldDescriptor]:")
+	g.P("        \"\"\"Return the field with a field number\"\"\"")
+	g.P("        for f in self.fields:")
+	g.P("            if f.number == number:")
+	g.P("                return f")
+	g.P("        return None")
+	g.P()
+	g.P()
+	g.P("_descriptors: Dict[str, PuregenMessageDescriptor] = {}")
+	g.P()
+	g.P()
+	g.P("def register_message_descriptors(*descriptors: PuregenMessageDescriptor) -> None:")
+	g.P("    \"\"\"Add descriptors to the package registry\"\"\"")
+	g.P("    for d in descriptors:")
+	g.P("        _descriptors[d.full_name] = d")
+	g.P()
+	g.P()
+	g.P("def find_message_descriptor(full_name: str) -> Optional[PuregenMessageDescriptor]:")
+	g.P("    \"\"\"Return the descriptor of a message by full proto name\"\"\"")
+	g.P("    return _descriptors.get(full_name)")
+	g.P()
+	g.P()
+	g.P("def message_descriptors() -> List[PuregenMessageDescriptor]:")
+	g.P("    \"\"\"Return the descriptors of the package's messages, ordered by full name\"\"\"")
+	g.P("    return [_descriptors[name] for name in sorted(_descriptors)]")
+}
@@ -0,0 +1,66 @@
+package generator
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// hasPythonFieldMask reports whether a module imports PuregenFieldMask, which
+// the apply_mask and merge methods of its messages take
+func hasPythonFieldMask(file *protogen.File) bool {
+	return len(collectDescribedMessages(file)) > 0
+}
+
+// generatePackageFieldMaskPython creates the puregen_fieldmask module of a
+// Python package, holding the PuregenFieldMask class
+func generatePackageFieldMaskPython(gen *protogen.Plugin, file *protogen.File, config *Config) {
+	moduleName := getPythonModuleName(file, config)
+	var filename string
+	if moduleName != "" {
+		filename = strings.ReplaceAll(moduleName, ".", "/") + "/puregen_fieldmask.py"
+	} else {
+		filename = "puregen_fieldmask.py"
+	}
+	filename = outputPath(config.Output.Python, filename)
+
+	// Only create once per output file: with paths=source_relative, protos of
+	// several packages can share a directory
+	if !config.state.createOnce(filename) {
+		return
+	}
+
+	g := gen.NewGeneratedFile(filename, "")
+	g.P("# Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P("# Package field mask type")
+	g.P()
+	g.P("import re")
+	g.P("from dataclasses import dataclass, field")
+	g.P("from typing import List")
+	g.P()
+	g.P()
+	g.P("@dataclass")
+	g.P("class PuregenFieldMask:")
+	g.P("    \"\"\"Names a set of fields, as google.protobuf.FieldMask does. Fields of")
+	g.P("    type google.protobuf.FieldMask are field masks. In JSON a mask is a string")
+	g.P("    of comma-separated lowerCamelCase paths, e.g. \"displayName,profile.bio\".\"\"\"")
+	g.P("    # Proto field names, which may select nested fields, e.g. \"profile.bio\"")
+	g.P("    paths: List[str] = field(default_factory=list)")
+	g.P()
+	g.P("    def merge(self, src: \"PuregenFieldMask\") -> None:")
+	g.P("        \"\"\"Append the paths of src, as proto merge does\"\"\"")
+	g.P("        self.paths.extend(src.paths)")
+	g.P()
+	g.P("    def redacted(self) -> \"PuregenFieldMask\":")
+	g.P("        \"\"\"Return a copy: paths hold no sensitive values\"\"\"")
+	g.P("        return PuregenFieldMask(paths=list(self.paths))")
+	g.P()
+	g.P("    def to_json_string(self) -> str:")
+	g.P("        \"\"\"Return the JSON form of the mask: its paths in lowerCamelCase, separated by commas\"\"\"")
+	g.P("        return \",\".join(re.sub(r\"_([a-z])\", lambda match: match.group(1).upper(), path) for path in self.paths)")
+	g.P()
+	g.P("    @classmethod")
+	g.P("    def from_json_string(cls, value: str) -> \"PuregenFieldMask\":")
+	g.P("        \"\"\"Create a mask from its JSON form: lowerCamelCase paths separated by commas\"\"\"")
+	g.P("        return cls(paths=[re.sub(r\"[A-Z]\", lambda match: \"_\" + match.group(0).lower(), path) for path in value.split(\",\") if path])")
+}
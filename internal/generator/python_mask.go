@@ -0,0 +1,132 @@
+package generator
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// writePythonFieldPaths writes a nested Paths class holding a path constant
+// for every field of a message
+func writePythonFieldPaths(g *protogen.GeneratedFile, msg *protogen.Message) {
+	if len(msg.Fields) == 0 {
+		return
+	}
+	g.P("    class Paths:")
+	g.P("        \"\"\"Field paths of ", msg.GoIdent.GoName, ", for apply_mask and field masks\"\"\"")
+	for _, field := range msg.Fields {
+		g.P("        ", pythonFieldPathConstant(field), " = ", pythonString(string(field.Desc.Name())))
+	}
+	g.P()
+}
+
+// pythonFieldPathConstant returns the name of a field's constant in Paths
+func pythonFieldPathConstant(field *protogen.Field) string {
+	return strings.ToUpper(getPythonFieldName(field.GoName))
+}
+
+// writePythonMaskMethods writes apply_mask and merge, shared by dataclasses and pydantic models
+func writePythonMaskMethods(g *protogen.GeneratedFile, msg *protogen.Message, config *Config) {
+	msgName := msg.GoIdent.GoName
+
+	zeros := make([]string, len(msg.Fields))
+	for i, field := range msg.Fields {
+		zeros[i] = pythonFieldName(field, config) + "=" + pythonZeroValue(field, config)
+	}
+	constructor := "cls"
+	if config.Python.Style == pydanticPythonStyle {
+		// model_construct skips validation, which zero values may not pass
+		constructor = "cls.model_construct"
+	}
+	g.P("    @classmethod")
+	g.P("    def zero_value(cls) -> Self:")
+	g.P("        \"\"\"Return a ", msgName, " with every field at its zero value, unlike")
+	g.P("        ", msgName, "(), which applies the field defaults.\"\"\"")
+	g.P("        return ", constructor, "(", strings.Join(zeros, ", "), ")")
+	g.P()
+
+	g.P("    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None:")
+	g.P("        \"\"\"Copy the fields named by paths, or by a field mask, from src. Paths are")
+	g.P("        proto (or JSON) field names and may select nested fields, e.g.")
+	g.P("        \"profile.bio\". A None src counts as a new ", msgName, ".\"\"\"")
+	g.P("        if isinstance(paths, PuregenFieldMask):")
+	g.P("            paths = paths.paths")
+	g.P("        source = src if src is not None else type(self)()")
+	g.P("        for path in paths:")
+	g.P("            name, dot, rest = path.partition('.')")
+	keyword := "if"
+	for _, field := range msg.Fields {
+		fieldName := pythonFieldName(field, config)
+		constant := msgName + ".Paths." + pythonFieldPathConstant(field)
+		condition := "name == " + constant
+		if jsonName := field.Desc.JSONName(); jsonName != string(field.Desc.Name()) {
+			condition = "name in (" + constant + ", " + pythonString(jsonName) + ")"
+		}
+		g.P("            ", keyword, " ", condition, ":")
+		keyword = "elif"
+		if isSingularMessage(field) {
+			g.P("                if not dot:")
+			g.P("                    self.", fieldName, " = copy.deepcopy(source.", fieldName, ")")
+			g.P("                else:")
+			g.P("                    if self.", fieldName, " is None:")
+			g.P("                        self.", fieldName, " = ", messageTypeName(field.Message), ".zero_value()")
+			g.P("                    self.", fieldName, ".apply_mask(source.", fieldName, ", [rest])")
+			continue
+		}
+		g.P("                if dot:")
+		g.P("                    raise ValueError(f", pythonString(msgName+": field {name!r} has no subfields"), ")")
+		if field.Message != nil {
+			g.P("                self.", fieldName, " = copy.deepcopy(source.", fieldName, ")")
+		} else if field.Desc.IsList() {
+			g.P("                self.", fieldName, " = list(source.", fieldName, ")")
+		} else {
+			g.P("                self.", fieldName, " = source.", fieldName)
+		}
+	}
+	if len(msg.Fields) == 0 {
+		g.P("            raise ValueError(f", pythonString(msgName+": unknown field path {path!r}"), ")")
+	} else {
+		g.P("            else:")
+		g.P("                raise ValueError(f", pythonString(msgName+": unknown field path {path!r}"), ")")
+	}
+	g.P()
+
+	g.P("    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None:")
+	g.P("        \"\"\"Merge src as proto merge does: fields set to a non-zero value")
+	g.P("        overwrite, lists are appended and messages are merged recursively. With")
+	g.P("        a mask, the fields of src outside the mask are left out.\"\"\"")
+	g.P("        if mask is not None:")
+	g.P("            masked = type(self).zero_value()")
+	g.P("            masked.apply_mask(src, mask)")
+	g.P("            src = masked")
+	for _, field := range msg.Fields {
+		fieldName := pythonFieldName(field, config)
+		value := "src." + fieldName
+		switch {
+		case field.Desc.IsMap() && field.Message.Fields[1].Message != nil:
+			g.P("        self.", fieldName, ".update(copy.deepcopy(", value, "))")
+		case field.Desc.IsMap():
+			g.P("        self.", fieldName, ".update(", value, ")")
+		case field.Desc.IsList() && field.Message != nil:
+			g.P("        self.", fieldName, ".extend(copy.deepcopy(", value, "))")
+		case field.Desc.IsList():
+			g.P("        self.", fieldName, ".extend(", value, ")")
+		case field.Message != nil:
+			g.P("        if ", value, " is not None:")
+			g.P("            if self.", fieldName, " is None:")
+			g.P("                self.", fieldName, " = ", messageTypeName(field.Message), ".zero_value()")
+			g.P("            self.", fieldName, ".merge(", value, ")")
+		default:
+			switch zeroValue := pythonZeroValue(field, config); zeroValue {
+			case "None":
+				g.P("        if ", value, " is not None:")
+			case `""`, "b''", "False", "0", "0.0":
+				g.P("        if ", value, ":")
+			default:
+				g.P("        if ", value, " != ", zeroValue, ":")
+			}
+			g.P("            self.", fieldName, " = ", value)
+		}
+	}
+	g.P()
+}
@@ -0,0 +1,333 @@
+package generator
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// pydanticAliases returns the alias arguments of a field: its JSON name, and
+// when it has other accepted names (see jsonFieldAliases), the choices it is
+// validated from
+func pydanticAliases(field *protogen.Field, config *Config) string {
+	name := pythonString(jsonFieldName(field, config))
+	aliases := jsonFieldAliases(field, config)
+	if len(aliases) == 0 {
+		return "alias=" + name
+	}
+	choices := []string{name}
+	for _, alias := range aliases {
+		choices = append(choices, pythonString(alias))
+	}
+	return "alias=" + name + ", validation_alias=AliasChoices(" + strings.Join(choices, ", ") + ")"
+}
+
+// generatePydanticMessage generates a pydantic BaseModel for a message, used
+// with python_style=pydantic
+func generatePydanticMessage(g *protogen.GeneratedFile, file *protogen.File, msg *protogen.Message, config *Config) {
+	writePythonComment(g, msg.Comments)
+	writePythonDeprecated(g, "", msg.Desc)
+
+	g.P("class ", msg.GoIdent.GoName, "(BaseModel):")
+	g.P("    \"\"\"Generated message class for ", msg.GoIdent.GoName, "\"\"\"")
+	g.P()
+	writePythonFieldPaths(g, msg)
+	writePythonDescriptorAnnotation(g, msg, config)
+	// Fields take their JSON names as aliases; populate_by_name also accepts the Python names
+	g.P("    model_config = ConfigDict(populate_by_name=True, protected_namespaces=())")
+	g.P()
+
+	for _, field := range msg.Fields {
+		if commentLines := formatPythonComment(field.Comments); len(commentLines) > 0 {
+			for _, line := range commentLines {
+				g.P("    ", line)
+			}
+		}
+		writePythonDeprecated(g, "    ", field.Desc)
+		g.P("    ", pythonFieldName(field, config), ": ", getPydanticFieldType(field, file, config),
+			" = Field(", getPydanticDefault(field, file, config), ", ", pydanticAliases(field, config), ")")
+	}
+	g.P()
+
+	if config.Features.Validation {
+		for _, field := range msg.Fields {
+			generatePydanticValidator(g, field, file, config)
+		}
+	}
+
+	// Bytes are base64 encoded in JSON, as in the Go and Java output
+	for _, field := range msg.Fields {
+		isMap := field.Desc.IsMap()
+		if isMap && field.Message.Fields[1].Desc.Kind().String() != "bytes" || !isMap && field.Desc.Kind().String() != "bytes" {
+			continue
+		}
+		fieldName := pythonFieldName(field, config)
+		fieldType := getPydanticFieldType(field, file, config)
+		g.P("    @field_validator(", pythonString(fieldName), ", mode=\"before\")")
+		g.P("    @classmethod")
+		g.P("    def _decode_", fieldName, "(cls, value: Any) -> Any:")
+		if isMap {
+			g.P("        if isinstance(value, dict):")
+			g.P("            return {key: base64.b64decode(item) if isinstance(item, str) else item for key, item in value.items()}")
+		} else if field.Desc.IsList() {
+			g.P("        if isinstance(value, list):")
+			g.P("            return [base64.b64decode(item) if isinstance(item, str) else item for item in value]")
+		} else {
+			g.P("        if isinstance(value, str):")
+			g.P("            return base64.b64decode(value)")
+		}
+		g.P("        return value")
+		g.P()
+		g.P("    @field_serializer(", pythonString(fieldName), ", when_used=\"json\")")
+		if isMap {
+			g.P("    def _encode_", fieldName, "(self, value: ", fieldType, ") -> Dict[", getPythonElementType(field.Message.Fields[0], config), ", str]:")
+			g.P("        return {key: base64.b64encode(item).decode('ascii') for key, item in value.items()}")
+		} else if field.Desc.IsList() {
+			g.P("    def _encode_", fieldName, "(self, value: ", fieldType, ") -> List[str]:")
+			g.P("        return [base64.b64encode(item).decode('ascii') for item in value]")
+		} else if field.Desc.HasOptionalKeyword() {
+			g.P("    def _encode_", fieldName, "(self, value: ", fieldType, ") -> Optional[str]:")
+			g.P("        return base64.b64encode(value).decode('ascii') if value is not None else None")
+		} else {
+			g.P("    def _encode_", fieldName, "(self, value: ", fieldType, ") -> str:")
+			g.P("        return base64.b64encode(value).decode('ascii')")
+		}
+		g.P()
+	}
+
+	// Field masks are strings in JSON, as in the dataclass output
+	for _, field := range msg.Fields {
+		if !isFieldMaskMessage(descriptorValue(field).Message) {
+			continue
+		}
+		fieldName := pythonFieldName(field, config)
+		fieldType := getPydanticFieldType(field, file, config)
+		g.P("    @field_validator(", pythonString(fieldName), ", mode=\"before\")")
+		g.P("    @classmethod")
+		g.P("    def _decode_", fieldName, "(cls, value: Any) -> Any:")
+		if field.Desc.IsMap() {
+			g.P("        if isinstance(value, dict):")
+			g.P("            return {key: ", pythonMessageFromJSON(field.Message.Fields[1].Message, "item"), " for key, item in value.items()}")
+		} else if field.Desc.IsList() {
+			g.P("        if isinstance(value, list):")
+			g.P("            return [", pythonMessageFromJSON(field.Message, "item"), " for item in value]")
+		} else {
+			g.P("        if isinstance(value, str):")
+			g.P("            return PuregenFieldMask.from_json_string(value)")
+		}
+		g.P("        return value")
+		g.P()
+		g.P("    @field_serializer(", pythonString(fieldName), ", when_used=\"json\")")
+		if field.Desc.IsMap() {
+			g.P("    def _encode_", fieldName, "(self, value: ", fieldType, ") -> Dict[", getPythonElementType(field.Message.Fields[0], config), ", str]:")
+			g.P("        return {key: item.to_json_string() for key, item in value.items()}")
+		} else if field.Desc.IsList() {
+			g.P("    def _encode_", fieldName, "(self, value: ", fieldType, ") -> List[str]:")
+			g.P("        return [item.to_json_string() for item in value]")
+		} else {
+			g.P("    def _encode_", fieldName, "(self, value: ", fieldType, ") -> Optional[str]:")
+			g.P("        return value.to_json_string() if value is not None else None")
+		}
+		g.P()
+	}
+
+	writePythonDeprecationWarnings(g, file, msg, config)
+	writePythonMaskMethods(g, msg, config)
+	writePythonRedactMethods(g, file, msg, config)
+
+	// Generate JSON serialization methods matching the dataclass API
+	g.P("    def to_json(self) -> str:")
+	g.P("        \"\"\"Convert message to JSON string\"\"\"")
+	g.P("        return json.dumps(self.to_dict())")
+	g.P()
+
+	g.P("    def to_dict(self) -> Dict[str, Any]:")
+	g.P("        \"\"\"Convert message to dictionary\"\"\"")
+	g.P("        result: Dict[str, Any] = self.model_dump(mode=\"json\", by_alias=True, exclude_none=True)")
+	var omitted []string
+	for _, field := range msg.Fields {
+		if fieldJSONConfig(field, config).OmitEmpty {
+			omitted = append(omitted, pythonString(jsonFieldName(field, config)))
+		}
+	}
+	switch {
+	case len(omitted) == 0:
+		g.P("        return result")
+	case len(omitted) == len(msg.Fields):
+		g.P("        return {key: value for key, value in result.items() if value}")
+	default:
+		// Only the fields omitting empty values leave them out
+		g.P("        return {key: value for key, value in result.items() if value or key not in {", strings.Join(omitted, ", "), "}}")
+	}
+	g.P()
+
+	g.P("    @classmethod")
+	g.P("    def from_json(cls, json_str: str) -> Self:")
+	g.P("        \"\"\"Create message from JSON string\"\"\"")
+	g.P("        return cls.from_dict(json.loads(json_str))")
+	g.P()
+
+	g.P("    @classmethod")
+	g.P("    def from_dict(cls, data: Dict[str, Any]) -> Self:")
+	g.P("        \"\"\"Create message from dictionary\"\"\"")
+	g.P("        return cls.model_validate(data)")
+	g.P()
+
+	for _, nested := range msg.Messages {
+		generatePydanticMessage(g, file, nested, config)
+	}
+
+	generatePythonMessageMetadata(g, msg, config)
+}
+
+// generatePydanticValidator writes a field_validator enforcing the required,
+// min_length, max_length and pattern keys of a field's metadata
+func generatePydanticValidator(g *protogen.GeneratedFile, field *protogen.Field, file *protogen.File, config *Config) {
+	metadata := parseFieldMetadata(field, config)
+	if metadata == nil {
+		return
+	}
+
+	fieldName := pythonFieldName(field, config)
+	isSingular := !field.Desc.IsList() && !field.Desc.IsMap()
+	hasLength := !isSingular || field.Desc.Kind().String() == "string" || field.Desc.Kind().String() == "bytes"
+	// Optional values are only checked when set
+	guard := ""
+	if isSingular && field.Desc.HasOptionalKeyword() {
+		guard = "value is not None and "
+	}
+
+	var checks []string
+	if metadata["validation"] == "required" || metadataBool(metadata["required"]) {
+		if isSingular && field.Enum != nil && isStringEnum(field.Enum, config) {
+			// String enums are unset when they hold their zero value
+			checks = append(checks, "if value == "+pythonString(string(field.Enum.Values[0].Desc.Name()))+":")
+		} else {
+			checks = append(checks, "if not value:")
+		}
+		checks = append(checks, "    raise ValueError("+pythonString(fieldName+" is required")+")")
+	}
+	if minLength, ok := metadataInt(metadata["min_length"]); ok && hasLength {
+		checks = append(checks,
+			"if "+guard+"len(value) < "+strconv.Itoa(minLength)+":",
+			"    raise ValueError("+pythonString("length of "+fieldName+" must be at least "+strconv.Itoa(minLength))+")")
+	}
+	if maxLength, ok := metadataInt(metadata["max_length"]); ok && hasLength {
+		checks = append(checks,
+			"if "+guard+"len(value) > "+strconv.Itoa(maxLength)+":",
+			"    raise ValueError("+pythonString("length of "+fieldName+" must be at most "+strconv.Itoa(maxLength))+")")
+	}
+	if pattern, ok := metadata["pattern"].(string); ok && isSingular && field.Desc.Kind().String() == "string" {
+		checks = append(checks,
+			"if "+guard+"re.search("+pythonString(pattern)+", value) is None:",
+			"    raise ValueError("+pythonString(fieldName+" does not match the required pattern")+")")
+	}
+	if len(checks) == 0 {
+		return
+	}
+
+	fieldType := getPydanticFieldType(field, file, config)
+	g.P("    @field_validator(", pythonString(fieldName), ")")
+	g.P("    @classmethod")
+	g.P("    def _validate_", fieldName, "(cls, value: ", fieldType, ") -> ", fieldType, ":")
+	for _, line := range checks {
+		g.P("        ", line)
+	}
+	g.P("        return value")
+	g.P()
+}
+
+// getPydanticFieldType returns the annotation of a field in a pydantic model,
+// and in the stubs of either style. Integer enums declared in the same module
+// are typed as their IntEnum class.
+func getPydanticFieldType(field *protogen.Field, file *protogen.File, config *Config) string {
+	if field.Desc.IsMap() {
+		keyType := getPythonElementType(field.Message.Fields[0], config)
+		valueType := getPydanticElementType(field.Message.Fields[1], file, config)
+		return "Dict[" + keyType + ", " + valueType + "]"
+	}
+
+	baseType := getPydanticElementType(field, file, config)
+	if field.Desc.IsList() {
+		return "List[" + baseType + "]"
+	}
+	if field.Desc.Kind().String() == "message" || field.Desc.HasOptionalKeyword() {
+		return "Optional[" + baseType + "]"
+	}
+	return baseType
+}
+
+func getPydanticElementType(field *protogen.Field, file *protogen.File, config *Config) string {
+	if isPydanticIntEnum(field, file, config) {
+		return field.Enum.GoIdent.GoName
+	}
+	return getPythonElementType(field, config)
+}
+
+// isPydanticIntEnum reports whether a field refers to an integer enum whose
+// IntEnum class is generated in the same module
+func isPydanticIntEnum(field *protogen.Field, file *protogen.File, config *Config) bool {
+	if field.Enum == nil || isStringEnum(field.Enum, config) {
+		return false
+	}
+	return field.Enum.Desc.ParentFile().Path() == file.Desc.Path()
+}
+
+// getPydanticDefault returns the default argument of a field's Field() call
+func getPydanticDefault(field *protogen.Field, file *protogen.File, config *Config) string {
+	if field.Desc.IsMap() {
+		return "default_factory=dict"
+	}
+	if field.Desc.IsList() {
+		return "default_factory=list"
+	}
+
+	defaultValue := getPythonDefaultValue(field, config)
+	if isPydanticIntEnum(field, file, config) && defaultValue != "None" {
+		// Default to the enum member rather than its number
+		number, _ := strconv.Atoi(defaultValue)
+		for _, value := range field.Enum.Values {
+			if int(value.Desc.Number()) == number {
+				defaultValue = field.Enum.GoIdent.GoName + "." + strings.ToUpper(string(value.Desc.Name()))
+				break
+			}
+		}
+	}
+	return "default=" + defaultValue
+}
+
+// metadataBool reports whether a metadata value is true or "true"
+func metadataBool(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+// metadataInt returns a metadata value given as a number or a numeric string
+func metadataInt(value any) (int, bool) {
+	var text string
+	switch v := value.(type) {
+	case string:
+		text = v
+	case json.Number:
+		text = v.String()
+	default:
+		return 0, false
+	}
+	n, err := strconv.Atoi(text)
+	return n, err == nil
+}
+
+// writePydanticModelRebuild calls model_rebuild on a model and its nested models
+func writePydanticModelRebuild(g *protogen.GeneratedFile, msg *protogen.Message) {
+	g.P(msg.GoIdent.GoName, ".model_rebuild()")
+	for _, nested := range msg.Messages {
+		writePydanticModelRebuild(g, nested)
+	}
+}
@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// writePythonRedactMethods writes redacted, shared by dataclasses and pydantic
+// models, and for messages with sensitive fields a __repr__ (and __str__ for
+// pydantic) that renders it. Nested messages keep their own __repr__, so the
+// rendering is safe throughout.
+func writePythonRedactMethods(g *protogen.GeneratedFile, file *protogen.File, msg *protogen.Message, config *Config) {
+	g.P("    def redacted(self) -> Self:")
+	g.P("        \"\"\"Return a deep copy that is safe to log: sensitive strings that are set")
+	g.P("        become ", pythonString(redactedValue), " and other sensitive fields are cleared,")
+	g.P("        in this message and in every nested message\"\"\"")
+	g.P("        result = copy.deepcopy(self)")
+	for _, field := range msg.Fields {
+		fieldName := pythonFieldName(field, config)
+		value := "result." + fieldName
+		switch {
+		case isSensitiveField(field, config) && isRedactableString(field):
+			g.P("        if ", value, ":")
+			g.P("            ", value, " = ", pythonString(redactedValue))
+		case isSensitiveField(field, config):
+			g.P("        ", value, " = ", pythonClearedValue(field, file, config))
+		case field.Desc.IsMap():
+			if field.Message.Fields[1].Message != nil {
+				g.P("        ", value, " = {key: item.redacted() for key, item in ", value, ".items()}")
+			}
+		case field.Desc.IsList():
+			if field.Message != nil {
+				g.P("        ", value, " = [item.redacted() for item in ", value, "]")
+			}
+		case field.Message != nil:
+			g.P("        if ", value, " is not None:")
+			g.P("            ", value, " = ", value, ".redacted()")
+		}
+	}
+	g.P("        return result")
+	g.P()
+
+	if !hasSensitiveFields(msg, config) {
+		return
+	}
+	fields := make([]string, len(msg.Fields))
+	for i, field := range msg.Fields {
+		fieldName := pythonFieldName(field, config)
+		fields[i] = fieldName + "={redacted." + fieldName + "!r}"
+	}
+	g.P("    def __repr__(self) -> str:")
+	g.P("        \"\"\"Render the message with sensitive fields redacted\"\"\"")
+	g.P("        redacted = self.redacted()")
+	g.P("        return f\"", msg.GoIdent.GoName, "(", strings.Join(fields, ", "), ")\"")
+	g.P()
+
+	// BaseModel.__str__ does not go through __repr__
+	if config.Python.Style == pydanticPythonStyle {
+		g.P("    def __str__(self) -> str:")
+		g.P("        return repr(self)")
+		g.P()
+	}
+}
+
+// pythonClearedValue returns the default value of a field: sensitive fields
+// hold it in a redacted copy, and deprecated fields are compared against it
+func pythonClearedValue(field *protogen.Field, file *protogen.File, config *Config) string {
+	switch {
+	case field.Desc.IsMap():
+		return "{}"
+	case field.Desc.IsList():
+		return "[]"
+	case config.Python.Style == pydanticPythonStyle:
+		return strings.TrimPrefix(getPydanticDefault(field, file, config), "default=")
+	}
+	return getPythonDefaultValue(field, config)
+}
@@ -0,0 +1,198 @@
+package generator
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// pythonRegistryExports are the names the puregen_registry module of a
+// package re-exports from its __init__.py
+var pythonRegistryExports = []string{"PuregenEnvelope", "find_message_type", "pack", "unpack", "unpack_as"}
+
+// generatePythonTypeRegistry registers the message classes of a module with
+// the package registry
+func generatePythonTypeRegistry(g *protogen.GeneratedFile, file *protogen.File, config *Config) {
+	messages := collectDescribedMessages(file)
+	if !config.Features.Registry || len(messages) == 0 {
+		return
+	}
+
+	g.P("# Message types")
+	g.P()
+	g.P("register_message_types({")
+	for _, msg := range messages {
+		g.P("    ", pythonString(string(msg.Desc.FullName())), ": ", msg.GoIdent.GoName, ",")
+	}
+	g.P("})")
+	g.P()
+}
+
+// generatePackageRegistryPython creates the puregen_registry module of a
+// Python package: the envelope type and the message type registry of the
+// package. With a common namespace the module re-exports them from the
+// puregen_registry module of that package, which resolves the messages of
+// every package.
+func generatePackageRegistryPython(gen *protogen.Plugin, file *protogen.File, config *Config) {
+	moduleName := getPythonModuleName(file, config)
+	var filename string
+	if moduleName != "" {
+		filename = strings.ReplaceAll(moduleName, ".", "/") + "/puregen_registry.py"
+	} else {
+		filename = "puregen_registry.py"
+	}
+	filename = outputPath(config.Output.Python, filename)
+
+	// Only create once per output file: with paths=source_relative, protos of
+	// several packages can share a directory
+	if !config.state.createOnce(filename) {
+		return
+	}
+
+	g := gen.NewGeneratedFile(filename, "")
+	g.P("# Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P("# Package message type registry")
+	g.P()
+	if config.CommonNamespace != "" {
+		generateGlobalRegistryPython(gen, config)
+		g.P("# The envelope type and the registry are shared by all packages")
+		g.P("from ", config.CommonNamespace, ".puregen_registry import ", strings.Join(pythonRegistryExports, ", "), ", register_message_types")
+		g.P()
+		g.P("__all__ = [")
+		for _, name := range pythonRegistryExports {
+			g.P("    ", pythonString(name), ",")
+		}
+		g.P("    \"register_message_types\",")
+		g.P("]")
+		return
+	}
+	writePythonRegistryModule(g, "the package", "a message of the package")
+}
+
+// generateGlobalRegistryPython creates the puregen_registry module of the
+// common namespace package, holding the envelope type and the message type
+// registry of every package
+func generateGlobalRegistryPython(gen *protogen.Plugin, config *Config) {
+	filename := outputPath(config.Output.Python, strings.ReplaceAll(config.CommonNamespace, ".", "/")+"/puregen_registry.py")
+	if !config.state.createOnce(filename) {
+		return
+	}
+
+	createTransportPackageStructure(gen, config)
+	generateCommonPackageInitPython(gen, config)
+
+	g := gen.NewGeneratedFile(filename, "")
+	g.P("# Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P("# Global message type registry. Generated packages register their")
+	g.P("# messages when imported.")
+	g.P()
+	writePythonRegistryModule(g, "every package", "a message of an imported package")
+}
+
+// writePythonRegistryModule writes the envelope type and a message type
+// registry holding the messages of registered, which unpack resolves
+// messages from
+func writePythonRegistryModule(g *protogen.GeneratedFile, registered, resolved string) {
+	g.P("import copy")
+	g.P("import json")
+	g.P("from dataclasses import dataclass")
+	g.P("from typing import Any, Dict, Optional, Type, TypeVar, cast")
+	g.P()
+	g.P("# Prefixes the full names of packed messages, as in google.protobuf.Any")
+	g.P("TYPE_URL_PREFIX = \"type.googleapis.com/\"")
+	g.P()
+	g.P("T = TypeVar(\"T\")")
+	g.P()
+	g.P()
+	g.P("@dataclass")
+	g.P("class PuregenEnvelope:")
+	g.P("    \"\"\"Holds a message of any type as JSON, named by its type URL. Fields of")
+	g.P("    type google.protobuf.Any are envelopes.\"\"\"")
+	g.P("    # \"type.googleapis.com/\" followed by the full proto name of the payload")
+	g.P("    type_url: str = \"\"")
+	g.P("    # The message as a JSON object")
+	g.P("    payload: Optional[Dict[str, Any]] = None")
+	g.P()
+	g.P("    def message_name(self) -> str:")
+	g.P("        \"\"\"Return the full proto name of the payload: the part of the type URL after its last '/'\"\"\"")
+	g.P("        return self.type_url.rsplit(\"/\", 1)[-1]")
+	g.P()
+	g.P("    def holds(self, cls: Type[Any]) -> bool:")
+	g.P("        \"\"\"Return whether the envelope holds a message of class cls\"\"\"")
+	g.P("        return _names.get(cls) == self.message_name()")
+	g.P()
+	g.P("    def merge(self, src: \"PuregenEnvelope\") -> None:")
+	g.P("        \"\"\"Replace the content of the envelope with that of src, if src holds a message\"\"\"")
+	g.P("        if src.type_url:")
+	g.P("            self.type_url = src.type_url")
+	g.P("            self.payload = copy.deepcopy(src.payload)")
+	g.P()
+	g.P("    def redacted(self) -> \"PuregenEnvelope\":")
+	g.P("        \"\"\"Return a copy without the payload, which may hold sensitive fields\"\"\"")
+	g.P("        return PuregenEnvelope(type_url=self.type_url)")
+	g.P()
+	g.P("    def __repr__(self) -> str:")
+	g.P("        return f\"PuregenEnvelope(type_url={self.type_url!r})\"")
+	g.P()
+	g.P("    def to_json(self) -> str:")
+	g.P("        \"\"\"Convert the envelope to a JSON string\"\"\"")
+	g.P("        return json.dumps(self.to_dict())")
+	g.P()
+	g.P("    def to_dict(self) -> Dict[str, Any]:")
+	g.P("        \"\"\"Convert the envelope to a dictionary\"\"\"")
+	g.P("        result: Dict[str, Any] = {\"type_url\": self.type_url}")
+	g.P("        if self.payload is not None:")
+	g.P("            result[\"payload\"] = self.payload")
+	g.P("        return result")
+	g.P()
+	g.P("    @classmethod")
+	g.P("    def from_json(cls, json_str: str) -> \"PuregenEnvelope\":")
+	g.P("        \"\"\"Create an envelope from a JSON string\"\"\"")
+	g.P("        return cls.from_dict(json.loads(json_str))")
+	g.P()
+	g.P("    @classmethod")
+	g.P("    def from_dict(cls, data: Dict[str, Any]) -> \"PuregenEnvelope\":")
+	g.P("        \"\"\"Create an envelope from a dictionary\"\"\"")
+	g.P("        return cls(type_url=data.get(\"type_url\") or \"\", payload=data.get(\"payload\"))")
+	g.P()
+	g.P()
+	g.P("_types: Dict[str, Type[Any]] = {}")
+	g.P("_names: Dict[Type[Any], str] = {}")
+	g.P()
+	g.P()
+	g.P("def register_message_types(types: Dict[str, Type[Any]]) -> None:")
+	g.P("    \"\"\"Add message classes of ", registered, " to the registry by full proto name\"\"\"")
+	g.P("    for full_name, cls in types.items():")
+	g.P("        _types[full_name] = cls")
+	g.P("        _names[cls] = full_name")
+	g.P()
+	g.P()
+	g.P("def find_message_type(full_name: str) -> Optional[Type[Any]]:")
+	g.P("    \"\"\"Return the class of a message of ", registered, " by full proto name\"\"\"")
+	g.P("    return _types.get(full_name)")
+	g.P()
+	g.P()
+	g.P("def pack(message: Any) -> PuregenEnvelope:")
+	g.P("    \"\"\"Wrap a message of ", registered, " in an envelope named by its type URL\"\"\"")
+	g.P("    full_name = _names.get(type(message))")
+	g.P("    if full_name is None:")
+	g.P("        raise ValueError(f\"pack: {type(message).__name__} is not a registered message\")")
+	g.P("    return PuregenEnvelope(type_url=TYPE_URL_PREFIX + full_name, payload=message.to_dict())")
+	g.P()
+	g.P()
+	g.P("def unpack(envelope: PuregenEnvelope) -> Any:")
+	g.P("    \"\"\"Decode the payload of an envelope into a new message of the type its")
+	g.P("    type URL names, which must be ", resolved, "\"\"\"")
+	g.P("    cls = _types.get(envelope.message_name())")
+	g.P("    if cls is None:")
+	g.P("        raise ValueError(f\"unpack: unknown message type {envelope.type_url!r}\")")
+	g.P("    return cls.from_dict(envelope.payload or {})")
+	g.P()
+	g.P()
+	g.P("def unpack_as(envelope: PuregenEnvelope, cls: Type[T]) -> T:")
+	g.P("    \"\"\"Decode the payload of an envelope into a message of class cls, which")
+	g.P("    must be the packed type\"\"\"")
+	g.P("    if not envelope.holds(cls):")
+	g.P("        raise ValueError(f\"unpack: envelope holds {envelope.message_name()!r}, not {cls.__name__}\")")
+	g.P("    return cast(T, cast(Any, cls).from_dict(envelope.payload or {}))")
+}
@@ -0,0 +1,268 @@
+package generator
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// generatePythonStub writes a .pyi stub declaring the public API of a generated
+// module. The declarations are written to a scratch file first, so that the
+// stub imports only the names they use.
+func generatePythonStub(gen *protogen.Plugin, file *protogen.File, filename string, config *Config) {
+	body := gen.NewGeneratedFile(filename+".body", "")
+	body.Skip()
+	writePythonStubDeclarations(body, file, config)
+	declarations, err := body.Content()
+	if err != nil {
+		gen.Error(err)
+		return
+	}
+
+	g := gen.NewGeneratedFile(filename, "")
+	g.P("# Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P()
+	writePythonStubImports(gen, g, file, config, string(declarations))
+	g.Write(declarations)
+}
+
+// writePythonStubImports writes the imports of a stub, leaving out those of
+// the generated module that its declarations do not use
+func writePythonStubImports(gen *protogen.Plugin, g *protogen.GeneratedFile, file *protogen.File, config *Config, declarations string) {
+	uses := func(name string) bool {
+		return regexp.MustCompile(`\b` + name + `\b`).MatchString(declarations)
+	}
+	// importNames writes an import of the names that are used, if any
+	importNames := func(module string, names ...string) {
+		var used []string
+		for _, name := range names {
+			if uses(name) {
+				used = append(used, name)
+			}
+		}
+		if len(used) > 0 {
+			g.P("from ", module, " import ", strings.Join(used, ", "))
+		}
+	}
+
+	importNames("dataclasses", "dataclass")
+	importNames("typing", "Optional", "List", "Dict", "Any", "ClassVar", "Final", "Literal", "Union")
+	importNames("abc", "ABC", "abstractmethod")
+	if uses("Self") {
+		g.P("import sys")
+	}
+	importNames("enum", "IntEnum")
+	importNames("pydantic", "BaseModel")
+	if config.CommonNamespace != "" {
+		importNames(config.CommonNamespace, "PuregenTransport", "AsyncPuregenTransport")
+	} else {
+		importNames(".puregen_transport", "PuregenTransport", "AsyncPuregenTransport")
+	}
+	importNames(".puregen_descriptors", "PuregenMessageDescriptor")
+	importNames(".puregen_registry", "PuregenEnvelope")
+	importNames(".puregen_fieldmask", "PuregenFieldMask")
+	for _, message := range collectSamePackageMessages(file) {
+		packagePath := getPythonImportModuleName(file, config)
+		if msgFile, ok := gen.FilesByPath[message.Desc.ParentFile().Path()]; ok {
+			packagePath = getPythonImportModuleName(msgFile, config)
+		}
+		msgFilename := strings.TrimSuffix(filepath.Base(message.Desc.ParentFile().Path()), ".proto")
+		importNames(packagePath+"."+msgFilename, message.GoIdent.GoName)
+	}
+	g.P()
+	if uses("Self") {
+		writePythonSelfImport(g)
+	}
+}
+
+// writePythonStubDeclarations writes the classes and constants of a stub
+func writePythonStubDeclarations(g *protogen.GeneratedFile, file *protogen.File, config *Config) {
+	for _, message := range collectImportedMessages(file) {
+		generatePythonMessageStub(g, file, message, config)
+	}
+	for _, enum := range collectAllEnums(file) {
+		generatePythonEnumStub(g, enum, config)
+	}
+	for _, message := range file.Messages {
+		generatePythonMessageStub(g, file, message, config)
+	}
+
+	hasServices := len(file.Services) > 0 && config.Features.Services
+	hasClients := len(file.Services) > 0 && config.Features.Clients
+	for _, service := range file.Services {
+		if hasServices {
+			generatePythonServiceStub(g, service, config)
+		}
+		if hasServices || hasClients {
+			generatePythonMethodConstantsStub(g, service, config)
+		}
+		if hasClients {
+			generatePythonClientStub(g, service, "", "PuregenTransport", "def ")
+			generatePythonClientStub(g, service, "Async", "AsyncPuregenTransport", "async def ")
+		}
+	}
+}
+
+func generatePythonEnumStub(g *protogen.GeneratedFile, enum *protogen.Enum, config *Config) {
+	enumName := enum.GoIdent.GoName
+
+	if isStringEnum(enum, config) {
+		g.P("class ", enumName, ":")
+		for _, value := range enum.Values {
+			g.P("    ", strings.ToUpper(string(value.Desc.Name())), ": Final = ", pythonString(string(value.Desc.Name())))
+		}
+		g.P("    VALUES: Final[List[str]]")
+		g.P("    @classmethod")
+		g.P("    def is_valid(cls, value: str) -> bool: ...")
+		if hasEnumDisplayNames(enum) {
+			g.P("    @classmethod")
+			g.P("    def display_name(cls, value: str) -> str: ...")
+		}
+	} else {
+		g.P("class ", enumName, "(IntEnum):")
+		for _, value := range enum.Values {
+			g.P("    ", strings.ToUpper(string(value.Desc.Name())), " = ", value.Desc.Number())
+		}
+		g.P("    @classmethod")
+		g.P("    def is_valid(cls, value: int) -> bool: ...")
+		if hasEnumDisplayNames(enum) {
+			g.P("    def display_name(self) -> str: ...")
+		}
+	}
+	g.P()
+
+	if config.Features.Metadata && parseEnumMetadata(enum, config) != nil {
+		g.P(enumName, "Metadata: Dict[str, Any]")
+		g.P()
+	}
+	if config.Features.Metadata && hasEnumValueMetadata(enum) {
+		g.P(enumName, "ValueMetadata: Dict[str, Dict[str, Any]]")
+		g.P()
+	}
+}
+
+func generatePythonMessageStub(g *protogen.GeneratedFile, file *protogen.File, msg *protogen.Message, config *Config) {
+	msgName := msg.GoIdent.GoName
+
+	if config.Python.Style == pydanticPythonStyle {
+		g.P("class ", msgName, "(BaseModel):")
+		for _, field := range msg.Fields {
+			g.P("    ", pythonFieldName(field, config), ": ", getPydanticFieldType(field, file, config), " = ...")
+		}
+	} else {
+		g.P("@dataclass")
+		g.P("class ", msgName, ":")
+		// As in the pydantic models, integer enums are typed as their IntEnum class
+		for _, field := range msg.Fields {
+			g.P("    ", pythonFieldName(field, config), ": ", getPydanticFieldType(field, file, config), " = ...")
+		}
+		if config.Features.Validation {
+			g.P("    def validate(self) -> bool: ...")
+		}
+	}
+	writePythonDescriptorAnnotation(g, msg, config)
+	if len(msg.Fields) > 0 {
+		g.P("    class Paths:")
+		for _, field := range msg.Fields {
+			g.P("        ", pythonFieldPathConstant(field), ": str")
+		}
+	}
+	g.P("    @classmethod")
+	g.P("    def zero_value(cls) -> Self: ...")
+	g.P("    def apply_mask(self, src: Optional[Self], paths: Union[List[str], PuregenFieldMask]) -> None: ...")
+	g.P("    def merge(self, src: Self, mask: Optional[PuregenFieldMask] = None) -> None: ...")
+	g.P("    def redacted(self) -> Self: ...")
+	if hasSensitiveFields(msg, config) {
+		g.P("    def __repr__(self) -> str: ...")
+		if config.Python.Style == pydanticPythonStyle {
+			g.P("    def __str__(self) -> str: ...")
+		}
+	}
+	g.P("    def to_json(self) -> str: ...")
+	g.P("    def to_dict(self) -> Dict[str, Any]: ...")
+	g.P("    @classmethod")
+	g.P("    def from_json(cls, json_str: str) -> Self: ...")
+	g.P("    @classmethod")
+	g.P("    def from_dict(cls, data: Dict[str, Any]) -> Self: ...")
+	g.P()
+
+	for _, nested := range msg.Messages {
+		generatePythonMessageStub(g, file, nested, config)
+	}
+
+	if !config.Features.Metadata {
+		return
+	}
+	hasMetadata := parseMessageMetadata(msg, config) != nil
+	if hasMetadata {
+		g.P(msgName, "Metadata: Dict[str, Any]")
+	}
+	hasFieldMetadata := false
+	for _, field := range msg.Fields {
+		if parseFieldMetadata(field, config) != nil {
+			g.P(msgName, "_", field.GoName, "_FIELD: str")
+			hasFieldMetadata = true
+		}
+	}
+	if hasFieldMetadata {
+		g.P(msgName, "FieldMetadata: Dict[str, Dict[str, Any]]")
+	}
+	if hasMetadata || hasFieldMetadata {
+		g.P()
+	}
+}
+
+func generatePythonServiceStub(g *protogen.GeneratedFile, service *protogen.Service, config *Config) {
+	serviceName := service.GoName
+
+	def := "def "
+	if config.Python.AsyncServices {
+		def = "async def "
+	}
+
+	g.P("class ", serviceName, "Service(ABC):")
+	for _, method := range service.Methods {
+		g.P("    @abstractmethod")
+		g.P("    ", def, pythonMethodSignature(method), ": ...")
+	}
+	g.P()
+
+	g.P("class Default", serviceName, "Service(", serviceName, "Service):")
+	for _, method := range service.Methods {
+		g.P("    ", def, pythonMethodSignature(method), ": ...")
+	}
+	g.P()
+}
+
+func generatePythonMethodConstantsStub(g *protogen.GeneratedFile, service *protogen.Service, config *Config) {
+	serviceName := service.GoName
+
+	g.P("class ", serviceName, "Methods:")
+	for _, method := range service.Methods {
+		g.P("    ", serviceName, "_", method.GoName, ": str")
+	}
+	if config.Features.Metadata {
+		g.P("    METHOD_METADATA: Dict[str, Dict[str, Any]]")
+	}
+	g.P()
+}
+
+// generatePythonClientStub declares a client; prefix, transportType and def
+// distinguish the asyncio client from the synchronous one
+func generatePythonClientStub(g *protogen.GeneratedFile, service *protogen.Service, prefix, transportType, def string) {
+	g.P("class ", prefix, service.GoName, "Client:")
+	g.P("    transport: ", transportType)
+	g.P("    def __init__(self, transport: ", transportType, ") -> None: ...")
+	for _, method := range service.Methods {
+		g.P("    ", def, pythonMethodSignature(method), ": ...")
+	}
+	g.P()
+}
+
+// pythonMethodSignature returns the name, parameters and return type of a service method
+func pythonMethodSignature(method *protogen.Method) string {
+	return getPythonMethodName(method.GoName) + "(self, ctx: Dict[str, Any], request: " +
+		method.Input.GoIdent.GoName + ") -> " + method.Output.GoIdent.GoName
+}
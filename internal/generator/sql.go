@@ -0,0 +1,248 @@
+package generator
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// sqlTable is a message mapped to a database table by its "table" metadata
+type sqlTable struct {
+	msg     *protogen.Message
+	schema  string
+	name    string
+	columns []*sqlColumn
+}
+
+// sqlColumn is a field mapped to a column of its message's table
+type sqlColumn struct {
+	field *protogen.Field
+	name  string
+	// sqlType is the explicit "type" of the column, or "" to map the field's type
+	sqlType string
+	// json is set for message, repeated and map fields, stored as JSON
+	json       bool
+	primaryKey bool
+	unique     bool
+	// index is "index" or "unique" for columns with an index of their own
+	index      string
+	foreignKey string
+}
+
+// collectSQLTables returns the messages of a file, including nested ones,
+// that declare a table. Scalar and enum fields become columns; message,
+// repeated and map fields only when they name a column or type, and are
+// stored as JSON.
+func collectSQLTables(file *protogen.File, config *Config) []*sqlTable {
+	var tables []*sqlTable
+	var add func(msg *protogen.Message)
+	add = func(msg *protogen.Message) {
+		if msg.Desc.IsMapEntry() {
+			return
+		}
+		metadata := parseMessageMetadata(msg, config)
+		if name := metadataString(metadata, "table"); name != "" {
+			table := &sqlTable{msg: msg, schema: metadataString(metadata, "schema"), name: name}
+			for _, field := range msg.Fields {
+				if column := newSQLColumn(field, config); column != nil {
+					table.columns = append(table.columns, column)
+				}
+			}
+			tables = append(tables, table)
+		}
+		for _, nested := range msg.Messages {
+			add(nested)
+		}
+	}
+	for _, msg := range file.Messages {
+		add(msg)
+	}
+	return tables
+}
+
+// newSQLColumn maps a field to a column, or returns nil for fields without one
+func newSQLColumn(field *protogen.Field, config *Config) *sqlColumn {
+	metadata := parseFieldMetadata(field, config)
+	name := metadataString(metadata, "column")
+	if name == "" {
+		name = metadataString(metadata, "db_column")
+	}
+	column := &sqlColumn{
+		field:      field,
+		name:       name,
+		sqlType:    metadataString(metadata, "type"),
+		json:       field.Message != nil || field.Desc.IsList(),
+		primaryKey: metadataBool(metadata["primary_key"]) || metadataString(metadata, "index") == "primary",
+		unique:     metadataBool(metadata["unique"]),
+		foreignKey: metadataString(metadata, "foreign_key"),
+	}
+	if column.json && column.name == "" && column.sqlType == "" {
+		return nil
+	}
+	if column.name == "" {
+		column.name = string(field.Desc.Name())
+	}
+	switch index := metadataString(metadata, "index"); {
+	case index == "unique":
+		column.index = "unique"
+	case metadataBool(metadata["index"]) || (index != "" && index != "primary"):
+		column.index = "index"
+	}
+	return column
+}
+
+// metadataString returns a string metadata value, or ""
+func metadataString(metadata map[string]any, key string) string {
+	value, _ := metadata[key].(string)
+	return value
+}
+
+// qualifiedName returns the quoted name of a table. SQLite has no schemas,
+// so the schema is left out there.
+func (t *sqlTable) qualifiedName(dialect string) string {
+	if t.schema == "" || dialect == sqliteSQLDialect {
+		return sqlIdentifier(t.name)
+	}
+	return sqlIdentifier(t.schema) + "." + sqlIdentifier(t.name)
+}
+
+// columnNames returns the quoted names of the columns of a table
+func (t *sqlTable) columnNames() []string {
+	names := make([]string, len(t.columns))
+	for i, column := range t.columns {
+		names[i] = sqlIdentifier(column.name)
+	}
+	return names
+}
+
+// sqlIdentifier quotes a table, column or schema name
+func sqlIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// sqlColumnType returns the column type of a field in a dialect
+func sqlColumnType(column *sqlColumn, dialect string, config *Config) string {
+	if column.sqlType != "" {
+		return strings.ToUpper(column.sqlType)
+	}
+	postgres := dialect == postgresSQLDialect
+	if column.json {
+		if postgres {
+			return "JSONB"
+		}
+		return "TEXT"
+	}
+	switch column.field.Desc.Kind().String() {
+	case "bool":
+		if postgres {
+			return "BOOLEAN"
+		}
+		return "INTEGER"
+	case "int32", "sint32", "sfixed32":
+		return "INTEGER"
+	case "int64", "sint64", "sfixed64", "uint32", "fixed32":
+		if postgres {
+			return "BIGINT"
+		}
+		return "INTEGER"
+	case "uint64", "fixed64":
+		// SQLite integers are signed 64-bit, so the values at or above 2^63
+		// would not fit; the Go helpers write decimal strings
+		if postgres {
+			return "NUMERIC(20)"
+		}
+		return "TEXT"
+	case "float", "double":
+		if postgres {
+			return "DOUBLE PRECISION"
+		}
+		return "REAL"
+	case "bytes":
+		if postgres {
+			return "BYTEA"
+		}
+		return "BLOB"
+	case "enum":
+		if isStringEnum(column.field.Enum, config) {
+			return "TEXT"
+		}
+		return "INTEGER"
+	}
+	return "TEXT"
+}
+
+// sqlReference renders the REFERENCES clause of a "foreign_key" such as
+// "customers.id" or "commerce.customers.id"
+func sqlReference(foreignKey, dialect string) string {
+	parts := strings.Split(foreignKey, ".")
+	if len(parts) == 1 {
+		return "REFERENCES " + sqlIdentifier(parts[0])
+	}
+	table := parts[:len(parts)-1]
+	if dialect == sqliteSQLDialect {
+		table = table[len(table)-1:]
+	}
+	for i, part := range table {
+		table[i] = sqlIdentifier(part)
+	}
+	return "REFERENCES " + strings.Join(table, ".") + " (" + sqlIdentifier(parts[len(parts)-1]) + ")"
+}
+
+// GenerateSQLFile writes the CREATE TABLE script of the messages of a file
+// that declare a table, in the configured dialect
+func GenerateSQLFile(gen *protogen.Plugin, file *protogen.File, config *Config) {
+	dialect := config.SQL.Dialect
+	tables := collectSQLTables(file, config)
+	if dialect == "" || len(tables) == 0 {
+		return
+	}
+
+	filename := outputPath(config.Output.SQL, strings.TrimSuffix(file.Desc.Path(), ".proto")+".sql")
+	g := gen.NewGeneratedFile(filename, "")
+	g.P("-- Code generated by protoc-gen-puregen. DO NOT EDIT.")
+	g.P("-- Dialect: ", dialect)
+	for _, table := range tables {
+		var lines, keys []string
+		for _, column := range table.columns {
+			line := sqlIdentifier(column.name) + " " + sqlColumnType(column, dialect, config)
+			if !column.json {
+				line += " NOT NULL"
+			}
+			if column.unique {
+				line += " UNIQUE"
+			}
+			if column.foreignKey != "" {
+				line += " " + sqlReference(column.foreignKey, dialect)
+			}
+			lines = append(lines, line)
+			if column.primaryKey {
+				keys = append(keys, sqlIdentifier(column.name))
+			}
+		}
+		if len(keys) > 0 {
+			lines = append(lines, "PRIMARY KEY ("+strings.Join(keys, ", ")+")")
+		}
+
+		g.P()
+		g.P("-- ", table.msg.Desc.FullName())
+		g.P("CREATE TABLE IF NOT EXISTS ", table.qualifiedName(dialect), " (")
+		for i, line := range lines {
+			if i < len(lines)-1 {
+				line += ","
+			}
+			g.P("    ", line)
+		}
+		g.P(");")
+		for _, column := range table.columns {
+			if column.index == "" {
+				continue
+			}
+			create := "CREATE INDEX"
+			if column.index == "unique" {
+				create = "CREATE UNIQUE INDEX"
+			}
+			indexName := sqlIdentifier(table.name + "_" + column.name + "_idx")
+			g.P(create, " IF NOT EXISTS ", indexName, " ON ", table.qualifiedName(dialect), " (", sqlIdentifier(column.name), ");")
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package generator
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// generatorState holds what the generators learn and create while handling a
+// CodeGeneratorRequest. The configs of every package of the request share it.
+type generatorState struct {
+	// Files of each proto package, used to apply package-level directives
+	packageFiles map[protoreflect.FullName][]protoreflect.FileDescriptor
+	// Cache of resolved file scopes by file path
+	fileScopes map[string]*fileScope
+	// Cache of puregen extension descriptors by file path and extension name
+	puregenExtensions map[string]protoreflect.ExtensionDescriptor
+	// Output files created so far that more than one proto file or message
+	// could write, such as registries, transports and package __init__.py files
+	createdFiles map[string]bool
+	// Generated files of each Go package, and the number of fields of the
+	// package whose functional option would be named With<Message><Field>
+	goPackageFiles map[protogen.GoImportPath][]*protogen.File
+	goOptionNames  map[protogen.GoImportPath]map[string]int
+}
+
+func newGeneratorState() *generatorState {
+	return &generatorState{
+		packageFiles:      make(map[protoreflect.FullName][]protoreflect.FileDescriptor),
+		fileScopes:        make(map[string]*fileScope),
+		puregenExtensions: make(map[string]protoreflect.ExtensionDescriptor),
+		createdFiles:      make(map[string]bool),
+		goPackageFiles:    make(map[protogen.GoImportPath][]*protogen.File),
+		goOptionNames:     make(map[protogen.GoImportPath]map[string]int),
+	}
+}
+
+// StartRequest gives c a new generator state for the files of a
+// CodeGeneratorRequest, recording the files of each proto package so that
+// directives on a package statement apply to all files of the package.
+// Configs returned by ForPackage afterwards share the state.
+func (c *Config) StartRequest(gen *protogen.Plugin) {
+	c.state = newGeneratorState()
+	for _, file := range gen.Files {
+		pkg := file.Desc.Package()
+		c.state.packageFiles[pkg] = append(c.state.packageFiles[pkg], file.Desc)
+		if file.Generate {
+			c.state.goPackageFiles[file.GoImportPath] = append(c.state.goPackageFiles[file.GoImportPath], file)
+		}
+	}
+}
+
+// createOnce records that an output file is created and reports whether it
+// was not created before in the request. Files shared by several proto files
+// are only written by the first of them.
+func (s *generatorState) createOnce(filename string) bool {
+	if s.createdFiles[filename] {
+		return false
+	}
+	s.createdFiles[filename] = true
+	return true
+}
+
+// goOptionNameCounts returns how many fields of the messages generated in a Go
+// package would have each functional option name With<Message><Field>. The
+// messages include those redefined locally from imported packages.
+func (s *generatorState) goOptionNameCounts(importPath protogen.GoImportPath) map[string]int {
+	if counts, ok := s.goOptionNames[importPath]; ok {
+		return counts
+	}
+	counts := make(map[string]int)
+	seen := make(map[protoreflect.FullName]bool)
+	var count func(msg *protogen.Message)
+	count = func(msg *protogen.Message) {
+		if msg.Desc.IsMapEntry() || seen[msg.Desc.FullName()] {
+			return
+		}
+		seen[msg.Desc.FullName()] = true
+		for _, field := range msg.Fields {
+			counts["With"+msg.GoIdent.GoName+field.GoName]++
+		}
+		for _, nested := range msg.Messages {
+			count(nested)
+		}
+	}
+	for _, file := range s.goPackageFiles[importPath] {
+		for _, msg := range collectImportedMessages(file) {
+			count(msg)
+		}
+		for _, msg := range file.Messages {
+			count(msg)
+		}
+	}
+	s.goOptionNames[importPath] = counts
+	return counts
+}
@@ -0,0 +1,19 @@
+package deprecation
+
+import "testing"
+
+func TestDeprecatedMetadata(t *testing.T) {
+	if ContactFieldMetadata[Contact_Fax_FIELD]["deprecated"] != true {
+		t.Errorf("Contact.fax metadata = %v", ContactFieldMetadata[Contact_Fax_FIELD])
+	}
+	if _, ok := ContactFieldMetadata["Contact_Name"]; ok {
+		t.Error("Contact.name has metadata")
+	}
+
+	if ContactServiceMethodMetadata[ContactService_GetLegacyContact]["deprecated"] != true {
+		t.Errorf("GetLegacyContact metadata = %v", ContactServiceMethodMetadata[ContactService_GetLegacyContact])
+	}
+	if _, ok := ContactServiceMethodMetadata[ContactService_GetContact]; ok {
+		t.Error("GetContact has metadata")
+	}
+}
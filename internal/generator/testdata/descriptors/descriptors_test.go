@@ -0,0 +1,68 @@
+package descriptors
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindMessageDescriptor(t *testing.T) {
+	d, ok := FindMessageDescriptor("puregen.test.descriptors.Item")
+	if !ok || d != ItemDescriptor || (&Item{}).Descriptor() != d {
+		t.Fatalf("FindMessageDescriptor(Item) = %v, %v", d, ok)
+	}
+	if _, ok := FindMessageDescriptor("puregen.test.descriptors.Missing"); ok {
+		t.Error("FindMessageDescriptor(Missing) succeeded")
+	}
+
+	var names []string
+	for _, d := range MessageDescriptors() {
+		names = append(names, d.FullName)
+	}
+	want := []string{
+		"puregen.test.descriptors.Catalog",
+		"puregen.test.descriptors.Empty",
+		"puregen.test.descriptors.Item",
+		"puregen.test.descriptors.Item.Note",
+	}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("MessageDescriptors() = %v, want %v", names, want)
+	}
+}
+
+func TestFieldDescriptors(t *testing.T) {
+	if got := ItemDescriptor.Metadata["table"]; got != "items" {
+		t.Errorf("Item metadata table = %v", got)
+	}
+
+	tests := []PuregenFieldDescriptor{
+		{Name: "id", GoName: "Id", JSONName: "id", Number: 1, Kind: "string",
+			Metadata: map[string]any{"db_column": "item_id", "primary_key": true}},
+		{Name: "price", GoName: "Price", JSONName: "price", Number: 2, Kind: "int64", Optional: true},
+		{Name: "tags", GoName: "Tags", JSONName: "tags", Number: 3, Kind: "string", Repeated: true},
+		{Name: "kind", GoName: "Kind", JSONName: "kind", Number: 4, Kind: "enum", TypeName: "puregen.test.descriptors.Kind"},
+		{Name: "display_title", GoName: "DisplayTitle", JSONName: "title", Number: 5, Kind: "string"},
+		{Name: "notes", GoName: "Notes", JSONName: "notes", Number: 6, Kind: "message", TypeName: "puregen.test.descriptors.Item.Note", Repeated: true},
+	}
+	if len(ItemDescriptor.Fields) != len(tests) {
+		t.Fatalf("Item has %d fields, want %d", len(ItemDescriptor.Fields), len(tests))
+	}
+	for i, want := range tests {
+		if got := *ItemDescriptor.Fields[i]; !reflect.DeepEqual(got, want) {
+			t.Errorf("Item field %d = %+v, want %+v", i, got, want)
+		}
+	}
+
+	items := CatalogDescriptor.FieldByName("items")
+	if items == nil || !items.Map || items.MapKey != "string" || items.Kind != "message" || items.TypeName != "puregen.test.descriptors.Item" {
+		t.Errorf("Catalog.items = %+v", items)
+	}
+	if labels := CatalogDescriptor.FieldByNumber(2); labels == nil || labels.MapKey != "int32" || labels.Kind != "string" {
+		t.Errorf("Catalog.labels = %+v", labels)
+	}
+	if ItemDescriptor.FieldByName("title") != ItemDescriptor.Fields[4] {
+		t.Error("FieldByName does not find fields by JSON name")
+	}
+	if len(EmptyDescriptor.Fields) != 0 {
+		t.Errorf("Empty has fields %v", EmptyDescriptor.Fields)
+	}
+}
@@ -0,0 +1,133 @@
+package enums
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestAliases(t *testing.T) {
+	if State_STATE_STARTED != State_STATE_RUNNING {
+		t.Errorf("STATE_STARTED = %d, want %d", State_STATE_STARTED, State_STATE_RUNNING)
+	}
+	// An aliased number is named after its first value
+	if got := State_STATE_STARTED.String(); got != "STATE_RUNNING" {
+		t.Errorf("STATE_STARTED.String() = %q", got)
+	}
+	if got, err := ParseState("STATE_STARTED"); err != nil || got != State_STATE_RUNNING {
+		t.Errorf("ParseState(STATE_STARTED) = %v, %v", got, err)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var job Job
+	data := `{"id": "j-1", "state": "STATE_STARTED", "history": [0, "STATE_DONE", 7], "stateByStep": {"build": 2, "test": "STATE_RUNNING"}}`
+	if err := job.FromJSON([]byte(data)); err != nil {
+		t.Fatalf("FromJSON() error = %v", err)
+	}
+	want := Job{
+		Id:          "j-1",
+		State:       State_STATE_RUNNING,
+		History:     []State{State_STATE_UNSPECIFIED, State_STATE_DONE, 7},
+		StateByStep: map[string]State{"build": State_STATE_DONE, "test": State_STATE_RUNNING},
+	}
+	if !reflect.DeepEqual(job, want) {
+		t.Errorf("FromJSON() = %+v, want %+v", job, want)
+	}
+
+	// Unknown numbers survive a round trip. Int enums are written as numbers,
+	// or as names with go_int_enums=name, which generates MarshalJSON.
+	if job.History[2].IsValid() {
+		t.Error("State(7).IsValid() = true")
+	}
+	wantJSON := "[0,2,7]"
+	if _, names := any(State(0)).(json.Marshaler); names {
+		wantJSON = `["STATE_UNSPECIFIED","STATE_DONE",7]`
+	}
+	out, err := json.Marshal(job.History)
+	if err != nil || string(out) != wantJSON {
+		t.Errorf("Marshal(history) = %s, %v, want %s", out, err, wantJSON)
+	}
+	var history []State
+	if err := json.Unmarshal(out, &history); err != nil || !reflect.DeepEqual(history, job.History) {
+		t.Errorf("Unmarshal(%s) = %v, %v", out, history, err)
+	}
+
+	// Unknown names are errors
+	var state State
+	if err := json.Unmarshal([]byte(`"STATE_PAUSED"`), &state); err == nil {
+		t.Error("Unmarshal(STATE_PAUSED) succeeded")
+	}
+}
+
+func TestTypedStringEnums(t *testing.T) {
+	job := NewJob(WithJobPriority(Priority_PRIORITY_HIGH), WithJobColors([]Color{Color_COLOR_RED}))
+	data, err := job.ToJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Job
+	if err := got.FromJSON(data); err != nil {
+		t.Fatalf("FromJSON(%s) error = %v", data, err)
+	}
+	if got.Priority != Priority_PRIORITY_HIGH || !reflect.DeepEqual(got.Colors, []Color{Color_COLOR_RED}) {
+		t.Errorf("FromJSON(%s) = %+v", data, got)
+	}
+
+	if !reflect.DeepEqual(PriorityValues(), []Priority{Priority_PRIORITY_UNSPECIFIED, Priority_PRIORITY_LOW, Priority_PRIORITY_HIGH}) {
+		t.Errorf("PriorityValues() = %v", PriorityValues())
+	}
+	if Priority("PRIORITY_URGENT").IsValid() || !Priority_PRIORITY_LOW.IsValid() {
+		t.Error("IsValid() disagrees with PriorityValues()")
+	}
+	if _, err := ParsePriority("PRIORITY_URGENT"); err == nil {
+		t.Error("ParsePriority(PRIORITY_URGENT) succeeded")
+	}
+
+	// Strict enums reject unknown values; others keep them
+	if err := got.FromJSON([]byte(`{"priority": "PRIORITY_URGENT"}`)); err == nil {
+		t.Error("FromJSON accepted an unknown Priority")
+	}
+	if err := got.FromJSON([]byte(`{"colors": ["COLOR_BLUE"]}`)); err != nil || got.Colors[0] != "COLOR_BLUE" {
+		t.Errorf("FromJSON(COLOR_BLUE) = %v, %v", got.Colors, err)
+	}
+}
+
+func TestValueMetadata(t *testing.T) {
+	if got := StateValueMetadata[State_STATE_DONE]["terminal"]; got != true {
+		t.Errorf("StateValueMetadata[STATE_DONE][terminal] = %v", got)
+	}
+	// Aliases share the entry of the value they alias
+	if got := StateValueMetadata[State_STATE_STARTED]["order"]; got != 1 {
+		t.Errorf("StateValueMetadata[STATE_STARTED][order] = %v", got)
+	}
+	if _, ok := StateValueMetadata[State_STATE_UNSPECIFIED]; ok {
+		t.Error("StateValueMetadata has an entry for STATE_UNSPECIFIED")
+	}
+	if !reflect.DeepEqual(StageValueMetadata[Stage_STAGE_BUILD]["steps"], []any{"compile", "link"}) {
+		t.Errorf("StageValueMetadata[STAGE_BUILD][steps] = %v", StageValueMetadata[Stage_STAGE_BUILD]["steps"])
+	}
+	if got := StageValueMetadata[Stage_STAGE_TEST]["deprecated"]; got != true {
+		t.Errorf("StageValueMetadata[STAGE_TEST][deprecated] = %v", got)
+	}
+}
+
+func TestDisplayName(t *testing.T) {
+	tests := []struct {
+		got, want string
+	}{
+		{State_STATE_RUNNING.DisplayName(), "Running"},
+		{State_STATE_STARTED.DisplayName(), "Running"},
+		{State_STATE_UNSPECIFIED.DisplayName(), "STATE_UNSPECIFIED"},
+		{State(7).DisplayName(), "State(7)"},
+		{Priority_PRIORITY_HIGH.DisplayName(), "High priority"},
+		{Priority_PRIORITY_LOW.DisplayName(), "PRIORITY_LOW"},
+		{StageDisplayName(Stage_STAGE_BUILD), "Build"},
+		{StageDisplayName("STAGE_DEPLOY"), "STAGE_DEPLOY"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("DisplayName() = %q, want %q", tt.got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package fieldmask
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestUpdateRequestRoundTrip(t *testing.T) {
+	request := &UpdateUserRequest{
+		User:       &User{Id: "u-1", DisplayName: "Ada", Profile: &Profile{Bio: "math", AvatarUrl: "a.png"}},
+		UpdateMask: &PuregenFieldMask{Paths: []string{"display_name", "profile.avatar_url"}},
+	}
+
+	data, err := request.ToJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Masks are strings of lowerCamelCase paths, as in protobuf's JSON mapping
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if got := string(fields["updateMask"]); got != `"displayName,profile.avatarUrl"` {
+		t.Errorf("updateMask = %s", got)
+	}
+
+	var decoded UpdateUserRequest
+	if err := decoded.FromJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(request) {
+		t.Errorf("round trip of %s = %+v", data, decoded)
+	}
+	if err := decoded.FromJSON([]byte(`{"updateMask": ["name"]}`)); err == nil {
+		t.Error("FromJSON accepted a mask that is not a string")
+	}
+}
+
+func TestApplyFieldMask(t *testing.T) {
+	request := &UpdateUserRequest{}
+	if err := request.FromJSON([]byte(`{"user": {"id": "u-2", "displayName": "Grace", "profile": {"bio": "navy", "avatarUrl": "g.png"}, "tags": ["x"]}, "updateMask": "displayName,profile.avatarUrl"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"display_name", "profile.avatar_url"}; !reflect.DeepEqual(request.UpdateMask.GetPaths(), want) {
+		t.Errorf("Paths = %q, want %q", request.UpdateMask.GetPaths(), want)
+	}
+
+	stored := &User{Id: "u-2", DisplayName: "Ada", Profile: &Profile{Bio: "math", AvatarUrl: "a.png"}, Tags: []string{"old"}}
+	applied := stored.Clone()
+	if err := applied.ApplyFieldMask(request.User, request.UpdateMask); err != nil {
+		t.Fatal(err)
+	}
+	want := &User{Id: "u-2", DisplayName: "Grace", Profile: &Profile{Bio: "math", AvatarUrl: "g.png"}, Tags: []string{"old"}}
+	if !applied.Equal(want) {
+		t.Errorf("ApplyFieldMask = %+v, want %+v", applied, want)
+	}
+
+	// Lists named by the mask are appended, the others are left alone
+	merged := stored.Clone()
+	if err := merged.MergeFieldMask(request.User, &PuregenFieldMask{Paths: []string{"tags", "profile.bio"}}); err != nil {
+		t.Fatal(err)
+	}
+	want = &User{Id: "u-2", DisplayName: "Ada", Profile: &Profile{Bio: "navy", AvatarUrl: "a.png"}, Tags: []string{"old", "x"}}
+	if !merged.Equal(want) {
+		t.Errorf("MergeFieldMask = %+v, want %+v", merged, want)
+	}
+
+	// The fields outside the mask keep their values rather than taking the
+	// defaults of NewUser, and a masked field set to its default is applied
+	merged = &User{Id: "u-2", DisplayName: "Grace"}
+	if err := merged.MergeFieldMask(&User{DisplayName: "Ada", Profile: &Profile{Bio: "navy"}}, &PuregenFieldMask{Paths: []string{"profile.bio"}}); err != nil {
+		t.Fatal(err)
+	}
+	if want := (&User{Id: "u-2", DisplayName: "Grace", Profile: &Profile{Bio: "navy"}}); !merged.Equal(want) {
+		t.Errorf("MergeFieldMask = %+v, want %+v", merged, want)
+	}
+	if err := merged.MergeFieldMask(NewUser(), &PuregenFieldMask{Paths: []string{"display_name"}}); err != nil || merged.DisplayName != "Anonymous" {
+		t.Errorf("MergeFieldMask of the default = %v, %+v", err, merged)
+	}
+
+	if err := stored.ApplyFieldMask(request.User, &PuregenFieldMask{Paths: []string{"unknown"}}); err == nil {
+		t.Error("ApplyFieldMask accepted an unknown path")
+	}
+	// A nil mask names no fields
+	if err := stored.ApplyFieldMask(request.User, nil); err != nil || stored.DisplayName != "Ada" {
+		t.Errorf("ApplyFieldMask(nil) = %v, %+v", err, stored)
+	}
+}
@@ -0,0 +1,29 @@
+package jsonmode
+
+import "testing"
+
+// JSON settings resolve from the package, then the file, then the message,
+// then the field
+func TestInheritedJSONSettings(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  interface{ ToJSON() ([]byte, error) }
+		want string
+	}{
+		{"package names, file omitEmpty", &Account{AccountId: "a-1"}, `{"account_id":"a-1"}`},
+		{"message names, field override", &Contact{}, `{"phone_number":""}`},
+		{"message names", &Contact{EmailAddress: "a@example.com"}, `{"emailAddress":"a@example.com","phone_number":""}`},
+		{"package names only", &Order{OrderId: "o-1"}, `{"order_id":"o-1","total_cents":0}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.msg.ToJSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("ToJSON() = %s, want %s", data, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package jsonnames
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Every json_naming mode accepts both the proto field names and the JSON names
+func TestFromJSONAcceptsBothNames(t *testing.T) {
+	want := &Team{
+		Name:          "core",
+		LeadProfile:   &Profile{Id: "p-1", DisplayName: "Jane", EmailAddress: "jane@example.com", TagList: []string{"a"}},
+		MembersByRole: map[string]*Profile{"dev": {DisplayName: "Joe", EmailAddress: "joe@example.com"}},
+	}
+	for _, data := range []string{
+		`{"name": "core", "lead_profile": {"id": "p-1", "display_name": "Jane", "email_address": "jane@example.com", "tag_list": ["a"]},
+		  "members_by_role": {"dev": {"display_name": "Joe", "email_address": "joe@example.com"}}}`,
+		`{"name": "core", "leadProfile": {"id": "p-1", "displayName": "Jane", "mail": "jane@example.com", "tagList": ["a"]},
+		  "membersByRole": {"dev": {"displayName": "Joe", "mail": "joe@example.com"}}}`,
+	} {
+		var got Team
+		if err := got.FromJSON([]byte(data)); err != nil {
+			t.Fatalf("FromJSON(%s) error = %v", data, err)
+		}
+		if !reflect.DeepEqual(&got, want) {
+			t.Errorf("FromJSON(%s) = %+v, want %+v", data, &got, want)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	want := &Profile{Id: "p-1", DisplayName: "Jane", EmailAddress: "jane@example.com"}
+	data, err := want.ToJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Profile
+	if err := got.FromJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("round trip of %s = %+v, want %+v", data, &got, want)
+	}
+
+	var empty Profile
+	if err := empty.FromJSON([]byte("null")); err != nil {
+		t.Errorf("FromJSON(null) error = %v", err)
+	}
+}
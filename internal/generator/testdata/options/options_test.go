@@ -0,0 +1,42 @@
+package options
+
+import "testing"
+
+func TestOptionDefaults(t *testing.T) {
+	account := NewAccount()
+	tests := []struct {
+		field, got, want string
+	}{
+		{"name", account.Name, "Anonymous"},
+		// The option wins over the comment directive
+		{"plan", account.Plan, "free"},
+		{"zone", account.Zone, "eu"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("NewAccount().%s = %q, want %q", tt.field, tt.got, tt.want)
+		}
+	}
+}
+
+func TestOptionEnumTypes(t *testing.T) {
+	// The file option makes Status an int enum; Region's own option keeps it
+	// string constants
+	if got := Status_STATUS_ACTIVE.String(); got != "STATUS_ACTIVE" {
+		t.Errorf("STATUS_ACTIVE.String() = %q", got)
+	}
+	account := Account{Status: Status_STATUS_ACTIVE, Region: Region_REGION_EU}
+	if account.Region != "REGION_EU" {
+		t.Errorf("Region = %q", account.Region)
+	}
+}
+
+func TestOptionMetadata(t *testing.T) {
+	if got := AccountMetadata["table"]; got != "accounts" {
+		t.Errorf("AccountMetadata[table] = %v", got)
+	}
+	method := AccountServiceMethodMetadata[AccountService_GetAccount]
+	if method["method"] != "GET" || method["path"] != "/accounts/{id}" {
+		t.Errorf("AccountServiceMethodMetadata[GetAccount] = %v", method)
+	}
+}
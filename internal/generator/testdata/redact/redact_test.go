@@ -0,0 +1,102 @@
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func newAccount() *Account {
+	return NewAccount(
+		WithAccountId("a-1"),
+		WithAccountEmail("jane@example.com"),
+		WithAccountRecoveryCodes([]string{"code-1"}),
+		WithAccountPin(1234),
+		WithAccountCredentials(&Credentials{Username: "jane", Password: "hunter2", Key: []byte("key")}),
+		WithAccountHistory([]*Credentials{{Username: "old", Password: "old-secret"}, nil}),
+		WithAccountByHost(map[string]*Credentials{"db": {Username: "app", Password: "db-secret"}}),
+		WithAccountDisplayName("Jane"),
+	)
+}
+
+// secrets are the sensitive values set by newAccount
+var secrets = []string{"jane@example.com", "code-1", "1234", "hunter2", "old-secret", "db-secret"}
+
+func TestRedacted(t *testing.T) {
+	account := newAccount()
+	r := account.Redacted()
+
+	want := &Account{
+		Id:          "a-1",
+		Email:       "[REDACTED]",
+		Credentials: &Credentials{Username: "jane", Password: "[REDACTED]"},
+		History:     []*Credentials{{Username: "old", Password: "[REDACTED]"}, nil},
+		ByHost:      map[string]*Credentials{"db": {Username: "app", Password: "[REDACTED]"}},
+		DisplayName: "Jane",
+	}
+	if !r.Equal(want) {
+		t.Errorf("Redacted() = %+v, want %+v", *r, *want)
+	}
+	if !account.Equal(newAccount()) {
+		t.Errorf("Redacted() changed the original: %+v", *account)
+	}
+	if got := (&Credentials{}).Redacted(); got.Password != "" {
+		t.Errorf("unset password redacted to %q", got.Password)
+	}
+	if (*Account)(nil).Redacted() != nil {
+		t.Error("Redacted() of nil is not nil")
+	}
+}
+
+func TestString(t *testing.T) {
+	for _, s := range []string{newAccount().String(), fmt.Sprint(newAccount()), fmt.Sprintf("%v", []*Account{newAccount()})} {
+		for _, secret := range secrets {
+			if strings.Contains(s, secret) {
+				t.Errorf("%s contains %q", s, secret)
+			}
+		}
+		if !strings.Contains(s, "Jane") {
+			t.Errorf("%s lacks non-sensitive fields", s)
+		}
+	}
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	logger.Info("request", "account", newAccount())
+
+	for _, secret := range secrets {
+		if strings.Contains(buf.String(), secret) {
+			t.Errorf("log %s contains %q", buf.String(), secret)
+		}
+	}
+	var record struct {
+		Account map[string]any `json:"account"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatal(err)
+	}
+	if record.Account["email"] != "[REDACTED]" || record.Account["displayName"] != "Jane" {
+		t.Errorf("logged account = %v", record.Account)
+	}
+	credentials, _ := record.Account["credentials"].(map[string]any)
+	if credentials["username"] != "jane" || credentials["password"] != "[REDACTED]" {
+		t.Errorf("logged credentials = %v", credentials)
+	}
+}
+
+func TestToJSONUnaltered(t *testing.T) {
+	data, err := newAccount().ToJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"jane@example.com", "hunter2", "db-secret"} {
+		if !bytes.Contains(data, []byte(secret)) {
+			t.Errorf("ToJSON() = %s, lacks %q", data, secret)
+		}
+	}
+}
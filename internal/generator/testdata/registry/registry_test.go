@@ -0,0 +1,132 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"example.com/puregentest/registry/shipping"
+)
+
+func TestPackUnpack(t *testing.T) {
+	env, err := Pack(&OrderPlaced{OrderId: "o-1", Amount: 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.TypeURL != "type.googleapis.com/puregen.test.registry.OrderPlaced" {
+		t.Errorf("TypeURL = %q", env.TypeURL)
+	}
+	if !env.Is(&OrderPlaced{}) || env.Is(&OrderCancelled{}) {
+		t.Error("Is does not match the packed type")
+	}
+
+	msg, err := Unpack(env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	placed, ok := msg.(*OrderPlaced)
+	if !ok || placed.OrderId != "o-1" || placed.Amount != 42 {
+		t.Errorf("Unpack = %#v", msg)
+	}
+
+	var cancelled OrderCancelled
+	if err := env.UnpackTo(&cancelled); err == nil {
+		t.Error("UnpackTo(OrderCancelled) succeeded")
+	}
+	if _, err := Unpack(&PuregenEnvelope{TypeURL: "type.googleapis.com/other.Missing"}); err == nil {
+		t.Error("Unpack(other.Missing) succeeded")
+	}
+	if msg, ok := NewMessage("puregen.test.registry.Event"); !ok || msg.ProtoName() != "puregen.test.registry.Event" {
+		t.Errorf("NewMessage(Event) = %v, %v", msg, ok)
+	}
+}
+
+func TestEnvelopeFields(t *testing.T) {
+	payload, _ := Pack(&OrderCancelled{OrderId: "o-2", Reason: "late"})
+	event := &Event{
+		Id:          "e-1",
+		Payload:     payload,
+		Attachments: []*PuregenEnvelope{payload},
+		Extras:      map[string]*PuregenEnvelope{"first": payload},
+	}
+
+	data, err := event.ToJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Event
+	if err := decoded.FromJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(event) {
+		t.Errorf("round trip of %s = %+v", data, decoded)
+	}
+	var fields map[string]json.RawMessage
+	json.Unmarshal(data, &fields)
+	var envelope map[string]json.RawMessage
+	json.Unmarshal(fields["payload"], &envelope)
+	if _, ok := envelope["type_url"]; !ok {
+		t.Errorf("payload is not an envelope: %s", fields["payload"])
+	}
+
+	// Envelopes are deep copied, and replaced by Merge
+	clone := event.Clone()
+	clone.Payload.Payload[0] = ' '
+	if !event.Payload.Equal(payload) || clone.Payload.Equal(payload) {
+		t.Error("Clone shares the payload")
+	}
+	other, _ := Pack(&OrderPlaced{OrderId: "o-3"})
+	event.Merge(&Event{Payload: other})
+	if !event.Payload.Is(&OrderPlaced{}) {
+		t.Errorf("Merge kept %q", event.Payload.TypeURL)
+	}
+
+	// Redacted drops payloads, which may hold sensitive fields
+	if r := event.Redacted(); r.Payload.Payload != nil || r.Payload.TypeURL != other.TypeURL {
+		t.Errorf("Redacted payload = %+v", r.Payload)
+	}
+}
+
+// unpackAny resolves envelopes from the registries of both packages: the
+// shared one with common_namespace, else each package's in turn
+func unpackAny(e *PuregenEnvelope) (PuregenMessage, error) {
+	if msg, ok := NewMessage(e.MessageName()); ok {
+		return msg, e.UnpackTo(msg)
+	}
+	if msg, ok := shipping.NewMessage(e.MessageName()); ok {
+		return msg, e.UnpackTo(msg)
+	}
+	return nil, fmt.Errorf("unknown message type %q", e.TypeURL)
+}
+
+func TestRegistriesOfSeveralPackages(t *testing.T) {
+	packed, err := shipping.Pack(&shipping.ShipmentSent{OrderId: "o-4", Carrier: "post"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Envelopes of the packages are one type with common_namespace, and
+	// convert into each other without it
+	event := &Event{Id: "e-4", Payload: (*PuregenEnvelope)(packed)}
+	shared := reflect.TypeOf(PuregenEnvelope{}) == reflect.TypeOf(shipping.PuregenEnvelope{})
+
+	// The shared registry resolves the messages of every package
+	msg, err := Unpack(event.Payload)
+	if shared != (err == nil) {
+		t.Errorf("Unpack(ShipmentSent) = %v, %v with shared registry %v", msg, err, shared)
+	}
+	if _, ok := NewMessage("puregen.test.registry.shipping.ShipmentSent"); ok != shared {
+		t.Errorf("NewMessage(ShipmentSent) found = %v with shared registry %v", ok, shared)
+	}
+
+	msg, err = unpackAny(event.Payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent, ok := msg.(*shipping.ShipmentSent); !ok || sent.Carrier != "post" {
+		t.Errorf("unpackAny = %#v", msg)
+	}
+	if _, err := unpackAny(&PuregenEnvelope{TypeURL: "type.googleapis.com/other.Missing"}); err == nil {
+		t.Error("unpackAny(other.Missing) succeeded")
+	}
+}
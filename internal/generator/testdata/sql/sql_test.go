@@ -0,0 +1,98 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// rowStore is a database/sql driver that keeps the arguments of the last
+// INSERT and returns them as the only row of every query
+type rowStore struct {
+	row []driver.Value
+}
+
+func (s *rowStore) Open(string) (driver.Conn, error) { return conn{s}, nil }
+
+type conn struct{ store *rowStore }
+
+func (c conn) Prepare(string) (driver.Stmt, error) { return stmt{c.store}, nil }
+func (c conn) Close() error                        { return nil }
+func (c conn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+
+type stmt struct{ store *rowStore }
+
+func (s stmt) Close() error  { return nil }
+func (s stmt) NumInput() int { return -1 }
+
+func (s stmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.store.row = args
+	return driver.RowsAffected(1), nil
+}
+
+func (s stmt) Query([]driver.Value) (driver.Rows, error) {
+	return &rows{columns: OrderColumns, row: s.store.row}, nil
+}
+
+type rows struct {
+	columns []string
+	row     []driver.Value
+}
+
+func (r *rows) Columns() []string { return r.columns }
+func (r *rows) Close() error      { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.row == nil {
+		return io.EOF
+	}
+	copy(dest, r.row)
+	r.row = nil
+	return nil
+}
+
+func TestOrderRoundTrip(t *testing.T) {
+	sql.Register("rowstore", &rowStore{})
+	db, err := sql.Open("rowstore", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	want := NewOrder(
+		WithOrderId("o-1"),
+		WithOrderCustomerId("c-1"),
+		WithOrderReference("r-1"),
+		WithOrderTotalCents(4200),
+		// database/sql rejects uint64 arguments at or above 2^63
+		WithOrderSequence(math.MaxUint64),
+		WithOrderPaid(true),
+		WithOrderWeight(1.5),
+		WithOrderSignature([]byte{1, 2}),
+		WithOrderStatus(OrderStatus_ORDER_STATUS_SHIPPED),
+		WithOrderAddress(&Address{Street: "1 Main St", City: "Springfield"}),
+	)
+	if err := InsertOrder(context.Background(), db, want); err != nil {
+		t.Fatal(err)
+	}
+
+	rs, err := db.Query(SelectOrderSQL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rs.Close()
+	if !rs.Next() {
+		t.Fatal("no row")
+	}
+	got, err := ScanOrder(rs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ScanOrder = %+v, want %+v", got, want)
+	}
+}
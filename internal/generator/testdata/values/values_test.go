@@ -0,0 +1,144 @@
+package values
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func newOrder() *Order {
+	return NewOrder(
+		WithOrderId("o-1"),
+		WithOrder_ItemCount(2),
+		WithOrderPayload([]byte("payload")),
+		WithOrderChunks([][]byte{[]byte("a"), nil}),
+		WithOrderLabels([]string{"x"}),
+		WithOrderTag(&Tag{Name: "t", Data: []byte("d")}),
+		WithOrderTags([]*Tag{{Name: "t1"}, nil}),
+		WithOrderCounters(map[string]int64{"a": 1}),
+		WithOrderTagsById(map[int32]*Tag{7: {Name: "seven"}}),
+		WithOrderBlobs(map[string][]byte{"b": []byte("blob")}),
+		WithOrderLine(&Order_Line{Sku: "sku"}),
+	)
+}
+
+func TestOptions(t *testing.T) {
+	if item := NewOrderItem(WithOrderItem_Count(3)); item.Count != 3 {
+		t.Errorf("Count = %d, want 3", item.Count)
+	}
+	if order := newOrder(); order.ItemCount != 2 || order.Counters["a"] != 1 {
+		t.Errorf("options not applied: %+v", order)
+	}
+}
+
+func TestGetters(t *testing.T) {
+	var order *Order
+	if order.GetId() != "" || order.GetTag() != nil || order.GetCounters() != nil || order.GetTag().GetName() != "" {
+		t.Error("getters of a nil message did not return zero values")
+	}
+	if got := newOrder().GetTagsById()[7].GetName(); got != "seven" {
+		t.Errorf("GetTagsById()[7].GetName() = %q, want seven", got)
+	}
+}
+
+func TestClone(t *testing.T) {
+	order := newOrder()
+	c := order.Clone()
+	if !c.Equal(order) {
+		t.Fatalf("Clone() = %+v, want equal to %+v", c, order)
+	}
+
+	// The copy shares no memory with the original
+	c.Payload[0] = 'P'
+	c.Chunks[0][0] = 'A'
+	c.Labels[0] = "y"
+	c.Tag.Name = "changed"
+	c.Tag.Data[0] = 'D'
+	c.Tags[0].Name = "changed"
+	c.Counters["a"] = 2
+	c.TagsById[7].Name = "changed"
+	c.Blobs["b"][0] = 'B'
+	c.Line.Sku = "changed"
+	if !order.Equal(newOrder()) {
+		t.Errorf("changing the clone changed the original: %+v", order)
+	}
+	if c.Chunks[1] != nil || c.Tags[1] != nil {
+		t.Error("Clone() did not keep nil elements")
+	}
+	if (*Order)(nil).Clone() != nil {
+		t.Error("Clone() of nil is not nil")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  *Order
+		equal bool
+	}{
+		{name: "nil and nil", equal: true},
+		{name: "nil and empty", b: &Order{}, equal: false},
+		{name: "nil and empty slices, maps and bytes", a: &Order{}, b: &Order{Payload: []byte{}, Labels: []string{}, Counters: map[string]int64{}}, equal: true},
+		{name: "unset and empty message", a: &Order{}, b: &Order{Tag: &Tag{}}, equal: false},
+		{name: "same map", a: &Order{Counters: map[string]int64{"a": 1}}, b: &Order{Counters: map[string]int64{"a": 1}}, equal: true},
+		{name: "different map value", a: &Order{Counters: map[string]int64{"a": 1}}, b: &Order{Counters: map[string]int64{"a": 2}}, equal: false},
+		{name: "different map key", a: &Order{Counters: map[string]int64{"a": 0}}, b: &Order{Counters: map[string]int64{"b": 0}}, equal: false},
+		{name: "map message values", a: &Order{TagsById: map[int32]*Tag{1: {Name: "x"}}}, b: &Order{TagsById: map[int32]*Tag{1: {Name: "y"}}}, equal: false},
+		{name: "map bytes values", a: &Order{Blobs: map[string][]byte{"b": nil}}, b: &Order{Blobs: map[string][]byte{"b": {}}}, equal: true},
+		{name: "optional field", a: &Order{Note: "n"}, b: &Order{}, equal: false},
+		{name: "full", a: newOrder(), b: newOrder(), equal: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Equal(tt.b); got != tt.equal {
+				t.Errorf("a.Equal(b) = %v, want %v", got, tt.equal)
+			}
+			if got := tt.b.Equal(tt.a); got != tt.equal {
+				t.Errorf("b.Equal(a) = %v, want %v", got, tt.equal)
+			}
+		})
+	}
+}
+
+// Map fields are JSON objects keyed by strings, with base64 bytes values, the
+// form Python's to_dict writes and from_dict reads
+func TestMapJSON(t *testing.T) {
+	data, err := newOrder().ToJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{
+		"counters": `{"a":1}`,
+		"tagsById": `{"7":{"name":"seven","data":null}}`,
+		"blobs":    `{"b":"YmxvYg=="}`,
+	} {
+		if got := string(fields[name]); got != want {
+			t.Errorf("%s = %s, want %s", name, got, want)
+		}
+	}
+	decoded := &Order{}
+	if err := decoded.FromJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(newOrder()) {
+		t.Errorf("FromJSON(ToJSON()) = %+v", decoded)
+	}
+
+	// As written by Python, which leaves out unset fields
+	python := `{"counters": {"a": 1}, "tagsById": {"7": {"name": "seven"}}, "blobs": {"b": "YmxvYg=="}}`
+	decoded = &Order{}
+	if err := decoded.FromJSON([]byte(python)); err != nil {
+		t.Fatal(err)
+	}
+	want := NewOrder(
+		WithOrderCounters(map[string]int64{"a": 1}),
+		WithOrderTagsById(map[int32]*Tag{7: {Name: "seven"}}),
+		WithOrderBlobs(map[string][]byte{"b": []byte("blob")}),
+	)
+	if !decoded.Equal(want) {
+		t.Errorf("FromJSON(%s) = %+v", python, decoded)
+	}
+}
@@ -0,0 +1,674 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        (unknown)
+// source: puregen/options.proto
+
+// Custom options for protoc-gen-puregen.
+//
+// These options are an alternative to the puregen:generate and
+// puregen:metadata comment directives. They are checked by the protobuf
+// compiler and visible to other tools. When an element carries both an
+// option and a comment directive, the option takes precedence.
+//
+// Usage:
+//
+//   import "puregen/options.proto";
+//
+//   message User {
+//     option (puregen.message) = { metadata: '{"table": "users"}' };
+//     string name = 1 [(puregen.field) = { default: "Anonymous" }];
+//   }
+
+package puregen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EnumType controls how an enum is generated
+type EnumType int32
+
+const (
+	// Use the default (string constants)
+	EnumType_ENUM_TYPE_UNSPECIFIED EnumType = 0
+	// Generate string constants, same as puregen:generate: {"enumType": "string"}
+	EnumType_ENUM_TYPE_STRING EnumType = 1
+	// Generate an integer enum, same as puregen:generate: {"enumType": "int"}
+	EnumType_ENUM_TYPE_INT EnumType = 2
+	// Generate a named string type in Go and string constants elsewhere, same
+	// as puregen:generate: {"enumType": "typed_string"}
+	EnumType_ENUM_TYPE_TYPED_STRING EnumType = 3
+)
+
+// Enum value maps for EnumType.
+var (
+	EnumType_name = map[int32]string{
+		0: "ENUM_TYPE_UNSPECIFIED",
+		1: "ENUM_TYPE_STRING",
+		2: "ENUM_TYPE_INT",
+		3: "ENUM_TYPE_TYPED_STRING",
+	}
+	EnumType_value = map[string]int32{
+		"ENUM_TYPE_UNSPECIFIED":  0,
+		"ENUM_TYPE_STRING":       1,
+		"ENUM_TYPE_INT":          2,
+		"ENUM_TYPE_TYPED_STRING": 3,
+	}
+)
+
+func (x EnumType) Enum() *EnumType {
+	p := new(EnumType)
+	*p = x
+	return p
+}
+
+func (x EnumType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumType) Descriptor() protoreflect.EnumDescriptor {
+	return file_puregen_options_proto_enumTypes[0].Descriptor()
+}
+
+func (EnumType) Type() protoreflect.EnumType {
+	return &file_puregen_options_proto_enumTypes[0]
+}
+
+func (x EnumType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumType.Descriptor instead.
+func (EnumType) EnumDescriptor() ([]byte, []int) {
+	return file_puregen_options_proto_rawDescGZIP(), []int{0}
+}
+
+// FileRules apply to every element in the file
+type FileRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Default generation type for all enums in the file
+	EnumType EnumType `protobuf:"varint,1,opt,name=enum_type,json=enumType,proto3,enum=puregen.EnumType" json:"enum_type,omitempty"`
+	// Metadata inherited by elements, as a JSON object scoped by element kind:
+	// {"messages": {...}, "fields": {...}, "enums": {...}, "methods": {...}}
+	Metadata string `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *FileRules) Reset() {
+	*x = FileRules{}
+	mi := &file_puregen_options_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileRules) ProtoMessage() {}
+
+func (x *FileRules) ProtoReflect() protoreflect.Message {
+	mi := &file_puregen_options_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileRules.ProtoReflect.Descriptor instead.
+func (*FileRules) Descriptor() ([]byte, []int) {
+	return file_puregen_options_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FileRules) GetEnumType() EnumType {
+	if x != nil {
+		return x.EnumType
+	}
+	return EnumType_ENUM_TYPE_UNSPECIFIED
+}
+
+func (x *FileRules) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+// MessageRules configure a message
+type MessageRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Metadata as a JSON object, same as puregen:metadata
+	Metadata string `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *MessageRules) Reset() {
+	*x = MessageRules{}
+	mi := &file_puregen_options_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRules) ProtoMessage() {}
+
+func (x *MessageRules) ProtoReflect() protoreflect.Message {
+	mi := &file_puregen_options_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRules.ProtoReflect.Descriptor instead.
+func (*MessageRules) Descriptor() ([]byte, []int) {
+	return file_puregen_options_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MessageRules) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+// FieldRules configure a field
+type FieldRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Default value, same as puregen:generate: {"value": "..."}
+	Default *string `protobuf:"bytes,1,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Metadata as a JSON object, same as puregen:metadata
+	Metadata string `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Mask the field in redacted copies and string renderings, same as
+	// puregen:generate: {"sensitive": true}
+	Sensitive *bool `protobuf:"varint,3,opt,name=sensitive,proto3,oneof" json:"sensitive,omitempty"`
+}
+
+func (x *FieldRules) Reset() {
+	*x = FieldRules{}
+	mi := &file_puregen_options_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldRules) ProtoMessage() {}
+
+func (x *FieldRules) ProtoReflect() protoreflect.Message {
+	mi := &file_puregen_options_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldRules.ProtoReflect.Descriptor instead.
+func (*FieldRules) Descriptor() ([]byte, []int) {
+	return file_puregen_options_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FieldRules) GetDefault() string {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return ""
+}
+
+func (x *FieldRules) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *FieldRules) GetSensitive() bool {
+	if x != nil && x.Sensitive != nil {
+		return *x.Sensitive
+	}
+	return false
+}
+
+// EnumRules configure an enum
+type EnumRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Generation type for this enum
+	EnumType EnumType `protobuf:"varint,1,opt,name=enum_type,json=enumType,proto3,enum=puregen.EnumType" json:"enum_type,omitempty"`
+	// Metadata as a JSON object, same as puregen:metadata
+	Metadata string `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Reject unknown values of a typed_string enum when decoding JSON, same as
+	// puregen:generate: {"strict": true}
+	Strict *bool `protobuf:"varint,3,opt,name=strict,proto3,oneof" json:"strict,omitempty"`
+}
+
+func (x *EnumRules) Reset() {
+	*x = EnumRules{}
+	mi := &file_puregen_options_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnumRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumRules) ProtoMessage() {}
+
+func (x *EnumRules) ProtoReflect() protoreflect.Message {
+	mi := &file_puregen_options_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumRules.ProtoReflect.Descriptor instead.
+func (*EnumRules) Descriptor() ([]byte, []int) {
+	return file_puregen_options_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EnumRules) GetEnumType() EnumType {
+	if x != nil {
+		return x.EnumType
+	}
+	return EnumType_ENUM_TYPE_UNSPECIFIED
+}
+
+func (x *EnumRules) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *EnumRules) GetStrict() bool {
+	if x != nil && x.Strict != nil {
+		return *x.Strict
+	}
+	return false
+}
+
+// HttpRule maps a method to an HTTP endpoint
+type HttpRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// HTTP verb, exposed as the "method" metadata key
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// URL path template, exposed as the "path" metadata key
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *HttpRule) Reset() {
+	*x = HttpRule{}
+	mi := &file_puregen_options_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HttpRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpRule) ProtoMessage() {}
+
+func (x *HttpRule) ProtoReflect() protoreflect.Message {
+	mi := &file_puregen_options_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpRule.ProtoReflect.Descriptor instead.
+func (*HttpRule) Descriptor() ([]byte, []int) {
+	return file_puregen_options_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HttpRule) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *HttpRule) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// MethodRules configure a service method
+type MethodRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// HTTP routing, merged into the method metadata
+	Http *HttpRule `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	// Metadata as a JSON object, same as puregen:metadata
+	Metadata string `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *MethodRules) Reset() {
+	*x = MethodRules{}
+	mi := &file_puregen_options_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MethodRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodRules) ProtoMessage() {}
+
+func (x *MethodRules) ProtoReflect() protoreflect.Message {
+	mi := &file_puregen_options_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodRules.ProtoReflect.Descriptor instead.
+func (*MethodRules) Descriptor() ([]byte, []int) {
+	return file_puregen_options_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MethodRules) GetHttp() *HttpRule {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *MethodRules) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+var file_puregen_options_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*FileRules)(nil),
+		Field:         51701,
+		Name:          "puregen.file",
+		Tag:           "bytes,51701,opt,name=file",
+		Filename:      "puregen/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*MessageRules)(nil),
+		Field:         51702,
+		Name:          "puregen.message",
+		Tag:           "bytes,51702,opt,name=message",
+		Filename:      "puregen/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldRules)(nil),
+		Field:         51703,
+		Name:          "puregen.field",
+		Tag:           "bytes,51703,opt,name=field",
+		Filename:      "puregen/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumOptions)(nil),
+		ExtensionType: (*EnumRules)(nil),
+		Field:         51704,
+		Name:          "puregen.enum",
+		Tag:           "bytes,51704,opt,name=enum",
+		Filename:      "puregen/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*MethodRules)(nil),
+		Field:         51705,
+		Name:          "puregen.method",
+		Tag:           "bytes,51705,opt,name=method",
+		Filename:      "puregen/options.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// optional puregen.FileRules file = 51701;
+	E_File = &file_puregen_options_proto_extTypes[0]
+)
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// optional puregen.MessageRules message = 51702;
+	E_Message = &file_puregen_options_proto_extTypes[1]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional puregen.FieldRules field = 51703;
+	E_Field = &file_puregen_options_proto_extTypes[2]
+)
+
+// Extension fields to descriptorpb.EnumOptions.
+var (
+	// optional puregen.EnumRules enum = 51704;
+	E_Enum = &file_puregen_options_proto_extTypes[3]
+)
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// optional puregen.MethodRules method = 51705;
+	E_Method = &file_puregen_options_proto_extTypes[4]
+)
+
+var File_puregen_options_proto protoreflect.FileDescriptor
+
+var file_puregen_options_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x75, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x70, 0x75, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x57, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x2e, 0x0a, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x75, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x6e, 0x75,
+	0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x65, 0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2a, 0x0a, 0x0c, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x84, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x21, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0x7f,
+	0x0a, 0x09, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x65,
+	0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x70, 0x75, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x08, 0x65, 0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22,
+	0x36, 0x0a, 0x08, 0x48, 0x74, 0x74, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x50, 0x0a, 0x0b, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x75, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x1a, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x6a, 0x0a, 0x08, 0x45, 0x6e, 0x75,
+	0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x14, 0x0a, 0x10, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54,
+	0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x4e, 0x55,
+	0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x44, 0x5f, 0x53, 0x54, 0x52,
+	0x49, 0x4e, 0x47, 0x10, 0x03, 0x3a, 0x46, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf5, 0x93, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x75, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x52, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf6, 0x93, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x3a, 0x4a, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf7, 0x93, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x75, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x46, 0x0a,
+	0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0xf8, 0x93, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x75,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x04, 0x65, 0x6e, 0x75, 0x6d, 0x3a, 0x4e, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0xf9, 0x93, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x75, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x45, 0x0a, 0x18, 0x69, 0x6f, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x6e, 0x6e, 0x61, 0x6e, 0x74, 0x6f, 0x2e, 0x70, 0x75, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x50, 0x01, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6e, 0x6e, 0x61, 0x6e, 0x74, 0x6f, 0x2f, 0x70, 0x75, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x75, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_puregen_options_proto_rawDescOnce sync.Once
+	file_puregen_options_proto_rawDescData = file_puregen_options_proto_rawDesc
+)
+
+func file_puregen_options_proto_rawDescGZIP() []byte {
+	file_puregen_options_proto_rawDescOnce.Do(func() {
+		file_puregen_options_proto_rawDescData = protoimpl.X.CompressGZIP(file_puregen_options_proto_rawDescData)
+	})
+	return file_puregen_options_proto_rawDescData
+}
+
+var file_puregen_options_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_puregen_options_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_puregen_options_proto_goTypes = []any{
+	(EnumType)(0),                       // 0: puregen.EnumType
+	(*FileRules)(nil),                   // 1: puregen.FileRules
+	(*MessageRules)(nil),                // 2: puregen.MessageRules
+	(*FieldRules)(nil),                  // 3: puregen.FieldRules
+	(*EnumRules)(nil),                   // 4: puregen.EnumRules
+	(*HttpRule)(nil),                    // 5: puregen.HttpRule
+	(*MethodRules)(nil),                 // 6: puregen.MethodRules
+	(*descriptorpb.FileOptions)(nil),    // 7: google.protobuf.FileOptions
+	(*descriptorpb.MessageOptions)(nil), // 8: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),   // 9: google.protobuf.FieldOptions
+	(*descriptorpb.EnumOptions)(nil),    // 10: google.protobuf.EnumOptions
+	(*descriptorpb.MethodOptions)(nil),  // 11: google.protobuf.MethodOptions
+}
+var file_puregen_options_proto_depIdxs = []int32{
+	0,  // 0: puregen.FileRules.enum_type:type_name -> puregen.EnumType
+	0,  // 1: puregen.EnumRules.enum_type:type_name -> puregen.EnumType
+	5,  // 2: puregen.MethodRules.http:type_name -> puregen.HttpRule
+	7,  // 3: puregen.file:extendee -> google.protobuf.FileOptions
+	8,  // 4: puregen.message:extendee -> google.protobuf.MessageOptions
+	9,  // 5: puregen.field:extendee -> google.protobuf.FieldOptions
+	10, // 6: puregen.enum:extendee -> google.protobuf.EnumOptions
+	11, // 7: puregen.method:extendee -> google.protobuf.MethodOptions
+	1,  // 8: puregen.file:type_name -> puregen.FileRules
+	2,  // 9: puregen.message:type_name -> puregen.MessageRules
+	3,  // 10: puregen.field:type_name -> puregen.FieldRules
+	4,  // 11: puregen.enum:type_name -> puregen.EnumRules
+	6,  // 12: puregen.method:type_name -> puregen.MethodRules
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	8,  // [8:13] is the sub-list for extension type_name
+	3,  // [3:8] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_puregen_options_proto_init() }
+func file_puregen_options_proto_init() {
+	if File_puregen_options_proto != nil {
+		return
+	}
+	file_puregen_options_proto_msgTypes[2].OneofWrappers = []any{}
+	file_puregen_options_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_puregen_options_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 5,
+			NumServices:   0,
+		},
+		GoTypes:           file_puregen_options_proto_goTypes,
+		DependencyIndexes: file_puregen_options_proto_depIdxs,
+		EnumInfos:         file_puregen_options_proto_enumTypes,
+		MessageInfos:      file_puregen_options_proto_msgTypes,
+		ExtensionInfos:    file_puregen_options_proto_extTypes,
+	}.Build()
+	File_puregen_options_proto = out.File
+	file_puregen_options_proto_rawDesc = nil
+	file_puregen_options_proto_goTypes = nil
+	file_puregen_options_proto_depIdxs = nil
+}